@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/infobloxopen/protoc-gen-gorm/txn"
+	"github.com/jinzhu/gorm"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Record is a row of the audit table written by the update and delete
+// handlers of the types with the audit option
+type Record struct {
+	ID uint64 `gorm:"primary_key"`
+	// Resource is the table of the audited row and Key its primary key
+	Resource  string
+	Key       string
+	Operation string
+	Actor     string
+	// Before and After are the protojson of the row, empty when the row did
+	// not exist on that side of the operation
+	Before    string `gorm:"type:text"`
+	After     string `gorm:"type:text"`
+	CreatedAt time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (Record) TableName() string {
+	return "audit_records"
+}
+
+// Txn is the transaction scope the mutation and its audit record are
+// written in
+type Txn = txn.Scope
+
+// Begin returns db if it is in a transaction already, otherwise a new
+// transaction is opened and it must be finished by the returned Txn.
+func Begin(ctx context.Context, db *gorm.DB) (*gorm.DB, *Txn, error) {
+	return txn.Begin(ctx, db, nil)
+}
+
+// Write inserts the record with the before and after state of the row, a nil
+// message stands for a missing row. Nothing is written if both are equal.
+func Write(db *gorm.DB, record Record, before, after proto.Message) error {
+	if before != nil && after != nil && proto.Equal(before, after) {
+		return nil
+	}
+	for _, state := range []struct {
+		msg proto.Message
+		to  *string
+	}{{before, &record.Before}, {after, &record.After}} {
+		if state.msg == nil {
+			continue
+		}
+		data, err := protojson.Marshal(state.msg)
+		if err != nil {
+			return err
+		}
+		*state.to = string(data)
+	}
+	return db.Create(&record).Error
+}
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func openDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("gorm.Open=%v; want success", err)
+	}
+	// every connection has a database of its own
+	db.DB().SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&Record{}).Error; err != nil {
+		t.Fatalf("AutoMigrate=%v; want success", err)
+	}
+	return db
+}
+
+func TestWrite(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	record := Record{Resource: "notes", Key: "1", Operation: "update", Actor: "alice"}
+	if err := Write(db, record, wrapperspb.String("before"), wrapperspb.String("after")); err != nil {
+		t.Fatalf("Write=%v; want success", err)
+	}
+	var stored []Record
+	if err := db.Find(&stored).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 {
+		t.Fatalf("records=%d; want 1", len(stored))
+	}
+	got := stored[0]
+	if got.Resource != "notes" || got.Key != "1" || got.Operation != "update" || got.Actor != "alice" {
+		t.Errorf("record=%+v; want the resource, key, operation and actor written", got)
+	}
+	if !strings.Contains(got.Before, `"before"`) || !strings.Contains(got.After, `"after"`) {
+		t.Errorf("Before=%q, After=%q; want the protojson of the states", got.Before, got.After)
+	}
+}
+
+func TestWriteMissingRow(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	if err := Write(db, Record{Resource: "notes", Key: "1", Operation: "delete"}, wrapperspb.String("before"), nil); err != nil {
+		t.Fatalf("Write=%v; want success", err)
+	}
+	var stored Record
+	if err := db.First(&stored).Error; err != nil {
+		t.Fatal(err)
+	}
+	if stored.Before == "" || stored.After != "" {
+		t.Errorf("Before=%q, After=%q; want only the state before", stored.Before, stored.After)
+	}
+}
+
+func TestWriteUnchanged(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	if err := Write(db, Record{Resource: "notes", Key: "1", Operation: "update"}, wrapperspb.String("same"), wrapperspb.String("same")); err != nil {
+		t.Fatalf("Write=%v; want success", err)
+	}
+	var count int
+	if err := db.Model(&Record{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("records=%d; want none for an unchanged row", count)
+	}
+}
+
+func TestBegin(t *testing.T) {
+	db := openDB(t)
+	defer db.Close()
+	tx, txn, err := Begin(context.Background(), db)
+	if err != nil {
+		t.Fatalf("Begin=%v; want success", err)
+	}
+	if err := Write(tx, Record{Resource: "notes", Key: "1", Operation: "create"}, nil, wrapperspb.String("after")); err != nil {
+		t.Fatalf("Write=%v; want success", err)
+	}
+	// the record is discarded with the mutation
+	txn.Rollback()
+	var count int
+	if err := db.Model(&Record{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 0 {
+		t.Errorf("records=%d; want none after the rollback", count)
+	}
+}
@@ -0,0 +1,156 @@
+package changes
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Op is the kind of write of a change
+type Op int
+
+const (
+	Create Op = iota + 1
+	Update
+	Delete
+)
+
+func (op Op) String() string {
+	switch op {
+	case Create:
+		return "create"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Policy is what a write does when the buffer of a subscriber is full
+type Policy int
+
+const (
+	// Drop discards the change for the subscriber, the write never waits
+	Drop Policy = iota
+	// Block makes the write wait until the subscriber received the change or
+	// its context is done, a subscriber of Block must not write the type
+	// itself while it is not receiving
+	Block
+)
+
+// Options configures a subscription, the zero Options is an unbuffered
+// channel dropping the changes the subscriber is not waiting for
+type Options struct {
+	// Buffer is the capacity of the channel of the subscriber
+	Buffer int
+	// Policy is what a write does when the channel is full
+	Policy Policy
+}
+
+// Broker fans the changes of a type out to its subscribers, the zero Broker
+// has none
+type Broker struct {
+	mu   sync.RWMutex
+	subs map[*subscriber]bool
+}
+
+type subscriber struct {
+	// mu serializes the sends, keeping the order of the changes, with the
+	// closing of the channel
+	mu     sync.Mutex
+	ch     reflect.Value
+	done   <-chan struct{}
+	policy Policy
+	closed bool
+}
+
+// Subscribe adds ch, a channel of the change events of the broker, e.g. a
+// chan IntPointChangeEvent, as a subscriber until ctx is done, then closes it
+func (b *Broker) Subscribe(ctx context.Context, ch interface{}, policy Policy) {
+	sub := &subscriber{ch: reflect.ValueOf(ch), done: ctx.Done(), policy: policy}
+	b.mu.Lock()
+	if b.subs == nil {
+		b.subs = make(map[*subscriber]bool)
+	}
+	b.subs[sub] = true
+	b.mu.Unlock()
+	go func() {
+		<-sub.done
+		b.mu.Lock()
+		delete(b.subs, sub)
+		b.mu.Unlock()
+		// a send waiting for the subscriber returns as ctx is done
+		sub.mu.Lock()
+		sub.closed = true
+		sub.ch.Close()
+		sub.mu.Unlock()
+	}()
+}
+
+// Subscribed reports whether the broker has subscribers, sparing the
+// conversion of a change nobody receives
+func (b *Broker) Subscribed() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subs) > 0
+}
+
+// Publish sends event, of the element type of the channels, to each
+// subscriber by its policy
+func (b *Broker) Publish(event interface{}) {
+	b.mu.RLock()
+	subs := make([]*subscriber, 0, len(b.subs))
+	for sub := range b.subs {
+		subs = append(subs, sub)
+	}
+	b.mu.RUnlock()
+	value := reflect.ValueOf(event)
+	for _, sub := range subs {
+		sub.send(value)
+	}
+}
+
+func (s *subscriber) send(value reflect.Value) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectSend, Chan: s.ch, Send: value},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(s.done)},
+	}
+	if s.policy == Drop {
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectDefault})
+	}
+	reflect.Select(cases)
+}
+
+// Register adds callbacks to db calling publish with each object created,
+// updated or deleted through it once the write succeeded, after the commit of
+// its own transaction. The writes inside a transaction of the caller publish
+// at the write, whether or not it is committed. Writes of no rows, writes by a
+// condition rather than of an object with a primary key, e.g. of a batch, and
+// raw SQL publish nothing. The name marks the registration, registering the
+// name again has no effect.
+func Register(db *gorm.DB, name string, publish func(op Op, value interface{}) error) {
+	if db.Callback().Create().Get(name) != nil {
+		return
+	}
+	callback := func(op Op) func(*gorm.Scope) {
+		return func(scope *gorm.Scope) {
+			if scope.HasError() || scope.PrimaryKeyZero() || scope.DB().RowsAffected == 0 {
+				return
+			}
+			if err := publish(op, scope.Value); err != nil {
+				scope.Log(err)
+			}
+		}
+	}
+	db.Callback().Create().After("gorm:commit_or_rollback_transaction").Register(name, callback(Create))
+	db.Callback().Update().After("gorm:commit_or_rollback_transaction").Register(name, callback(Update))
+	db.Callback().Delete().After("gorm:commit_or_rollback_transaction").Register(name, callback(Delete))
+}
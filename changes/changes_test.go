@@ -0,0 +1,127 @@
+package changes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+type change struct {
+	op    Op
+	value interface{}
+}
+
+type note struct {
+	ID   uint64 `gorm:"primary_key"`
+	Text string
+}
+
+func TestOpString(t *testing.T) {
+	for op, want := range map[Op]string{Create: "create", Update: "update", Delete: "delete", Op(0): "unknown"} {
+		if got := op.String(); got != want {
+			t.Errorf("Op(%d).String()=%q; want %q", op, got, want)
+		}
+	}
+}
+
+func TestBrokerPublish(t *testing.T) {
+	var broker Broker
+	if broker.Subscribed() {
+		t.Fatal("Subscribed()=true; want false for the zero Broker")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan string, 2)
+	broker.Subscribe(ctx, ch, Drop)
+	if !broker.Subscribed() {
+		t.Fatal("Subscribed()=false; want true")
+	}
+	broker.Publish("first")
+	broker.Publish("second")
+	// the buffer is full, the change is dropped
+	broker.Publish("third")
+	if got := <-ch; got != "first" {
+		t.Errorf("received %q; want first", got)
+	}
+	if got := <-ch; got != "second" {
+		t.Errorf("received %q; want second", got)
+	}
+
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Error("received a change; want the channel closed once ctx is done")
+	}
+	for broker.Subscribed() {
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBrokerBlock(t *testing.T) {
+	var broker Broker
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := make(chan int)
+	broker.Subscribe(ctx, ch, Block)
+	done := make(chan struct{})
+	go func() {
+		broker.Publish(1)
+		close(done)
+	}()
+	// the publish waits for the subscriber
+	if got := <-ch; got != 1 {
+		t.Errorf("received %d; want 1", got)
+	}
+	<-done
+}
+
+func TestRegister(t *testing.T) {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("gorm.Open=%v; want success", err)
+	}
+	defer db.Close()
+	db.DB().SetMaxOpenConns(1)
+	if err := db.AutoMigrate(&note{}).Error; err != nil {
+		t.Fatalf("AutoMigrate=%v; want success", err)
+	}
+	var published []change
+	publish := func(op Op, value interface{}) error {
+		published = append(published, change{op, value})
+		return nil
+	}
+	Register(db, "notes:changes", publish)
+	// registering the name again has no effect
+	Register(db, "notes:changes", publish)
+
+	n := &note{Text: "a"}
+	if err := db.Create(n).Error; err != nil {
+		t.Fatal(err)
+	}
+	n.Text = "b"
+	if err := db.Save(n).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Delete(n).Error; err != nil {
+		t.Fatal(err)
+	}
+	// a write by a condition publishes nothing
+	if err := db.Model(&note{}).Where("text = ?", "b").Update("text", "c").Error; err != nil {
+		t.Fatal(err)
+	}
+	// nor does a write of no rows
+	if err := db.Delete(&note{ID: 42}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Op{Create, Update, Delete}
+	if len(published) != len(want) {
+		t.Fatalf("published %v; want %v", published, want)
+	}
+	for i, op := range want {
+		if published[i].op != op || published[i].value != n {
+			t.Errorf("change %d=%v; want %v of the note", i, published[i], op)
+		}
+	}
+}
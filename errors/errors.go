@@ -1,6 +1,13 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
 var EmptyIdError = errors.New("id is empty")
 
@@ -8,4 +15,94 @@ var NilArgumentError = errors.New("argument is nil")
 
 var NoTransactionError = errors.New("transaction is not opened")
 
+var UnknownSortColumnError = errors.New("unknown sort column")
+
+var UnknownSelectColumnError = errors.New("unknown select column")
+
+var UnknownUpdateFieldError = errors.New("unknown update field")
+
+var UnknownPreloadError = errors.New("unknown preload association")
+
+var InvalidCursorError = errors.New("invalid cursor")
+
+var ImmutableError = errors.New("object is immutable")
+
+var InvalidOrderError = errors.New("invalid order")
+
+var MissingReferenceError = errors.New("referenced row does not exist")
+
+var ConditionFailedError = errors.New("row does not have the expected value")
+
 var BadRepeatedFieldMaskTpl = "unexpected fieldmask count %d for objects count %d"
+
+// IsUniqueViolation reports whether err is a unique constraint violation
+// returned by the postgres driver
+func IsUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// IsConflict reports whether err is a serialization failure or a deadlock
+// returned by the postgres driver, a concurrent write won and the
+// transaction can be retried
+func IsConflict(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && (pqErr.Code == "40001" || pqErr.Code == "40P01")
+}
+
+// IsLockNotAvailable reports whether err is the lock_not_available error of
+// the postgres driver, returned by a NOWAIT locking read of a locked row
+func IsLockNotAvailable(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "55P03"
+}
+
+// Status converts err to a gRPC status error, a missing record is NotFound,
+// a unique violation AlreadyExists, an invalid argument of the generated
+// handlers InvalidArgument, an update of an immutable object
+// FailedPrecondition and a conflict, a locked row or a failed compare and
+// swap Aborted. The
+// converted error still unwraps to err, errors with a status already and
+// other errors are returned as is.
+func Status(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var code codes.Code
+	switch {
+	case gorm.IsRecordNotFoundError(err):
+		code = codes.NotFound
+	case IsUniqueViolation(err):
+		code = codes.AlreadyExists
+	case errors.Is(err, EmptyIdError), errors.Is(err, NilArgumentError), errors.Is(err, UnknownSortColumnError), errors.Is(err, UnknownSelectColumnError), errors.Is(err, UnknownUpdateFieldError), errors.Is(err, UnknownPreloadError), errors.Is(err, InvalidCursorError), errors.Is(err, InvalidOrderError), errors.Is(err, MissingReferenceError):
+		code = codes.InvalidArgument
+	case errors.Is(err, ImmutableError):
+		code = codes.FailedPrecondition
+	case IsConflict(err), IsLockNotAvailable(err), errors.Is(err, ConditionFailedError):
+		code = codes.Aborted
+	default:
+		return err
+	}
+	return &statusError{status: status.New(code, err.Error()), err: err}
+}
+
+type statusError struct {
+	status *status.Status
+	err    error
+}
+
+func (e *statusError) Error() string {
+	return e.status.Err().Error()
+}
+
+// GRPCStatus is the status returned by status.FromError
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,101 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatus(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{gorm.ErrRecordNotFound, codes.NotFound},
+		{&pq.Error{Code: "23505"}, codes.AlreadyExists},
+		{fmt.Errorf("reading: %w", EmptyIdError), codes.InvalidArgument},
+		{UnknownSortColumnError, codes.InvalidArgument},
+		{fmt.Errorf("%w \"secret\"", UnknownSelectColumnError), codes.InvalidArgument},
+		{fmt.Errorf("%w \"Id\"", UnknownUpdateFieldError), codes.InvalidArgument},
+		{fmt.Errorf("%w \"Secrets\"", UnknownPreloadError), codes.InvalidArgument},
+		{fmt.Errorf("%w: no IntPoint of point_id 7", MissingReferenceError), codes.InvalidArgument},
+		{fmt.Errorf("%w: bad", InvalidCursorError), codes.InvalidArgument},
+		{fmt.Errorf("%w: 3 is listed twice", InvalidOrderError), codes.InvalidArgument},
+		{fmt.Errorf("%w: ledger entry", ImmutableError), codes.FailedPrecondition},
+		{&pq.Error{Code: "40001"}, codes.Aborted},
+		{&pq.Error{Code: "55P03"}, codes.Aborted},
+		{fmt.Errorf("%w: status is not PENDING", ConditionFailedError), codes.Aborted},
+		{status.Error(codes.PermissionDenied, "denied"), codes.PermissionDenied},
+		{errors.New("other"), codes.Unknown},
+	} {
+		if code := status.Code(Status(tc.err)); code != tc.code {
+			t.Errorf("Status(%v)=%v; want %v", tc.err, code, tc.code)
+		}
+	}
+	if err := Status(EmptyIdError); !errors.Is(err, EmptyIdError) {
+		t.Errorf("Status(EmptyIdError)=%v does not unwrap to EmptyIdError", err)
+	}
+	if Status(nil) != nil {
+		t.Error("Status(nil) is not nil")
+	}
+}
+
+// fieldError is a validation error of protoc-gen-validate
+type fieldError struct {
+	field, reason string
+	cause         error
+}
+
+func (e fieldError) Error() string  { return e.field + ": " + e.reason }
+func (e fieldError) Field() string  { return e.field }
+func (e fieldError) Reason() string { return e.reason }
+func (e fieldError) Cause() error   { return e.cause }
+
+type multiError []error
+
+func (m multiError) Error() string      { return fmt.Sprint([]error(m)) }
+func (m multiError) AllErrors() []error { return m }
+
+type validated struct{ err error }
+
+func (v validated) ValidateAll() error { return v.err }
+
+func TestValidate(t *testing.T) {
+	if err := Validate(validated{}); err != nil {
+		t.Errorf("Validate of a valid message=%v; want nil", err)
+	}
+	if err := Validate("not validated"); err != nil {
+		t.Errorf("Validate of no validation=%v; want nil", err)
+	}
+	err := Validate(validated{multiError{
+		fieldError{field: "name", reason: "value length must be at least 1 runes"},
+		fieldError{field: "ship_to", reason: "embedded message failed validation", cause: multiError{
+			fieldError{field: "zip", reason: "value must be greater than 0"},
+		}},
+	}})
+	st, ok := status.FromError(Status(err))
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("Validate=%v; want an InvalidArgument status", err)
+	}
+	var violations []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				violations = append(violations, v.Field+"="+v.Description)
+			}
+		}
+	}
+	if want := []string{"name=value length must be at least 1 runes", "ship_to.zip=value must be greater than 0"}; fmt.Sprint(violations) != fmt.Sprint(want) {
+		t.Errorf("BadRequest violations=%q; want %q", violations, want)
+	}
+	other := errors.New("invalid")
+	if err := Validate(validated{other}); !errors.Is(err, other) || err.(*ValidationError).Violations[0].Description != "invalid" {
+		t.Errorf("Validate of a plain error=%v; want a violation unwrapping to it", err)
+	}
+}
@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidationError is a message failing its validation, with a violation per
+// field. It is an InvalidArgument status error with the violations in a
+// google.rpc.BadRequest detail.
+type ValidationError struct {
+	Violations []*errdetails.BadRequest_FieldViolation
+	err        error
+}
+
+func (e *ValidationError) Error() string {
+	var violations []string
+	for _, v := range e.Violations {
+		if v.Field == "" {
+			violations = append(violations, v.Description)
+		} else {
+			violations = append(violations, v.Field+": "+v.Description)
+		}
+	}
+	return "invalid argument: " + strings.Join(violations, "; ")
+}
+
+// GRPCStatus is the status returned by status.FromError
+func (e *ValidationError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	if detailed, err := st.WithDetails(&errdetails.BadRequest{FieldViolations: e.Violations}); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// Unwrap returns the error of the validator
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
+// Validate validates m with the ValidateAll method generated by
+// protoc-gen-validate, or Validate if it has no ValidateAll, and returns a
+// ValidationError of all the violations, nil for a valid message or one
+// without validation. Each violation with a Field and a Reason, as the
+// validation errors of protoc-gen-validate have, is a field violation, the
+// violations of an embedded message are given by their dotted path.
+func Validate(m interface{}) error {
+	var err error
+	switch v := m.(type) {
+	case interface{ ValidateAll() error }:
+		err = v.ValidateAll()
+	case interface{ Validate() error }:
+		err = v.Validate()
+	}
+	if err == nil {
+		return nil
+	}
+	return &ValidationError{Violations: fieldViolations("", err), err: err}
+}
+
+// fieldViolations returns the violations of err, of the fields below the
+// path prefix
+func fieldViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	if multi, ok := err.(interface{ AllErrors() []error }); ok {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, err := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(prefix, err)...)
+		}
+		return violations
+	}
+	field, ok := err.(interface {
+		Field() string
+		Reason() string
+	})
+	if !ok {
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+	path := field.Field()
+	if prefix != "" {
+		path = prefix + "." + path
+	}
+	// the violations of an embedded message are the cause of its own
+	if wrapper, ok := err.(interface{ Cause() error }); ok && wrapper.Cause() != nil {
+		if _, ok := wrapper.Cause().(interface{ Field() string }); ok {
+			return fieldViolations(path, wrapper.Cause())
+		}
+		if _, ok := wrapper.Cause().(interface{ AllErrors() []error }); ok {
+			return fieldViolations(path, wrapper.Cause())
+		}
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: field.Reason()}}
+}
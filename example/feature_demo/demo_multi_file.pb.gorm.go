@@ -2,13 +2,22 @@ package example
 
 import (
 	context "context"
+	sql "database/sql"
 	fmt "fmt"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	explain "github.com/infobloxopen/protoc-gen-gorm/explain"
+	returning "github.com/infobloxopen/protoc-gen-gorm/returning"
+	types "github.com/infobloxopen/protoc-gen-gorm/types"
 	gorm "github.com/jinzhu/gorm"
+	pq "github.com/lib/pq"
 	go_uuid "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	strconv "strconv"
+	strings "strings"
 )
 
 type ExternalChildORM struct {
@@ -23,6 +32,37 @@ func (ExternalChildORM) TableName() string {
 	return "external_children"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *ExternalChildORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *ExternalChildORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := ExternalChildORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// ExternalChildORMIndexes lists the indexes declared by the gorm tags of ExternalChildORM
+var ExternalChildORMIndexes = []types.IndexDef{}
+
+// applyExternalChildConditionalPreloads preloads the associations of the conditional
+// preloads of ExternalChild in ctx whose condition holds
+func applyExternalChildConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "ExternalChild") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *ExternalChild) ToORM(ctx context.Context) (ExternalChildORM, error) {
@@ -57,6 +97,119 @@ func (m *ExternalChildORM) ToPB(ctx context.Context) (ExternalChild, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *ExternalChild) MergeToORM(ctx context.Context, dst *ExternalChildORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *ExternalChild) ApplyFieldMaskToORM(ctx context.Context, dst *ExternalChildORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *ExternalChildORM) applyFieldMaskPath(from *ExternalChildORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffExternalChild returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffExternalChild(old, new *ExternalChildORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &ExternalChildORM{}
+	}
+	if new == nil {
+		new = &ExternalChildORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	return mask
+}
+
+// ExternalChildSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func ExternalChildSliceToORM(ctx context.Context, in []*ExternalChild) ([]*ExternalChildORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*ExternalChildORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// ExternalChildORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func ExternalChildORMSliceToPB(ctx context.Context, in []*ExternalChildORM) ([]*ExternalChild, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*ExternalChild, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type ExternalChild the arg will be the target, the caller the one being converted from
 
@@ -81,9 +234,10 @@ type ExternalChildWithAfterToPB interface {
 }
 
 type BlogPostORM struct {
-	Author string
-	Id     uint64
-	Title  string
+	Author   string
+	AuthorId string
+	Id       uint64 `gorm:"primary_key;auto_increment:false"`
+	Title    string
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -91,6 +245,37 @@ func (BlogPostORM) TableName() string {
 	return "blog_posts"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *BlogPostORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *BlogPostORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := BlogPostORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// BlogPostORMIndexes lists the indexes declared by the gorm tags of BlogPostORM
+var BlogPostORMIndexes = []types.IndexDef{}
+
+// applyBlogPostConditionalPreloads preloads the associations of the conditional
+// preloads of BlogPost in ctx whose condition holds
+func applyBlogPostConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "BlogPost") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *BlogPost) ToORM(ctx context.Context) (BlogPostORM, error) {
@@ -104,6 +289,7 @@ func (m *BlogPost) ToORM(ctx context.Context) (BlogPostORM, error) {
 	to.Id = m.Id
 	to.Title = m.Title
 	to.Author = m.Author
+	to.AuthorId = m.AuthorId
 	if posthook, ok := interface{}(m).(BlogPostWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -123,12 +309,156 @@ func (m *BlogPostORM) ToPB(ctx context.Context) (BlogPost, error) {
 	to.Id = m.Id
 	to.Title = m.Title
 	to.Author = m.Author
+	to.AuthorId = m.AuthorId
 	if posthook, ok := interface{}(m).(BlogPostWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *BlogPost) MergeToORM(ctx context.Context, dst *BlogPostORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Title = to.Title
+	dst.Author = to.Author
+	dst.AuthorId = to.AuthorId
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *BlogPost) ApplyFieldMaskToORM(ctx context.Context, dst *BlogPostORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *BlogPostORM) applyFieldMaskPath(from *BlogPostORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Title":
+		if rest != "" {
+			return false
+		}
+		m.Title = from.Title
+		return true
+	case "Author":
+		if rest != "" {
+			return false
+		}
+		m.Author = from.Author
+		return true
+	case "AuthorId":
+		if rest != "" {
+			return false
+		}
+		m.AuthorId = from.AuthorId
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffBlogPost returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffBlogPost(old, new *BlogPostORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &BlogPostORM{}
+	}
+	if new == nil {
+		new = &BlogPostORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Title != new.Title {
+		mask.Paths = append(mask.Paths, "Title")
+	}
+	if old.Author != new.Author {
+		mask.Paths = append(mask.Paths, "Author")
+	}
+	if old.AuthorId != new.AuthorId {
+		mask.Paths = append(mask.Paths, "AuthorId")
+	}
+	return mask
+}
+
+// BlogPostSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func BlogPostSliceToORM(ctx context.Context, in []*BlogPost) ([]*BlogPostORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*BlogPostORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// BlogPostORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func BlogPostORMSliceToPB(ctx context.Context, in []*BlogPostORM) ([]*BlogPost, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*BlogPost, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type BlogPost the arg will be the target, the caller the one being converted from
 
@@ -152,6 +482,19 @@ type BlogPostWithAfterToPB interface {
 	AfterToPB(context.Context, *BlogPost) error
 }
 
+// DemoMultiFileSchemaHash identifies the schema of the ORM types defined in demo_multi_file.proto
+const DemoMultiFileSchemaHash = "56a04f346c0f443d742bd05e27ab01a919cb3782943cd04e92b18969e7b9b46b"
+
+// RegisterDemoMultiFileCallbacks registers the GORM callbacks of the ORM types defined
+// in demo_multi_file.proto, registering them again replaces the previous ones
+func RegisterDemoMultiFileCallbacks(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	returning.Register(db)
+	return nil
+}
+
 // DefaultCreateExternalChild executes a basic gorm create call
 func DefaultCreateExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
@@ -166,7 +509,8 @@ func DefaultCreateExternalChild(ctx context.Context, in *ExternalChild, db *gorm
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterCreate_); ok {
@@ -185,6 +529,174 @@ type ExternalChildORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCopyFromExternalChild inserts the objects with a COPY into the external_children table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateExternalChildSet
+// leaves them to the DB.
+func DefaultCopyFromExternalChild(ctx context.Context, in []*ExternalChild, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]ExternalChildORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into external_children takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into external_children needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("external_children", "id", "primary_included_id", "primary_string_type_id", "primary_uuid_type_id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id, row.PrimaryIncludedId, row.PrimaryStringTypeId, row.PrimaryUUIDTypeId); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// ExternalChildORMMaxBatchSize is the most rows of an INSERT of DefaultCreateExternalChildSet,
+// postgres takes 65535 bind parameters in a statement and a row has 4
+const ExternalChildORMMaxBatchSize = 16383
+
+// DefaultCreateExternalChildSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most ExternalChildORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateExternalChildSet(ctx context.Context, in []*ExternalChild, db *gorm.DB, batchSize int) ([]*ExternalChild, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > ExternalChildORMMaxBatchSize {
+		batchSize = ExternalChildORMMaxBatchSize
+	}
+	rows := make([]ExternalChildORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*ExternalChildORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*4)
+			for _, row := range batch {
+				values := []interface{}{row.Id, row.PrimaryIncludedId, row.PrimaryStringTypeId, row.PrimaryUUIDTypeId}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*ExternalChildORM
+			if err := tx.Raw(`INSERT INTO "external_children" ("id", "primary_included_id", "primary_string_type_id", "primary_uuid_type_id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ExternalChildORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertExternalChildSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most ExternalChildORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertExternalChildSet(ctx context.Context, in []*ExternalChild, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > ExternalChildORMMaxBatchSize {
+		batchSize = ExternalChildORMMaxBatchSize
+	}
+	rows := make([]ExternalChildORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*4)
+			for _, row := range batch {
+				values := []interface{}{row.Id, row.PrimaryIncludedId, row.PrimaryStringTypeId, row.PrimaryUUIDTypeId}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "external_children" ("id", "primary_included_id", "primary_string_type_id", "primary_uuid_type_id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO UPDATE SET "primary_included_id" = EXCLUDED."primary_included_id", "primary_string_type_id" = EXCLUDED."primary_string_type_id", "primary_uuid_type_id" = EXCLUDED."primary_uuid_type_id"`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
 func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -204,6 +716,9 @@ func DefaultReadExternalChild(ctx context.Context, in *ExternalChild, db *gorm.D
 	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ExternalChildORM{}); err != nil {
 		return nil, err
 	}
+	if db, err = applyExternalChildConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
@@ -232,7 +747,44 @@ type ExternalChildORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
+// DefaultReadExternalChildForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadExternalChildForUpdate(ctx context.Context, in *ExternalChild, db *gorm.DB, wait types.LockWait) (*ExternalChild, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := ExternalChildORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
 func DefaultDeleteExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) error {
+	return defaultDeleteExternalChild(ctx, in, db, nil)
+}
+
+// DefaultDeleteExternalChildWithResult is DefaultDeleteExternalChild reporting the affected rows,
+// no affected rows means no ExternalChild matched
+func DefaultDeleteExternalChildWithResult(ctx context.Context, in *ExternalChild, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteExternalChild(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -248,10 +800,14 @@ func DefaultDeleteExternalChild(ctx context.Context, in *ExternalChild, db *gorm
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&ExternalChildORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&ExternalChildORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
@@ -305,6 +861,18 @@ type ExternalChildORMWithAfterDeleteSet interface {
 
 // DefaultStrictUpdateExternalChild clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, error) {
+	return defaultStrictUpdateExternalChild(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateExternalChildWithResult is DefaultStrictUpdateExternalChild reporting the affected rows
+// and whether the ExternalChild existed before the update
+func DefaultStrictUpdateExternalChildWithResult(ctx context.Context, in *ExternalChild, db *gorm.DB) (*ExternalChild, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateExternalChild(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db *gorm.DB, result *types.WriteResult) (*ExternalChild, error) {
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExternalChild")
 	}
@@ -325,9 +893,14 @@ func DefaultStrictUpdateExternalChild(ctx context.Context, in *ExternalChild, db
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
@@ -447,8 +1020,9 @@ func DefaultApplyFieldMaskExternalChild(ctx context.Context, patchee *ExternalCh
 	return patchee, nil
 }
 
-// DefaultListExternalChild executes a gorm list call
-func DefaultListExternalChild(ctx context.Context, db *gorm.DB) ([]*ExternalChild, error) {
+// DefaultListExternalChild executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListExternalChild(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*ExternalChild, error) {
 	in := ExternalChild{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -463,12 +1037,15 @@ func DefaultListExternalChild(ctx context.Context, db *gorm.DB) ([]*ExternalChil
 	if err != nil {
 		return nil, err
 	}
+	if db, err = applyExternalChildConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
 	ormResponse := []ExternalChildORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -500,6 +1077,85 @@ type ExternalChildORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]ExternalChildORM) error
 }
 
+// DefaultExplainListExternalChild returns the SELECT DefaultListExternalChild would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListExternalChild(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := ExternalChild{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ExternalChildORM{}, &ExternalChild{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyExternalChildConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &ExternalChildORM{})
+}
+
+// ExternalChildORMSelectable lists the columns accepted by DefaultSelectExternalChild
+var ExternalChildORMSelectable = map[string]struct{}{
+	"id":                     {},
+	"primary_included_id":    {},
+	"primary_string_type_id": {},
+	"primary_uuid_type_id":   {},
+}
+
+// DefaultSelectExternalChild runs the query of DefaultListExternalChild for the columns only, which
+// must be in ExternalChildORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectExternalChild(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := ExternalChildORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "external_children."+column)
+	}
+	in := ExternalChild{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ExternalChildORM{}, &ExternalChild{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyExternalChildConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExternalChildORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&ExternalChildORM{}).Select(selected).Rows()
+}
+
 // DefaultCreateBlogPost executes a basic gorm create call
 func DefaultCreateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
@@ -509,12 +1165,16 @@ func DefaultCreateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*Blo
 	if err != nil {
 		return nil, err
 	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterCreate_); ok {
@@ -533,6 +1193,190 @@ type BlogPostORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCopyFromBlogPost inserts the objects with a COPY into the blog_posts table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateBlogPostSet
+// leaves them to the DB.
+func DefaultCopyFromBlogPost(ctx context.Context, in []*BlogPost, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]BlogPostORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == 0 {
+			return 0, fmt.Errorf("COPY into blog_posts takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into blog_posts needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("blog_posts", "author", "author_id", "id", "title"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Author, row.AuthorId, row.Id, row.Title); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// BlogPostORMMaxBatchSize is the most rows of an INSERT of DefaultCreateBlogPostSet,
+// postgres takes 65535 bind parameters in a statement and a row has 4
+const BlogPostORMMaxBatchSize = 16383
+
+// DefaultCreateBlogPostSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most BlogPostORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateBlogPostSet(ctx context.Context, in []*BlogPost, db *gorm.DB, batchSize int) ([]*BlogPost, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > BlogPostORMMaxBatchSize {
+		batchSize = BlogPostORMMaxBatchSize
+	}
+	rows := make([]BlogPostORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*BlogPostORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*4)
+			for _, row := range batch {
+				values := []interface{}{row.Author, row.AuthorId, row.Id, row.Title}
+				if row.Id == 0 {
+					values[2] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*BlogPostORM
+			if err := tx.Raw(`INSERT INTO "blog_posts" ("author", "author_id", "id", "title") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return BlogPostORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertBlogPostSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most BlogPostORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertBlogPostSet(ctx context.Context, in []*BlogPost, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > BlogPostORMMaxBatchSize {
+		batchSize = BlogPostORMMaxBatchSize
+	}
+	rows := make([]BlogPostORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*4)
+			for _, row := range batch {
+				values := []interface{}{row.Author, row.AuthorId, row.Id, row.Title}
+				if row.Id == 0 {
+					values[2] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "blog_posts" ("author", "author_id", "id", "title") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO UPDATE SET "author" = EXCLUDED."author", "author_id" = EXCLUDED."author_id", "title" = EXCLUDED."title"`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+// ParseBlogPostName returns the ids of a resource name of the form authors/{author}/posts/{post}
+func ParseBlogPostName(name string) (BlogPostORM, error) {
+	to := BlogPostORM{}
+	segments := strings.Split(name, "/")
+	if len(segments) != 4 || segments[0] != "authors" || segments[1] == "" || segments[2] != "posts" || segments[3] == "" {
+		return to, status.Errorf(codes.InvalidArgument, "malformed BlogPost name %q", name)
+	}
+	to.AuthorId = segments[1]
+	if v, err := strconv.ParseUint(segments[3], 10, 64); err != nil {
+		return to, status.Errorf(codes.InvalidArgument, "malformed BlogPost name %q", name)
+	} else {
+		to.Id = uint64(v)
+	}
+	return to, nil
+}
+
 func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -541,6 +1385,13 @@ func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogP
 	if err != nil {
 		return nil, err
 	}
+	if in.Name != "" {
+		key, err := ParseBlogPostName(in.Name)
+		if err != nil {
+			return nil, err
+		}
+		ormObj = key
+	}
 	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
@@ -552,6 +1403,9 @@ func DefaultReadBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogP
 	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &BlogPostORM{}); err != nil {
 		return nil, err
 	}
+	if db, err = applyBlogPostConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
@@ -580,7 +1434,51 @@ type BlogPostORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
+// DefaultReadBlogPostForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadBlogPostForUpdate(ctx context.Context, in *BlogPost, db *gorm.DB, wait types.LockWait) (*BlogPost, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if in.Name != "" {
+		key, err := ParseBlogPostName(in.Name)
+		if err != nil {
+			return nil, err
+		}
+		ormObj = key
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := BlogPostORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
 func DefaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) error {
+	return defaultDeleteBlogPost(ctx, in, db, nil)
+}
+
+// DefaultDeleteBlogPostWithResult is DefaultDeleteBlogPost reporting the affected rows,
+// no affected rows means no BlogPost matched
+func DefaultDeleteBlogPostWithResult(ctx context.Context, in *BlogPost, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteBlogPost(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -588,6 +1486,13 @@ func DefaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) error
 	if err != nil {
 		return err
 	}
+	if in.Name != "" {
+		key, err := ParseBlogPostName(in.Name)
+		if err != nil {
+			return err
+		}
+		ormObj = key
+	}
 	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
@@ -596,10 +1501,14 @@ func DefaultDeleteBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) error
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&BlogPostORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&BlogPostORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
@@ -653,6 +1562,18 @@ type BlogPostORMWithAfterDeleteSet interface {
 
 // DefaultStrictUpdateBlogPost clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, error) {
+	return defaultStrictUpdateBlogPost(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateBlogPostWithResult is DefaultStrictUpdateBlogPost reporting the affected rows
+// and whether the BlogPost existed before the update
+func DefaultStrictUpdateBlogPostWithResult(ctx context.Context, in *BlogPost, db *gorm.DB) (*BlogPost, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateBlogPost(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB, result *types.WriteResult) (*BlogPost, error) {
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateBlogPost")
 	}
@@ -673,9 +1594,14 @@ func DefaultStrictUpdateBlogPost(ctx context.Context, in *BlogPost, db *gorm.DB)
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
@@ -775,6 +1701,53 @@ func DefaultPatchSetBlogPost(ctx context.Context, objects []*BlogPost, updateMas
 	return results, nil
 }
 
+// DefaultUpdateBlogPostByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key and by-fields cannot be in updateMask.
+func DefaultUpdateBlogPostByIds(ctx context.Context, ids []uint64, in *BlogPost, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Title":
+			columns["title"] = ormObj.Title
+		case "Author":
+			columns["author"] = ormObj.Author
+		case "AuthorId":
+			columns["author_id"] = ormObj.AuthorId
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&BlogPostORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
 // DefaultApplyFieldMaskBlogPost patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patcher *BlogPost, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*BlogPost, error) {
 	if patcher == nil {
@@ -796,6 +1769,14 @@ func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patch
 			patchee.Author = patcher.Author
 			continue
 		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"AuthorId" {
+			patchee.AuthorId = patcher.AuthorId
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -803,8 +1784,9 @@ func DefaultApplyFieldMaskBlogPost(ctx context.Context, patchee *BlogPost, patch
 	return patchee, nil
 }
 
-// DefaultListBlogPost executes a gorm list call
-func DefaultListBlogPost(ctx context.Context, db *gorm.DB) ([]*BlogPost, error) {
+// DefaultListBlogPost executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListBlogPost(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*BlogPost, error) {
 	in := BlogPost{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -819,12 +1801,15 @@ func DefaultListBlogPost(ctx context.Context, db *gorm.DB) ([]*BlogPost, error)
 	if err != nil {
 		return nil, err
 	}
+	if db, err = applyBlogPostConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
 	ormResponse := []BlogPostORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -855,3 +1840,82 @@ type BlogPostORMWithBeforeListFind interface {
 type BlogPostORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]BlogPostORM) error
 }
+
+// DefaultExplainListBlogPost returns the SELECT DefaultListBlogPost would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListBlogPost(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := BlogPost{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &BlogPostORM{}, &BlogPost{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyBlogPostConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &BlogPostORM{})
+}
+
+// BlogPostORMSelectable lists the columns accepted by DefaultSelectBlogPost
+var BlogPostORMSelectable = map[string]struct{}{
+	"author":    {},
+	"author_id": {},
+	"id":        {},
+	"title":     {},
+}
+
+// DefaultSelectBlogPost runs the query of DefaultListBlogPost for the columns only, which
+// must be in BlogPostORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectBlogPost(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := BlogPostORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "blog_posts."+column)
+	}
+	in := BlogPost{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &BlogPostORM{}, &BlogPost{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyBlogPostConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(BlogPostORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&BlogPostORM{}).Select(selected).Rows()
+}
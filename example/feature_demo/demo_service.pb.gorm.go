@@ -2,22 +2,32 @@ package example
 
 import (
 	context "context"
+	sql "database/sql"
 	json "encoding/json"
 	fmt "fmt"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	query "github.com/infobloxopen/atlas-app-toolkit/query"
+	changes "github.com/infobloxopen/protoc-gen-gorm/changes"
 	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	explain "github.com/infobloxopen/protoc-gen-gorm/explain"
+	returning "github.com/infobloxopen/protoc-gen-gorm/returning"
+	timeout "github.com/infobloxopen/protoc-gen-gorm/timeout"
+	types "github.com/infobloxopen/protoc-gen-gorm/types"
 	gorm "github.com/jinzhu/gorm"
+	pq "github.com/lib/pq"
 	trace "go.opencensus.io/trace"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	strings "strings"
+	time "time"
 )
 
 type IntPointORM struct {
-	Id uint32
-	X  int32
-	Y  int32
+	Id        uint32
+	RequestId *string `gorm:"unique"`
+	X         int32
+	Y         int32
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -25,6 +35,37 @@ func (IntPointORM) TableName() string {
 	return "int_points"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *IntPointORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *IntPointORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := IntPointORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// IntPointORMIndexes lists the indexes declared by the gorm tags of IntPointORM
+var IntPointORMIndexes = []types.IndexDef{}
+
+// applyIntPointConditionalPreloads preloads the associations of the conditional
+// preloads of IntPoint in ctx whose condition holds
+func applyIntPointConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "IntPoint") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *IntPoint) ToORM(ctx context.Context) (IntPointORM, error) {
@@ -38,6 +79,10 @@ func (m *IntPoint) ToORM(ctx context.Context) (IntPointORM, error) {
 	to.Id = m.Id
 	to.X = m.X
 	to.Y = m.Y
+	if m.RequestId != nil {
+		v := *m.RequestId
+		to.RequestId = &v
+	}
 	if posthook, ok := interface{}(m).(IntPointWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -57,12 +102,161 @@ func (m *IntPointORM) ToPB(ctx context.Context) (IntPoint, error) {
 	to.Id = m.Id
 	to.X = m.X
 	to.Y = m.Y
+	if m.RequestId != nil {
+		v := *m.RequestId
+		to.RequestId = &v
+	}
 	if posthook, ok := interface{}(m).(IntPointWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *IntPoint) MergeToORM(ctx context.Context, dst *IntPointORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.X = to.X
+	dst.Y = to.Y
+	if m.RequestId != nil {
+		dst.RequestId = to.RequestId
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *IntPoint) ApplyFieldMaskToORM(ctx context.Context, dst *IntPointORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *IntPointORM) applyFieldMaskPath(from *IntPointORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "X":
+		if rest != "" {
+			return false
+		}
+		m.X = from.X
+		return true
+	case "Y":
+		if rest != "" {
+			return false
+		}
+		m.Y = from.Y
+		return true
+	case "RequestId":
+		if rest != "" {
+			return false
+		}
+		m.RequestId = from.RequestId
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffIntPoint returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffIntPoint(old, new *IntPointORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &IntPointORM{}
+	}
+	if new == nil {
+		new = &IntPointORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.X != new.X {
+		mask.Paths = append(mask.Paths, "X")
+	}
+	if old.Y != new.Y {
+		mask.Paths = append(mask.Paths, "Y")
+	}
+	if (old.RequestId == nil) != (new.RequestId == nil) || old.RequestId != nil && *old.RequestId != *new.RequestId {
+		mask.Paths = append(mask.Paths, "RequestId")
+	}
+	return mask
+}
+
+// IntPointSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func IntPointSliceToORM(ctx context.Context, in []*IntPoint) ([]*IntPointORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*IntPointORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// IntPointORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func IntPointORMSliceToPB(ctx context.Context, in []*IntPointORM) ([]*IntPoint, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*IntPoint, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type IntPoint the arg will be the target, the caller the one being converted from
 
@@ -86,6 +280,36 @@ type IntPointWithAfterToPB interface {
 	AfterToPB(context.Context, *IntPoint) error
 }
 
+// IntPointChangeEvent is a create, update or delete of a IntPoint through GORM, holding
+// the IntPoint as it was written
+type IntPointChangeEvent struct {
+	Op       changes.Op
+	IntPoint *IntPoint
+}
+
+var intPointChanges changes.Broker
+
+// SubscribeIntPoint returns a channel of the changes of the IntPoint objects written
+// through a db of the Register callbacks, until ctx is done and the channel
+// is closed. The policy of opts is what a write does when it is full.
+func SubscribeIntPoint(ctx context.Context, opts changes.Options) <-chan IntPointChangeEvent {
+	ch := make(chan IntPointChangeEvent, opts.Buffer)
+	intPointChanges.Subscribe(ctx, ch, opts.Policy)
+	return ch
+}
+
+func publishIntPointChange(op changes.Op, orm *IntPointORM) error {
+	if !intPointChanges.Subscribed() {
+		return nil
+	}
+	pbObj, err := orm.ToPB(context.Background())
+	if err != nil {
+		return err
+	}
+	intPointChanges.Publish(IntPointChangeEvent{Op: op, IntPoint: &pbObj})
+	return nil
+}
+
 type SomethingORM struct {
 	Field string
 }
@@ -95,6 +319,23 @@ func (SomethingORM) TableName() string {
 	return "somethings"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *SomethingORM) ClearAssociations() {
+}
+
+// SomethingORMIndexes lists the indexes declared by the gorm tags of SomethingORM
+var SomethingORMIndexes = []types.IndexDef{}
+
+// applySomethingConditionalPreloads preloads the associations of the conditional
+// preloads of Something in ctx whose condition holds
+func applySomethingConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Something") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *Something) ToORM(ctx context.Context) (SomethingORM, error) {
@@ -129,6 +370,119 @@ func (m *SomethingORM) ToPB(ctx context.Context) (Something, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Something) MergeToORM(ctx context.Context, dst *SomethingORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Field = to.Field
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Something) ApplyFieldMaskToORM(ctx context.Context, dst *SomethingORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *SomethingORM) applyFieldMaskPath(from *SomethingORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Field":
+		if rest != "" {
+			return false
+		}
+		m.Field = from.Field
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffSomething returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffSomething(old, new *SomethingORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &SomethingORM{}
+	}
+	if new == nil {
+		new = &SomethingORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Field != new.Field {
+		mask.Paths = append(mask.Paths, "Field")
+	}
+	return mask
+}
+
+// SomethingSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func SomethingSliceToORM(ctx context.Context, in []*Something) ([]*SomethingORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*SomethingORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// SomethingORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func SomethingORMSliceToPB(ctx context.Context, in []*SomethingORM) ([]*Something, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Something, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Something the arg will be the target, the caller the one being converted from
 
@@ -161,6 +515,23 @@ func (CircleORM) TableName() string {
 	return "circles"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *CircleORM) ClearAssociations() {
+}
+
+// CircleORMIndexes lists the indexes declared by the gorm tags of CircleORM
+var CircleORMIndexes = []types.IndexDef{}
+
+// applyCircleConditionalPreloads preloads the associations of the conditional
+// preloads of Circle in ctx whose condition holds
+func applyCircleConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Circle") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *Circle) ToORM(ctx context.Context) (CircleORM, error) {
@@ -195,6 +566,119 @@ func (m *CircleORM) ToPB(ctx context.Context) (Circle, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Circle) MergeToORM(ctx context.Context, dst *CircleORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.R = to.R
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Circle) ApplyFieldMaskToORM(ctx context.Context, dst *CircleORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *CircleORM) applyFieldMaskPath(from *CircleORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "R":
+		if rest != "" {
+			return false
+		}
+		m.R = from.R
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffCircle returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffCircle(old, new *CircleORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &CircleORM{}
+	}
+	if new == nil {
+		new = &CircleORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.R != new.R {
+		mask.Paths = append(mask.Paths, "R")
+	}
+	return mask
+}
+
+// CircleSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func CircleSliceToORM(ctx context.Context, in []*Circle) ([]*CircleORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*CircleORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// CircleORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func CircleORMSliceToPB(ctx context.Context, in []*CircleORM) ([]*Circle, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Circle, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Circle the arg will be the target, the caller the one being converted from
 
@@ -218,6 +702,28 @@ type CircleWithAfterToPB interface {
 	AfterToPB(context.Context, *Circle) error
 }
 
+// DemoServiceSchemaHash identifies the schema of the ORM types defined in demo_service.proto
+const DemoServiceSchemaHash = "7f34f2b9bc586860cc51962368e318d81425a2de35b30827c159aa5289334b3d"
+
+// RegisterDemoServiceCallbacks registers the GORM callbacks of the ORM types defined
+// in demo_service.proto, registering them again replaces the previous ones
+func RegisterDemoServiceCallbacks(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	returning.Register(db)
+	changes.Register(db, "protoc-gen-gorm:example:demo_service:emit_changes", func(op changes.Op, value interface{}) error {
+		switch v := value.(type) {
+		case *IntPointORM:
+			return publishIntPointChange(op, v)
+		case IntPointORM:
+			return publishIntPointChange(op, &v)
+		}
+		return nil
+	})
+	return nil
+}
+
 // DefaultCreateIntPoint executes a basic gorm create call
 func DefaultCreateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
 	if in == nil {
@@ -232,7 +738,8 @@ func DefaultCreateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*Int
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterCreate_); ok {
@@ -251,36 +758,225 @@ type IntPointORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *query.FieldSelection) (*IntPoint, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// DefaultCopyFromIntPoint inserts the objects with a COPY into the int_points table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromIntPoint(ctx context.Context, in []*IntPoint, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	rows := make([]IntPointORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
 	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into int_points needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("int_points", "request_id", "x", "y"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.RequestId, row.X, row.Y); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
 	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db, fs); err != nil {
+	return int64(len(rows)), nil
+}
+
+// IntPointORMMaxBatchSize is the most rows of an INSERT of DefaultCreateIntPointSet,
+// postgres takes 65535 bind parameters in a statement and a row has 3
+const IntPointORMMaxBatchSize = 21845
+
+// DefaultCreateIntPointSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most IntPointORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB, batchSize int) ([]*IntPoint, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > IntPointORMMaxBatchSize {
+		batchSize = IntPointORMMaxBatchSize
+	}
+	rows := make([]IntPointORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
+	}
+	created := make([]*IntPointORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?)")
+				args = append(args, row.RequestId, row.X, row.Y)
+			}
+			var stored []*IntPointORM
+			if err := tx.Raw(`INSERT INTO "int_points" ("request_id", "x", "y") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, fs, &IntPointORM{}); err != nil {
+	return IntPointORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertIntPointSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most IntPointORMMaxBatchSize, updating the row of the same
+// "request_id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertIntPointSet(ctx context.Context, in []*IntPoint, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > IntPointORMMaxBatchSize {
+		batchSize = IntPointORMMaxBatchSize
+	}
+	rows := make([]IntPointORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?)")
+				args = append(args, row.RequestId, row.X, row.Y)
+			}
+			written := tx.Exec(`INSERT INTO "int_points" ("request_id", "x", "y") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("request_id") DO UPDATE SET "x" = EXCLUDED."x", "y" = EXCLUDED."y"`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+// DefaultSaveIntPoint executes a basic gorm save call, inserting the object or updating the
+// row of its primary key, as used by the Create methods with the SAVE create mode
+func DefaultSaveIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeSave_); ok {
+		if db, err = hook.BeforeSave_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := IntPointORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+	written := returning.Set(db).Save(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(IntPointORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db, fs); err != nil {
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterSave_); ok {
+		if err = hook.AfterSave_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type IntPointORMWithBeforeSave_ interface {
+	BeforeSave_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type IntPointORMWithAfterSave_ interface {
+	AfterSave_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, fs *query.FieldSelection) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, fs, &IntPointORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyIntPointConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db, fs); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := IntPointORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(IntPointORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db, fs); err != nil {
 			return nil, err
 		}
 	}
@@ -298,7 +994,44 @@ type IntPointORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB, *query.FieldSelection) error
 }
 
+// DefaultReadIntPointForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadIntPointForUpdate(ctx context.Context, in *IntPoint, db *gorm.DB, wait types.LockWait) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := IntPointORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
 func DefaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) error {
+	return defaultDeleteIntPoint(ctx, in, db, nil)
+}
+
+// DefaultDeleteIntPointWithResult is DefaultDeleteIntPoint reporting the affected rows,
+// no affected rows means no IntPoint matched
+func DefaultDeleteIntPointWithResult(ctx context.Context, in *IntPoint, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteIntPoint(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -314,10 +1047,14 @@ func DefaultDeleteIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) error
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&IntPointORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&ormObj)
+	if err = deleted.Error; err != nil {
 		return err
 	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
@@ -371,6 +1108,18 @@ type IntPointORMWithAfterDeleteSet interface {
 
 // DefaultStrictUpdateIntPoint clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, error) {
+	return defaultStrictUpdateIntPoint(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateIntPointWithResult is DefaultStrictUpdateIntPoint reporting the affected rows
+// and whether the IntPoint existed before the update
+func DefaultStrictUpdateIntPointWithResult(ctx context.Context, in *IntPoint, db *gorm.DB) (*IntPoint, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateIntPoint(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB, result *types.WriteResult) (*IntPoint, error) {
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateIntPoint")
 	}
@@ -391,9 +1140,14 @@ func DefaultStrictUpdateIntPoint(ctx context.Context, in *IntPoint, db *gorm.DB)
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
@@ -493,6 +1247,162 @@ func DefaultPatchSetIntPoint(ctx context.Context, objects []*IntPoint, updateMas
 	return results, nil
 }
 
+// DefaultUpdateIntPointByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key and by-fields cannot be in updateMask.
+func DefaultUpdateIntPointByIds(ctx context.Context, ids []uint32, in *IntPoint, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "X":
+			columns["x"] = ormObj.X
+		case "Y":
+			columns["y"] = ormObj.Y
+		case "RequestId":
+			columns["request_id"] = ormObj.RequestId
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&IntPointORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultUpdateIntPointIfX sets the x column and those of the fields in updateMask to
+// their values in in for the row of its key, only if its x is still expected, e.g. for
+// a transition of a state machine. A row of another x fails with
+// errors.ConditionFailedError and a missing row with gorm.ErrRecordNotFound.
+func DefaultUpdateIntPointIfX(ctx context.Context, in *IntPoint, expected int32, updateMask *field_mask.FieldMask, db *gorm.DB) (*IntPoint, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	expectedObj, err := (&IntPoint{X: expected}).ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string]interface{}{"x": ormObj.X}
+	for _, path := range updateMask.GetPaths() {
+		switch path {
+		case "X":
+			columns["x"] = ormObj.X
+		case "Y":
+			columns["y"] = ormObj.Y
+		case "RequestId":
+			columns["request_id"] = ormObj.RequestId
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	updated := db.Model(&IntPointORM{}).Where("id = ?", ormObj.Id).Where("x = ?", expectedObj.X).Updates(columns)
+	if err := updated.Error; err != nil {
+		return nil, err
+	}
+	if updated.RowsAffected == 0 {
+		var count int
+		if err := db.Model(&IntPointORM{}).Where("id = ?", ormObj.Id).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%w: x is not %v", errors.ConditionFailedError, expected)
+	}
+	stored := IntPointORM{}
+	if err := db.Where("id = ?", ormObj.Id).First(&stored).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := stored.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, nil
+}
+
+// DefaultGetOrCreateIntPointByRequestId reads the IntPoint with the same RequestId or creates it
+// if it does not exist yet, created reports which of the two happened
+func DefaultGetOrCreateIntPointByRequestId(ctx context.Context, in *IntPoint, db *gorm.DB) (_ *IntPoint, created bool, err error) {
+	if in == nil {
+		return nil, false, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	// inside a transaction a failed insert has to be rolled back to a savepoint
+	_, inTxn := db.CommonDB().(*sql.Tx)
+	var pbResponse *IntPoint
+	for retried := false; ; retried = true {
+		found := IntPointORM{}
+		if err = db.Where("request_id = ?", ormObj.RequestId).First(&found).Error; err == nil {
+			pbObj, err := found.ToPB(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			pbResponse = &pbObj
+			break
+		} else if !gorm.IsRecordNotFoundError(err) {
+			return nil, false, err
+		}
+		if inTxn {
+			if err = db.Exec("SAVEPOINT get_or_create_int_point_by_request_id").Error; err != nil {
+				return nil, false, err
+			}
+		}
+		if pbResponse, err = DefaultCreateIntPoint(ctx, in, db); err == nil {
+			if inTxn {
+				if err = db.Exec("RELEASE SAVEPOINT get_or_create_int_point_by_request_id").Error; err != nil {
+					return nil, false, err
+				}
+			}
+			created = true
+			break
+		}
+		if inTxn {
+			if txnErr := db.Exec("ROLLBACK TO SAVEPOINT get_or_create_int_point_by_request_id").Error; txnErr != nil {
+				return nil, false, txnErr
+			}
+		}
+		// only a concurrent create of the same key is worth reading again
+		if retried || !errors.IsUniqueViolation(err) {
+			return nil, false, err
+		}
+	}
+	return pbResponse, created, nil
+}
+
 // DefaultApplyFieldMaskIntPoint patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskIntPoint(ctx context.Context, patchee *IntPoint, patcher *IntPoint, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*IntPoint, error) {
 	if patcher == nil {
@@ -514,6 +1424,10 @@ func DefaultApplyFieldMaskIntPoint(ctx context.Context, patchee *IntPoint, patch
 			patchee.Y = patcher.Y
 			continue
 		}
+		if f == prefix+"RequestId" {
+			patchee.RequestId = patcher.RequestId
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -521,13 +1435,27 @@ func DefaultApplyFieldMaskIntPoint(ctx context.Context, patchee *IntPoint, patch
 	return patchee, nil
 }
 
-// DefaultListIntPoint executes a gorm list call
-func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection) ([]*IntPoint, error) {
+// IntPointORMSortable lists the sort tags accepted by DefaultListIntPoint
+var IntPointORMSortable = map[string]struct{}{
+	"id":         {},
+	"request_id": {},
+	"x":          {},
+	"y":          {},
+}
+
+// DefaultListIntPoint executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, scopes ...func(*gorm.DB) *gorm.DB) ([]*IntPoint, error) {
 	in := IntPoint{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
+	for _, cr := range s.GetCriterias() {
+		if _, ok := IntPointORMSortable[cr.GetTag()]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSortColumnError, cr.GetTag())
+		}
+	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
 			return nil, err
@@ -537,12 +1465,15 @@ func DefaultListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s
 	if err != nil {
 		return nil, err
 	}
+	if db, err = applyIntPointConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
 	ormResponse := []IntPointORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -574,6 +1505,224 @@ type IntPointORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]IntPointORM, *query.Filtering, *query.Sorting, *query.Pagination, *query.FieldSelection) error
 }
 
+// DefaultListIntPointDistinctOnX is DefaultListIntPoint keeping the first row of each x,
+// a SELECT DISTINCT ON ordered by x ahead of the sorting
+func DefaultListIntPointDistinctOnX(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, scopes ...func(*gorm.DB) *gorm.DB) ([]*IntPoint, error) {
+	in := IntPoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range s.GetCriterias() {
+		if _, ok := IntPointORMSortable[cr.GetTag()]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSortColumnError, cr.GetTag())
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Select("DISTINCT ON (int_points.x) int_points.*").Order("int_points.x")
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyIntPointConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []IntPointORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*IntPoint{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultExplainListIntPoint returns the SELECT DefaultListIntPoint would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := IntPoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, cr := range s.GetCriterias() {
+		if _, ok := IntPointORMSortable[cr.GetTag()]; !ok {
+			return "", fmt.Errorf("%w %q", errors.UnknownSortColumnError, cr.GetTag())
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, s, p, fs)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyIntPointConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &IntPointORM{})
+}
+
+// IntPointORMSelectable lists the columns accepted by DefaultSelectIntPoint
+var IntPointORMSelectable = map[string]struct{}{
+	"id":         {},
+	"request_id": {},
+	"x":          {},
+	"y":          {},
+}
+
+// DefaultSelectIntPoint runs the query of DefaultListIntPoint for the columns only, which
+// must be in IntPointORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectIntPoint(ctx context.Context, db *gorm.DB, columns []string, f *query.Filtering, s *query.Sorting, p *query.Pagination, fs *query.FieldSelection, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := IntPointORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "int_points."+column)
+	}
+	in := IntPoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cr := range s.GetCriterias() {
+		if _, ok := IntPointORMSortable[cr.GetTag()]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSortColumnError, cr.GetTag())
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, s, p, fs)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyIntPointConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(IntPointORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db, f, s, p, fs); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&IntPointORM{}).Select(selected).Rows()
+}
+
+// AggregateIntPointsByXRow is a group of the IntPoint rows returned by DefaultAggregateIntPointsByX
+type AggregateIntPointsByXRow struct {
+	X     int32   `gorm:"column:x"`
+	Count int64   `gorm:"column:count"`
+	SumY  float64 `gorm:"column:sum_y"`
+}
+
+// DefaultAggregateIntPointsByX groups the IntPoint rows matching the filter by x,
+// the groups are ordered by their keys
+// the scopes are applied after the filter and the account scope
+func DefaultAggregateIntPointsByX(ctx context.Context, db *gorm.DB, f *query.Filtering, scopes ...func(*gorm.DB) *gorm.DB) ([]*AggregateIntPointsByXRow, error) {
+	in := IntPoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Model(&IntPointORM{}).Where(&ormObj).Scopes(scopes...).Select("x, COUNT(*) AS count, COALESCE(SUM(y), 0) AS sum_y")
+	db = db.Group("x").Order("x")
+	rows := []*AggregateIntPointsByXRow{}
+	if err := db.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// FacetsIntPointCounts are the counts of the IntPoint rows returned by DefaultFacetsIntPoint by
+// each value of each facet
+type FacetsIntPointCounts struct {
+	X map[int32]int64
+	Y map[int32]int64
+}
+
+// DefaultFacetsIntPoint counts the IntPoint rows matching the filter by each value of x, y,
+// in a grouped query per facet, a value of no rows is missing from the counts
+// the scopes are applied after the filter and the account scope
+func DefaultFacetsIntPoint(ctx context.Context, db *gorm.DB, f *query.Filtering, scopes ...func(*gorm.DB) *gorm.DB) (*FacetsIntPointCounts, error) {
+	in := IntPoint{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &IntPointORM{}, &IntPoint{}, f, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Model(&IntPointORM{}).Where(&ormObj).Scopes(scopes...)
+	counts := &FacetsIntPointCounts{}
+	var xRows []struct {
+		Value int32
+		Count int64
+	}
+	if err := db.Select("x AS value, COUNT(*) AS count").Group("x").Scan(&xRows).Error; err != nil {
+		return nil, err
+	}
+	counts.X = make(map[int32]int64, len(xRows))
+	for _, row := range xRows {
+		counts.X[row.Value] = row.Count
+	}
+	var yRows []struct {
+		Value int32
+		Count int64
+	}
+	if err := db.Select("y AS value, COUNT(*) AS count").Group("y").Scan(&yRows).Error; err != nil {
+		return nil, err
+	}
+	counts.Y = make(map[int32]int64, len(yRows))
+	for _, row := range yRows {
+		counts.Y[row.Value] = row.Count
+	}
+	return counts, nil
+}
+
 // DefaultCreateSomething executes a basic gorm create call
 func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*Something, error) {
 	if in == nil {
@@ -588,7 +1737,8 @@ func DefaultCreateSomething(ctx context.Context, in *Something, db *gorm.DB) (*S
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(SomethingORMWithAfterCreate_); ok {
@@ -607,6 +1757,105 @@ type SomethingORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCopyFromSomething inserts the objects with a COPY into the somethings table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromSomething(ctx context.Context, in []*Something, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]SomethingORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into somethings needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("somethings", "field"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Field); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// SomethingORMMaxBatchSize is the most rows of an INSERT of DefaultCreateSomethingSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const SomethingORMMaxBatchSize = 65535
+
+// DefaultCreateSomethingSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most SomethingORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateSomethingSet(ctx context.Context, in []*Something, db *gorm.DB, batchSize int) ([]*Something, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > SomethingORMMaxBatchSize {
+		batchSize = SomethingORMMaxBatchSize
+	}
+	rows := make([]SomethingORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*SomethingORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				tuples = append(tuples, "(?)")
+				args = append(args, row.Field)
+			}
+			var stored []*SomethingORM
+			if err := tx.Raw(`INSERT INTO "somethings" ("field") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return SomethingORMSliceToPB(ctx, created)
+}
+
 // DefaultApplyFieldMaskSomething patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, patcher *Something, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Something, error) {
 	if patcher == nil {
@@ -627,8 +1876,9 @@ func DefaultApplyFieldMaskSomething(ctx context.Context, patchee *Something, pat
 	return patchee, nil
 }
 
-// DefaultListSomething executes a gorm list call
-func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error) {
+// DefaultListSomething executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListSomething(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Something, error) {
 	in := Something{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -643,12 +1893,15 @@ func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error
 	if err != nil {
 		return nil, err
 	}
+	if db, err = applySomethingConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	ormResponse := []SomethingORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -658,25 +1911,99 @@ func DefaultListSomething(ctx context.Context, db *gorm.DB) ([]*Something, error
 			return nil, err
 		}
 	}
-	pbResponse := []*Something{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	pbResponse := []*Something{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SomethingORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SomethingORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SomethingORM) error
+}
+
+// DefaultExplainListSomething returns the SELECT DefaultListSomething would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListSomething(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Something{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &SomethingORM{}, &Something{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applySomethingConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return explain.SQL(db, &SomethingORM{})
+}
+
+// SomethingORMSelectable lists the columns accepted by DefaultSelectSomething
+var SomethingORMSelectable = map[string]struct{}{
+	"field": {},
+}
+
+// DefaultSelectSomething runs the query of DefaultListSomething for the columns only, which
+// must be in SomethingORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectSomething(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := SomethingORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "somethings."+column)
+	}
+	in := Something{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &SomethingORM{}, &Something{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applySomethingConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SomethingORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
-}
-
-type SomethingORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type SomethingORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type SomethingORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]SomethingORM) error
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return db.Model(&SomethingORM{}).Select(selected).Rows()
 }
 
 // DefaultCreateCircle executes a basic gorm create call
@@ -693,7 +2020,8 @@ func DefaultCreateCircle(ctx context.Context, in *Circle, db *gorm.DB) (*Circle,
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(CircleORMWithAfterCreate_); ok {
@@ -712,6 +2040,105 @@ type CircleORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCopyFromCircle inserts the objects with a COPY into the circles table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromCircle(ctx context.Context, in []*Circle, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]CircleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into circles needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("circles", "r"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.R); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// CircleORMMaxBatchSize is the most rows of an INSERT of DefaultCreateCircleSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const CircleORMMaxBatchSize = 65535
+
+// DefaultCreateCircleSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most CircleORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateCircleSet(ctx context.Context, in []*Circle, db *gorm.DB, batchSize int) ([]*Circle, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > CircleORMMaxBatchSize {
+		batchSize = CircleORMMaxBatchSize
+	}
+	rows := make([]CircleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*CircleORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				tuples = append(tuples, "(?)")
+				args = append(args, row.R)
+			}
+			var stored []*CircleORM
+			if err := tx.Raw(`INSERT INTO "circles" ("r") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return CircleORMSliceToPB(ctx, created)
+}
+
 // DefaultApplyFieldMaskCircle patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *Circle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Circle, error) {
 	if patcher == nil {
@@ -732,8 +2159,9 @@ func DefaultApplyFieldMaskCircle(ctx context.Context, patchee *Circle, patcher *
 	return patchee, nil
 }
 
-// DefaultListCircle executes a gorm list call
-func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
+// DefaultListCircle executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListCircle(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Circle, error) {
 	in := Circle{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -748,12 +2176,15 @@ func DefaultListCircle(ctx context.Context, db *gorm.DB) ([]*Circle, error) {
 	if err != nil {
 		return nil, err
 	}
+	if db, err = applyCircleConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	ormResponse := []CircleORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
@@ -783,6 +2214,81 @@ type CircleORMWithBeforeListFind interface {
 type CircleORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]CircleORM) error
 }
+
+// DefaultExplainListCircle returns the SELECT DefaultListCircle would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListCircle(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Circle{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CircleORM{}, &Circle{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyCircleConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return explain.SQL(db, &CircleORM{})
+}
+
+// CircleORMSelectable lists the columns accepted by DefaultSelectCircle
+var CircleORMSelectable = map[string]struct{}{
+	"r": {},
+}
+
+// DefaultSelectCircle runs the query of DefaultListCircle for the columns only, which
+// must be in CircleORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectCircle(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := CircleORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "circles."+column)
+	}
+	in := Circle{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CircleORM{}, &Circle{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyCircleConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CircleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return db.Model(&CircleORM{}).Select(selected).Rows()
+}
+
 type IntPointServiceDefaultServer struct {
 	DB *gorm.DB
 }
@@ -824,6 +2330,127 @@ type IntPointServiceIntPointWithAfterCreate interface {
 	AfterCreate(context.Context, *CreateIntPointResponse, *gorm.DB) error
 }
 
+// CreateOrReplace ...
+func (m *IntPointServiceDefaultServer) CreateOrReplace(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreateOrReplace); ok {
+		var err error
+		if db, err = custom.BeforeCreateOrReplace(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// create mode SAVE: a provided primary key updates the stored row
+	res, err := DefaultSaveIntPoint(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	out := &CreateIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreateOrReplace); ok {
+		var err error
+		if err = custom.AfterCreateOrReplace(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeCreateOrReplace called before DefaultCreateOrReplaceIntPoint in the default CreateOrReplace handler
+type IntPointServiceIntPointWithBeforeCreateOrReplace interface {
+	BeforeCreateOrReplace(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterCreateOrReplace called before DefaultCreateOrReplaceIntPoint in the default CreateOrReplace handler
+type IntPointServiceIntPointWithAfterCreateOrReplace interface {
+	AfterCreateOrReplace(context.Context, *CreateIntPointResponse, *gorm.DB) error
+}
+
+// CreateOnce ...
+func (m *IntPointServiceDefaultServer) CreateOnce(ctx context.Context, in *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreateOnce); ok {
+		var err error
+		if db, err = custom.BeforeCreateOnce(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	// a retried create of the idempotency key returns the row of the key
+	var res *IntPoint
+	var err error
+	created := true
+	if in.GetPayload().GetRequestId() != "" {
+		res, created, err = DefaultGetOrCreateIntPointByRequestId(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultCreateIntPoint(ctx, in.GetPayload(), db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &CreateIntPointResponse{Result: res}
+	if created {
+		err = gateway.SetCreated(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreateOnce); ok {
+		var err error
+		if err = custom.AfterCreateOnce(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeCreateOnce called before DefaultCreateOnceIntPoint in the default CreateOnce handler
+type IntPointServiceIntPointWithBeforeCreateOnce interface {
+	BeforeCreateOnce(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterCreateOnce called before DefaultCreateOnceIntPoint in the default CreateOnce handler
+type IntPointServiceIntPointWithAfterCreateOnce interface {
+	AfterCreateOnce(context.Context, *CreateIntPointResponse, *gorm.DB) error
+}
+
+// CreateSet ...
+func (m *IntPointServiceDefaultServer) CreateSet(ctx context.Context, in *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeCreateSet); ok {
+		var err error
+		if db, err = custom.BeforeCreateSet(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateIntPointSet(ctx, in.GetObjects(), db, 1000)
+	if err != nil {
+		return nil, err
+	}
+	out := &CreateSetIntPointResponse{Results: res}
+	err = gateway.SetCreated(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterCreateSet); ok {
+		var err error
+		if err = custom.AfterCreateSet(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithBeforeCreateSet interface {
+	BeforeCreateSet(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterCreateSet called before DefaultCreateSetIntPoint in the default CreateSet handler
+type IntPointServiceIntPointWithAfterCreateSet interface {
+	AfterCreateSet(context.Context, *CreateSetIntPointResponse, *gorm.DB) error
+}
+
 // Read ...
 func (m *IntPointServiceDefaultServer) Read(ctx context.Context, in *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	db := m.DB
@@ -896,6 +2523,41 @@ type IntPointServiceIntPointWithAfterUpdate interface {
 	AfterUpdate(context.Context, *UpdateIntPointResponse, *gorm.DB) error
 }
 
+// UpdateIfX ...
+func (m *IntPointServiceDefaultServer) UpdateIfX(ctx context.Context, in *UpdateIntPointIfXRequest) (*UpdateIntPointResponse, error) {
+	var err error
+	var res *IntPoint
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdateIfX); ok {
+		var err error
+		if db, err = custom.BeforeUpdateIfX(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err = DefaultUpdateIntPointIfX(ctx, in.GetPayload(), in.GetExpectedX(), in.GetUpdateMask(), db)
+	if err != nil {
+		return nil, err
+	}
+	out := &UpdateIntPointResponse{Result: res}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterUpdateIfX); ok {
+		var err error
+		if err = custom.AfterUpdateIfX(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeUpdateIfX called before DefaultUpdateIfXIntPoint in the default UpdateIfX handler
+type IntPointServiceIntPointWithBeforeUpdateIfX interface {
+	BeforeUpdateIfX(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterUpdateIfX called before DefaultUpdateIfXIntPoint in the default UpdateIfX handler
+type IntPointServiceIntPointWithAfterUpdateIfX interface {
+	AfterUpdateIfX(context.Context, *UpdateIntPointResponse, *gorm.DB) error
+}
+
 // UpdateSet ...
 func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error) {
 	if in == nil {
@@ -903,6 +2565,13 @@ func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *Update
 	}
 
 	db := m.DB
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	db, timeoutTxn, errTimeout := timeout.Begin(ctx, db, 30*time.Second)
+	if errTimeout != nil {
+		return nil, errTimeout
+	}
+	defer timeoutTxn.Rollback()
 
 	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeUpdateSet); ok {
 		var err error
@@ -924,6 +2593,9 @@ func (m *IntPointServiceDefaultServer) UpdateSet(ctx context.Context, in *Update
 			return nil, err
 		}
 	}
+	if err := timeoutTxn.Commit(); err != nil {
+		return nil, err
+	}
 
 	return out, nil
 }
@@ -1020,6 +2692,55 @@ type IntPointServiceSomethingWithAfterListSomething interface {
 	AfterListSomething(context.Context, *ListSomethingResponse, *gorm.DB) error
 }
 
+// ListFirstPerX ...
+func (m *IntPointServiceDefaultServer) ListFirstPerX(ctx context.Context, in *ListIntPointRequest) (*ListIntPointResponse, error) {
+	db := m.DB
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithBeforeListFirstPerX); ok {
+		var err error
+		if db, err = custom.BeforeListFirstPerX(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pagedRequest := false
+	if in.GetPaging().GetLimit() >= 1 {
+		in.Paging.Limit++
+		pagedRequest = true
+	}
+	res, err := DefaultListIntPointDistinctOnX(ctx, db, in.Filter, in.OrderBy, in.Paging, in.Fields)
+	if err != nil {
+		return nil, err
+	}
+	var resPaging *query.PageInfo
+	if pagedRequest {
+		var offset int32
+		var size int32 = int32(len(res))
+		if size == in.GetPaging().GetLimit() {
+			size--
+			res = res[:size]
+			offset = in.GetPaging().GetOffset() + size
+		}
+		resPaging = &query.PageInfo{Offset: offset}
+	}
+	out := &ListIntPointResponse{Results: res, PageInfo: resPaging}
+	if custom, ok := interface{}(in).(IntPointServiceIntPointWithAfterListFirstPerX); ok {
+		var err error
+		if err = custom.AfterListFirstPerX(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// IntPointServiceIntPointWithBeforeListFirstPerX called before DefaultListFirstPerXIntPoint in the default ListFirstPerX handler
+type IntPointServiceIntPointWithBeforeListFirstPerX interface {
+	BeforeListFirstPerX(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// IntPointServiceIntPointWithAfterListFirstPerX called before DefaultListFirstPerXIntPoint in the default ListFirstPerX handler
+type IntPointServiceIntPointWithAfterListFirstPerX interface {
+	AfterListFirstPerX(context.Context, *ListIntPointResponse, *gorm.DB) error
+}
+
 // Delete ...
 func (m *IntPointServiceDefaultServer) Delete(ctx context.Context, in *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
 	db := m.DB
@@ -1053,6 +2774,30 @@ type IntPointServiceIntPointWithAfterDelete interface {
 	AfterDelete(context.Context, *DeleteIntPointResponse, *gorm.DB) error
 }
 
+// AggregateIntPointsByX ...
+func (m *IntPointServiceDefaultServer) AggregateIntPointsByX(ctx context.Context, in *ListIntPointRequest) (*emptypb.Empty, error) {
+	out := &emptypb.Empty{}
+	return out, nil
+}
+
+// FacetsIntPoint ...
+func (m *IntPointServiceDefaultServer) FacetsIntPoint(ctx context.Context, in *ListIntPointRequest) (*emptypb.Empty, error) {
+	out := &emptypb.Empty{}
+	return out, nil
+}
+
+// PurgeTypeWithID ...
+func (m *IntPointServiceDefaultServer) PurgeTypeWithID(ctx context.Context, in *DeleteIntPointRequest) (*emptypb.Empty, error) {
+	out := &emptypb.Empty{}
+	return out, nil
+}
+
+// ListTrashTypeWithID ...
+func (m *IntPointServiceDefaultServer) ListTrashTypeWithID(ctx context.Context, in *ListIntPointRequest) (*emptypb.Empty, error) {
+	out := &emptypb.Empty{}
+	return out, nil
+}
+
 // CustomMethod ...
 func (m *IntPointServiceDefaultServer) CustomMethod(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
 	out := &emptypb.Empty{}
@@ -24,12 +24,40 @@ type IntPointServiceClient interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error)
+	// The SAVE create mode updates the row instead when the payload has an id
+	CreateOrReplace(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error)
+	// CreateOnce returns the point created by an earlier request of the same
+	// request_id
+	CreateOnce(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error)
+	// CreateSet inserts the points with INSERTs of batch_size rows
+	CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error)
 	Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error)
 	Update(ctx context.Context, in *UpdateIntPointRequest, opts ...grpc.CallOption) (*UpdateIntPointResponse, error)
+	// UpdateIfX moves the point from the expected x, a point of another x is
+	// left as is and fails with Aborted
+	UpdateIfX(ctx context.Context, in *UpdateIntPointIfXRequest, opts ...grpc.CallOption) (*UpdateIntPointResponse, error)
+	// UpdateSet patches all of the points in a single transaction of 30s
 	UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest, opts ...grpc.CallOption) (*UpdateSetIntPointResponse, error)
 	List(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*ListIntPointResponse, error)
 	ListSomething(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSomethingResponse, error)
+	// ListFirstPerX lists the first point of each x with a DISTINCT ON, in the
+	// order of the request sorting after x
+	ListFirstPerX(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*ListIntPointResponse, error)
 	Delete(ctx context.Context, in *DeleteIntPointRequest, opts ...grpc.CallOption) (*DeleteIntPointResponse, error)
+	// AggregateIntPointsByX generates a DefaultAggregateIntPointsByX handler,
+	// counting the points and summing their y by x, the method is a stub
+	AggregateIntPointsByX(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// FacetsIntPoint generates a DefaultFacetsIntPoint handler counting the
+	// points by each x and by each y, the method is a stub
+	FacetsIntPoint(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PurgeTypeWithID generates a DefaultPurgeTypeWithID handler deleting a
+	// soft deleted TypeWithID for good, the method is a stub to be guarded by
+	// the implementation
+	PurgeTypeWithID(ctx context.Context, in *DeleteIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTrashTypeWithID generates a DefaultListTrashTypeWithID handler
+	// listing the soft deleted TypeWithID rows, the method is a stub to be
+	// guarded by the implementation, which defines the response
+	ListTrashTypeWithID(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CustomMethod can't be autogenerated as it matches no conventions, it will
 	// become a stub
 	CustomMethod(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -54,6 +82,33 @@ func (c *intPointServiceClient) Create(ctx context.Context, in *CreateIntPointRe
 	return out, nil
 }
 
+func (c *intPointServiceClient) CreateOrReplace(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error) {
+	out := new(CreateIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/CreateOrReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *intPointServiceClient) CreateOnce(ctx context.Context, in *CreateIntPointRequest, opts ...grpc.CallOption) (*CreateIntPointResponse, error) {
+	out := new(CreateIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/CreateOnce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *intPointServiceClient) CreateSet(ctx context.Context, in *CreateSetIntPointRequest, opts ...grpc.CallOption) (*CreateSetIntPointResponse, error) {
+	out := new(CreateSetIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/CreateSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *intPointServiceClient) Read(ctx context.Context, in *ReadIntPointRequest, opts ...grpc.CallOption) (*ReadIntPointResponse, error) {
 	out := new(ReadIntPointResponse)
 	err := c.cc.Invoke(ctx, "/example.IntPointService/Read", in, out, opts...)
@@ -72,6 +127,15 @@ func (c *intPointServiceClient) Update(ctx context.Context, in *UpdateIntPointRe
 	return out, nil
 }
 
+func (c *intPointServiceClient) UpdateIfX(ctx context.Context, in *UpdateIntPointIfXRequest, opts ...grpc.CallOption) (*UpdateIntPointResponse, error) {
+	out := new(UpdateIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/UpdateIfX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *intPointServiceClient) UpdateSet(ctx context.Context, in *UpdateSetIntPointRequest, opts ...grpc.CallOption) (*UpdateSetIntPointResponse, error) {
 	out := new(UpdateSetIntPointResponse)
 	err := c.cc.Invoke(ctx, "/example.IntPointService/UpdateSet", in, out, opts...)
@@ -99,6 +163,15 @@ func (c *intPointServiceClient) ListSomething(ctx context.Context, in *emptypb.E
 	return out, nil
 }
 
+func (c *intPointServiceClient) ListFirstPerX(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*ListIntPointResponse, error) {
+	out := new(ListIntPointResponse)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/ListFirstPerX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *intPointServiceClient) Delete(ctx context.Context, in *DeleteIntPointRequest, opts ...grpc.CallOption) (*DeleteIntPointResponse, error) {
 	out := new(DeleteIntPointResponse)
 	err := c.cc.Invoke(ctx, "/example.IntPointService/Delete", in, out, opts...)
@@ -108,6 +181,42 @@ func (c *intPointServiceClient) Delete(ctx context.Context, in *DeleteIntPointRe
 	return out, nil
 }
 
+func (c *intPointServiceClient) AggregateIntPointsByX(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/AggregateIntPointsByX", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *intPointServiceClient) FacetsIntPoint(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/FacetsIntPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *intPointServiceClient) PurgeTypeWithID(ctx context.Context, in *DeleteIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/PurgeTypeWithID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *intPointServiceClient) ListTrashTypeWithID(ctx context.Context, in *ListIntPointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/example.IntPointService/ListTrashTypeWithID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *intPointServiceClient) CustomMethod(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/example.IntPointService/CustomMethod", in, out, opts...)
@@ -135,12 +244,40 @@ type IntPointServiceServer interface {
 	// so multiple objects can have CURDL handlers in the same service, provided
 	// they are given unique suffixes
 	Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error)
+	// The SAVE create mode updates the row instead when the payload has an id
+	CreateOrReplace(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error)
+	// CreateOnce returns the point created by an earlier request of the same
+	// request_id
+	CreateOnce(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error)
+	// CreateSet inserts the points with INSERTs of batch_size rows
+	CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error)
 	Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error)
 	Update(context.Context, *UpdateIntPointRequest) (*UpdateIntPointResponse, error)
+	// UpdateIfX moves the point from the expected x, a point of another x is
+	// left as is and fails with Aborted
+	UpdateIfX(context.Context, *UpdateIntPointIfXRequest) (*UpdateIntPointResponse, error)
+	// UpdateSet patches all of the points in a single transaction of 30s
 	UpdateSet(context.Context, *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error)
 	List(context.Context, *ListIntPointRequest) (*ListIntPointResponse, error)
 	ListSomething(context.Context, *emptypb.Empty) (*ListSomethingResponse, error)
+	// ListFirstPerX lists the first point of each x with a DISTINCT ON, in the
+	// order of the request sorting after x
+	ListFirstPerX(context.Context, *ListIntPointRequest) (*ListIntPointResponse, error)
 	Delete(context.Context, *DeleteIntPointRequest) (*DeleteIntPointResponse, error)
+	// AggregateIntPointsByX generates a DefaultAggregateIntPointsByX handler,
+	// counting the points and summing their y by x, the method is a stub
+	AggregateIntPointsByX(context.Context, *ListIntPointRequest) (*emptypb.Empty, error)
+	// FacetsIntPoint generates a DefaultFacetsIntPoint handler counting the
+	// points by each x and by each y, the method is a stub
+	FacetsIntPoint(context.Context, *ListIntPointRequest) (*emptypb.Empty, error)
+	// PurgeTypeWithID generates a DefaultPurgeTypeWithID handler deleting a
+	// soft deleted TypeWithID for good, the method is a stub to be guarded by
+	// the implementation
+	PurgeTypeWithID(context.Context, *DeleteIntPointRequest) (*emptypb.Empty, error)
+	// ListTrashTypeWithID generates a DefaultListTrashTypeWithID handler
+	// listing the soft deleted TypeWithID rows, the method is a stub to be
+	// guarded by the implementation, which defines the response
+	ListTrashTypeWithID(context.Context, *ListIntPointRequest) (*emptypb.Empty, error)
 	// CustomMethod can't be autogenerated as it matches no conventions, it will
 	// become a stub
 	CustomMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
@@ -156,12 +293,24 @@ type UnimplementedIntPointServiceServer struct {
 func (UnimplementedIntPointServiceServer) Create(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (UnimplementedIntPointServiceServer) CreateOrReplace(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrReplace not implemented")
+}
+func (UnimplementedIntPointServiceServer) CreateOnce(context.Context, *CreateIntPointRequest) (*CreateIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnce not implemented")
+}
+func (UnimplementedIntPointServiceServer) CreateSet(context.Context, *CreateSetIntPointRequest) (*CreateSetIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSet not implemented")
+}
 func (UnimplementedIntPointServiceServer) Read(context.Context, *ReadIntPointRequest) (*ReadIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
 func (UnimplementedIntPointServiceServer) Update(context.Context, *UpdateIntPointRequest) (*UpdateIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
+func (UnimplementedIntPointServiceServer) UpdateIfX(context.Context, *UpdateIntPointIfXRequest) (*UpdateIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIfX not implemented")
+}
 func (UnimplementedIntPointServiceServer) UpdateSet(context.Context, *UpdateSetIntPointRequest) (*UpdateSetIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSet not implemented")
 }
@@ -171,9 +320,24 @@ func (UnimplementedIntPointServiceServer) List(context.Context, *ListIntPointReq
 func (UnimplementedIntPointServiceServer) ListSomething(context.Context, *emptypb.Empty) (*ListSomethingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSomething not implemented")
 }
+func (UnimplementedIntPointServiceServer) ListFirstPerX(context.Context, *ListIntPointRequest) (*ListIntPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFirstPerX not implemented")
+}
 func (UnimplementedIntPointServiceServer) Delete(context.Context, *DeleteIntPointRequest) (*DeleteIntPointResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedIntPointServiceServer) AggregateIntPointsByX(context.Context, *ListIntPointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateIntPointsByX not implemented")
+}
+func (UnimplementedIntPointServiceServer) FacetsIntPoint(context.Context, *ListIntPointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FacetsIntPoint not implemented")
+}
+func (UnimplementedIntPointServiceServer) PurgeTypeWithID(context.Context, *DeleteIntPointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTypeWithID not implemented")
+}
+func (UnimplementedIntPointServiceServer) ListTrashTypeWithID(context.Context, *ListIntPointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashTypeWithID not implemented")
+}
 func (UnimplementedIntPointServiceServer) CustomMethod(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CustomMethod not implemented")
 }
@@ -211,6 +375,60 @@ func _IntPointService_Create_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_CreateOrReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).CreateOrReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/CreateOrReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).CreateOrReplace(ctx, req.(*CreateIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntPointService_CreateOnce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).CreateOnce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/CreateOnce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).CreateOnce(ctx, req.(*CreateIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntPointService_CreateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSetIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).CreateSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/CreateSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).CreateSet(ctx, req.(*CreateSetIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IntPointService_Read_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReadIntPointRequest)
 	if err := dec(in); err != nil {
@@ -247,6 +465,24 @@ func _IntPointService_Update_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_UpdateIfX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIntPointIfXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).UpdateIfX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/UpdateIfX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).UpdateIfX(ctx, req.(*UpdateIntPointIfXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IntPointService_UpdateSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSetIntPointRequest)
 	if err := dec(in); err != nil {
@@ -301,6 +537,24 @@ func _IntPointService_ListSomething_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_ListFirstPerX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).ListFirstPerX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/ListFirstPerX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).ListFirstPerX(ctx, req.(*ListIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IntPointService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteIntPointRequest)
 	if err := dec(in); err != nil {
@@ -319,6 +573,78 @@ func _IntPointService_Delete_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IntPointService_AggregateIntPointsByX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).AggregateIntPointsByX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/AggregateIntPointsByX",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).AggregateIntPointsByX(ctx, req.(*ListIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntPointService_FacetsIntPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).FacetsIntPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/FacetsIntPoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).FacetsIntPoint(ctx, req.(*ListIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntPointService_PurgeTypeWithID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).PurgeTypeWithID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/PurgeTypeWithID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).PurgeTypeWithID(ctx, req.(*DeleteIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IntPointService_ListTrashTypeWithID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IntPointServiceServer).ListTrashTypeWithID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.IntPointService/ListTrashTypeWithID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IntPointServiceServer).ListTrashTypeWithID(ctx, req.(*ListIntPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IntPointService_CustomMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -366,6 +692,18 @@ var IntPointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _IntPointService_Create_Handler,
 		},
+		{
+			MethodName: "CreateOrReplace",
+			Handler:    _IntPointService_CreateOrReplace_Handler,
+		},
+		{
+			MethodName: "CreateOnce",
+			Handler:    _IntPointService_CreateOnce_Handler,
+		},
+		{
+			MethodName: "CreateSet",
+			Handler:    _IntPointService_CreateSet_Handler,
+		},
 		{
 			MethodName: "Read",
 			Handler:    _IntPointService_Read_Handler,
@@ -374,6 +712,10 @@ var IntPointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _IntPointService_Update_Handler,
 		},
+		{
+			MethodName: "UpdateIfX",
+			Handler:    _IntPointService_UpdateIfX_Handler,
+		},
 		{
 			MethodName: "UpdateSet",
 			Handler:    _IntPointService_UpdateSet_Handler,
@@ -386,10 +728,30 @@ var IntPointService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSomething",
 			Handler:    _IntPointService_ListSomething_Handler,
 		},
+		{
+			MethodName: "ListFirstPerX",
+			Handler:    _IntPointService_ListFirstPerX_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _IntPointService_Delete_Handler,
 		},
+		{
+			MethodName: "AggregateIntPointsByX",
+			Handler:    _IntPointService_AggregateIntPointsByX_Handler,
+		},
+		{
+			MethodName: "FacetsIntPoint",
+			Handler:    _IntPointService_FacetsIntPoint_Handler,
+		},
+		{
+			MethodName: "PurgeTypeWithID",
+			Handler:    _IntPointService_PurgeTypeWithID_Handler,
+		},
+		{
+			MethodName: "ListTrashTypeWithID",
+			Handler:    _IntPointService_ListTrashTypeWithID_Handler,
+		},
 		{
 			MethodName: "CustomMethod",
 			Handler:    _IntPointService_CustomMethod_Handler,
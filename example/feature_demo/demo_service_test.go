@@ -1,9 +1,23 @@
 package example
 
 import (
+	"context"
+	"database/sql/driver"
+	goerrors "errors"
 	fmt "fmt"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/protoc-gen-gorm/changes"
+	"github.com/infobloxopen/protoc-gen-gorm/errors"
+	"github.com/infobloxopen/protoc-gen-gorm/internal/dbtest"
+	"github.com/jinzhu/gorm"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestMultipleCrud(t *testing.T) {
@@ -24,4 +38,205 @@ func TestMultipleCrud(t *testing.T) {
 			}
 		}
 	})
-}
\ No newline at end of file
+}
+func TestDefaultListIntPointUnknownSortColumn(t *testing.T) {
+	s := &query.Sorting{Criterias: []*query.SortCriteria{{Tag: "x"}, {Tag: "y; DROP TABLE int_points"}}}
+	_, err := DefaultListIntPoint(context.Background(), nil, nil, s, nil, nil)
+	if !goerrors.Is(err, errors.UnknownSortColumnError) {
+		t.Errorf("DefaultListIntPoint=%v; want %v", err, errors.UnknownSortColumnError)
+	}
+}
+
+func TestDefaultListIntPointDistinctOnXUnknownSortColumn(t *testing.T) {
+	s := &query.Sorting{Criterias: []*query.SortCriteria{{Tag: "y"}, {Tag: "x, (SELECT 1)"}}}
+	_, err := DefaultListIntPointDistinctOnX(context.Background(), nil, nil, s, nil, nil)
+	if !goerrors.Is(err, errors.UnknownSortColumnError) {
+		t.Errorf("DefaultListIntPointDistinctOnX=%v; want %v", err, errors.UnknownSortColumnError)
+	}
+}
+
+func TestDefaultSelectIntPointUnknownColumn(t *testing.T) {
+	_, err := DefaultSelectIntPoint(context.Background(), nil, []string{"x", "y FROM int_points; --"}, nil, nil, nil, nil)
+	if !goerrors.Is(err, errors.UnknownSelectColumnError) {
+		t.Errorf("DefaultSelectIntPoint=%v; want %v", err, errors.UnknownSelectColumnError)
+	}
+}
+
+func TestDefaultCreateIntPointSet(t *testing.T) {
+	if IntPointORMMaxBatchSize*3 > 65535 {
+		t.Errorf("IntPointORMMaxBatchSize=%d exceeds 65535 parameters for 3 columns", IntPointORMMaxBatchSize)
+	}
+	_, err := DefaultCreateIntPointSet(context.Background(), []*IntPoint{{X: 1}, nil}, nil, 0)
+	if !goerrors.Is(err, errors.NilArgumentError) {
+		t.Errorf("DefaultCreateIntPointSet=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestDefaultUpsertIntPointSet(t *testing.T) {
+	ctx := context.Background()
+	if n, err := DefaultUpsertIntPointSet(ctx, nil, nil, 0); n != 0 || err != nil {
+		t.Errorf("DefaultUpsertIntPointSet of no objects=%d, %v; want 0, nil", n, err)
+	}
+	_, err := DefaultUpsertIntPointSet(ctx, []*IntPoint{{X: 1}, nil}, nil, 0)
+	if !goerrors.Is(err, errors.NilArgumentError) {
+		t.Errorf("DefaultUpsertIntPointSet=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestDefaultGetOrCreateIntPointByRequestId(t *testing.T) {
+	_, created, err := DefaultGetOrCreateIntPointByRequestId(context.Background(), nil, nil)
+	if err != errors.NilArgumentError || created {
+		t.Errorf("DefaultGetOrCreateIntPointByRequestId=%v, %v; want %v", created, err, errors.NilArgumentError)
+	}
+
+	columns := []string{"id", "request_id", "x", "y"}
+	requestID := "req-1"
+	in := &IntPoint{RequestId: &requestID, X: 3}
+	t.Run("found", func(t *testing.T) {
+		db, recorder, err := dbtest.Open("postgres")
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorder.Return(`FROM "int_points"`, columns, []driver.Value{int64(5), "req-1", int64(1), int64(2)})
+		got, created, err := DefaultGetOrCreateIntPointByRequestId(context.Background(), in, db)
+		if err != nil || created || got.GetId() != 5 || got.GetX() != 1 {
+			t.Errorf("DefaultGetOrCreateIntPointByRequestId=%v, %v, %v; want the stored point 5", got, created, err)
+		}
+		for _, query := range recorder.Writes() {
+			if strings.HasPrefix(query, "INSERT") {
+				t.Errorf("queries=%q; want no insert of a stored key", recorder.Writes())
+			}
+		}
+	})
+	t.Run("created", func(t *testing.T) {
+		db, recorder, err := dbtest.Open("postgres")
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorder.Return(`INSERT INTO "int_points"`, []string{"id"}, []driver.Value{int64(6)})
+		got, created, err := DefaultGetOrCreateIntPointByRequestId(context.Background(), in, db)
+		if err != nil || !created || got.GetId() != 6 || got.GetX() != 3 {
+			t.Errorf("DefaultGetOrCreateIntPointByRequestId=%v, %v, %v; want the new point 6", got, created, err)
+		}
+	})
+	t.Run("concurrent create", func(t *testing.T) {
+		db, recorder, err := dbtest.Open("postgres")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the key is stored by another caller between the read and the insert
+		recorder.Return(`FROM "int_points"`, columns, []driver.Value{int64(7), "req-1", int64(1), int64(2)})
+		recorder.ReturnOnce(`FROM "int_points"`, columns)
+		recorder.FailOnce(`INSERT INTO "int_points"`, &pq.Error{Code: "23505"})
+		tx := db.Begin()
+		got, created, err := DefaultGetOrCreateIntPointByRequestId(context.Background(), in, tx)
+		if err != nil || created || got.GetId() != 7 {
+			t.Fatalf("DefaultGetOrCreateIntPointByRequestId=%v, %v, %v; want the point 7 of the other caller", got, created, err)
+		}
+		var savepoints []string
+		for _, query := range recorder.Writes() {
+			if strings.Contains(query, "SAVEPOINT") {
+				savepoints = append(savepoints, query)
+			}
+		}
+		want := []string{"SAVEPOINT get_or_create_int_point_by_request_id", "ROLLBACK TO SAVEPOINT get_or_create_int_point_by_request_id"}
+		if !reflect.DeepEqual(savepoints, want) {
+			t.Errorf("savepoints=%q; want %q", savepoints, want)
+		}
+	})
+}
+
+func TestDefaultUpdateIntPointByIds(t *testing.T) {
+	ctx := context.Background()
+	if _, err := DefaultUpdateIntPointByIds(ctx, []uint32{1}, nil, &fieldmaskpb.FieldMask{Paths: []string{"X"}}, nil); err != errors.NilArgumentError {
+		t.Errorf("DefaultUpdateIntPointByIds of no object=%v; want %v", err, errors.NilArgumentError)
+	}
+	for _, path := range []string{"Id", "Z"} {
+		_, err := DefaultUpdateIntPointByIds(ctx, []uint32{1}, &IntPoint{}, &fieldmaskpb.FieldMask{Paths: []string{"X", path}}, nil)
+		if !goerrors.Is(err, errors.UnknownUpdateFieldError) {
+			t.Errorf("DefaultUpdateIntPointByIds of %s=%v; want UnknownUpdateFieldError", path, err)
+		}
+	}
+	if n, err := DefaultUpdateIntPointByIds(ctx, nil, &IntPoint{}, &fieldmaskpb.FieldMask{Paths: []string{"X"}}, nil); n != 0 || err != nil {
+		t.Errorf("DefaultUpdateIntPointByIds of no ids=%d, %v; want 0, nil", n, err)
+	}
+}
+
+func TestUpdateByIdsKeepsDeletedAtAndShardKey(t *testing.T) {
+	ctx := context.Background()
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"Title", "DeletedAt"}}
+	if _, err := DefaultUpdateDocumentByIds(ctx, []uint64{1}, &Document{}, mask, nil); !goerrors.Is(err, errors.UnknownUpdateFieldError) {
+		t.Errorf("DefaultUpdateDocumentByIds of DeletedAt=%v; want UnknownUpdateFieldError", err)
+	}
+	// moving the rows to another tenant would leave them in the wrong shard
+	mask = &fieldmaskpb.FieldMask{Paths: []string{"Body", "TenantId"}}
+	if _, err := DefaultUpdateShardedNoteByIds(ctx, []uint64{1}, &ShardedNote{}, mask, nil); !goerrors.Is(err, errors.UnknownUpdateFieldError) {
+		t.Errorf("DefaultUpdateShardedNoteByIds of TenantId=%v; want UnknownUpdateFieldError", err)
+	}
+}
+
+func TestDefaultUpdateIntPointIfX(t *testing.T) {
+	ctx := context.Background()
+	if _, err := DefaultUpdateIntPointIfX(ctx, nil, 1, nil, nil); err != errors.NilArgumentError {
+		t.Errorf("DefaultUpdateIntPointIfX of no object=%v; want %v", err, errors.NilArgumentError)
+	}
+	if _, err := DefaultUpdateIntPointIfX(ctx, &IntPoint{X: 2}, 1, nil, nil); err != errors.EmptyIdError {
+		t.Errorf("DefaultUpdateIntPointIfX of no id=%v; want %v", err, errors.EmptyIdError)
+	}
+	_, err := DefaultUpdateIntPointIfX(ctx, &IntPoint{Id: 1, X: 2}, 1, &fieldmaskpb.FieldMask{Paths: []string{"Z"}}, nil)
+	if !goerrors.Is(err, errors.UnknownUpdateFieldError) {
+		t.Errorf("DefaultUpdateIntPointIfX of Z=%v; want UnknownUpdateFieldError", err)
+	}
+}
+
+func TestSubscribeIntPoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := SubscribeIntPoint(ctx, changes.Options{Buffer: 1})
+	for _, x := range []int32{1, 2} {
+		if err := publishIntPointChange(changes.Create, &IntPointORM{Id: 1, X: x}); err != nil {
+			t.Fatalf("publishIntPointChange: %v", err)
+		}
+	}
+	// the second change is dropped as the buffer is full
+	if e := <-ch; e.Op != changes.Create || e.IntPoint.GetX() != 1 {
+		t.Errorf("first change=%v %v; want create of x 1", e.Op, e.IntPoint)
+	}
+	select {
+	case e := <-ch:
+		t.Errorf("change %v %v should have been dropped", e.Op, e.IntPoint)
+	default:
+	}
+	cancel()
+	if _, ok := <-ch; ok {
+		t.Error("channel of a done subscriber should be closed")
+	}
+}
+
+func TestParseBlogPostName(t *testing.T) {
+	key, err := ParseBlogPostName("authors/ann/posts/12")
+	if err != nil {
+		t.Fatalf("ParseBlogPostName: %v", err)
+	}
+	if key.AuthorId != "ann" || key.Id != 12 {
+		t.Errorf("ParseBlogPostName=%+v; want author ann and id 12", key)
+	}
+	for _, name := range []string{"authors/ann/posts", "authors//posts/12", "writers/ann/posts/12", "authors/ann/posts/x"} {
+		if _, err := ParseBlogPostName(name); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("ParseBlogPostName(%q)=%v; want InvalidArgument", name, err)
+		}
+	}
+	_, err = DefaultReadBlogPost(context.Background(), &BlogPost{Name: "authors/ann"}, nil)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("DefaultReadBlogPost=%v; want InvalidArgument", err)
+	}
+}
+
+func TestDefaultReorderFolderDocumentsRejectsDuplicates(t *testing.T) {
+	ctx := context.Background()
+	if err := DefaultReorderFolderDocuments(ctx, &gorm.DB{}, 1, []uint64{2, 3, 2}); !goerrors.Is(err, errors.InvalidOrderError) {
+		t.Errorf("DefaultReorderFolderDocuments of a duplicate=%v; want InvalidOrderError", err)
+	}
+	if err := DefaultReorderFolderDocuments(ctx, &gorm.DB{}, 0, nil); err != errors.EmptyIdError {
+		t.Errorf("DefaultReorderFolderDocuments without a parent=%v; want EmptyIdError", err)
+	}
+}
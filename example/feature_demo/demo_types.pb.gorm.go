@@ -2,21 +2,31 @@ package example
 
 import (
 	context "context"
+	sql "database/sql"
+	driver "database/sql/driver"
+	base64 "encoding/base64"
 	fmt "fmt"
 	auth "github.com/infobloxopen/atlas-app-toolkit/auth"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
 	user "github.com/infobloxopen/protoc-gen-gorm/example/user"
+	explain "github.com/infobloxopen/protoc-gen-gorm/explain"
+	returning "github.com/infobloxopen/protoc-gen-gorm/returning"
+	timestamps "github.com/infobloxopen/protoc-gen-gorm/timestamps"
+	txn "github.com/infobloxopen/protoc-gen-gorm/txn"
 	types "github.com/infobloxopen/protoc-gen-gorm/types"
 	gorm "github.com/jinzhu/gorm"
 	postgres "github.com/jinzhu/gorm/dialects/postgres"
 	pq "github.com/lib/pq"
 	go_uuid "github.com/satori/go.uuid"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
 	strings "strings"
 	time "time"
 )
@@ -27,8 +37,9 @@ type TestTypesORM struct {
 	Array2                    pq.StringArray
 	BecomesInt                string
 	CreatedAt                 *time.Time
-	JsonField                 *postgres.Jsonb `gorm:"type:jsonb"`
+	JsonField                 *postgres.Jsonb `gorm:"type:jsonb;default:'{}'::jsonb"`
 	NullableUuid              *go_uuid.UUID   `gorm:"type:uuid"`
+	OptionalCount             *int32
 	OptionalString            *string
 	ThingsTypeWithIDId        *uint32
 	TimeOnly                  string `gorm:"type:time"`
@@ -41,6 +52,43 @@ func (TestTypesORM) TableName() string {
 	return "smorgasbord"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestTypesORM) ClearAssociations() {
+}
+
+// Region returns the string at region in JsonField, false if it is missing
+func (m *TestTypesORM) Region() (string, bool) {
+	var v string
+	if m.JsonField == nil || !types.JSONPath(m.JsonField.RawMessage, "region", &v) {
+		return "", false
+	}
+	return v, true
+}
+
+// MaxLimit returns the int64 at limits.max in JsonField, false if it is missing
+func (m *TestTypesORM) MaxLimit() (int64, bool) {
+	var v int64
+	if m.JsonField == nil || !types.JSONPath(m.JsonField.RawMessage, "limits.max", &v) {
+		return 0, false
+	}
+	return v, true
+}
+
+// TestTypesORMIndexes lists the indexes declared by the gorm tags of TestTypesORM
+var TestTypesORMIndexes = []types.IndexDef{
+	{Name: "idx_smorgasbord_json", Columns: []string{"json_field"}, Unique: false, Type: "gin"},
+}
+
+// applyTestTypesConditionalPreloads preloads the associations of the conditional
+// preloads of TestTypes in ctx whose condition holds
+func applyTestTypesConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestTypes") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *TestTypes) ToORM(ctx context.Context) (TestTypesORM, error) {
@@ -70,8 +118,10 @@ func (m *TestTypes) ToORM(ctx context.Context) (TestTypesORM, error) {
 		to.CreatedAt = &t
 	}
 	to.TypeWithIdId = m.TypeWithIdId
-	if m.JsonField != nil {
+	if m.JsonField != nil && m.JsonField.Value != "" {
 		to.JsonField = &postgres.Jsonb{[]byte(m.JsonField.Value)}
+	} else {
+		to.JsonField = &postgres.Jsonb{[]byte("{}")}
 	}
 	if m.NullableUuid != nil {
 		tempUUID, uErr := go_uuid.FromString(m.NullableUuid.Value)
@@ -85,6 +135,10 @@ func (m *TestTypes) ToORM(ctx context.Context) (TestTypesORM, error) {
 			return to, err
 		}
 	}
+	if m.OptionalCount != nil {
+		v := *m.OptionalCount
+		to.OptionalCount = &v
+	}
 	if posthook, ok := interface{}(m).(TestTypesWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -111,8 +165,10 @@ func (m *TestTypesORM) ToPB(ctx context.Context) (TestTypes, error) {
 		to.CreatedAt = timestamppb.New(*m.CreatedAt)
 	}
 	to.TypeWithIdId = m.TypeWithIdId
-	if m.JsonField != nil {
+	if m.JsonField != nil && m.JsonField.RawMessage != nil {
 		to.JsonField = &types.JSONValue{Value: string(m.JsonField.RawMessage)}
+	} else {
+		to.JsonField = &types.JSONValue{Value: "{}"}
 	}
 	if m.NullableUuid != nil {
 		to.NullableUuid = &types.UUIDValue{Value: m.NullableUuid.String()}
@@ -122,12 +178,223 @@ func (m *TestTypesORM) ToPB(ctx context.Context) (TestTypes, error) {
 			return to, err
 		}
 	}
+	if m.OptionalCount != nil {
+		v := *m.OptionalCount
+		to.OptionalCount = &v
+	}
 	if posthook, ok := interface{}(m).(TestTypesWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestTypes) MergeToORM(ctx context.Context, dst *TestTypesORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.OptionalString != nil {
+		dst.OptionalString = to.OptionalString
+	}
+	dst.BecomesInt = to.BecomesInt
+	if m.Uuid != nil {
+		dst.Uuid = to.Uuid
+	}
+	if m.CreatedAt != nil {
+		dst.CreatedAt = to.CreatedAt
+	}
+	dst.TypeWithIdId = to.TypeWithIdId
+	if m.JsonField != nil {
+		dst.JsonField = to.JsonField
+	}
+	if m.NullableUuid != nil {
+		dst.NullableUuid = to.NullableUuid
+	}
+	if m.TimeOnly != nil {
+		dst.TimeOnly = to.TimeOnly
+	}
+	if m.OptionalCount != nil {
+		dst.OptionalCount = to.OptionalCount
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestTypes) ApplyFieldMaskToORM(ctx context.Context, dst *TestTypesORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestTypesORM) applyFieldMaskPath(from *TestTypesORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "OptionalString":
+		if rest != "" {
+			return false
+		}
+		m.OptionalString = from.OptionalString
+		return true
+	case "BecomesInt":
+		if rest != "" {
+			return false
+		}
+		m.BecomesInt = from.BecomesInt
+		return true
+	case "Uuid":
+		if rest != "" {
+			return false
+		}
+		m.Uuid = from.Uuid
+		return true
+	case "CreatedAt":
+		if rest != "" {
+			return false
+		}
+		m.CreatedAt = from.CreatedAt
+		return true
+	case "TypeWithIdId":
+		if rest != "" {
+			return false
+		}
+		m.TypeWithIdId = from.TypeWithIdId
+		return true
+	case "JsonField":
+		if rest != "" {
+			return false
+		}
+		m.JsonField = from.JsonField
+		return true
+	case "NullableUuid":
+		if rest != "" {
+			return false
+		}
+		m.NullableUuid = from.NullableUuid
+		return true
+	case "TimeOnly":
+		if rest != "" {
+			return false
+		}
+		m.TimeOnly = from.TimeOnly
+		return true
+	case "OptionalCount":
+		if rest != "" {
+			return false
+		}
+		m.OptionalCount = from.OptionalCount
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestTypes returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestTypes(old, new *TestTypesORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestTypesORM{}
+	}
+	if new == nil {
+		new = &TestTypesORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if (old.OptionalString == nil) != (new.OptionalString == nil) || old.OptionalString != nil && *old.OptionalString != *new.OptionalString {
+		mask.Paths = append(mask.Paths, "OptionalString")
+	}
+	if old.BecomesInt != new.BecomesInt {
+		mask.Paths = append(mask.Paths, "BecomesInt")
+	}
+	if !reflect.DeepEqual(old.Uuid, new.Uuid) {
+		mask.Paths = append(mask.Paths, "Uuid")
+	}
+	if (old.CreatedAt == nil) != (new.CreatedAt == nil) || old.CreatedAt != nil && !old.CreatedAt.Truncate(time.Microsecond).Equal(new.CreatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "CreatedAt")
+	}
+	if old.TypeWithIdId != new.TypeWithIdId {
+		mask.Paths = append(mask.Paths, "TypeWithIdId")
+	}
+	if !reflect.DeepEqual(old.JsonField, new.JsonField) {
+		mask.Paths = append(mask.Paths, "JsonField")
+	}
+	if !reflect.DeepEqual(old.NullableUuid, new.NullableUuid) {
+		mask.Paths = append(mask.Paths, "NullableUuid")
+	}
+	if old.TimeOnly != new.TimeOnly {
+		mask.Paths = append(mask.Paths, "TimeOnly")
+	}
+	if (old.OptionalCount == nil) != (new.OptionalCount == nil) || old.OptionalCount != nil && *old.OptionalCount != *new.OptionalCount {
+		mask.Paths = append(mask.Paths, "OptionalCount")
+	}
+	return mask
+}
+
+// TestTypesSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestTypesSliceToORM(ctx context.Context, in []*TestTypes) ([]*TestTypesORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestTypesORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestTypesORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestTypesORMSliceToPB(ctx context.Context, in []*TestTypesORM) ([]*TestTypes, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestTypes, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type TestTypes the arg will be the target, the caller the one being converted from
 
@@ -153,21 +420,50 @@ type TestTypesWithAfterToPB interface {
 
 type TypeWithIDORM struct {
 	ANestedObject     *TestTypesORM `gorm:"foreignkey:ANestedObjectTypeWithIDId;association_foreignkey:Id"`
+	Active            types.YesNo   `gorm:"type:char(1)"`
 	Address           *types.Inet   `gorm:"type:inet"`
+	CorrelationId     string
+	CreatedBy         string
+	Currency          string
 	DeletedAt         *time.Time
+	DeletedBy         *string
+	Details           types.AnyJSON `gorm:"type:jsonb"`
+	DisplayName       string        `gorm:"type:text COLLATE \"und-x-icu\""`
 	DoubleField       *float64
+	Email             string `gorm:"type:citext;unique_index:uix_type_with_ids_email"`
+	ExternalId        string
 	FloatField        *float32
 	Id                uint32
 	IntPointId        *uint32
-	Ip                string          `gorm:"column:ip_addr"`
-	MultiAccountTypes []*JoinTable    `gorm:"foreignkey:TypeWithIDID"`
+	Ip                string       `gorm:"column:ip_addr"`
+	Location          *types.Point `gorm:"type:geography(Point,4326)"`
+	MultiAccountTypes []*JoinTable `gorm:"foreignkey:TypeWithIDID"`
+	ObservedAt        *time.Time
+	ObservedAtNanos   int32
+	PastStatuses      types.EnumNames `gorm:"type:text[]"`
 	Point             *IntPointORM    `gorm:"foreignkey:IntPointId;association_foreignkey:Id"`
-	SecretInt         int32           `gorm:"-"`
+	Price             int64           `gorm:"type:bigint"`
+	Priority          int32
+	RegisteredAt      *time.Time `gorm:"default:now();not null"`
+	RetryDelay        *int64     `gorm:"type:bigint"`
+	ReviewStatus      int32
+	Revision          int64
+	SecretInt         int32                     `gorm:"-"`
+	SeenAt            *time.Time                `gorm:"default:now()"`
+	ShipTo            *ShippingAddressComposite `gorm:"type:shipping_address_t"`
+	Signature         string                    `gorm:"type:text"`
+	Slug              string
+	StartAt           *time.Time
+	StartTz           string
+	State             string          `gorm:"default:'active'"`
+	Status            int16           `gorm:"type:smallint"`
 	TagSizeTest       string          `gorm:"size:512"`
 	TagTest           float32         `gorm:"type:float;precision:6"`
 	Things            []*TestTypesORM `gorm:"foreignkey:ThingsTypeWithIDId;association_foreignkey:Id"`
 	TimeOnly          string          `gorm:"type:time"`
-	User              *user.UserORM   `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	Timeout           *types.Interval `gorm:"type:interval"`
+	UpdatedBy         string
+	User              *user.UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
 	UserId            *string
 }
 
@@ -176,6 +472,196 @@ func (TypeWithIDORM) TableName() string {
 	return "type_with_ids"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TypeWithIDORM) ClearAssociations() {
+	m.ANestedObject = nil
+	m.Point = nil
+	m.Things = nil
+	m.User = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TypeWithIDORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := TypeWithIDORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// ReloadComputed reads the columns set by the DB, registered_at, revision, seen_at, state, of the row of the
+// primary key of the object again, leaving its other fields as they are
+func (m *TypeWithIDORM) ReloadComputed(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := TypeWithIDORM{}
+	if err := db.Select([]string{"registered_at", "revision", "seen_at", "state"}).Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	m.RegisteredAt = reloaded.RegisteredAt
+	m.Revision = reloaded.Revision
+	m.SeenAt = reloaded.SeenAt
+	m.State = reloaded.State
+	return nil
+}
+
+// ValidateReferences checks that the rows the foreign keys of Point refer to
+// exist, unless the association is set and written along with the object, and fails
+// with errors.MissingReferenceError otherwise. The rows are locked until the
+// transaction of db ends, so that they are not deleted before the write.
+func (m *TypeWithIDORM) ValidateReferences(ctx context.Context, db *gorm.DB) error {
+	if m.Point == nil && m.IntPointId != nil {
+		found := IntPointORM{}
+		if err := db.Set("gorm:query_option", "FOR SHARE").Select("id").Where("id = ?", *m.IntPointId).First(&found).Error; gorm.IsRecordNotFoundError(err) {
+			return fmt.Errorf("%w: no IntPoint of int_point_id %v", errors.MissingReferenceError, *m.IntPointId)
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TypeWithIDLocationWithin returns a scope of the TypeWithIDORM rows with a Location
+// within meters of the point, by ST_DWithin of PostGIS
+func TypeWithIDLocationWithin(point types.Point, meters float64) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("ST_DWithin(type_with_ids.location, ?::geography, ?)", point, meters)
+	}
+}
+
+// TypeWithIDORMIndexes lists the indexes declared by the gorm tags of TypeWithIDORM
+var TypeWithIDORMIndexes = []types.IndexDef{
+	{Name: "idx_type_with_ids_correlation_id", Columns: []string{"correlation_id"}, Unique: false, Include: []string{"email", "created_by"}},
+	{Name: "idx_type_with_ids_location", Columns: []string{"location"}, Unique: false, Type: "gist"},
+	{Name: "uix_type_with_ids_email", Columns: []string{"email"}, Unique: true},
+	{Name: "uix_type_with_ids_live_slug", Columns: []string{"slug"}, Unique: true, Where: "state <> 'archived'"},
+}
+
+// TypeWithIDORMForeignKeys lists the foreign keys of the associations of TypeWithIDORM
+var TypeWithIDORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_smorgasbord_a_nested_object_type_with_id_id", Table: "smorgasbord", Columns: []string{"a_nested_object_type_with_id_id"}, References: "type_with_ids", ReferencedColumns: []string{"id"}},
+	{Name: "fk_type_with_ids_int_point_id", Table: "type_with_ids", Columns: []string{"int_point_id"}, References: "int_points", ReferencedColumns: []string{"id"}},
+	{Name: "fk_type_with_ids_review_status", Table: "type_with_ids", Columns: []string{"review_status"}, References: "review_statuses", ReferencedColumns: []string{"id"}},
+	{Name: "fk_smorgasbord_things_type_with_id_id", Table: "smorgasbord", Columns: []string{"things_type_with_id_id"}, References: "type_with_ids", ReferencedColumns: []string{"id"}},
+	{Name: "fk_type_with_ids_user_id", Table: "type_with_ids", Columns: []string{"user_id"}, References: "users", ReferencedColumns: []string{"id"}},
+}
+
+// LoadTypeWithIDANestedObjectForAll loads the ANestedObject of all the parents with a single query and
+// sets them on each parent by ANestedObjectTypeWithIDId. The parents
+// lose the ANestedObject they held, a nil parent is skipped.
+func LoadTypeWithIDANestedObjectForAll(ctx context.Context, db *gorm.DB, parents []*TypeWithIDORM) error {
+	type key struct {
+		Id uint32
+	}
+	byKey := make(map[key][]*TypeWithIDORM, len(parents))
+	loaded := make(map[*TypeWithIDORM]bool, len(parents))
+	var ids []uint32
+	idSeen := make(map[uint32]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.ANestedObject = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TestTypesORM
+	if err := db.Where("a_nested_object_type_with_id_id IN (?)", ids).Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.ANestedObjectTypeWithIDId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.ANestedObjectTypeWithIDId}] {
+			if parent.ANestedObject == nil {
+				parent.ANestedObject = child
+			}
+		}
+	}
+	return nil
+}
+
+// LoadTypeWithIDThingsForAll loads the Things of all the parents with a single query and
+// sets them on each parent by ThingsTypeWithIDId. The parents
+// lose the Things they held, a nil parent is skipped.
+func LoadTypeWithIDThingsForAll(ctx context.Context, db *gorm.DB, parents []*TypeWithIDORM) error {
+	type key struct {
+		Id uint32
+	}
+	byKey := make(map[key][]*TypeWithIDORM, len(parents))
+	loaded := make(map[*TypeWithIDORM]bool, len(parents))
+	var ids []uint32
+	idSeen := make(map[uint32]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Things = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TestTypesORM
+	if err := db.Where("things_type_with_id_id IN (?)", ids).Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.ThingsTypeWithIDId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.ThingsTypeWithIDId}] {
+			parent.Things = append(parent.Things, child)
+		}
+	}
+	return nil
+}
+
+// WithTypeWithIDConditionalPreload returns a context making the Read and List
+// handlers of TypeWithID preload the association name, e.g. ANestedObject, when cond holds
+// for their context
+func WithTypeWithIDConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "TypeWithID", name, cond)
+}
+
+// applyTypeWithIDConditionalPreloads preloads the associations of the conditional
+// preloads of TypeWithID in ctx whose condition holds
+func applyTypeWithIDConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TypeWithID") {
+		switch preload.Name {
+		case "ANestedObject", "Point", "Things", "User":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *TypeWithID) ToORM(ctx context.Context) (TypeWithIDORM, error) {
@@ -244,6 +730,85 @@ func (m *TypeWithID) ToORM(ctx context.Context) (TypeWithIDORM, error) {
 		t := m.DeletedAt.AsTime()
 		to.DeletedAt = &t
 	}
+	to.Status = int16(m.Status)
+	to.State = m.State
+	if m.SeenAt != nil {
+		t := m.SeenAt.AsTime()
+		to.SeenAt = &t
+	}
+	to.Active = types.YesNo(m.Active)
+	to.Slug = m.Slug
+	to.Slug = strings.ToLower(strings.TrimSpace(to.Slug))
+	if m.Timeout != nil {
+		v, err := types.IntervalFromPB(m.Timeout)
+		if err != nil {
+			return to, err
+		}
+		to.Timeout = &v
+	}
+	if m.RetryDelay != nil {
+		v, err := types.DurationNanos(m.RetryDelay)
+		if err != nil {
+			return to, err
+		}
+		to.RetryDelay = &v
+	}
+	if m.ObservedAt != nil {
+		t := m.ObservedAt.AsTime()
+		to.ObservedAtNanos = int32(t.Nanosecond() % 1000)
+		t = t.Truncate(time.Microsecond)
+		to.ObservedAt = &t
+	}
+	if m.Details != nil {
+		v, err := types.AnyJSONFromPB(m.Details)
+		if err != nil {
+			return to, err
+		}
+		to.Details = v
+	}
+	if m.RegisteredAt != nil && (m.RegisteredAt.Seconds != 0 || m.RegisteredAt.Nanos != 0) {
+		t := m.RegisteredAt.AsTime()
+		to.RegisteredAt = &t
+	}
+	to.ExternalId = m.ExternalId
+	to.ReviewStatus = int32(m.ReviewStatus)
+	if m.Location != nil {
+		if to.Location, err = types.NewPoint(m.Location.Lat, m.Location.Lng); err != nil {
+			return to, err
+		}
+	}
+	if m.ShipTo != nil {
+		to.ShipTo = &ShippingAddressComposite{Street: m.ShipTo.Street, City: m.ShipTo.City, Zip: m.ShipTo.Zip}
+	}
+	to.DisplayName = m.DisplayName
+	if m.StartAt != nil {
+		t := m.StartAt.AsTime()
+		if _, err := time.LoadLocation(m.StartTz); err != nil {
+			return to, err
+		}
+		to.StartAt = &t
+	}
+	to.StartTz = m.StartTz
+	to.Priority = m.Priority
+	if m.Price != "" {
+		if to.Price, err = types.ParseMinorUnits(m.Price, 2); err != nil {
+			return to, fmt.Errorf("Price: %v", err)
+		}
+	}
+	if to.Price != 0 && m.Currency == "" {
+		return to, fmt.Errorf("Price %s has no Currency", m.Price)
+	}
+	to.Currency = m.Currency
+	to.Email = m.Email
+	for _, v := range m.PastStatuses {
+		name, ok := TestTypesStatus_name[int32(v)]
+		if !ok {
+			return to, fmt.Errorf("PastStatuses: %d is not a value of status", v)
+		}
+		to.PastStatuses = append(to.PastStatuses, name)
+	}
+	to.Signature = base64.StdEncoding.EncodeToString(m.Signature)
+	to.CorrelationId = m.CorrelationId
 	if posthook, ok := interface{}(m).(TypeWithIDWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -313,137 +878,698 @@ func (m *TypeWithIDORM) ToPB(ctx context.Context) (TypeWithID, error) {
 	if m.DeletedAt != nil {
 		to.DeletedAt = timestamppb.New(*m.DeletedAt)
 	}
-	if posthook, ok := interface{}(m).(TypeWithIDWithAfterToPB); ok {
-		err = posthook.AfterToPB(ctx, &to)
+	to.CreatedBy = m.CreatedBy
+	to.Status = TestTypesStatus(m.Status)
+	to.State = m.State
+	if m.SeenAt != nil {
+		to.SeenAt = timestamppb.New(*m.SeenAt)
 	}
-	return to, err
-}
-
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type TypeWithID the arg will be the target, the caller the one being converted from
-
-// TypeWithIDBeforeToORM called before default ToORM code
-type TypeWithIDWithBeforeToORM interface {
-	BeforeToORM(context.Context, *TypeWithIDORM) error
-}
-
-// TypeWithIDAfterToORM called after default ToORM code
-type TypeWithIDWithAfterToORM interface {
-	AfterToORM(context.Context, *TypeWithIDORM) error
-}
-
-// TypeWithIDBeforeToPB called before default ToPB code
-type TypeWithIDWithBeforeToPB interface {
-	BeforeToPB(context.Context, *TypeWithID) error
-}
-
-// TypeWithIDAfterToPB called after default ToPB code
-type TypeWithIDWithAfterToPB interface {
-	AfterToPB(context.Context, *TypeWithID) error
-}
-
-type MultiaccountTypeWithIDORM struct {
-	AccountID string
-	Id        uint64
-	SomeField string
-}
-
-// TableName overrides the default tablename generated by GORM
-func (MultiaccountTypeWithIDORM) TableName() string {
-	return "multiaccount_type_with_ids"
-}
-
-// ToORM runs the BeforeToORM hook if present, converts the fields of this
-// object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *MultiaccountTypeWithID) ToORM(ctx context.Context) (MultiaccountTypeWithIDORM, error) {
-	to := MultiaccountTypeWithIDORM{}
-	var err error
-	if prehook, ok := interface{}(m).(MultiaccountTypeWithIDWithBeforeToORM); ok {
-		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+	to.Active = bool(m.Active)
+	to.Slug = m.Slug
+	if m.Timeout != nil {
+		to.Timeout = durationpb.New(time.Duration(*m.Timeout))
+	}
+	if m.RetryDelay != nil {
+		to.RetryDelay = durationpb.New(time.Duration(*m.RetryDelay))
+	}
+	if m.ObservedAt != nil {
+		to.ObservedAt = timestamppb.New(m.ObservedAt.Truncate(time.Microsecond).Add(time.Duration(m.ObservedAtNanos)))
+	}
+	if m.Details != nil {
+		v, err := m.Details.ToPB()
+		if err != nil {
 			return to, err
 		}
+		to.Details = v
 	}
-	to.Id = m.Id
-	to.SomeField = m.SomeField
-	accountID, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return to, err
+	if m.RegisteredAt != nil {
+		to.RegisteredAt = timestamppb.New(*m.RegisteredAt)
 	}
-	to.AccountID = accountID
-	if posthook, ok := interface{}(m).(MultiaccountTypeWithIDWithAfterToORM); ok {
-		err = posthook.AfterToORM(ctx, &to)
+	to.ExternalId = m.ExternalId
+	to.ReviewStatus = TestTypesStatus(m.ReviewStatus)
+	to.Revision = m.Revision
+	if m.Location != nil {
+		to.Location = &types.GeoPoint{Lat: m.Location.Lat, Lng: m.Location.Lng}
 	}
-	return to, err
-}
-
-// ToPB runs the BeforeToPB hook if present, converts the fields of this
-// object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *MultiaccountTypeWithIDORM) ToPB(ctx context.Context) (MultiaccountTypeWithID, error) {
-	to := MultiaccountTypeWithID{}
-	var err error
-	if prehook, ok := interface{}(m).(MultiaccountTypeWithIDWithBeforeToPB); ok {
-		if err = prehook.BeforeToPB(ctx, &to); err != nil {
-			return to, err
+	if m.ShipTo != nil {
+		to.ShipTo = &ShippingAddress{Street: m.ShipTo.Street, City: m.ShipTo.City, Zip: m.ShipTo.Zip}
+	}
+	to.DisplayName = m.DisplayName
+	if m.StartAt != nil {
+		to.StartAt = timestamppb.New(*m.StartAt)
+	}
+	to.StartTz = m.StartTz
+	to.Priority = m.Priority
+	to.Price = types.FormatMinorUnits(m.Price, 2)
+	to.Currency = m.Currency
+	to.Email = m.Email
+	for _, name := range m.PastStatuses {
+		v, ok := TestTypesStatus_value[name]
+		if !ok {
+			return to, fmt.Errorf("PastStatuses: %q is not a value of status", name)
 		}
+		to.PastStatuses = append(to.PastStatuses, TestTypesStatus(v))
 	}
-	to.Id = m.Id
-	to.SomeField = m.SomeField
-	if posthook, ok := interface{}(m).(MultiaccountTypeWithIDWithAfterToPB); ok {
+	if m.Signature != "" {
+		if to.Signature, err = base64.StdEncoding.DecodeString(m.Signature); err != nil {
+			return to, fmt.Errorf("Signature: %w", err)
+		}
+	}
+	to.CorrelationId = m.CorrelationId
+	if posthook, ok := interface{}(m).(TypeWithIDWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type MultiaccountTypeWithID the arg will be the target, the caller the one being converted from
-
-// MultiaccountTypeWithIDBeforeToORM called before default ToORM code
-type MultiaccountTypeWithIDWithBeforeToORM interface {
-	BeforeToORM(context.Context, *MultiaccountTypeWithIDORM) error
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TypeWithID) MergeToORM(ctx context.Context, dst *TypeWithIDORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Ip = to.Ip
+	if m.Address != nil {
+		dst.Address = to.Address
+	}
+	dst.TagTest = to.TagTest
+	dst.TagSizeTest = to.TagSizeTest
+	if m.FloatField != nil {
+		dst.FloatField = to.FloatField
+	}
+	if m.DoubleField != nil {
+		dst.DoubleField = to.DoubleField
+	}
+	if m.TimeOnly != nil {
+		dst.TimeOnly = to.TimeOnly
+	}
+	if m.DeletedAt != nil {
+		dst.DeletedAt = to.DeletedAt
+	}
+	dst.Status = to.Status
+	dst.State = to.State
+	if m.SeenAt != nil {
+		dst.SeenAt = to.SeenAt
+	}
+	dst.Active = to.Active
+	dst.Slug = to.Slug
+	if m.Timeout != nil {
+		dst.Timeout = to.Timeout
+	}
+	if m.RetryDelay != nil {
+		dst.RetryDelay = to.RetryDelay
+	}
+	if m.ObservedAt != nil {
+		dst.ObservedAt = to.ObservedAt
+		dst.ObservedAtNanos = to.ObservedAtNanos
+	}
+	if m.Details != nil {
+		dst.Details = to.Details
+	}
+	if to.RegisteredAt != nil {
+		dst.RegisteredAt = to.RegisteredAt
+	}
+	dst.ReviewStatus = to.ReviewStatus
+	if m.Location != nil {
+		dst.Location = to.Location
+	}
+	if m.ShipTo != nil {
+		dst.ShipTo = to.ShipTo
+	}
+	dst.DisplayName = to.DisplayName
+	if m.StartAt != nil {
+		dst.StartAt = to.StartAt
+	}
+	dst.StartTz = to.StartTz
+	dst.Priority = to.Priority
+	dst.Price = to.Price
+	dst.Currency = to.Currency
+	dst.Email = to.Email
+	dst.PastStatuses = to.PastStatuses
+	dst.Signature = to.Signature
+	dst.CorrelationId = to.CorrelationId
+	if associations {
+		dst.Things = to.Things
+		dst.ANestedObject = to.ANestedObject
+		dst.Point = to.Point
+		dst.User = to.User
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TypeWithID) ApplyFieldMaskToORM(ctx context.Context, dst *TypeWithIDORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TypeWithIDORM) applyFieldMaskPath(from *TypeWithIDORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Ip":
+		if rest != "" {
+			return false
+		}
+		m.Ip = from.Ip
+		return true
+	case "Things":
+		if rest != "" {
+			return false
+		}
+		m.Things = from.Things
+		return true
+	case "ANestedObject":
+		if rest == "" {
+			m.ANestedObject = from.ANestedObject
+			return true
+		}
+		nested := from.ANestedObject
+		if nested == nil {
+			nested = &TestTypesORM{}
+		}
+		if m.ANestedObject == nil {
+			m.ANestedObject = &TestTypesORM{}
+		}
+		return m.ANestedObject.applyFieldMaskPath(nested, rest)
+	case "Point":
+		if rest == "" {
+			m.Point = from.Point
+			return true
+		}
+		nested := from.Point
+		if nested == nil {
+			nested = &IntPointORM{}
+		}
+		if m.Point == nil {
+			m.Point = &IntPointORM{}
+		}
+		return m.Point.applyFieldMaskPath(nested, rest)
+	case "User":
+		if rest != "" {
+			return false
+		}
+		m.User = from.User
+		return true
+	case "Address":
+		if rest != "" {
+			return false
+		}
+		m.Address = from.Address
+		return true
+	case "TagTest":
+		if rest != "" {
+			return false
+		}
+		m.TagTest = from.TagTest
+		return true
+	case "TagSizeTest":
+		if rest != "" {
+			return false
+		}
+		m.TagSizeTest = from.TagSizeTest
+		return true
+	case "FloatField":
+		if rest != "" {
+			return false
+		}
+		m.FloatField = from.FloatField
+		return true
+	case "DoubleField":
+		if rest != "" {
+			return false
+		}
+		m.DoubleField = from.DoubleField
+		return true
+	case "TimeOnly":
+		if rest != "" {
+			return false
+		}
+		m.TimeOnly = from.TimeOnly
+		return true
+	case "DeletedAt":
+		if rest != "" {
+			return false
+		}
+		m.DeletedAt = from.DeletedAt
+		return true
+	case "Status":
+		if rest != "" {
+			return false
+		}
+		m.Status = from.Status
+		return true
+	case "State":
+		if rest != "" {
+			return false
+		}
+		m.State = from.State
+		return true
+	case "SeenAt":
+		if rest != "" {
+			return false
+		}
+		m.SeenAt = from.SeenAt
+		return true
+	case "Active":
+		if rest != "" {
+			return false
+		}
+		m.Active = from.Active
+		return true
+	case "Slug":
+		if rest != "" {
+			return false
+		}
+		m.Slug = from.Slug
+		return true
+	case "Timeout":
+		if rest != "" {
+			return false
+		}
+		m.Timeout = from.Timeout
+		return true
+	case "RetryDelay":
+		if rest != "" {
+			return false
+		}
+		m.RetryDelay = from.RetryDelay
+		return true
+	case "ObservedAt":
+		if rest != "" {
+			return false
+		}
+		m.ObservedAt = from.ObservedAt
+		m.ObservedAtNanos = from.ObservedAtNanos
+		return true
+	case "Details":
+		if rest != "" {
+			return false
+		}
+		m.Details = from.Details
+		return true
+	case "RegisteredAt":
+		if rest != "" {
+			return false
+		}
+		m.RegisteredAt = from.RegisteredAt
+		return true
+	case "ExternalId":
+		if rest != "" {
+			return false
+		}
+		m.ExternalId = from.ExternalId
+		return true
+	case "ReviewStatus":
+		if rest != "" {
+			return false
+		}
+		m.ReviewStatus = from.ReviewStatus
+		return true
+	case "Location":
+		if rest != "" {
+			return false
+		}
+		m.Location = from.Location
+		return true
+	case "ShipTo":
+		if rest != "" {
+			return false
+		}
+		m.ShipTo = from.ShipTo
+		return true
+	case "DisplayName":
+		if rest != "" {
+			return false
+		}
+		m.DisplayName = from.DisplayName
+		return true
+	case "StartAt":
+		if rest != "" {
+			return false
+		}
+		m.StartAt = from.StartAt
+		return true
+	case "StartTz":
+		if rest != "" {
+			return false
+		}
+		m.StartTz = from.StartTz
+		return true
+	case "Priority":
+		if rest != "" {
+			return false
+		}
+		m.Priority = from.Priority
+		return true
+	case "Price":
+		if rest != "" {
+			return false
+		}
+		m.Price = from.Price
+		return true
+	case "Currency":
+		if rest != "" {
+			return false
+		}
+		m.Currency = from.Currency
+		return true
+	case "Email":
+		if rest != "" {
+			return false
+		}
+		m.Email = from.Email
+		return true
+	case "PastStatuses":
+		if rest != "" {
+			return false
+		}
+		m.PastStatuses = from.PastStatuses
+		return true
+	case "Signature":
+		if rest != "" {
+			return false
+		}
+		m.Signature = from.Signature
+		return true
+	case "CorrelationId":
+		if rest != "" {
+			return false
+		}
+		m.CorrelationId = from.CorrelationId
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTypeWithID returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTypeWithID(old, new *TypeWithIDORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TypeWithIDORM{}
+	}
+	if new == nil {
+		new = &TypeWithIDORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Ip != new.Ip {
+		mask.Paths = append(mask.Paths, "Ip")
+	}
+	differsThings := len(old.Things) != len(new.Things)
+	for i := 0; !differsThings && i < len(old.Things); i++ {
+		differsThings = (old.Things[i] == nil) != (new.Things[i] == nil) || len(DiffTestTypes(old.Things[i], new.Things[i]).Paths) > 0
+	}
+	if differsThings {
+		mask.Paths = append(mask.Paths, "Things")
+	}
+	if (old.ANestedObject == nil) != (new.ANestedObject == nil) || len(DiffTestTypes(old.ANestedObject, new.ANestedObject).Paths) > 0 {
+		mask.Paths = append(mask.Paths, "ANestedObject")
+	}
+	if (old.Point == nil) != (new.Point == nil) || old.Point != nil && (old.Point.Id != new.Point.Id) {
+		mask.Paths = append(mask.Paths, "Point")
+	}
+	if (old.User == nil) != (new.User == nil) || old.User != nil && (old.User.Id != new.User.Id) {
+		mask.Paths = append(mask.Paths, "User")
+	}
+	if !reflect.DeepEqual(old.Address, new.Address) {
+		mask.Paths = append(mask.Paths, "Address")
+	}
+	if old.TagTest != new.TagTest {
+		mask.Paths = append(mask.Paths, "TagTest")
+	}
+	if old.TagSizeTest != new.TagSizeTest {
+		mask.Paths = append(mask.Paths, "TagSizeTest")
+	}
+	if (old.FloatField == nil) != (new.FloatField == nil) || old.FloatField != nil && *old.FloatField != *new.FloatField {
+		mask.Paths = append(mask.Paths, "FloatField")
+	}
+	if (old.DoubleField == nil) != (new.DoubleField == nil) || old.DoubleField != nil && *old.DoubleField != *new.DoubleField {
+		mask.Paths = append(mask.Paths, "DoubleField")
+	}
+	if old.TimeOnly != new.TimeOnly {
+		mask.Paths = append(mask.Paths, "TimeOnly")
+	}
+	if (old.DeletedAt == nil) != (new.DeletedAt == nil) || old.DeletedAt != nil && !old.DeletedAt.Truncate(time.Microsecond).Equal(new.DeletedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "DeletedAt")
+	}
+	if old.CreatedBy != new.CreatedBy {
+		mask.Paths = append(mask.Paths, "CreatedBy")
+	}
+	if old.Status != new.Status {
+		mask.Paths = append(mask.Paths, "Status")
+	}
+	if old.State != new.State {
+		mask.Paths = append(mask.Paths, "State")
+	}
+	if (old.SeenAt == nil) != (new.SeenAt == nil) || old.SeenAt != nil && !old.SeenAt.Truncate(time.Microsecond).Equal(new.SeenAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "SeenAt")
+	}
+	if !reflect.DeepEqual(old.Active, new.Active) {
+		mask.Paths = append(mask.Paths, "Active")
+	}
+	if old.Slug != new.Slug {
+		mask.Paths = append(mask.Paths, "Slug")
+	}
+	if !reflect.DeepEqual(old.Timeout, new.Timeout) {
+		mask.Paths = append(mask.Paths, "Timeout")
+	}
+	if (old.RetryDelay == nil) != (new.RetryDelay == nil) || old.RetryDelay != nil && *old.RetryDelay != *new.RetryDelay {
+		mask.Paths = append(mask.Paths, "RetryDelay")
+	}
+	if (old.ObservedAt == nil) != (new.ObservedAt == nil) || old.ObservedAt != nil && !old.ObservedAt.Truncate(time.Microsecond).Equal(new.ObservedAt.Truncate(time.Microsecond)) || old.ObservedAtNanos != new.ObservedAtNanos {
+		mask.Paths = append(mask.Paths, "ObservedAt")
+	}
+	if !reflect.DeepEqual(old.Details, new.Details) {
+		mask.Paths = append(mask.Paths, "Details")
+	}
+	if (old.RegisteredAt == nil) != (new.RegisteredAt == nil) || old.RegisteredAt != nil && !old.RegisteredAt.Truncate(time.Microsecond).Equal(new.RegisteredAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "RegisteredAt")
+	}
+	if old.ExternalId != new.ExternalId {
+		mask.Paths = append(mask.Paths, "ExternalId")
+	}
+	if old.ReviewStatus != new.ReviewStatus {
+		mask.Paths = append(mask.Paths, "ReviewStatus")
+	}
+	if old.Revision != new.Revision {
+		mask.Paths = append(mask.Paths, "Revision")
+	}
+	if !reflect.DeepEqual(old.Location, new.Location) {
+		mask.Paths = append(mask.Paths, "Location")
+	}
+	if (old.ShipTo == nil) != (new.ShipTo == nil) || old.ShipTo != nil && *old.ShipTo != *new.ShipTo {
+		mask.Paths = append(mask.Paths, "ShipTo")
+	}
+	if old.DisplayName != new.DisplayName {
+		mask.Paths = append(mask.Paths, "DisplayName")
+	}
+	if (old.StartAt == nil) != (new.StartAt == nil) || old.StartAt != nil && !old.StartAt.Truncate(time.Microsecond).Equal(new.StartAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "StartAt")
+	}
+	if old.StartTz != new.StartTz {
+		mask.Paths = append(mask.Paths, "StartTz")
+	}
+	if old.Priority != new.Priority {
+		mask.Paths = append(mask.Paths, "Priority")
+	}
+	if old.Price != new.Price {
+		mask.Paths = append(mask.Paths, "Price")
+	}
+	if old.Currency != new.Currency {
+		mask.Paths = append(mask.Paths, "Currency")
+	}
+	if old.Email != new.Email {
+		mask.Paths = append(mask.Paths, "Email")
+	}
+	if !reflect.DeepEqual(old.PastStatuses, new.PastStatuses) {
+		mask.Paths = append(mask.Paths, "PastStatuses")
+	}
+	if old.Signature != new.Signature {
+		mask.Paths = append(mask.Paths, "Signature")
+	}
+	if old.CorrelationId != new.CorrelationId {
+		mask.Paths = append(mask.Paths, "CorrelationId")
+	}
+	return mask
 }
 
-// MultiaccountTypeWithIDAfterToORM called after default ToORM code
-type MultiaccountTypeWithIDWithAfterToORM interface {
-	AfterToORM(context.Context, *MultiaccountTypeWithIDORM) error
+// StartAtInZone returns the StartAt in the zone of StartTz, the zero time if it is
+// not set and UTC for an empty zone
+func (m *TypeWithID) StartAtInZone() (time.Time, error) {
+	if m.GetStartAt() == nil {
+		return time.Time{}, nil
+	}
+	loc, err := time.LoadLocation(m.GetStartTz())
+	if err != nil {
+		return time.Time{}, err
+	}
+	return m.StartAt.AsTime().In(loc), nil
 }
 
-// MultiaccountTypeWithIDBeforeToPB called before default ToPB code
-type MultiaccountTypeWithIDWithBeforeToPB interface {
-	BeforeToPB(context.Context, *MultiaccountTypeWithID) error
+// TypeWithIDSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TypeWithIDSliceToORM(ctx context.Context, in []*TypeWithID) ([]*TypeWithIDORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TypeWithIDORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
 }
 
-// MultiaccountTypeWithIDAfterToPB called after default ToPB code
-type MultiaccountTypeWithIDWithAfterToPB interface {
-	AfterToPB(context.Context, *MultiaccountTypeWithID) error
+// TypeWithIDORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TypeWithIDORMSliceToPB(ctx context.Context, in []*TypeWithIDORM) ([]*TypeWithID, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TypeWithID, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
 }
 
-type MultiaccountTypeWithoutIDORM struct {
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TypeWithID the arg will be the target, the caller the one being converted from
+
+// TypeWithIDBeforeToORM called before default ToORM code
+type TypeWithIDWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TypeWithIDORM) error
+}
+
+// TypeWithIDAfterToORM called after default ToORM code
+type TypeWithIDWithAfterToORM interface {
+	AfterToORM(context.Context, *TypeWithIDORM) error
+}
+
+// TypeWithIDBeforeToPB called before default ToPB code
+type TypeWithIDWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TypeWithID) error
+}
+
+// TypeWithIDAfterToPB called after default ToPB code
+type TypeWithIDWithAfterToPB interface {
+	AfterToPB(context.Context, *TypeWithID) error
+}
+
+type MultiaccountTypeWithIDORM struct {
 	AccountID string
+	Id        uint64
 	SomeField string
 }
 
 // TableName overrides the default tablename generated by GORM
-func (MultiaccountTypeWithoutIDORM) TableName() string {
-	return "multiaccount_type_without_ids"
+func (MultiaccountTypeWithIDORM) TableName() string {
+	return "multiaccount_type_with_ids"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *MultiaccountTypeWithIDORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *MultiaccountTypeWithIDORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	reloaded := MultiaccountTypeWithIDORM{}
+	if err := db.Where("id = ? AND account_id = ?", m.Id, accountID).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// MultiaccountTypeWithIDORMIndexes lists the indexes declared by the gorm tags of MultiaccountTypeWithIDORM
+var MultiaccountTypeWithIDORMIndexes = []types.IndexDef{}
+
+// applyMultiaccountTypeWithIDConditionalPreloads preloads the associations of the conditional
+// preloads of MultiaccountTypeWithID in ctx whose condition holds
+func applyMultiaccountTypeWithIDConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "MultiaccountTypeWithID") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *MultiaccountTypeWithoutID) ToORM(ctx context.Context) (MultiaccountTypeWithoutIDORM, error) {
-	to := MultiaccountTypeWithoutIDORM{}
+func (m *MultiaccountTypeWithID) ToORM(ctx context.Context) (MultiaccountTypeWithIDORM, error) {
+	to := MultiaccountTypeWithIDORM{}
 	var err error
-	if prehook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(MultiaccountTypeWithIDWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
 	}
+	to.Id = m.Id
 	to.SomeField = m.SomeField
 	accountID, err := auth.GetAccountID(ctx, nil)
 	if err != nil {
 		return to, err
 	}
 	to.AccountID = accountID
-	if posthook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithAfterToORM); ok {
+	if posthook, ok := interface{}(m).(MultiaccountTypeWithIDWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -451,162 +1577,212 @@ func (m *MultiaccountTypeWithoutID) ToORM(ctx context.Context) (MultiaccountType
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *MultiaccountTypeWithoutIDORM) ToPB(ctx context.Context) (MultiaccountTypeWithoutID, error) {
-	to := MultiaccountTypeWithoutID{}
+func (m *MultiaccountTypeWithIDORM) ToPB(ctx context.Context) (MultiaccountTypeWithID, error) {
+	to := MultiaccountTypeWithID{}
 	var err error
-	if prehook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(MultiaccountTypeWithIDWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
 	}
+	to.Id = m.Id
 	to.SomeField = m.SomeField
-	if posthook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithAfterToPB); ok {
+	if posthook, ok := interface{}(m).(MultiaccountTypeWithIDWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type MultiaccountTypeWithoutID the arg will be the target, the caller the one being converted from
-
-// MultiaccountTypeWithoutIDBeforeToORM called before default ToORM code
-type MultiaccountTypeWithoutIDWithBeforeToORM interface {
-	BeforeToORM(context.Context, *MultiaccountTypeWithoutIDORM) error
-}
-
-// MultiaccountTypeWithoutIDAfterToORM called after default ToORM code
-type MultiaccountTypeWithoutIDWithAfterToORM interface {
-	AfterToORM(context.Context, *MultiaccountTypeWithoutIDORM) error
-}
-
-// MultiaccountTypeWithoutIDBeforeToPB called before default ToPB code
-type MultiaccountTypeWithoutIDWithBeforeToPB interface {
-	BeforeToPB(context.Context, *MultiaccountTypeWithoutID) error
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *MultiaccountTypeWithID) MergeToORM(ctx context.Context, dst *MultiaccountTypeWithIDORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.SomeField = to.SomeField
+	return nil
 }
 
-// MultiaccountTypeWithoutIDAfterToPB called after default ToPB code
-type MultiaccountTypeWithoutIDWithAfterToPB interface {
-	AfterToPB(context.Context, *MultiaccountTypeWithoutID) error
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *MultiaccountTypeWithID) ApplyFieldMaskToORM(ctx context.Context, dst *MultiaccountTypeWithIDORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
 }
 
-type PrimaryUUIDTypeORM struct {
-	Child *ExternalChildORM `gorm:"foreignkey:PrimaryUUIDTypeId;association_foreignkey:Id"`
-	Id    *go_uuid.UUID     `gorm:"type:uuid"`
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *MultiaccountTypeWithIDORM) applyFieldMaskPath(from *MultiaccountTypeWithIDORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "SomeField":
+		if rest != "" {
+			return false
+		}
+		m.SomeField = from.SomeField
+		return true
+	default:
+		return false
+	}
 }
 
-// TableName overrides the default tablename generated by GORM
-func (PrimaryUUIDTypeORM) TableName() string {
-	return "primary_uuid_types"
+// DiffMultiaccountTypeWithID returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffMultiaccountTypeWithID(old, new *MultiaccountTypeWithIDORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &MultiaccountTypeWithIDORM{}
+	}
+	if new == nil {
+		new = &MultiaccountTypeWithIDORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.SomeField != new.SomeField {
+		mask.Paths = append(mask.Paths, "SomeField")
+	}
+	return mask
 }
 
-// ToORM runs the BeforeToORM hook if present, converts the fields of this
-// object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *PrimaryUUIDType) ToORM(ctx context.Context) (PrimaryUUIDTypeORM, error) {
-	to := PrimaryUUIDTypeORM{}
-	var err error
-	if prehook, ok := interface{}(m).(PrimaryUUIDTypeWithBeforeToORM); ok {
-		if err = prehook.BeforeToORM(ctx, &to); err != nil {
-			return to, err
-		}
+// MultiaccountTypeWithIDSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func MultiaccountTypeWithIDSliceToORM(ctx context.Context, in []*MultiaccountTypeWithID) ([]*MultiaccountTypeWithIDORM, error) {
+	if in == nil {
+		return nil, nil
 	}
-	if m.Id != nil {
-		tempUUID, uErr := go_uuid.FromString(m.Id.Value)
-		if uErr != nil {
-			return to, uErr
+	out := make([]*MultiaccountTypeWithIDORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
 		}
-		to.Id = &tempUUID
-	}
-	if m.Child != nil {
-		tempChild, err := m.Child.ToORM(ctx)
+		to, err := m.ToORM(ctx)
 		if err != nil {
-			return to, err
+			return nil, fmt.Errorf("%d: %w", i, err)
 		}
-		to.Child = &tempChild
-	}
-	if posthook, ok := interface{}(m).(PrimaryUUIDTypeWithAfterToORM); ok {
-		err = posthook.AfterToORM(ctx, &to)
+		out[i] = &to
 	}
-	return to, err
+	return out, nil
 }
 
-// ToPB runs the BeforeToPB hook if present, converts the fields of this
-// object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *PrimaryUUIDTypeORM) ToPB(ctx context.Context) (PrimaryUUIDType, error) {
-	to := PrimaryUUIDType{}
-	var err error
-	if prehook, ok := interface{}(m).(PrimaryUUIDTypeWithBeforeToPB); ok {
-		if err = prehook.BeforeToPB(ctx, &to); err != nil {
-			return to, err
-		}
-	}
-	if m.Id != nil {
-		to.Id = &types.UUIDValue{Value: m.Id.String()}
+// MultiaccountTypeWithIDORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func MultiaccountTypeWithIDORMSliceToPB(ctx context.Context, in []*MultiaccountTypeWithIDORM) ([]*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, nil
 	}
-	if m.Child != nil {
-		tempChild, err := m.Child.ToPB(ctx)
+	out := make([]*MultiaccountTypeWithID, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
 		if err != nil {
-			return to, err
+			return nil, fmt.Errorf("%d: %w", i, err)
 		}
-		to.Child = &tempChild
-	}
-	if posthook, ok := interface{}(m).(PrimaryUUIDTypeWithAfterToPB); ok {
-		err = posthook.AfterToPB(ctx, &to)
+		out[i] = &to
 	}
-	return to, err
+	return out, nil
 }
 
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type PrimaryUUIDType the arg will be the target, the caller the one being converted from
+// of type MultiaccountTypeWithID the arg will be the target, the caller the one being converted from
 
-// PrimaryUUIDTypeBeforeToORM called before default ToORM code
-type PrimaryUUIDTypeWithBeforeToORM interface {
-	BeforeToORM(context.Context, *PrimaryUUIDTypeORM) error
-}
+// MultiaccountTypeWithIDBeforeToORM called before default ToORM code
+type MultiaccountTypeWithIDWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MultiaccountTypeWithIDORM) error
+}
 
-// PrimaryUUIDTypeAfterToORM called after default ToORM code
-type PrimaryUUIDTypeWithAfterToORM interface {
-	AfterToORM(context.Context, *PrimaryUUIDTypeORM) error
+// MultiaccountTypeWithIDAfterToORM called after default ToORM code
+type MultiaccountTypeWithIDWithAfterToORM interface {
+	AfterToORM(context.Context, *MultiaccountTypeWithIDORM) error
 }
 
-// PrimaryUUIDTypeBeforeToPB called before default ToPB code
-type PrimaryUUIDTypeWithBeforeToPB interface {
-	BeforeToPB(context.Context, *PrimaryUUIDType) error
+// MultiaccountTypeWithIDBeforeToPB called before default ToPB code
+type MultiaccountTypeWithIDWithBeforeToPB interface {
+	BeforeToPB(context.Context, *MultiaccountTypeWithID) error
 }
 
-// PrimaryUUIDTypeAfterToPB called after default ToPB code
-type PrimaryUUIDTypeWithAfterToPB interface {
-	AfterToPB(context.Context, *PrimaryUUIDType) error
+// MultiaccountTypeWithIDAfterToPB called after default ToPB code
+type MultiaccountTypeWithIDWithAfterToPB interface {
+	AfterToPB(context.Context, *MultiaccountTypeWithID) error
 }
 
-type PrimaryStringTypeORM struct {
-	Child *ExternalChildORM `gorm:"foreignkey:PrimaryStringTypeId;association_foreignkey:Id"`
-	Id    string
+type MultiaccountTypeWithoutIDORM struct {
+	AccountID string
+	SomeField string
 }
 
 // TableName overrides the default tablename generated by GORM
-func (PrimaryStringTypeORM) TableName() string {
-	return "primary_string_types"
+func (MultiaccountTypeWithoutIDORM) TableName() string {
+	return "multiaccount_type_without_ids"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *MultiaccountTypeWithoutIDORM) ClearAssociations() {
+}
+
+// MultiaccountTypeWithoutIDORMIndexes lists the indexes declared by the gorm tags of MultiaccountTypeWithoutIDORM
+var MultiaccountTypeWithoutIDORMIndexes = []types.IndexDef{}
+
+// applyMultiaccountTypeWithoutIDConditionalPreloads preloads the associations of the conditional
+// preloads of MultiaccountTypeWithoutID in ctx whose condition holds
+func applyMultiaccountTypeWithoutIDConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "MultiaccountTypeWithoutID") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *PrimaryStringType) ToORM(ctx context.Context) (PrimaryStringTypeORM, error) {
-	to := PrimaryStringTypeORM{}
+func (m *MultiaccountTypeWithoutID) ToORM(ctx context.Context) (MultiaccountTypeWithoutIDORM, error) {
+	to := MultiaccountTypeWithoutIDORM{}
 	var err error
-	if prehook, ok := interface{}(m).(PrimaryStringTypeWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	to.Id = m.Id
-	if m.Child != nil {
-		tempChild, err := m.Child.ToORM(ctx)
-		if err != nil {
-			return to, err
-		}
-		to.Child = &tempChild
+	to.SomeField = m.SomeField
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return to, err
 	}
-	if posthook, ok := interface{}(m).(PrimaryStringTypeWithAfterToORM); ok {
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -614,80 +1790,290 @@ func (m *PrimaryStringType) ToORM(ctx context.Context) (PrimaryStringTypeORM, er
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *PrimaryStringTypeORM) ToPB(ctx context.Context) (PrimaryStringType, error) {
-	to := PrimaryStringType{}
+func (m *MultiaccountTypeWithoutIDORM) ToPB(ctx context.Context) (MultiaccountTypeWithoutID, error) {
+	to := MultiaccountTypeWithoutID{}
 	var err error
-	if prehook, ok := interface{}(m).(PrimaryStringTypeWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	to.Id = m.Id
-	if m.Child != nil {
-		tempChild, err := m.Child.ToPB(ctx)
+	to.SomeField = m.SomeField
+	if posthook, ok := interface{}(m).(MultiaccountTypeWithoutIDWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *MultiaccountTypeWithoutID) MergeToORM(ctx context.Context, dst *MultiaccountTypeWithoutIDORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.SomeField = to.SomeField
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *MultiaccountTypeWithoutID) ApplyFieldMaskToORM(ctx context.Context, dst *MultiaccountTypeWithoutIDORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *MultiaccountTypeWithoutIDORM) applyFieldMaskPath(from *MultiaccountTypeWithoutIDORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "SomeField":
+		if rest != "" {
+			return false
+		}
+		m.SomeField = from.SomeField
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffMultiaccountTypeWithoutID returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffMultiaccountTypeWithoutID(old, new *MultiaccountTypeWithoutIDORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &MultiaccountTypeWithoutIDORM{}
+	}
+	if new == nil {
+		new = &MultiaccountTypeWithoutIDORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.SomeField != new.SomeField {
+		mask.Paths = append(mask.Paths, "SomeField")
+	}
+	return mask
+}
+
+// MultiaccountTypeWithoutIDSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func MultiaccountTypeWithoutIDSliceToORM(ctx context.Context, in []*MultiaccountTypeWithoutID) ([]*MultiaccountTypeWithoutIDORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*MultiaccountTypeWithoutIDORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
 		if err != nil {
-			return to, err
+			return nil, fmt.Errorf("%d: %w", i, err)
 		}
-		to.Child = &tempChild
+		out[i] = &to
 	}
-	if posthook, ok := interface{}(m).(PrimaryStringTypeWithAfterToPB); ok {
-		err = posthook.AfterToPB(ctx, &to)
+	return out, nil
+}
+
+// MultiaccountTypeWithoutIDORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func MultiaccountTypeWithoutIDORMSliceToPB(ctx context.Context, in []*MultiaccountTypeWithoutIDORM) ([]*MultiaccountTypeWithoutID, error) {
+	if in == nil {
+		return nil, nil
 	}
-	return to, err
+	out := make([]*MultiaccountTypeWithoutID, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
 }
 
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type PrimaryStringType the arg will be the target, the caller the one being converted from
+// of type MultiaccountTypeWithoutID the arg will be the target, the caller the one being converted from
 
-// PrimaryStringTypeBeforeToORM called before default ToORM code
-type PrimaryStringTypeWithBeforeToORM interface {
-	BeforeToORM(context.Context, *PrimaryStringTypeORM) error
+// MultiaccountTypeWithoutIDBeforeToORM called before default ToORM code
+type MultiaccountTypeWithoutIDWithBeforeToORM interface {
+	BeforeToORM(context.Context, *MultiaccountTypeWithoutIDORM) error
 }
 
-// PrimaryStringTypeAfterToORM called after default ToORM code
-type PrimaryStringTypeWithAfterToORM interface {
-	AfterToORM(context.Context, *PrimaryStringTypeORM) error
+// MultiaccountTypeWithoutIDAfterToORM called after default ToORM code
+type MultiaccountTypeWithoutIDWithAfterToORM interface {
+	AfterToORM(context.Context, *MultiaccountTypeWithoutIDORM) error
 }
 
-// PrimaryStringTypeBeforeToPB called before default ToPB code
-type PrimaryStringTypeWithBeforeToPB interface {
-	BeforeToPB(context.Context, *PrimaryStringType) error
+// MultiaccountTypeWithoutIDBeforeToPB called before default ToPB code
+type MultiaccountTypeWithoutIDWithBeforeToPB interface {
+	BeforeToPB(context.Context, *MultiaccountTypeWithoutID) error
 }
 
-// PrimaryStringTypeAfterToPB called after default ToPB code
-type PrimaryStringTypeWithAfterToPB interface {
-	AfterToPB(context.Context, *PrimaryStringType) error
+// MultiaccountTypeWithoutIDAfterToPB called after default ToPB code
+type MultiaccountTypeWithoutIDWithAfterToPB interface {
+	AfterToPB(context.Context, *MultiaccountTypeWithoutID) error
 }
 
-type TestTagORM struct {
-	Id           string
-	TestTagAssoc *TestTagAssociationORM `gorm:"foreignkey:TestTagId;association_foreignkey:Id"`
+type PrimaryUUIDTypeORM struct {
+	Child *ExternalChildORM `gorm:"foreignkey:PrimaryUUIDTypeId;association_foreignkey:Id"`
+	Id    *go_uuid.UUID     `gorm:"type:uuid"`
 }
 
 // TableName overrides the default tablename generated by GORM
-func (TestTagORM) TableName() string {
-	return "test_tags"
+func (PrimaryUUIDTypeORM) TableName() string {
+	return "primary_uuid_types"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *PrimaryUUIDTypeORM) ClearAssociations() {
+	m.Child = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *PrimaryUUIDTypeORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == nil || *m.Id == go_uuid.Nil {
+		return errors.EmptyIdError
+	}
+	reloaded := PrimaryUUIDTypeORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// PrimaryUUIDTypeORMIndexes lists the indexes declared by the gorm tags of PrimaryUUIDTypeORM
+var PrimaryUUIDTypeORMIndexes = []types.IndexDef{}
+
+// PrimaryUUIDTypeORMForeignKeys lists the foreign keys of the associations of PrimaryUUIDTypeORM
+var PrimaryUUIDTypeORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_external_children_primary_uuid_type_id", Table: "external_children", Columns: []string{"primary_uuid_type_id"}, References: "primary_uuid_types", ReferencedColumns: []string{"id"}},
+}
+
+// LoadPrimaryUUIDTypeChildForAll loads the Child of all the parents with a single query and
+// sets them on each parent by PrimaryUUIDTypeId, in the order of id. The parents
+// lose the Child they held, a nil parent is skipped.
+func LoadPrimaryUUIDTypeChildForAll(ctx context.Context, db *gorm.DB, parents []*PrimaryUUIDTypeORM) error {
+	type key struct {
+		Id go_uuid.UUID
+	}
+	byKey := make(map[key][]*PrimaryUUIDTypeORM, len(parents))
+	loaded := make(map[*PrimaryUUIDTypeORM]bool, len(parents))
+	var ids []go_uuid.UUID
+	idSeen := make(map[go_uuid.UUID]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Child = nil
+		if parent.Id == nil {
+			continue
+		}
+		k := key{*parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*ExternalChildORM
+	if err := db.Where("primary_uuid_type_id IN (?)", ids).Order("id").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.PrimaryUUIDTypeId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.PrimaryUUIDTypeId}] {
+			if parent.Child == nil {
+				parent.Child = child
+			}
+		}
+	}
+	return nil
+}
+
+// WithPrimaryUUIDTypeConditionalPreload returns a context making the Read and List
+// handlers of PrimaryUUIDType preload the association name, e.g. Child, when cond holds
+// for their context
+func WithPrimaryUUIDTypeConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "PrimaryUUIDType", name, cond)
+}
+
+// applyPrimaryUUIDTypeConditionalPreloads preloads the associations of the conditional
+// preloads of PrimaryUUIDType in ctx whose condition holds
+func applyPrimaryUUIDTypeConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "PrimaryUUIDType") {
+		switch preload.Name {
+		case "Child":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *TestTag) ToORM(ctx context.Context) (TestTagORM, error) {
-	to := TestTagORM{}
+func (m *PrimaryUUIDType) ToORM(ctx context.Context) (PrimaryUUIDTypeORM, error) {
+	to := PrimaryUUIDTypeORM{}
 	var err error
-	if prehook, ok := interface{}(m).(TestTagWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(PrimaryUUIDTypeWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	to.Id = m.Id
-	if m.TestTagAssoc != nil {
-		tempTestTagAssoc, err := m.TestTagAssoc.ToORM(ctx)
+	if m.Id != nil {
+		tempUUID, uErr := go_uuid.FromString(m.Id.Value)
+		if uErr != nil {
+			return to, uErr
+		}
+		to.Id = &tempUUID
+	}
+	if m.Child != nil {
+		tempChild, err := m.Child.ToORM(ctx)
 		if err != nil {
 			return to, err
 		}
-		to.TestTagAssoc = &tempTestTagAssoc
+		to.Child = &tempChild
 	}
-	if posthook, ok := interface{}(m).(TestTagWithAfterToORM); ok {
+	if posthook, ok := interface{}(m).(PrimaryUUIDTypeWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -695,262 +2081,311 @@ func (m *TestTag) ToORM(ctx context.Context) (TestTagORM, error) {
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *TestTagORM) ToPB(ctx context.Context) (TestTag, error) {
-	to := TestTag{}
+func (m *PrimaryUUIDTypeORM) ToPB(ctx context.Context) (PrimaryUUIDType, error) {
+	to := PrimaryUUIDType{}
 	var err error
-	if prehook, ok := interface{}(m).(TestTagWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(PrimaryUUIDTypeWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	to.Id = m.Id
-	if m.TestTagAssoc != nil {
-		tempTestTagAssoc, err := m.TestTagAssoc.ToPB(ctx)
+	if m.Id != nil {
+		to.Id = &types.UUIDValue{Value: m.Id.String()}
+	}
+	if m.Child != nil {
+		tempChild, err := m.Child.ToPB(ctx)
 		if err != nil {
 			return to, err
 		}
-		to.TestTagAssoc = &tempTestTagAssoc
+		to.Child = &tempChild
 	}
-	if posthook, ok := interface{}(m).(TestTagWithAfterToPB); ok {
+	if posthook, ok := interface{}(m).(PrimaryUUIDTypeWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type TestTag the arg will be the target, the caller the one being converted from
-
-// TestTagBeforeToORM called before default ToORM code
-type TestTagWithBeforeToORM interface {
-	BeforeToORM(context.Context, *TestTagORM) error
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *PrimaryUUIDType) MergeToORM(ctx context.Context, dst *PrimaryUUIDTypeORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Id != nil {
+		dst.Id = to.Id
+	}
+	if associations {
+		dst.Child = to.Child
+	}
+	return nil
 }
 
-// TestTagAfterToORM called after default ToORM code
-type TestTagWithAfterToORM interface {
-	AfterToORM(context.Context, *TestTagORM) error
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *PrimaryUUIDType) ApplyFieldMaskToORM(ctx context.Context, dst *PrimaryUUIDTypeORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
 }
 
-// TestTagBeforeToPB called before default ToPB code
-type TestTagWithBeforeToPB interface {
-	BeforeToPB(context.Context, *TestTag) error
-}
-
-// TestTagAfterToPB called after default ToPB code
-type TestTagWithAfterToPB interface {
-	AfterToPB(context.Context, *TestTag) error
-}
-
-type TestAssocHandlerDefaultORM struct {
-	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerDefaultId;association_foreignkey:Id"`
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *PrimaryUUIDTypeORM) applyFieldMaskPath(from *PrimaryUUIDTypeORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Child":
+		if rest == "" {
+			m.Child = from.Child
+			return true
+		}
+		nested := from.Child
+		if nested == nil {
+			nested = &ExternalChildORM{}
+		}
+		if m.Child == nil {
+			m.Child = &ExternalChildORM{}
+		}
+		return m.Child.applyFieldMaskPath(nested, rest)
+	default:
+		return false
+	}
 }
 
-// TableName overrides the default tablename generated by GORM
-func (TestAssocHandlerDefaultORM) TableName() string {
-	return "test_assoc_handler_defaults"
+// DiffPrimaryUUIDType returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffPrimaryUUIDType(old, new *PrimaryUUIDTypeORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &PrimaryUUIDTypeORM{}
+	}
+	if new == nil {
+		new = &PrimaryUUIDTypeORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if !reflect.DeepEqual(old.Id, new.Id) {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if (old.Child == nil) != (new.Child == nil) || len(DiffExternalChild(old.Child, new.Child).Paths) > 0 {
+		mask.Paths = append(mask.Paths, "Child")
+	}
+	return mask
 }
 
-// ToORM runs the BeforeToORM hook if present, converts the fields of this
-// object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *TestAssocHandlerDefault) ToORM(ctx context.Context) (TestAssocHandlerDefaultORM, error) {
-	to := TestAssocHandlerDefaultORM{}
-	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerDefaultWithBeforeToORM); ok {
-		if err = prehook.BeforeToORM(ctx, &to); err != nil {
-			return to, err
-		}
+// PrimaryUUIDTypeSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func PrimaryUUIDTypeSliceToORM(ctx context.Context, in []*PrimaryUUIDType) ([]*PrimaryUUIDTypeORM, error) {
+	if in == nil {
+		return nil, nil
 	}
-	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+	out := make([]*PrimaryUUIDTypeORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
 		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
 	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerDefaultWithAfterToORM); ok {
-		err = posthook.AfterToORM(ctx, &to)
-	}
-	return to, err
+	return out, nil
 }
 
-// ToPB runs the BeforeToPB hook if present, converts the fields of this
-// object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *TestAssocHandlerDefaultORM) ToPB(ctx context.Context) (TestAssocHandlerDefault, error) {
-	to := TestAssocHandlerDefault{}
-	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerDefaultWithBeforeToPB); ok {
-		if err = prehook.BeforeToPB(ctx, &to); err != nil {
-			return to, err
-		}
+// PrimaryUUIDTypeORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func PrimaryUUIDTypeORMSliceToPB(ctx context.Context, in []*PrimaryUUIDTypeORM) ([]*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, nil
 	}
-	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+	out := make([]*PrimaryUUIDType, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
 		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
 	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerDefaultWithAfterToPB); ok {
-		err = posthook.AfterToPB(ctx, &to)
-	}
-	return to, err
+	return out, nil
 }
 
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type TestAssocHandlerDefault the arg will be the target, the caller the one being converted from
+// of type PrimaryUUIDType the arg will be the target, the caller the one being converted from
 
-// TestAssocHandlerDefaultBeforeToORM called before default ToORM code
-type TestAssocHandlerDefaultWithBeforeToORM interface {
-	BeforeToORM(context.Context, *TestAssocHandlerDefaultORM) error
+// PrimaryUUIDTypeBeforeToORM called before default ToORM code
+type PrimaryUUIDTypeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PrimaryUUIDTypeORM) error
 }
 
-// TestAssocHandlerDefaultAfterToORM called after default ToORM code
-type TestAssocHandlerDefaultWithAfterToORM interface {
-	AfterToORM(context.Context, *TestAssocHandlerDefaultORM) error
+// PrimaryUUIDTypeAfterToORM called after default ToORM code
+type PrimaryUUIDTypeWithAfterToORM interface {
+	AfterToORM(context.Context, *PrimaryUUIDTypeORM) error
 }
 
-// TestAssocHandlerDefaultBeforeToPB called before default ToPB code
-type TestAssocHandlerDefaultWithBeforeToPB interface {
-	BeforeToPB(context.Context, *TestAssocHandlerDefault) error
+// PrimaryUUIDTypeBeforeToPB called before default ToPB code
+type PrimaryUUIDTypeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PrimaryUUIDType) error
 }
 
-// TestAssocHandlerDefaultAfterToPB called after default ToPB code
-type TestAssocHandlerDefaultWithAfterToPB interface {
-	AfterToPB(context.Context, *TestAssocHandlerDefault) error
+// PrimaryUUIDTypeAfterToPB called after default ToPB code
+type PrimaryUUIDTypeWithAfterToPB interface {
+	AfterToPB(context.Context, *PrimaryUUIDType) error
 }
 
-type TestAssocHandlerReplaceORM struct {
-	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerReplaceId;association_foreignkey:Id;replace:true"`
+type PrimaryStringTypeORM struct {
+	Child *ExternalChildORM `gorm:"foreignkey:PrimaryStringTypeId;association_foreignkey:Id"`
+	Id    string
 }
 
 // TableName overrides the default tablename generated by GORM
-func (TestAssocHandlerReplaceORM) TableName() string {
-	return "test_assoc_handler_replaces"
+func (PrimaryStringTypeORM) TableName() string {
+	return "primary_string_types"
 }
 
-// ToORM runs the BeforeToORM hook if present, converts the fields of this
-// object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *TestAssocHandlerReplace) ToORM(ctx context.Context) (TestAssocHandlerReplaceORM, error) {
-	to := TestAssocHandlerReplaceORM{}
-	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerReplaceWithBeforeToORM); ok {
-		if err = prehook.BeforeToORM(ctx, &to); err != nil {
-			return to, err
-		}
-	}
-	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
-		}
-	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerReplaceWithAfterToORM); ok {
-		err = posthook.AfterToORM(ctx, &to)
-	}
-	return to, err
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *PrimaryStringTypeORM) ClearAssociations() {
+	m.Child = nil
 }
 
-// ToPB runs the BeforeToPB hook if present, converts the fields of this
-// object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *TestAssocHandlerReplaceORM) ToPB(ctx context.Context) (TestAssocHandlerReplace, error) {
-	to := TestAssocHandlerReplace{}
-	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerReplaceWithBeforeToPB); ok {
-		if err = prehook.BeforeToPB(ctx, &to); err != nil {
-			return to, err
-		}
-	}
-	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
-		}
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *PrimaryStringTypeORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
 	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerReplaceWithAfterToPB); ok {
-		err = posthook.AfterToPB(ctx, &to)
+	reloaded := PrimaryStringTypeORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
 	}
-	return to, err
-}
-
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type TestAssocHandlerReplace the arg will be the target, the caller the one being converted from
-
-// TestAssocHandlerReplaceBeforeToORM called before default ToORM code
-type TestAssocHandlerReplaceWithBeforeToORM interface {
-	BeforeToORM(context.Context, *TestAssocHandlerReplaceORM) error
+	*m = reloaded
+	return nil
 }
 
-// TestAssocHandlerReplaceAfterToORM called after default ToORM code
-type TestAssocHandlerReplaceWithAfterToORM interface {
-	AfterToORM(context.Context, *TestAssocHandlerReplaceORM) error
-}
+// PrimaryStringTypeORMIndexes lists the indexes declared by the gorm tags of PrimaryStringTypeORM
+var PrimaryStringTypeORMIndexes = []types.IndexDef{}
 
-// TestAssocHandlerReplaceBeforeToPB called before default ToPB code
-type TestAssocHandlerReplaceWithBeforeToPB interface {
-	BeforeToPB(context.Context, *TestAssocHandlerReplace) error
+// PrimaryStringTypeORMForeignKeys lists the foreign keys of the associations of PrimaryStringTypeORM
+var PrimaryStringTypeORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_external_children_primary_string_type_id", Table: "external_children", Columns: []string{"primary_string_type_id"}, References: "primary_string_types", ReferencedColumns: []string{"id"}},
 }
 
-// TestAssocHandlerReplaceAfterToPB called after default ToPB code
-type TestAssocHandlerReplaceWithAfterToPB interface {
-	AfterToPB(context.Context, *TestAssocHandlerReplace) error
+// LoadPrimaryStringTypeChildForAll loads the Child of all the parents with a single query and
+// sets them on each parent by PrimaryStringTypeId, in the order of id. The parents
+// lose the Child they held, a nil parent is skipped.
+func LoadPrimaryStringTypeChildForAll(ctx context.Context, db *gorm.DB, parents []*PrimaryStringTypeORM) error {
+	type key struct {
+		Id string
+	}
+	byKey := make(map[key][]*PrimaryStringTypeORM, len(parents))
+	loaded := make(map[*PrimaryStringTypeORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Child = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*ExternalChildORM
+	if err := db.Where("primary_string_type_id IN (?)", ids).Order("id").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.PrimaryStringTypeId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.PrimaryStringTypeId}] {
+			if parent.Child == nil {
+				parent.Child = child
+			}
+		}
+	}
+	return nil
 }
 
-type TestAssocHandlerClearORM struct {
-	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerClearId;association_foreignkey:Id;clear:true"`
+// WithPrimaryStringTypeConditionalPreload returns a context making the Read and List
+// handlers of PrimaryStringType preload the association name, e.g. Child, when cond holds
+// for their context
+func WithPrimaryStringTypeConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "PrimaryStringType", name, cond)
 }
 
-// TableName overrides the default tablename generated by GORM
-func (TestAssocHandlerClearORM) TableName() string {
-	return "test_assoc_handler_clears"
+// applyPrimaryStringTypeConditionalPreloads preloads the associations of the conditional
+// preloads of PrimaryStringType in ctx whose condition holds
+func applyPrimaryStringTypeConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "PrimaryStringType") {
+		switch preload.Name {
+		case "Child":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *TestAssocHandlerClear) ToORM(ctx context.Context) (TestAssocHandlerClearORM, error) {
-	to := TestAssocHandlerClearORM{}
+func (m *PrimaryStringType) ToORM(ctx context.Context) (PrimaryStringTypeORM, error) {
+	to := PrimaryStringTypeORM{}
 	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerClearWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(PrimaryStringTypeWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
 	}
 	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+	if m.Child != nil {
+		tempChild, err := m.Child.ToORM(ctx)
+		if err != nil {
+			return to, err
 		}
+		to.Child = &tempChild
 	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerClearWithAfterToORM); ok {
+	if posthook, ok := interface{}(m).(PrimaryStringTypeWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -958,243 +2393,307 @@ func (m *TestAssocHandlerClear) ToORM(ctx context.Context) (TestAssocHandlerClea
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *TestAssocHandlerClearORM) ToPB(ctx context.Context) (TestAssocHandlerClear, error) {
-	to := TestAssocHandlerClear{}
+func (m *PrimaryStringTypeORM) ToPB(ctx context.Context) (PrimaryStringType, error) {
+	to := PrimaryStringType{}
 	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerClearWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(PrimaryStringTypeWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
 	}
 	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+	if m.Child != nil {
+		tempChild, err := m.Child.ToPB(ctx)
+		if err != nil {
+			return to, err
 		}
+		to.Child = &tempChild
 	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerClearWithAfterToPB); ok {
+	if posthook, ok := interface{}(m).(PrimaryStringTypeWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type TestAssocHandlerClear the arg will be the target, the caller the one being converted from
-
-// TestAssocHandlerClearBeforeToORM called before default ToORM code
-type TestAssocHandlerClearWithBeforeToORM interface {
-	BeforeToORM(context.Context, *TestAssocHandlerClearORM) error
-}
-
-// TestAssocHandlerClearAfterToORM called after default ToORM code
-type TestAssocHandlerClearWithAfterToORM interface {
-	AfterToORM(context.Context, *TestAssocHandlerClearORM) error
-}
-
-// TestAssocHandlerClearBeforeToPB called before default ToPB code
-type TestAssocHandlerClearWithBeforeToPB interface {
-	BeforeToPB(context.Context, *TestAssocHandlerClear) error
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *PrimaryStringType) MergeToORM(ctx context.Context, dst *PrimaryStringTypeORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	if associations {
+		dst.Child = to.Child
+	}
+	return nil
 }
 
-// TestAssocHandlerClearAfterToPB called after default ToPB code
-type TestAssocHandlerClearWithAfterToPB interface {
-	AfterToPB(context.Context, *TestAssocHandlerClear) error
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *PrimaryStringType) ApplyFieldMaskToORM(ctx context.Context, dst *PrimaryStringTypeORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
 }
 
-type TestAssocHandlerAppendORM struct {
-	Id           string
-	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerAppendId;association_foreignkey:Id;append:true"`
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *PrimaryStringTypeORM) applyFieldMaskPath(from *PrimaryStringTypeORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Child":
+		if rest == "" {
+			m.Child = from.Child
+			return true
+		}
+		nested := from.Child
+		if nested == nil {
+			nested = &ExternalChildORM{}
+		}
+		if m.Child == nil {
+			m.Child = &ExternalChildORM{}
+		}
+		return m.Child.applyFieldMaskPath(nested, rest)
+	default:
+		return false
+	}
 }
 
-// TableName overrides the default tablename generated by GORM
-func (TestAssocHandlerAppendORM) TableName() string {
-	return "test_assoc_handler_appends"
+// DiffPrimaryStringType returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffPrimaryStringType(old, new *PrimaryStringTypeORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &PrimaryStringTypeORM{}
+	}
+	if new == nil {
+		new = &PrimaryStringTypeORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if (old.Child == nil) != (new.Child == nil) || len(DiffExternalChild(old.Child, new.Child).Paths) > 0 {
+		mask.Paths = append(mask.Paths, "Child")
+	}
+	return mask
 }
 
-// ToORM runs the BeforeToORM hook if present, converts the fields of this
-// object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *TestAssocHandlerAppend) ToORM(ctx context.Context) (TestAssocHandlerAppendORM, error) {
-	to := TestAssocHandlerAppendORM{}
-	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerAppendWithBeforeToORM); ok {
-		if err = prehook.BeforeToORM(ctx, &to); err != nil {
-			return to, err
-		}
+// PrimaryStringTypeSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func PrimaryStringTypeSliceToORM(ctx context.Context, in []*PrimaryStringType) ([]*PrimaryStringTypeORM, error) {
+	if in == nil {
+		return nil, nil
 	}
-	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+	out := make([]*PrimaryStringTypeORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
 		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
 	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerAppendWithAfterToORM); ok {
-		err = posthook.AfterToORM(ctx, &to)
-	}
-	return to, err
+	return out, nil
 }
 
-// ToPB runs the BeforeToPB hook if present, converts the fields of this
-// object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *TestAssocHandlerAppendORM) ToPB(ctx context.Context) (TestAssocHandlerAppend, error) {
-	to := TestAssocHandlerAppend{}
-	var err error
-	if prehook, ok := interface{}(m).(TestAssocHandlerAppendWithBeforeToPB); ok {
-		if err = prehook.BeforeToPB(ctx, &to); err != nil {
-			return to, err
-		}
+// PrimaryStringTypeORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func PrimaryStringTypeORMSliceToPB(ctx context.Context, in []*PrimaryStringTypeORM) ([]*PrimaryStringType, error) {
+	if in == nil {
+		return nil, nil
 	}
-	to.Id = m.Id
-	for _, v := range m.TestTagAssoc {
-		if v != nil {
-			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
-				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
-			} else {
-				return to, cErr
-			}
-		} else {
-			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+	out := make([]*PrimaryStringType, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
 		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
 	}
-	if posthook, ok := interface{}(m).(TestAssocHandlerAppendWithAfterToPB); ok {
-		err = posthook.AfterToPB(ctx, &to)
-	}
-	return to, err
+	return out, nil
 }
 
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type TestAssocHandlerAppend the arg will be the target, the caller the one being converted from
+// of type PrimaryStringType the arg will be the target, the caller the one being converted from
 
-// TestAssocHandlerAppendBeforeToORM called before default ToORM code
-type TestAssocHandlerAppendWithBeforeToORM interface {
-	BeforeToORM(context.Context, *TestAssocHandlerAppendORM) error
+// PrimaryStringTypeBeforeToORM called before default ToORM code
+type PrimaryStringTypeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PrimaryStringTypeORM) error
 }
 
-// TestAssocHandlerAppendAfterToORM called after default ToORM code
-type TestAssocHandlerAppendWithAfterToORM interface {
-	AfterToORM(context.Context, *TestAssocHandlerAppendORM) error
+// PrimaryStringTypeAfterToORM called after default ToORM code
+type PrimaryStringTypeWithAfterToORM interface {
+	AfterToORM(context.Context, *PrimaryStringTypeORM) error
 }
 
-// TestAssocHandlerAppendBeforeToPB called before default ToPB code
-type TestAssocHandlerAppendWithBeforeToPB interface {
-	BeforeToPB(context.Context, *TestAssocHandlerAppend) error
+// PrimaryStringTypeBeforeToPB called before default ToPB code
+type PrimaryStringTypeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PrimaryStringType) error
 }
 
-// TestAssocHandlerAppendAfterToPB called after default ToPB code
-type TestAssocHandlerAppendWithAfterToPB interface {
-	AfterToPB(context.Context, *TestAssocHandlerAppend) error
+// PrimaryStringTypeAfterToPB called after default ToPB code
+type PrimaryStringTypeWithAfterToPB interface {
+	AfterToPB(context.Context, *PrimaryStringType) error
 }
 
-type TestTagAssociationORM struct {
-	SomeField                 string
-	TestAssocHandlerAppendId  *string
-	TestAssocHandlerClearId   *string
-	TestAssocHandlerDefaultId *string
-	TestAssocHandlerReplaceId *string
-	TestTagId                 *string
+type TestTagORM struct {
+	Id           string
+	TestTagAssoc *TestTagAssociationORM `gorm:"foreignkey:TestTagId;association_foreignkey:Id"`
 }
 
 // TableName overrides the default tablename generated by GORM
-func (TestTagAssociationORM) TableName() string {
-	return "test_tag_associations"
+func (TestTagORM) TableName() string {
+	return "test_tags"
 }
 
-// ToORM runs the BeforeToORM hook if present, converts the fields of this
-// object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *TestTagAssociation) ToORM(ctx context.Context) (TestTagAssociationORM, error) {
-	to := TestTagAssociationORM{}
-	var err error
-	if prehook, ok := interface{}(m).(TestTagAssociationWithBeforeToORM); ok {
-		if err = prehook.BeforeToORM(ctx, &to); err != nil {
-			return to, err
-		}
-	}
-	to.SomeField = m.SomeField
-	if posthook, ok := interface{}(m).(TestTagAssociationWithAfterToORM); ok {
-		err = posthook.AfterToORM(ctx, &to)
-	}
-	return to, err
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestTagORM) ClearAssociations() {
+	m.TestTagAssoc = nil
 }
 
-// ToPB runs the BeforeToPB hook if present, converts the fields of this
-// object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *TestTagAssociationORM) ToPB(ctx context.Context) (TestTagAssociation, error) {
-	to := TestTagAssociation{}
-	var err error
-	if prehook, ok := interface{}(m).(TestTagAssociationWithBeforeToPB); ok {
-		if err = prehook.BeforeToPB(ctx, &to); err != nil {
-			return to, err
-		}
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TestTagORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
 	}
-	to.SomeField = m.SomeField
-	if posthook, ok := interface{}(m).(TestTagAssociationWithAfterToPB); ok {
-		err = posthook.AfterToPB(ctx, &to)
+	reloaded := TestTagORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
 	}
-	return to, err
-}
-
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type TestTagAssociation the arg will be the target, the caller the one being converted from
-
-// TestTagAssociationBeforeToORM called before default ToORM code
-type TestTagAssociationWithBeforeToORM interface {
-	BeforeToORM(context.Context, *TestTagAssociationORM) error
+	*m = reloaded
+	return nil
 }
 
-// TestTagAssociationAfterToORM called after default ToORM code
-type TestTagAssociationWithAfterToORM interface {
-	AfterToORM(context.Context, *TestTagAssociationORM) error
-}
+// TestTagORMIndexes lists the indexes declared by the gorm tags of TestTagORM
+var TestTagORMIndexes = []types.IndexDef{}
 
-// TestTagAssociationBeforeToPB called before default ToPB code
-type TestTagAssociationWithBeforeToPB interface {
-	BeforeToPB(context.Context, *TestTagAssociation) error
+// TestTagORMForeignKeys lists the foreign keys of the associations of TestTagORM
+var TestTagORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_test_tag_associations_test_tag_id", Table: "test_tag_associations", Columns: []string{"test_tag_id"}, References: "test_tags", ReferencedColumns: []string{"id"}},
 }
 
-// TestTagAssociationAfterToPB called after default ToPB code
-type TestTagAssociationWithAfterToPB interface {
-	AfterToPB(context.Context, *TestTagAssociation) error
+// LoadTestTagTestTagAssocForAll loads the TestTagAssoc of all the parents with a single query and
+// sets them on each parent by TestTagId. The parents
+// lose the TestTagAssoc they held, a nil parent is skipped.
+func LoadTestTagTestTagAssocForAll(ctx context.Context, db *gorm.DB, parents []*TestTagORM) error {
+	type key struct {
+		Id string
+	}
+	byKey := make(map[key][]*TestTagORM, len(parents))
+	loaded := make(map[*TestTagORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.TestTagAssoc = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TestTagAssociationORM
+	if err := db.Where("test_tag_id IN (?)", ids).Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.TestTagId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.TestTagId}] {
+			if parent.TestTagAssoc == nil {
+				parent.TestTagAssoc = child
+			}
+		}
+	}
+	return nil
 }
 
-type PrimaryIncludedORM struct {
-	Child *ExternalChildORM `gorm:"foreignkey:PrimaryIncludedId;association_foreignkey:Id"`
-	Id    go_uuid.UUID
+// WithTestTagConditionalPreload returns a context making the Read and List
+// handlers of TestTag preload the association name, e.g. TestTagAssoc, when cond holds
+// for their context
+func WithTestTagConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "TestTag", name, cond)
 }
 
-// TableName overrides the default tablename generated by GORM
-func (PrimaryIncludedORM) TableName() string {
-	return "primary_includeds"
+// applyTestTagConditionalPreloads preloads the associations of the conditional
+// preloads of TestTag in ctx whose condition holds
+func applyTestTagConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestTag") {
+		switch preload.Name {
+		case "TestTagAssoc":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *PrimaryIncluded) ToORM(ctx context.Context) (PrimaryIncludedORM, error) {
-	to := PrimaryIncludedORM{}
+func (m *TestTag) ToORM(ctx context.Context) (TestTagORM, error) {
+	to := TestTagORM{}
 	var err error
-	if prehook, ok := interface{}(m).(PrimaryIncludedWithBeforeToORM); ok {
+	if prehook, ok := interface{}(m).(TestTagWithBeforeToORM); ok {
 		if err = prehook.BeforeToORM(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	if m.Child != nil {
-		tempChild, err := m.Child.ToORM(ctx)
+	to.Id = m.Id
+	if m.TestTagAssoc != nil {
+		tempTestTagAssoc, err := m.TestTagAssoc.ToORM(ctx)
 		if err != nil {
 			return to, err
 		}
-		to.Child = &tempChild
+		to.TestTagAssoc = &tempTestTagAssoc
 	}
-	if posthook, ok := interface{}(m).(PrimaryIncludedWithAfterToORM); ok {
+	if posthook, ok := interface{}(m).(TestTagWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
 	return to, err
@@ -1202,196 +2701,11250 @@ func (m *PrimaryIncluded) ToORM(ctx context.Context) (PrimaryIncludedORM, error)
 
 // ToPB runs the BeforeToPB hook if present, converts the fields of this
 // object to PB format, runs the AfterToPB hook, then returns the PB object
-func (m *PrimaryIncludedORM) ToPB(ctx context.Context) (PrimaryIncluded, error) {
-	to := PrimaryIncluded{}
+func (m *TestTagORM) ToPB(ctx context.Context) (TestTag, error) {
+	to := TestTag{}
 	var err error
-	if prehook, ok := interface{}(m).(PrimaryIncludedWithBeforeToPB); ok {
+	if prehook, ok := interface{}(m).(TestTagWithBeforeToPB); ok {
 		if err = prehook.BeforeToPB(ctx, &to); err != nil {
 			return to, err
 		}
 	}
-	if m.Child != nil {
-		tempChild, err := m.Child.ToPB(ctx)
+	to.Id = m.Id
+	if m.TestTagAssoc != nil {
+		tempTestTagAssoc, err := m.TestTagAssoc.ToPB(ctx)
 		if err != nil {
 			return to, err
 		}
-		to.Child = &tempChild
+		to.TestTagAssoc = &tempTestTagAssoc
 	}
-	if posthook, ok := interface{}(m).(PrimaryIncludedWithAfterToPB); ok {
+	if posthook, ok := interface{}(m).(TestTagWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type PrimaryIncluded the arg will be the target, the caller the one being converted from
-
-// PrimaryIncludedBeforeToORM called before default ToORM code
-type PrimaryIncludedWithBeforeToORM interface {
-	BeforeToORM(context.Context, *PrimaryIncludedORM) error
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestTag) MergeToORM(ctx context.Context, dst *TestTagORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	if associations {
+		dst.TestTagAssoc = to.TestTagAssoc
+	}
+	return nil
 }
 
-// PrimaryIncludedAfterToORM called after default ToORM code
-type PrimaryIncludedWithAfterToORM interface {
-	AfterToORM(context.Context, *PrimaryIncludedORM) error
-}
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestTag) ApplyFieldMaskToORM(ctx context.Context, dst *TestTagORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestTagORM) applyFieldMaskPath(from *TestTagORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "TestTagAssoc":
+		if rest == "" {
+			m.TestTagAssoc = from.TestTagAssoc
+			return true
+		}
+		nested := from.TestTagAssoc
+		if nested == nil {
+			nested = &TestTagAssociationORM{}
+		}
+		if m.TestTagAssoc == nil {
+			m.TestTagAssoc = &TestTagAssociationORM{}
+		}
+		return m.TestTagAssoc.applyFieldMaskPath(nested, rest)
+	default:
+		return false
+	}
+}
+
+// DiffTestTag returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestTag(old, new *TestTagORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestTagORM{}
+	}
+	if new == nil {
+		new = &TestTagORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if (old.TestTagAssoc == nil) != (new.TestTagAssoc == nil) || len(DiffTestTagAssociation(old.TestTagAssoc, new.TestTagAssoc).Paths) > 0 {
+		mask.Paths = append(mask.Paths, "TestTagAssoc")
+	}
+	return mask
+}
+
+// TestTagSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestTagSliceToORM(ctx context.Context, in []*TestTag) ([]*TestTagORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestTagORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestTagORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestTagORMSliceToPB(ctx context.Context, in []*TestTagORM) ([]*TestTag, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestTag, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestTag the arg will be the target, the caller the one being converted from
+
+// TestTagBeforeToORM called before default ToORM code
+type TestTagWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestTagORM) error
+}
+
+// TestTagAfterToORM called after default ToORM code
+type TestTagWithAfterToORM interface {
+	AfterToORM(context.Context, *TestTagORM) error
+}
+
+// TestTagBeforeToPB called before default ToPB code
+type TestTagWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestTag) error
+}
+
+// TestTagAfterToPB called after default ToPB code
+type TestTagWithAfterToPB interface {
+	AfterToPB(context.Context, *TestTag) error
+}
+
+type TestAssocHandlerDefaultORM struct {
+	Id           string
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerDefaultId;association_foreignkey:Id"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestAssocHandlerDefaultORM) TableName() string {
+	return "test_assoc_handler_defaults"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestAssocHandlerDefaultORM) ClearAssociations() {
+	m.TestTagAssoc = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TestAssocHandlerDefaultORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := TestAssocHandlerDefaultORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// TestAssocHandlerDefaultORMIndexes lists the indexes declared by the gorm tags of TestAssocHandlerDefaultORM
+var TestAssocHandlerDefaultORMIndexes = []types.IndexDef{}
+
+// TestAssocHandlerDefaultORMForeignKeys lists the foreign keys of the associations of TestAssocHandlerDefaultORM
+var TestAssocHandlerDefaultORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_test_tag_associations_test_assoc_handler_default_id", Table: "test_tag_associations", Columns: []string{"test_assoc_handler_default_id"}, References: "test_assoc_handler_defaults", ReferencedColumns: []string{"id"}},
+}
+
+// LoadTestAssocHandlerDefaultTestTagAssocForAll loads the TestTagAssoc of all the parents with a single query and
+// sets them on each parent by TestAssocHandlerDefaultId. The parents
+// lose the TestTagAssoc they held, a nil parent is skipped.
+func LoadTestAssocHandlerDefaultTestTagAssocForAll(ctx context.Context, db *gorm.DB, parents []*TestAssocHandlerDefaultORM) error {
+	type key struct {
+		Id string
+	}
+	byKey := make(map[key][]*TestAssocHandlerDefaultORM, len(parents))
+	loaded := make(map[*TestAssocHandlerDefaultORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.TestTagAssoc = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TestTagAssociationORM
+	if err := db.Where("test_assoc_handler_default_id IN (?)", ids).Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.TestAssocHandlerDefaultId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.TestAssocHandlerDefaultId}] {
+			parent.TestTagAssoc = append(parent.TestTagAssoc, child)
+		}
+	}
+	return nil
+}
+
+// WithTestAssocHandlerDefaultConditionalPreload returns a context making the Read and List
+// handlers of TestAssocHandlerDefault preload the association name, e.g. TestTagAssoc, when cond holds
+// for their context
+func WithTestAssocHandlerDefaultConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "TestAssocHandlerDefault", name, cond)
+}
+
+// applyTestAssocHandlerDefaultConditionalPreloads preloads the associations of the conditional
+// preloads of TestAssocHandlerDefault in ctx whose condition holds
+func applyTestAssocHandlerDefaultConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestAssocHandlerDefault") {
+		switch preload.Name {
+		case "TestTagAssoc":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TestAssocHandlerDefault) ToORM(ctx context.Context) (TestAssocHandlerDefaultORM, error) {
+	to := TestAssocHandlerDefaultORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerDefaultWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerDefaultWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TestAssocHandlerDefaultORM) ToPB(ctx context.Context) (TestAssocHandlerDefault, error) {
+	to := TestAssocHandlerDefault{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerDefaultWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerDefaultWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestAssocHandlerDefault) MergeToORM(ctx context.Context, dst *TestAssocHandlerDefaultORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	if associations {
+		dst.TestTagAssoc = to.TestTagAssoc
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestAssocHandlerDefault) ApplyFieldMaskToORM(ctx context.Context, dst *TestAssocHandlerDefaultORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestAssocHandlerDefaultORM) applyFieldMaskPath(from *TestAssocHandlerDefaultORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "TestTagAssoc":
+		if rest != "" {
+			return false
+		}
+		m.TestTagAssoc = from.TestTagAssoc
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestAssocHandlerDefault returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestAssocHandlerDefault(old, new *TestAssocHandlerDefaultORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestAssocHandlerDefaultORM{}
+	}
+	if new == nil {
+		new = &TestAssocHandlerDefaultORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	differsTestTagAssoc := len(old.TestTagAssoc) != len(new.TestTagAssoc)
+	for i := 0; !differsTestTagAssoc && i < len(old.TestTagAssoc); i++ {
+		differsTestTagAssoc = (old.TestTagAssoc[i] == nil) != (new.TestTagAssoc[i] == nil) || len(DiffTestTagAssociation(old.TestTagAssoc[i], new.TestTagAssoc[i]).Paths) > 0
+	}
+	if differsTestTagAssoc {
+		mask.Paths = append(mask.Paths, "TestTagAssoc")
+	}
+	return mask
+}
+
+// TestAssocHandlerDefaultSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerDefaultSliceToORM(ctx context.Context, in []*TestAssocHandlerDefault) ([]*TestAssocHandlerDefaultORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerDefaultORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestAssocHandlerDefaultORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerDefaultORMSliceToPB(ctx context.Context, in []*TestAssocHandlerDefaultORM) ([]*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerDefault, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestAssocHandlerDefault the arg will be the target, the caller the one being converted from
+
+// TestAssocHandlerDefaultBeforeToORM called before default ToORM code
+type TestAssocHandlerDefaultWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestAssocHandlerDefaultORM) error
+}
+
+// TestAssocHandlerDefaultAfterToORM called after default ToORM code
+type TestAssocHandlerDefaultWithAfterToORM interface {
+	AfterToORM(context.Context, *TestAssocHandlerDefaultORM) error
+}
+
+// TestAssocHandlerDefaultBeforeToPB called before default ToPB code
+type TestAssocHandlerDefaultWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestAssocHandlerDefault) error
+}
+
+// TestAssocHandlerDefaultAfterToPB called after default ToPB code
+type TestAssocHandlerDefaultWithAfterToPB interface {
+	AfterToPB(context.Context, *TestAssocHandlerDefault) error
+}
+
+type TestAssocHandlerReplaceORM struct {
+	Id           string
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerReplaceId;association_foreignkey:Id;replace:true"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestAssocHandlerReplaceORM) TableName() string {
+	return "test_assoc_handler_replaces"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestAssocHandlerReplaceORM) ClearAssociations() {
+	m.TestTagAssoc = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TestAssocHandlerReplaceORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := TestAssocHandlerReplaceORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// TestAssocHandlerReplaceORMIndexes lists the indexes declared by the gorm tags of TestAssocHandlerReplaceORM
+var TestAssocHandlerReplaceORMIndexes = []types.IndexDef{}
+
+// TestAssocHandlerReplaceORMForeignKeys lists the foreign keys of the associations of TestAssocHandlerReplaceORM
+var TestAssocHandlerReplaceORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_test_tag_associations_test_assoc_handler_replace_id", Table: "test_tag_associations", Columns: []string{"test_assoc_handler_replace_id"}, References: "test_assoc_handler_replaces", ReferencedColumns: []string{"id"}},
+}
+
+// LoadTestAssocHandlerReplaceTestTagAssocForAll loads the TestTagAssoc of all the parents with a single query and
+// sets them on each parent by TestAssocHandlerReplaceId. The parents
+// lose the TestTagAssoc they held, a nil parent is skipped.
+func LoadTestAssocHandlerReplaceTestTagAssocForAll(ctx context.Context, db *gorm.DB, parents []*TestAssocHandlerReplaceORM) error {
+	type key struct {
+		Id string
+	}
+	byKey := make(map[key][]*TestAssocHandlerReplaceORM, len(parents))
+	loaded := make(map[*TestAssocHandlerReplaceORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.TestTagAssoc = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TestTagAssociationORM
+	if err := db.Where("test_assoc_handler_replace_id IN (?)", ids).Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.TestAssocHandlerReplaceId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.TestAssocHandlerReplaceId}] {
+			parent.TestTagAssoc = append(parent.TestTagAssoc, child)
+		}
+	}
+	return nil
+}
+
+// WithTestAssocHandlerReplaceConditionalPreload returns a context making the Read and List
+// handlers of TestAssocHandlerReplace preload the association name, e.g. TestTagAssoc, when cond holds
+// for their context
+func WithTestAssocHandlerReplaceConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "TestAssocHandlerReplace", name, cond)
+}
+
+// applyTestAssocHandlerReplaceConditionalPreloads preloads the associations of the conditional
+// preloads of TestAssocHandlerReplace in ctx whose condition holds
+func applyTestAssocHandlerReplaceConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestAssocHandlerReplace") {
+		switch preload.Name {
+		case "TestTagAssoc":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TestAssocHandlerReplace) ToORM(ctx context.Context) (TestAssocHandlerReplaceORM, error) {
+	to := TestAssocHandlerReplaceORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerReplaceWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerReplaceWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TestAssocHandlerReplaceORM) ToPB(ctx context.Context) (TestAssocHandlerReplace, error) {
+	to := TestAssocHandlerReplace{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerReplaceWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerReplaceWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestAssocHandlerReplace) MergeToORM(ctx context.Context, dst *TestAssocHandlerReplaceORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	if associations {
+		dst.TestTagAssoc = to.TestTagAssoc
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestAssocHandlerReplace) ApplyFieldMaskToORM(ctx context.Context, dst *TestAssocHandlerReplaceORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestAssocHandlerReplaceORM) applyFieldMaskPath(from *TestAssocHandlerReplaceORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "TestTagAssoc":
+		if rest != "" {
+			return false
+		}
+		m.TestTagAssoc = from.TestTagAssoc
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestAssocHandlerReplace returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestAssocHandlerReplace(old, new *TestAssocHandlerReplaceORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestAssocHandlerReplaceORM{}
+	}
+	if new == nil {
+		new = &TestAssocHandlerReplaceORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	differsTestTagAssoc := len(old.TestTagAssoc) != len(new.TestTagAssoc)
+	for i := 0; !differsTestTagAssoc && i < len(old.TestTagAssoc); i++ {
+		differsTestTagAssoc = (old.TestTagAssoc[i] == nil) != (new.TestTagAssoc[i] == nil) || len(DiffTestTagAssociation(old.TestTagAssoc[i], new.TestTagAssoc[i]).Paths) > 0
+	}
+	if differsTestTagAssoc {
+		mask.Paths = append(mask.Paths, "TestTagAssoc")
+	}
+	return mask
+}
+
+// TestAssocHandlerReplaceSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerReplaceSliceToORM(ctx context.Context, in []*TestAssocHandlerReplace) ([]*TestAssocHandlerReplaceORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerReplaceORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestAssocHandlerReplaceORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerReplaceORMSliceToPB(ctx context.Context, in []*TestAssocHandlerReplaceORM) ([]*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerReplace, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestAssocHandlerReplace the arg will be the target, the caller the one being converted from
+
+// TestAssocHandlerReplaceBeforeToORM called before default ToORM code
+type TestAssocHandlerReplaceWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestAssocHandlerReplaceORM) error
+}
+
+// TestAssocHandlerReplaceAfterToORM called after default ToORM code
+type TestAssocHandlerReplaceWithAfterToORM interface {
+	AfterToORM(context.Context, *TestAssocHandlerReplaceORM) error
+}
+
+// TestAssocHandlerReplaceBeforeToPB called before default ToPB code
+type TestAssocHandlerReplaceWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestAssocHandlerReplace) error
+}
+
+// TestAssocHandlerReplaceAfterToPB called after default ToPB code
+type TestAssocHandlerReplaceWithAfterToPB interface {
+	AfterToPB(context.Context, *TestAssocHandlerReplace) error
+}
+
+type TestAssocHandlerClearORM struct {
+	Id           string
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerClearId;association_foreignkey:Id;clear:true"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestAssocHandlerClearORM) TableName() string {
+	return "test_assoc_handler_clears"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestAssocHandlerClearORM) ClearAssociations() {
+	m.TestTagAssoc = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TestAssocHandlerClearORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := TestAssocHandlerClearORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// TestAssocHandlerClearORMIndexes lists the indexes declared by the gorm tags of TestAssocHandlerClearORM
+var TestAssocHandlerClearORMIndexes = []types.IndexDef{}
+
+// TestAssocHandlerClearORMForeignKeys lists the foreign keys of the associations of TestAssocHandlerClearORM
+var TestAssocHandlerClearORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_test_tag_associations_test_assoc_handler_clear_id", Table: "test_tag_associations", Columns: []string{"test_assoc_handler_clear_id"}, References: "test_assoc_handler_clears", ReferencedColumns: []string{"id"}},
+}
+
+// LoadTestAssocHandlerClearTestTagAssocForAll loads the TestTagAssoc of all the parents with a single query and
+// sets them on each parent by TestAssocHandlerClearId. The parents
+// lose the TestTagAssoc they held, a nil parent is skipped.
+func LoadTestAssocHandlerClearTestTagAssocForAll(ctx context.Context, db *gorm.DB, parents []*TestAssocHandlerClearORM) error {
+	type key struct {
+		Id string
+	}
+	byKey := make(map[key][]*TestAssocHandlerClearORM, len(parents))
+	loaded := make(map[*TestAssocHandlerClearORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.TestTagAssoc = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TestTagAssociationORM
+	if err := db.Where("test_assoc_handler_clear_id IN (?)", ids).Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.TestAssocHandlerClearId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.TestAssocHandlerClearId}] {
+			parent.TestTagAssoc = append(parent.TestTagAssoc, child)
+		}
+	}
+	return nil
+}
+
+// WithTestAssocHandlerClearConditionalPreload returns a context making the Read and List
+// handlers of TestAssocHandlerClear preload the association name, e.g. TestTagAssoc, when cond holds
+// for their context
+func WithTestAssocHandlerClearConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "TestAssocHandlerClear", name, cond)
+}
+
+// applyTestAssocHandlerClearConditionalPreloads preloads the associations of the conditional
+// preloads of TestAssocHandlerClear in ctx whose condition holds
+func applyTestAssocHandlerClearConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestAssocHandlerClear") {
+		switch preload.Name {
+		case "TestTagAssoc":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TestAssocHandlerClear) ToORM(ctx context.Context) (TestAssocHandlerClearORM, error) {
+	to := TestAssocHandlerClearORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerClearWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerClearWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TestAssocHandlerClearORM) ToPB(ctx context.Context) (TestAssocHandlerClear, error) {
+	to := TestAssocHandlerClear{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerClearWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerClearWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestAssocHandlerClear) MergeToORM(ctx context.Context, dst *TestAssocHandlerClearORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	if associations {
+		dst.TestTagAssoc = to.TestTagAssoc
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestAssocHandlerClear) ApplyFieldMaskToORM(ctx context.Context, dst *TestAssocHandlerClearORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestAssocHandlerClearORM) applyFieldMaskPath(from *TestAssocHandlerClearORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "TestTagAssoc":
+		if rest != "" {
+			return false
+		}
+		m.TestTagAssoc = from.TestTagAssoc
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestAssocHandlerClear returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestAssocHandlerClear(old, new *TestAssocHandlerClearORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestAssocHandlerClearORM{}
+	}
+	if new == nil {
+		new = &TestAssocHandlerClearORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	differsTestTagAssoc := len(old.TestTagAssoc) != len(new.TestTagAssoc)
+	for i := 0; !differsTestTagAssoc && i < len(old.TestTagAssoc); i++ {
+		differsTestTagAssoc = (old.TestTagAssoc[i] == nil) != (new.TestTagAssoc[i] == nil) || len(DiffTestTagAssociation(old.TestTagAssoc[i], new.TestTagAssoc[i]).Paths) > 0
+	}
+	if differsTestTagAssoc {
+		mask.Paths = append(mask.Paths, "TestTagAssoc")
+	}
+	return mask
+}
+
+// TestAssocHandlerClearSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerClearSliceToORM(ctx context.Context, in []*TestAssocHandlerClear) ([]*TestAssocHandlerClearORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerClearORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestAssocHandlerClearORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerClearORMSliceToPB(ctx context.Context, in []*TestAssocHandlerClearORM) ([]*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerClear, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestAssocHandlerClear the arg will be the target, the caller the one being converted from
+
+// TestAssocHandlerClearBeforeToORM called before default ToORM code
+type TestAssocHandlerClearWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestAssocHandlerClearORM) error
+}
+
+// TestAssocHandlerClearAfterToORM called after default ToORM code
+type TestAssocHandlerClearWithAfterToORM interface {
+	AfterToORM(context.Context, *TestAssocHandlerClearORM) error
+}
+
+// TestAssocHandlerClearBeforeToPB called before default ToPB code
+type TestAssocHandlerClearWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestAssocHandlerClear) error
+}
+
+// TestAssocHandlerClearAfterToPB called after default ToPB code
+type TestAssocHandlerClearWithAfterToPB interface {
+	AfterToPB(context.Context, *TestAssocHandlerClear) error
+}
+
+type TestAssocHandlerAppendORM struct {
+	Id           string
+	TestTagAssoc []*TestTagAssociationORM `gorm:"foreignkey:TestAssocHandlerAppendId;association_foreignkey:Id;append:true"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestAssocHandlerAppendORM) TableName() string {
+	return "test_assoc_handler_appends"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestAssocHandlerAppendORM) ClearAssociations() {
+	m.TestTagAssoc = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TestAssocHandlerAppendORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := TestAssocHandlerAppendORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// TestAssocHandlerAppendORMIndexes lists the indexes declared by the gorm tags of TestAssocHandlerAppendORM
+var TestAssocHandlerAppendORMIndexes = []types.IndexDef{}
+
+// TestAssocHandlerAppendORMForeignKeys lists the foreign keys of the associations of TestAssocHandlerAppendORM
+var TestAssocHandlerAppendORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_test_tag_associations_test_assoc_handler_append_id", Table: "test_tag_associations", Columns: []string{"test_assoc_handler_append_id"}, References: "test_assoc_handler_appends", ReferencedColumns: []string{"id"}},
+}
+
+// LoadTestAssocHandlerAppendTestTagAssocForAll loads the TestTagAssoc of all the parents with a single query and
+// sets them on each parent by TestAssocHandlerAppendId. The parents
+// lose the TestTagAssoc they held, a nil parent is skipped.
+func LoadTestAssocHandlerAppendTestTagAssocForAll(ctx context.Context, db *gorm.DB, parents []*TestAssocHandlerAppendORM) error {
+	type key struct {
+		Id string
+	}
+	byKey := make(map[key][]*TestAssocHandlerAppendORM, len(parents))
+	loaded := make(map[*TestAssocHandlerAppendORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.TestTagAssoc = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TestTagAssociationORM
+	if err := db.Where("test_assoc_handler_append_id IN (?)", ids).Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.TestAssocHandlerAppendId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.TestAssocHandlerAppendId}] {
+			parent.TestTagAssoc = append(parent.TestTagAssoc, child)
+		}
+	}
+	return nil
+}
+
+// WithTestAssocHandlerAppendConditionalPreload returns a context making the Read and List
+// handlers of TestAssocHandlerAppend preload the association name, e.g. TestTagAssoc, when cond holds
+// for their context
+func WithTestAssocHandlerAppendConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "TestAssocHandlerAppend", name, cond)
+}
+
+// applyTestAssocHandlerAppendConditionalPreloads preloads the associations of the conditional
+// preloads of TestAssocHandlerAppend in ctx whose condition holds
+func applyTestAssocHandlerAppendConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestAssocHandlerAppend") {
+		switch preload.Name {
+		case "TestTagAssoc":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TestAssocHandlerAppend) ToORM(ctx context.Context) (TestAssocHandlerAppendORM, error) {
+	to := TestAssocHandlerAppendORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerAppendWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToORM(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerAppendWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TestAssocHandlerAppendORM) ToPB(ctx context.Context) (TestAssocHandlerAppend, error) {
+	to := TestAssocHandlerAppend{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocHandlerAppendWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.TestTagAssoc {
+		if v != nil {
+			if tempTestTagAssoc, cErr := v.ToPB(ctx); cErr == nil {
+				to.TestTagAssoc = append(to.TestTagAssoc, &tempTestTagAssoc)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.TestTagAssoc = append(to.TestTagAssoc, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocHandlerAppendWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestAssocHandlerAppend) MergeToORM(ctx context.Context, dst *TestAssocHandlerAppendORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	if associations {
+		dst.TestTagAssoc = to.TestTagAssoc
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestAssocHandlerAppend) ApplyFieldMaskToORM(ctx context.Context, dst *TestAssocHandlerAppendORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestAssocHandlerAppendORM) applyFieldMaskPath(from *TestAssocHandlerAppendORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "TestTagAssoc":
+		if rest != "" {
+			return false
+		}
+		m.TestTagAssoc = from.TestTagAssoc
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestAssocHandlerAppend returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestAssocHandlerAppend(old, new *TestAssocHandlerAppendORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestAssocHandlerAppendORM{}
+	}
+	if new == nil {
+		new = &TestAssocHandlerAppendORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	differsTestTagAssoc := len(old.TestTagAssoc) != len(new.TestTagAssoc)
+	for i := 0; !differsTestTagAssoc && i < len(old.TestTagAssoc); i++ {
+		differsTestTagAssoc = (old.TestTagAssoc[i] == nil) != (new.TestTagAssoc[i] == nil) || len(DiffTestTagAssociation(old.TestTagAssoc[i], new.TestTagAssoc[i]).Paths) > 0
+	}
+	if differsTestTagAssoc {
+		mask.Paths = append(mask.Paths, "TestTagAssoc")
+	}
+	return mask
+}
+
+// TestAssocHandlerAppendSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerAppendSliceToORM(ctx context.Context, in []*TestAssocHandlerAppend) ([]*TestAssocHandlerAppendORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerAppendORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestAssocHandlerAppendORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocHandlerAppendORMSliceToPB(ctx context.Context, in []*TestAssocHandlerAppendORM) ([]*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocHandlerAppend, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestAssocHandlerAppend the arg will be the target, the caller the one being converted from
+
+// TestAssocHandlerAppendBeforeToORM called before default ToORM code
+type TestAssocHandlerAppendWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestAssocHandlerAppendORM) error
+}
+
+// TestAssocHandlerAppendAfterToORM called after default ToORM code
+type TestAssocHandlerAppendWithAfterToORM interface {
+	AfterToORM(context.Context, *TestAssocHandlerAppendORM) error
+}
+
+// TestAssocHandlerAppendBeforeToPB called before default ToPB code
+type TestAssocHandlerAppendWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestAssocHandlerAppend) error
+}
+
+// TestAssocHandlerAppendAfterToPB called after default ToPB code
+type TestAssocHandlerAppendWithAfterToPB interface {
+	AfterToPB(context.Context, *TestAssocHandlerAppend) error
+}
+
+type TestTagAssociationORM struct {
+	SomeField                 string
+	TestAssocHandlerAppendId  *string
+	TestAssocHandlerClearId   *string
+	TestAssocHandlerDefaultId *string
+	TestAssocHandlerReplaceId *string
+	TestTagId                 *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestTagAssociationORM) TableName() string {
+	return "test_tag_associations"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestTagAssociationORM) ClearAssociations() {
+}
+
+// TestTagAssociationORMIndexes lists the indexes declared by the gorm tags of TestTagAssociationORM
+var TestTagAssociationORMIndexes = []types.IndexDef{}
+
+// applyTestTagAssociationConditionalPreloads preloads the associations of the conditional
+// preloads of TestTagAssociation in ctx whose condition holds
+func applyTestTagAssociationConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestTagAssociation") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TestTagAssociation) ToORM(ctx context.Context) (TestTagAssociationORM, error) {
+	to := TestTagAssociationORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TestTagAssociationWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.SomeField = m.SomeField
+	if posthook, ok := interface{}(m).(TestTagAssociationWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TestTagAssociationORM) ToPB(ctx context.Context) (TestTagAssociation, error) {
+	to := TestTagAssociation{}
+	var err error
+	if prehook, ok := interface{}(m).(TestTagAssociationWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.SomeField = m.SomeField
+	if posthook, ok := interface{}(m).(TestTagAssociationWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestTagAssociation) MergeToORM(ctx context.Context, dst *TestTagAssociationORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.SomeField = to.SomeField
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestTagAssociation) ApplyFieldMaskToORM(ctx context.Context, dst *TestTagAssociationORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestTagAssociationORM) applyFieldMaskPath(from *TestTagAssociationORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "SomeField":
+		if rest != "" {
+			return false
+		}
+		m.SomeField = from.SomeField
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestTagAssociation returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestTagAssociation(old, new *TestTagAssociationORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestTagAssociationORM{}
+	}
+	if new == nil {
+		new = &TestTagAssociationORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.SomeField != new.SomeField {
+		mask.Paths = append(mask.Paths, "SomeField")
+	}
+	return mask
+}
+
+// TestTagAssociationSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestTagAssociationSliceToORM(ctx context.Context, in []*TestTagAssociation) ([]*TestTagAssociationORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestTagAssociationORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestTagAssociationORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestTagAssociationORMSliceToPB(ctx context.Context, in []*TestTagAssociationORM) ([]*TestTagAssociation, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestTagAssociation, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestTagAssociation the arg will be the target, the caller the one being converted from
+
+// TestTagAssociationBeforeToORM called before default ToORM code
+type TestTagAssociationWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestTagAssociationORM) error
+}
+
+// TestTagAssociationAfterToORM called after default ToORM code
+type TestTagAssociationWithAfterToORM interface {
+	AfterToORM(context.Context, *TestTagAssociationORM) error
+}
+
+// TestTagAssociationBeforeToPB called before default ToPB code
+type TestTagAssociationWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestTagAssociation) error
+}
+
+// TestTagAssociationAfterToPB called after default ToPB code
+type TestTagAssociationWithAfterToPB interface {
+	AfterToPB(context.Context, *TestTagAssociation) error
+}
+
+type TestAssocLabeledORM struct {
+	Id     string
+	Labels []*TestAssocLabelORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:test_assoc_labeled_test_assoc_labels;jointable_foreignkey:TestAssocLabeledId;association_jointable_foreignkey:TestAssocLabelId"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestAssocLabeledORM) TableName() string {
+	return "test_assoc_labeleds"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestAssocLabeledORM) ClearAssociations() {
+	m.Labels = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TestAssocLabeledORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := TestAssocLabeledORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// TestAssocLabeledORMIndexes lists the indexes declared by the gorm tags of TestAssocLabeledORM
+var TestAssocLabeledORMIndexes = []types.IndexDef{}
+
+// WithTestAssocLabeledConditionalPreload returns a context making the Read and List
+// handlers of TestAssocLabeled preload the association name, e.g. Labels, when cond holds
+// for their context
+func WithTestAssocLabeledConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "TestAssocLabeled", name, cond)
+}
+
+// applyTestAssocLabeledConditionalPreloads preloads the associations of the conditional
+// preloads of TestAssocLabeled in ctx whose condition holds
+func applyTestAssocLabeledConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestAssocLabeled") {
+		switch preload.Name {
+		case "Labels":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TestAssocLabeled) ToORM(ctx context.Context) (TestAssocLabeledORM, error) {
+	to := TestAssocLabeledORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocLabeledWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.Labels {
+		if v != nil {
+			if tempLabels, cErr := v.ToORM(ctx); cErr == nil {
+				to.Labels = append(to.Labels, &tempLabels)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Labels = append(to.Labels, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocLabeledWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TestAssocLabeledORM) ToPB(ctx context.Context) (TestAssocLabeled, error) {
+	to := TestAssocLabeled{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocLabeledWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	for _, v := range m.Labels {
+		if v != nil {
+			if tempLabels, cErr := v.ToPB(ctx); cErr == nil {
+				to.Labels = append(to.Labels, &tempLabels)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Labels = append(to.Labels, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(TestAssocLabeledWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestAssocLabeled) MergeToORM(ctx context.Context, dst *TestAssocLabeledORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	if associations {
+		dst.Labels = to.Labels
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestAssocLabeled) ApplyFieldMaskToORM(ctx context.Context, dst *TestAssocLabeledORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestAssocLabeledORM) applyFieldMaskPath(from *TestAssocLabeledORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Labels":
+		if rest != "" {
+			return false
+		}
+		m.Labels = from.Labels
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestAssocLabeled returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestAssocLabeled(old, new *TestAssocLabeledORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestAssocLabeledORM{}
+	}
+	if new == nil {
+		new = &TestAssocLabeledORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	differsLabels := len(old.Labels) != len(new.Labels)
+	for i := 0; !differsLabels && i < len(old.Labels); i++ {
+		differsLabels = (old.Labels[i] == nil) != (new.Labels[i] == nil) || old.Labels[i] != nil && (old.Labels[i].Id != new.Labels[i].Id)
+	}
+	if differsLabels {
+		mask.Paths = append(mask.Paths, "Labels")
+	}
+	return mask
+}
+
+// TestAssocLabeledSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocLabeledSliceToORM(ctx context.Context, in []*TestAssocLabeled) ([]*TestAssocLabeledORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocLabeledORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestAssocLabeledORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocLabeledORMSliceToPB(ctx context.Context, in []*TestAssocLabeledORM) ([]*TestAssocLabeled, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocLabeled, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestAssocLabeled the arg will be the target, the caller the one being converted from
+
+// TestAssocLabeledBeforeToORM called before default ToORM code
+type TestAssocLabeledWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestAssocLabeledORM) error
+}
+
+// TestAssocLabeledAfterToORM called after default ToORM code
+type TestAssocLabeledWithAfterToORM interface {
+	AfterToORM(context.Context, *TestAssocLabeledORM) error
+}
+
+// TestAssocLabeledBeforeToPB called before default ToPB code
+type TestAssocLabeledWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestAssocLabeled) error
+}
+
+// TestAssocLabeledAfterToPB called after default ToPB code
+type TestAssocLabeledWithAfterToPB interface {
+	AfterToPB(context.Context, *TestAssocLabeled) error
+}
+
+type TestAssocLabelORM struct {
+	Id   string
+	Name string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestAssocLabelORM) TableName() string {
+	return "test_assoc_labels"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TestAssocLabelORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *TestAssocLabelORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := TestAssocLabelORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// TestAssocLabelORMIndexes lists the indexes declared by the gorm tags of TestAssocLabelORM
+var TestAssocLabelORMIndexes = []types.IndexDef{}
+
+// applyTestAssocLabelConditionalPreloads preloads the associations of the conditional
+// preloads of TestAssocLabel in ctx whose condition holds
+func applyTestAssocLabelConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "TestAssocLabel") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TestAssocLabel) ToORM(ctx context.Context) (TestAssocLabelORM, error) {
+	to := TestAssocLabelORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocLabelWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(TestAssocLabelWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TestAssocLabelORM) ToPB(ctx context.Context) (TestAssocLabel, error) {
+	to := TestAssocLabel{}
+	var err error
+	if prehook, ok := interface{}(m).(TestAssocLabelWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(TestAssocLabelWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *TestAssocLabel) MergeToORM(ctx context.Context, dst *TestAssocLabelORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Name = to.Name
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *TestAssocLabel) ApplyFieldMaskToORM(ctx context.Context, dst *TestAssocLabelORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TestAssocLabelORM) applyFieldMaskPath(from *TestAssocLabelORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Name":
+		if rest != "" {
+			return false
+		}
+		m.Name = from.Name
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTestAssocLabel returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTestAssocLabel(old, new *TestAssocLabelORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TestAssocLabelORM{}
+	}
+	if new == nil {
+		new = &TestAssocLabelORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Name != new.Name {
+		mask.Paths = append(mask.Paths, "Name")
+	}
+	return mask
+}
+
+// TestAssocLabelSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocLabelSliceToORM(ctx context.Context, in []*TestAssocLabel) ([]*TestAssocLabelORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocLabelORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TestAssocLabelORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TestAssocLabelORMSliceToPB(ctx context.Context, in []*TestAssocLabelORM) ([]*TestAssocLabel, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TestAssocLabel, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TestAssocLabel the arg will be the target, the caller the one being converted from
+
+// TestAssocLabelBeforeToORM called before default ToORM code
+type TestAssocLabelWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TestAssocLabelORM) error
+}
+
+// TestAssocLabelAfterToORM called after default ToORM code
+type TestAssocLabelWithAfterToORM interface {
+	AfterToORM(context.Context, *TestAssocLabelORM) error
+}
+
+// TestAssocLabelBeforeToPB called before default ToPB code
+type TestAssocLabelWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TestAssocLabel) error
+}
+
+// TestAssocLabelAfterToPB called after default ToPB code
+type TestAssocLabelWithAfterToPB interface {
+	AfterToPB(context.Context, *TestAssocLabel) error
+}
+
+type PrimaryIncludedORM struct {
+	Child *ExternalChildORM `gorm:"foreignkey:PrimaryIncludedId;association_foreignkey:Id"`
+	Id    go_uuid.UUID
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PrimaryIncludedORM) TableName() string {
+	return "primary_includeds"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *PrimaryIncludedORM) ClearAssociations() {
+	m.Child = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *PrimaryIncludedORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == go_uuid.Nil {
+		return errors.EmptyIdError
+	}
+	reloaded := PrimaryIncludedORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// PrimaryIncludedORMIndexes lists the indexes declared by the gorm tags of PrimaryIncludedORM
+var PrimaryIncludedORMIndexes = []types.IndexDef{}
+
+// PrimaryIncludedORMForeignKeys lists the foreign keys of the associations of PrimaryIncludedORM
+var PrimaryIncludedORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_external_children_primary_included_id", Table: "external_children", Columns: []string{"primary_included_id"}, References: "primary_includeds", ReferencedColumns: []string{"id"}},
+}
+
+// LoadPrimaryIncludedChildForAll loads the Child of all the parents with a single query and
+// sets them on each parent by PrimaryIncludedId, in the order of id. The parents
+// lose the Child they held, a nil parent is skipped.
+func LoadPrimaryIncludedChildForAll(ctx context.Context, db *gorm.DB, parents []*PrimaryIncludedORM) error {
+	type key struct {
+		Id go_uuid.UUID
+	}
+	byKey := make(map[key][]*PrimaryIncludedORM, len(parents))
+	loaded := make(map[*PrimaryIncludedORM]bool, len(parents))
+	var ids []go_uuid.UUID
+	idSeen := make(map[go_uuid.UUID]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Child = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*ExternalChildORM
+	if err := db.Where("primary_included_id IN (?)", ids).Order("id").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.PrimaryIncludedId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.PrimaryIncludedId}] {
+			if parent.Child == nil {
+				parent.Child = child
+			}
+		}
+	}
+	return nil
+}
+
+// WithPrimaryIncludedConditionalPreload returns a context making the Read and List
+// handlers of PrimaryIncluded preload the association name, e.g. Child, when cond holds
+// for their context
+func WithPrimaryIncludedConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "PrimaryIncluded", name, cond)
+}
+
+// applyPrimaryIncludedConditionalPreloads preloads the associations of the conditional
+// preloads of PrimaryIncluded in ctx whose condition holds
+func applyPrimaryIncludedConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "PrimaryIncluded") {
+		switch preload.Name {
+		case "Child":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *PrimaryIncluded) ToORM(ctx context.Context) (PrimaryIncludedORM, error) {
+	to := PrimaryIncludedORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PrimaryIncludedWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if m.Child != nil {
+		tempChild, err := m.Child.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Child = &tempChild
+	}
+	if posthook, ok := interface{}(m).(PrimaryIncludedWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PrimaryIncludedORM) ToPB(ctx context.Context) (PrimaryIncluded, error) {
+	to := PrimaryIncluded{}
+	var err error
+	if prehook, ok := interface{}(m).(PrimaryIncludedWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if m.Child != nil {
+		tempChild, err := m.Child.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Child = &tempChild
+	}
+	if posthook, ok := interface{}(m).(PrimaryIncludedWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *PrimaryIncluded) MergeToORM(ctx context.Context, dst *PrimaryIncludedORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if associations {
+		dst.Child = to.Child
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *PrimaryIncluded) ApplyFieldMaskToORM(ctx context.Context, dst *PrimaryIncludedORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *PrimaryIncludedORM) applyFieldMaskPath(from *PrimaryIncludedORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Child":
+		if rest == "" {
+			m.Child = from.Child
+			return true
+		}
+		nested := from.Child
+		if nested == nil {
+			nested = &ExternalChildORM{}
+		}
+		if m.Child == nil {
+			m.Child = &ExternalChildORM{}
+		}
+		return m.Child.applyFieldMaskPath(nested, rest)
+	default:
+		return false
+	}
+}
+
+// DiffPrimaryIncluded returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffPrimaryIncluded(old, new *PrimaryIncludedORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &PrimaryIncludedORM{}
+	}
+	if new == nil {
+		new = &PrimaryIncludedORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if (old.Child == nil) != (new.Child == nil) || len(DiffExternalChild(old.Child, new.Child).Paths) > 0 {
+		mask.Paths = append(mask.Paths, "Child")
+	}
+	return mask
+}
+
+// PrimaryIncludedSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func PrimaryIncludedSliceToORM(ctx context.Context, in []*PrimaryIncluded) ([]*PrimaryIncludedORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*PrimaryIncludedORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// PrimaryIncludedORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func PrimaryIncludedORMSliceToPB(ctx context.Context, in []*PrimaryIncludedORM) ([]*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*PrimaryIncluded, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type PrimaryIncluded the arg will be the target, the caller the one being converted from
+
+// PrimaryIncludedBeforeToORM called before default ToORM code
+type PrimaryIncludedWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PrimaryIncludedORM) error
+}
+
+// PrimaryIncludedAfterToORM called after default ToORM code
+type PrimaryIncludedWithAfterToORM interface {
+	AfterToORM(context.Context, *PrimaryIncludedORM) error
+}
+
+// PrimaryIncludedBeforeToPB called before default ToPB code
+type PrimaryIncludedWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PrimaryIncluded) error
+}
+
+// PrimaryIncludedAfterToPB called after default ToPB code
+type PrimaryIncludedWithAfterToPB interface {
+	AfterToPB(context.Context, *PrimaryIncluded) error
+}
+
+type LedgerEntryORM struct {
+	Amount    int64
+	CreatedAt *time.Time
+	Id        uint64
+	Memo      string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (LedgerEntryORM) TableName() string {
+	return "ledger_entries"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *LedgerEntryORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *LedgerEntryORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := LedgerEntryORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// BeforeUpdate is called by gorm before updating the row, LedgerEntry is immutable
+// and is never updated
+func (m *LedgerEntryORM) BeforeUpdate() error {
+	return errors.ImmutableError
+}
+
+// LedgerEntryORMIndexes lists the indexes declared by the gorm tags of LedgerEntryORM
+var LedgerEntryORMIndexes = []types.IndexDef{}
+
+// applyLedgerEntryConditionalPreloads preloads the associations of the conditional
+// preloads of LedgerEntry in ctx whose condition holds
+func applyLedgerEntryConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "LedgerEntry") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *LedgerEntry) ToORM(ctx context.Context) (LedgerEntryORM, error) {
+	to := LedgerEntryORM{}
+	var err error
+	if prehook, ok := interface{}(m).(LedgerEntryWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Amount = m.Amount
+	to.Memo = m.Memo
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(LedgerEntryWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *LedgerEntryORM) ToPB(ctx context.Context) (LedgerEntry, error) {
+	to := LedgerEntry{}
+	var err error
+	if prehook, ok := interface{}(m).(LedgerEntryWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Amount = m.Amount
+	to.Memo = m.Memo
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(LedgerEntryWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *LedgerEntry) MergeToORM(ctx context.Context, dst *LedgerEntryORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Amount = to.Amount
+	dst.Memo = to.Memo
+	if m.CreatedAt != nil {
+		dst.CreatedAt = to.CreatedAt
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *LedgerEntry) ApplyFieldMaskToORM(ctx context.Context, dst *LedgerEntryORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *LedgerEntryORM) applyFieldMaskPath(from *LedgerEntryORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Amount":
+		if rest != "" {
+			return false
+		}
+		m.Amount = from.Amount
+		return true
+	case "Memo":
+		if rest != "" {
+			return false
+		}
+		m.Memo = from.Memo
+		return true
+	case "CreatedAt":
+		if rest != "" {
+			return false
+		}
+		m.CreatedAt = from.CreatedAt
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffLedgerEntry returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffLedgerEntry(old, new *LedgerEntryORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &LedgerEntryORM{}
+	}
+	if new == nil {
+		new = &LedgerEntryORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Amount != new.Amount {
+		mask.Paths = append(mask.Paths, "Amount")
+	}
+	if old.Memo != new.Memo {
+		mask.Paths = append(mask.Paths, "Memo")
+	}
+	if (old.CreatedAt == nil) != (new.CreatedAt == nil) || old.CreatedAt != nil && !old.CreatedAt.Truncate(time.Microsecond).Equal(new.CreatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "CreatedAt")
+	}
+	return mask
+}
+
+// LedgerEntrySliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func LedgerEntrySliceToORM(ctx context.Context, in []*LedgerEntry) ([]*LedgerEntryORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*LedgerEntryORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// LedgerEntryORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func LedgerEntryORMSliceToPB(ctx context.Context, in []*LedgerEntryORM) ([]*LedgerEntry, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*LedgerEntry, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type LedgerEntry the arg will be the target, the caller the one being converted from
+
+// LedgerEntryBeforeToORM called before default ToORM code
+type LedgerEntryWithBeforeToORM interface {
+	BeforeToORM(context.Context, *LedgerEntryORM) error
+}
+
+// LedgerEntryAfterToORM called after default ToORM code
+type LedgerEntryWithAfterToORM interface {
+	AfterToORM(context.Context, *LedgerEntryORM) error
+}
+
+// LedgerEntryBeforeToPB called before default ToPB code
+type LedgerEntryWithBeforeToPB interface {
+	BeforeToPB(context.Context, *LedgerEntry) error
+}
+
+// LedgerEntryAfterToPB called after default ToPB code
+type LedgerEntryWithAfterToPB interface {
+	AfterToPB(context.Context, *LedgerEntry) error
+}
+
+type PostalAddressORM struct {
+	City   string
+	Street string
+	Zip    string `gorm:"column:postal_code"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PostalAddressORM) TableName() string {
+	return "postal_addresses"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *PostalAddressORM) ClearAssociations() {
+}
+
+// PostalAddressORMIndexes lists the indexes declared by the gorm tags of PostalAddressORM
+var PostalAddressORMIndexes = []types.IndexDef{}
+
+// applyPostalAddressConditionalPreloads preloads the associations of the conditional
+// preloads of PostalAddress in ctx whose condition holds
+func applyPostalAddressConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "PostalAddress") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *PostalAddress) ToORM(ctx context.Context) (PostalAddressORM, error) {
+	to := PostalAddressORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PostalAddressWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Street = m.Street
+	to.City = m.City
+	to.Zip = m.Zip
+	if posthook, ok := interface{}(m).(PostalAddressWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PostalAddressORM) ToPB(ctx context.Context) (PostalAddress, error) {
+	to := PostalAddress{}
+	var err error
+	if prehook, ok := interface{}(m).(PostalAddressWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Street = m.Street
+	to.City = m.City
+	to.Zip = m.Zip
+	if posthook, ok := interface{}(m).(PostalAddressWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *PostalAddress) MergeToORM(ctx context.Context, dst *PostalAddressORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Street = to.Street
+	dst.City = to.City
+	dst.Zip = to.Zip
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *PostalAddress) ApplyFieldMaskToORM(ctx context.Context, dst *PostalAddressORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *PostalAddressORM) applyFieldMaskPath(from *PostalAddressORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Street":
+		if rest != "" {
+			return false
+		}
+		m.Street = from.Street
+		return true
+	case "City":
+		if rest != "" {
+			return false
+		}
+		m.City = from.City
+		return true
+	case "Zip":
+		if rest != "" {
+			return false
+		}
+		m.Zip = from.Zip
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffPostalAddress returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffPostalAddress(old, new *PostalAddressORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &PostalAddressORM{}
+	}
+	if new == nil {
+		new = &PostalAddressORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Street != new.Street {
+		mask.Paths = append(mask.Paths, "Street")
+	}
+	if old.City != new.City {
+		mask.Paths = append(mask.Paths, "City")
+	}
+	if old.Zip != new.Zip {
+		mask.Paths = append(mask.Paths, "Zip")
+	}
+	return mask
+}
+
+// PostalAddressSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func PostalAddressSliceToORM(ctx context.Context, in []*PostalAddress) ([]*PostalAddressORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*PostalAddressORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// PostalAddressORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func PostalAddressORMSliceToPB(ctx context.Context, in []*PostalAddressORM) ([]*PostalAddress, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*PostalAddress, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type PostalAddress the arg will be the target, the caller the one being converted from
+
+// PostalAddressBeforeToORM called before default ToORM code
+type PostalAddressWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PostalAddressORM) error
+}
+
+// PostalAddressAfterToORM called after default ToORM code
+type PostalAddressWithAfterToORM interface {
+	AfterToORM(context.Context, *PostalAddressORM) error
+}
+
+// PostalAddressBeforeToPB called before default ToPB code
+type PostalAddressWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PostalAddress) error
+}
+
+// PostalAddressAfterToPB called after default ToPB code
+type PostalAddressWithAfterToPB interface {
+	AfterToPB(context.Context, *PostalAddress) error
+}
+
+type WarehouseORM struct {
+	Address PostalAddressORM `gorm:"embedded;embedded_prefix:address_;preload:false"`
+	Id      uint64
+	Name    string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WarehouseORM) TableName() string {
+	return "warehouses"
+}
+
+// WarehouseHistoryORM is a prior version of a WarehouseORM row, valid from ValidFrom, the
+// end of the version before it or nil for the first one, until ValidTo, when
+// Operation, "update" or "delete", replaced it
+type WarehouseHistoryORM struct {
+	HistoryID     uint64 `gorm:"primary_key"`
+	ValidFrom     *time.Time
+	ValidTo       time.Time
+	Operation     string
+	AddressCity   string `gorm:"column:address_city"`
+	AddressStreet string `gorm:"column:address_street"`
+	AddressZip    string `gorm:"column:address_postal_code"`
+	Id            uint64 `gorm:"column:id;index:idx_warehouses_history_id"`
+	Name          string `gorm:"column:name"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WarehouseHistoryORM) TableName() string {
+	return "warehouses_history"
+}
+
+// ToPB converts the version to the Warehouse it was
+func (m *WarehouseHistoryORM) ToPB(ctx context.Context) (Warehouse, error) {
+	to := WarehouseORM{}
+	to.Address.City = m.AddressCity
+	to.Address.Street = m.AddressStreet
+	to.Address.Zip = m.AddressZip
+	to.Id = m.Id
+	to.Name = m.Name
+	return to.ToPB(ctx)
+}
+
+// writeWarehouseHistory inserts the prior version of the row, valid since the end of
+// its last version, the handlers call it in the transaction of the write
+func writeWarehouseHistory(db *gorm.DB, prior *WarehouseORM, operation string) error {
+	db = db.New().Unscoped()
+	last := WarehouseHistoryORM{}
+	err := db.Where("id = ?", prior.Id).Order("valid_to DESC, history_id DESC").First(&last).Error
+	if err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	version := WarehouseHistoryORM{ValidTo: time.Now().UTC(), Operation: operation}
+	if err == nil {
+		version.ValidFrom = &last.ValidTo
+	}
+	version.AddressCity = prior.Address.City
+	version.AddressStreet = prior.Address.Street
+	version.AddressZip = prior.Address.Zip
+	version.Id = prior.Id
+	version.Name = prior.Name
+	return db.Create(&version).Error
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *WarehouseORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *WarehouseORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := WarehouseORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// WarehouseORMIndexes lists the indexes declared by the gorm tags of WarehouseORM
+var WarehouseORMIndexes = []types.IndexDef{}
+
+// applyWarehouseConditionalPreloads preloads the associations of the conditional
+// preloads of Warehouse in ctx whose condition holds
+func applyWarehouseConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Warehouse") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Warehouse) ToORM(ctx context.Context) (WarehouseORM, error) {
+	to := WarehouseORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WarehouseWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	if m.Address != nil {
+		tempAddress, err := m.Address.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Address = tempAddress
+	}
+	if posthook, ok := interface{}(m).(WarehouseWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WarehouseORM) ToPB(ctx context.Context) (Warehouse, error) {
+	to := Warehouse{}
+	var err error
+	if prehook, ok := interface{}(m).(WarehouseWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	tempAddress, err := m.Address.ToPB(ctx)
+	if err != nil {
+		return to, err
+	}
+	to.Address = &tempAddress
+	if posthook, ok := interface{}(m).(WarehouseWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Warehouse) MergeToORM(ctx context.Context, dst *WarehouseORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Name = to.Name
+	if m.Address != nil {
+		dst.Address = to.Address
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Warehouse) ApplyFieldMaskToORM(ctx context.Context, dst *WarehouseORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *WarehouseORM) applyFieldMaskPath(from *WarehouseORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Name":
+		if rest != "" {
+			return false
+		}
+		m.Name = from.Name
+		return true
+	case "Address":
+		if rest == "" {
+			m.Address = from.Address
+			return true
+		}
+		return m.Address.applyFieldMaskPath(&from.Address, rest)
+	default:
+		return false
+	}
+}
+
+// DiffWarehouse returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffWarehouse(old, new *WarehouseORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &WarehouseORM{}
+	}
+	if new == nil {
+		new = &WarehouseORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Name != new.Name {
+		mask.Paths = append(mask.Paths, "Name")
+	}
+	if len(DiffPostalAddress(&old.Address, &new.Address).Paths) > 0 {
+		mask.Paths = append(mask.Paths, "Address")
+	}
+	return mask
+}
+
+// WarehouseSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func WarehouseSliceToORM(ctx context.Context, in []*Warehouse) ([]*WarehouseORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*WarehouseORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// WarehouseORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func WarehouseORMSliceToPB(ctx context.Context, in []*WarehouseORM) ([]*Warehouse, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Warehouse, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Warehouse the arg will be the target, the caller the one being converted from
+
+// WarehouseBeforeToORM called before default ToORM code
+type WarehouseWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WarehouseORM) error
+}
+
+// WarehouseAfterToORM called after default ToORM code
+type WarehouseWithAfterToORM interface {
+	AfterToORM(context.Context, *WarehouseORM) error
+}
+
+// WarehouseBeforeToPB called before default ToPB code
+type WarehouseWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Warehouse) error
+}
+
+// WarehouseAfterToPB called after default ToPB code
+type WarehouseWithAfterToPB interface {
+	AfterToPB(context.Context, *Warehouse) error
+}
+
+type ShardedNoteORM struct {
+	Body     string
+	Id       uint64
+	TenantId string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ShardedNoteORM) TableName() string {
+	return "sharded_notes"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *ShardedNoteORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *ShardedNoteORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := ShardedNoteORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// ShardedNoteORMIndexes lists the indexes declared by the gorm tags of ShardedNoteORM
+var ShardedNoteORMIndexes = []types.IndexDef{}
+
+// applyShardedNoteConditionalPreloads preloads the associations of the conditional
+// preloads of ShardedNote in ctx whose condition holds
+func applyShardedNoteConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "ShardedNote") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ShardedNote) ToORM(ctx context.Context) (ShardedNoteORM, error) {
+	to := ShardedNoteORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ShardedNoteWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TenantId = m.TenantId
+	to.Body = m.Body
+	if posthook, ok := interface{}(m).(ShardedNoteWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ShardedNoteORM) ToPB(ctx context.Context) (ShardedNote, error) {
+	to := ShardedNote{}
+	var err error
+	if prehook, ok := interface{}(m).(ShardedNoteWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.TenantId = m.TenantId
+	to.Body = m.Body
+	if posthook, ok := interface{}(m).(ShardedNoteWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *ShardedNote) MergeToORM(ctx context.Context, dst *ShardedNoteORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.TenantId = to.TenantId
+	dst.Body = to.Body
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *ShardedNote) ApplyFieldMaskToORM(ctx context.Context, dst *ShardedNoteORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *ShardedNoteORM) applyFieldMaskPath(from *ShardedNoteORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "TenantId":
+		if rest != "" {
+			return false
+		}
+		m.TenantId = from.TenantId
+		return true
+	case "Body":
+		if rest != "" {
+			return false
+		}
+		m.Body = from.Body
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffShardedNote returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffShardedNote(old, new *ShardedNoteORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &ShardedNoteORM{}
+	}
+	if new == nil {
+		new = &ShardedNoteORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.TenantId != new.TenantId {
+		mask.Paths = append(mask.Paths, "TenantId")
+	}
+	if old.Body != new.Body {
+		mask.Paths = append(mask.Paths, "Body")
+	}
+	return mask
+}
+
+// ShardedNoteSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func ShardedNoteSliceToORM(ctx context.Context, in []*ShardedNote) ([]*ShardedNoteORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*ShardedNoteORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// ShardedNoteORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func ShardedNoteORMSliceToPB(ctx context.Context, in []*ShardedNoteORM) ([]*ShardedNote, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*ShardedNote, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ShardedNote the arg will be the target, the caller the one being converted from
+
+// ShardedNoteBeforeToORM called before default ToORM code
+type ShardedNoteWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ShardedNoteORM) error
+}
+
+// ShardedNoteAfterToORM called after default ToORM code
+type ShardedNoteWithAfterToORM interface {
+	AfterToORM(context.Context, *ShardedNoteORM) error
+}
+
+// ShardedNoteBeforeToPB called before default ToPB code
+type ShardedNoteWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ShardedNote) error
+}
+
+// ShardedNoteAfterToPB called after default ToPB code
+type ShardedNoteWithAfterToPB interface {
+	AfterToPB(context.Context, *ShardedNote) error
+}
+
+type FolderORM struct {
+	Documents []*DocumentORM `gorm:"foreignkey:FolderId;association_foreignkey:Id;preload:true" atlas:"position:Position"`
+	Id        uint64
+	Name      string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (FolderORM) TableName() string {
+	return "folders"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *FolderORM) ClearAssociations() {
+	m.Documents = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *FolderORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	db = db.Preload("Documents")
+	reloaded := FolderORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// AfterFind is called by gorm after loading the row and its associations, it
+// sets the back-references of Documents to m
+func (m *FolderORM) AfterFind() error {
+	for _, v := range m.Documents {
+		if v != nil {
+			v.Folder = m
+		}
+	}
+	return nil
+}
+
+// FolderORMIndexes lists the indexes declared by the gorm tags of FolderORM
+var FolderORMIndexes = []types.IndexDef{}
+
+// FolderORMForeignKeys lists the foreign keys of the associations of FolderORM
+var FolderORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_documents_folder_id", Table: "documents", Columns: []string{"folder_id"}, References: "folders", ReferencedColumns: []string{"id"}},
+}
+
+// LoadFolderDocumentsForAll loads the Documents of all the parents with a single query and
+// sets them on each parent by FolderId, in the order of position. The parents
+// lose the Documents they held, a nil parent is skipped.
+func LoadFolderDocumentsForAll(ctx context.Context, db *gorm.DB, parents []*FolderORM) error {
+	type key struct {
+		Id uint64
+	}
+	byKey := make(map[key][]*FolderORM, len(parents))
+	loaded := make(map[*FolderORM]bool, len(parents))
+	var ids []uint64
+	idSeen := make(map[uint64]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Documents = nil
+		k := key{parent.Id}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*DocumentORM
+	if err := db.Where("folder_id IN (?)", ids).Order("position").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.FolderId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.FolderId}] {
+			parent.Documents = append(parent.Documents, child)
+		}
+	}
+	return nil
+}
+
+// WithFolderConditionalPreload returns a context making the Read and List
+// handlers of Folder preload the association name, e.g. Documents, when cond holds
+// for their context
+func WithFolderConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "Folder", name, cond)
+}
+
+// applyFolderConditionalPreloads preloads the associations of the conditional
+// preloads of Folder in ctx whose condition holds
+func applyFolderConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Folder") {
+		switch preload.Name {
+		case "Documents":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Folder) ToORM(ctx context.Context) (FolderORM, error) {
+	to := FolderORM{}
+	var err error
+	if prehook, ok := interface{}(m).(FolderWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Documents {
+		if v != nil {
+			if tempDocuments, cErr := v.ToORM(ctx); cErr == nil {
+				to.Documents = append(to.Documents, &tempDocuments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Documents = append(to.Documents, nil)
+		}
+	}
+	for i, e := range to.Documents {
+		e.Position = int(i)
+	}
+	if posthook, ok := interface{}(m).(FolderWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *FolderORM) ToPB(ctx context.Context) (Folder, error) {
+	to := Folder{}
+	var err error
+	if prehook, ok := interface{}(m).(FolderWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	ctx = types.WithVisited(ctx, m)
+	to.Id = m.Id
+	to.Name = m.Name
+	for _, v := range m.Documents {
+		if v != nil && v.DeletedAt != nil && !types.IncludeDeleted(ctx) {
+			continue
+		}
+		if v != nil {
+			if tempDocuments, cErr := v.ToPB(ctx); cErr == nil {
+				to.Documents = append(to.Documents, &tempDocuments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Documents = append(to.Documents, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(FolderWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Folder) MergeToORM(ctx context.Context, dst *FolderORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Name = to.Name
+	if associations {
+		dst.Documents = to.Documents
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Folder) ApplyFieldMaskToORM(ctx context.Context, dst *FolderORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *FolderORM) applyFieldMaskPath(from *FolderORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Name":
+		if rest != "" {
+			return false
+		}
+		m.Name = from.Name
+		return true
+	case "Documents":
+		if rest != "" {
+			return false
+		}
+		m.Documents = from.Documents
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffFolder returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffFolder(old, new *FolderORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &FolderORM{}
+	}
+	if new == nil {
+		new = &FolderORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Name != new.Name {
+		mask.Paths = append(mask.Paths, "Name")
+	}
+	differsDocuments := len(old.Documents) != len(new.Documents)
+	for i := 0; !differsDocuments && i < len(old.Documents); i++ {
+		differsDocuments = (old.Documents[i] == nil) != (new.Documents[i] == nil) || len(DiffDocument(old.Documents[i], new.Documents[i]).Paths) > 0
+	}
+	if differsDocuments {
+		mask.Paths = append(mask.Paths, "Documents")
+	}
+	return mask
+}
+
+// FolderSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func FolderSliceToORM(ctx context.Context, in []*Folder) ([]*FolderORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*FolderORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// FolderORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func FolderORMSliceToPB(ctx context.Context, in []*FolderORM) ([]*Folder, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Folder, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Folder the arg will be the target, the caller the one being converted from
+
+// FolderBeforeToORM called before default ToORM code
+type FolderWithBeforeToORM interface {
+	BeforeToORM(context.Context, *FolderORM) error
+}
+
+// FolderAfterToORM called after default ToORM code
+type FolderWithAfterToORM interface {
+	AfterToORM(context.Context, *FolderORM) error
+}
+
+// FolderBeforeToPB called before default ToPB code
+type FolderWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Folder) error
+}
+
+// FolderAfterToPB called after default ToPB code
+type FolderWithAfterToPB interface {
+	AfterToPB(context.Context, *Folder) error
+}
+
+type DocumentORM struct {
+	DeletedAt *time.Time
+	Folder    *FolderORM `gorm:"foreignkey:FolderId;association_foreignkey:Id;save_associations:false"`
+	FolderId  *uint64
+	Id        uint64
+	Position  int
+	Title     string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (DocumentORM) TableName() string {
+	return "documents"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *DocumentORM) ClearAssociations() {
+	m.Folder = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *DocumentORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := DocumentORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// DocumentORMIndexes lists the indexes declared by the gorm tags of DocumentORM
+var DocumentORMIndexes = []types.IndexDef{}
+
+// DocumentORMForeignKeys lists the foreign keys of the associations of DocumentORM
+var DocumentORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_documents_folder_id", Table: "documents", Columns: []string{"folder_id"}, References: "folders", ReferencedColumns: []string{"id"}},
+}
+
+// WithDocumentConditionalPreload returns a context making the Read and List
+// handlers of Document preload the association name, e.g. Folder, when cond holds
+// for their context
+func WithDocumentConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "Document", name, cond)
+}
+
+// applyDocumentConditionalPreloads preloads the associations of the conditional
+// preloads of Document in ctx whose condition holds
+func applyDocumentConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Document") {
+		switch preload.Name {
+		case "Folder":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Document) ToORM(ctx context.Context) (DocumentORM, error) {
+	to := DocumentORM{}
+	var err error
+	if prehook, ok := interface{}(m).(DocumentWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	if m.Folder != nil {
+		tempFolder, err := m.Folder.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Folder = &tempFolder
+	}
+	if m.DeletedAt != nil {
+		t := m.DeletedAt.AsTime()
+		to.DeletedAt = &t
+	}
+	if posthook, ok := interface{}(m).(DocumentWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *DocumentORM) ToPB(ctx context.Context) (Document, error) {
+	to := Document{}
+	var err error
+	if prehook, ok := interface{}(m).(DocumentWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Title = m.Title
+	if m.Folder != nil && !types.Visited(ctx, m.Folder) {
+		tempFolder, err := m.Folder.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Folder = &tempFolder
+	}
+	if m.DeletedAt != nil {
+		to.DeletedAt = timestamppb.New(*m.DeletedAt)
+	}
+	if posthook, ok := interface{}(m).(DocumentWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Document) MergeToORM(ctx context.Context, dst *DocumentORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Title = to.Title
+	if m.DeletedAt != nil {
+		dst.DeletedAt = to.DeletedAt
+	}
+	if associations {
+		dst.Folder = to.Folder
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Document) ApplyFieldMaskToORM(ctx context.Context, dst *DocumentORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *DocumentORM) applyFieldMaskPath(from *DocumentORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Title":
+		if rest != "" {
+			return false
+		}
+		m.Title = from.Title
+		return true
+	case "Folder":
+		if rest == "" {
+			m.Folder = from.Folder
+			return true
+		}
+		nested := from.Folder
+		if nested == nil {
+			nested = &FolderORM{}
+		}
+		if m.Folder == nil {
+			m.Folder = &FolderORM{}
+		}
+		return m.Folder.applyFieldMaskPath(nested, rest)
+	case "DeletedAt":
+		if rest != "" {
+			return false
+		}
+		m.DeletedAt = from.DeletedAt
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffDocument returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffDocument(old, new *DocumentORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &DocumentORM{}
+	}
+	if new == nil {
+		new = &DocumentORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Title != new.Title {
+		mask.Paths = append(mask.Paths, "Title")
+	}
+	if (old.Folder == nil) != (new.Folder == nil) || old.Folder != nil && (old.Folder.Id != new.Folder.Id) {
+		mask.Paths = append(mask.Paths, "Folder")
+	}
+	if (old.DeletedAt == nil) != (new.DeletedAt == nil) || old.DeletedAt != nil && !old.DeletedAt.Truncate(time.Microsecond).Equal(new.DeletedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "DeletedAt")
+	}
+	return mask
+}
+
+// DocumentSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func DocumentSliceToORM(ctx context.Context, in []*Document) ([]*DocumentORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*DocumentORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// DocumentORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func DocumentORMSliceToPB(ctx context.Context, in []*DocumentORM) ([]*Document, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Document, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Document the arg will be the target, the caller the one being converted from
+
+// DocumentBeforeToORM called before default ToORM code
+type DocumentWithBeforeToORM interface {
+	BeforeToORM(context.Context, *DocumentORM) error
+}
+
+// DocumentAfterToORM called after default ToORM code
+type DocumentWithAfterToORM interface {
+	AfterToORM(context.Context, *DocumentORM) error
+}
+
+// DocumentBeforeToPB called before default ToPB code
+type DocumentWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Document) error
+}
+
+// DocumentAfterToPB called after default ToPB code
+type DocumentWithAfterToPB interface {
+	AfterToPB(context.Context, *Document) error
+}
+
+type ImportedRecordORM struct {
+	CreatedAt *time.Time
+	Id        uint64
+	Payload   string
+	UpdatedAt *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ImportedRecordORM) TableName() string {
+	return "imported_records"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *ImportedRecordORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *ImportedRecordORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := ImportedRecordORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// ImportedRecordORMIndexes lists the indexes declared by the gorm tags of ImportedRecordORM
+var ImportedRecordORMIndexes = []types.IndexDef{}
+
+// applyImportedRecordConditionalPreloads preloads the associations of the conditional
+// preloads of ImportedRecord in ctx whose condition holds
+func applyImportedRecordConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "ImportedRecord") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ImportedRecord) ToORM(ctx context.Context) (ImportedRecordORM, error) {
+	to := ImportedRecordORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ImportedRecordWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Payload = m.Payload
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(ImportedRecordWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ImportedRecordORM) ToPB(ctx context.Context) (ImportedRecord, error) {
+	to := ImportedRecord{}
+	var err error
+	if prehook, ok := interface{}(m).(ImportedRecordWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Payload = m.Payload
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(ImportedRecordWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *ImportedRecord) MergeToORM(ctx context.Context, dst *ImportedRecordORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Payload = to.Payload
+	if m.CreatedAt != nil {
+		dst.CreatedAt = to.CreatedAt
+	}
+	if m.UpdatedAt != nil {
+		dst.UpdatedAt = to.UpdatedAt
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *ImportedRecord) ApplyFieldMaskToORM(ctx context.Context, dst *ImportedRecordORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *ImportedRecordORM) applyFieldMaskPath(from *ImportedRecordORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Payload":
+		if rest != "" {
+			return false
+		}
+		m.Payload = from.Payload
+		return true
+	case "CreatedAt":
+		if rest != "" {
+			return false
+		}
+		m.CreatedAt = from.CreatedAt
+		return true
+	case "UpdatedAt":
+		if rest != "" {
+			return false
+		}
+		m.UpdatedAt = from.UpdatedAt
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffImportedRecord returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffImportedRecord(old, new *ImportedRecordORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &ImportedRecordORM{}
+	}
+	if new == nil {
+		new = &ImportedRecordORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Payload != new.Payload {
+		mask.Paths = append(mask.Paths, "Payload")
+	}
+	if (old.CreatedAt == nil) != (new.CreatedAt == nil) || old.CreatedAt != nil && !old.CreatedAt.Truncate(time.Microsecond).Equal(new.CreatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "CreatedAt")
+	}
+	if (old.UpdatedAt == nil) != (new.UpdatedAt == nil) || old.UpdatedAt != nil && !old.UpdatedAt.Truncate(time.Microsecond).Equal(new.UpdatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "UpdatedAt")
+	}
+	return mask
+}
+
+// ImportedRecordSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func ImportedRecordSliceToORM(ctx context.Context, in []*ImportedRecord) ([]*ImportedRecordORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*ImportedRecordORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// ImportedRecordORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func ImportedRecordORMSliceToPB(ctx context.Context, in []*ImportedRecordORM) ([]*ImportedRecord, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*ImportedRecord, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ImportedRecord the arg will be the target, the caller the one being converted from
+
+// ImportedRecordBeforeToORM called before default ToORM code
+type ImportedRecordWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ImportedRecordORM) error
+}
+
+// ImportedRecordAfterToORM called after default ToORM code
+type ImportedRecordWithAfterToORM interface {
+	AfterToORM(context.Context, *ImportedRecordORM) error
+}
+
+// ImportedRecordBeforeToPB called before default ToPB code
+type ImportedRecordWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ImportedRecord) error
+}
+
+// ImportedRecordAfterToPB called after default ToPB code
+type ImportedRecordWithAfterToPB interface {
+	AfterToPB(context.Context, *ImportedRecord) error
+}
+
+// TestTypesStatusORM is a row of the review_statuses lookup table of the values of
+// TestTypesStatus
+type TestTypesStatusORM struct {
+	Id   int32  `gorm:"primary_key;auto_increment:false"`
+	Name string `gorm:"not null"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TestTypesStatusORM) TableName() string {
+	return "review_statuses"
+}
+
+// TestTypesStatusORMRows are the values of TestTypesStatus, an alias keeps the first name
+var TestTypesStatusORMRows = []TestTypesStatusORM{
+	{Id: 0, Name: "UNKNOWN"},
+	{Id: 1, Name: "GOOD"},
+	{Id: 2, Name: "BAD"},
+}
+
+// SeedTestTypesStatusORM writes the rows of TestTypesStatusORMRows to review_statuses,
+// after the migration of the table, it inserts the missing values and
+// renames the others
+func SeedTestTypesStatusORM(db *gorm.DB) error {
+	for _, row := range TestTypesStatusORMRows {
+		var count int
+		if err := db.Model(&TestTypesStatusORM{}).Where("id = ?", row.Id).Count(&count).Error; err != nil {
+			return err
+		}
+		var err error
+		if count == 0 {
+			// a create would leave out the zero id
+			err = db.Exec("INSERT INTO review_statuses (id, name) VALUES (?, ?)", row.Id, row.Name).Error
+		} else {
+			err = db.Model(&TestTypesStatusORM{}).Where("id = ?", row.Id).Update("name", row.Name).Error
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ShippingAddressComposite is a ShippingAddress stored as a value of
+// the postgres composite type shipping_address_t
+type ShippingAddressComposite struct {
+	Street string
+	City   string
+	Zip    int32
+}
+
+// ShippingAddressCompositeTypeSQL creates shipping_address_t, the attributes
+// are the fields of ShippingAddress in order
+const ShippingAddressCompositeTypeSQL = "CREATE TYPE shipping_address_t AS (street text, city text, zip integer)"
+
+// Value implements the Value part of the sql scannable interface, the
+// value is written as a row literal
+func (c ShippingAddressComposite) Value() (driver.Value, error) {
+	return types.CompositeValue(c.Street, c.City, c.Zip)
+}
+
+// Scan implements the scan part of the sql scannable interface, a NULL
+// attribute is read as the zero value
+func (c *ShippingAddressComposite) Scan(value interface{}) error {
+	*c = ShippingAddressComposite{}
+	return types.ScanComposite(value, &c.Street, &c.City, &c.Zip)
+}
+
+// CreateShippingAddressCompositeType creates shipping_address_t unless it exists,
+// it runs ahead of the AutoMigrate of the tables storing ShippingAddressComposite
+func CreateShippingAddressCompositeType(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	var exists bool
+	if err := db.Raw("SELECT to_regtype(?) IS NOT NULL", "shipping_address_t").Row().Scan(&exists); err != nil || exists {
+		return err
+	}
+	return db.Exec(ShippingAddressCompositeTypeSQL).Error
+}
+
+// DemoTypesColumnRenames lists the renamed_from columns of the ORM types defined in
+// demo_types.proto, in the order they are renamed
+var DemoTypesColumnRenames = []types.ColumnRename{
+	{Table: "smorgasbord", From: "maybe_string", To: "optional_string"},
+}
+
+// RenameDemoTypesColumns renames the columns of DemoTypesColumnRenames, it runs ahead
+// of AutoMigrate, which would add the new column and leave the data in the
+// old one. A rename is skipped unless the old column exists and the new one
+// does not, so it runs on every migration.
+func RenameDemoTypesColumns(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	for _, rename := range DemoTypesColumnRenames {
+		if !db.Dialect().HasColumn(rename.Table, rename.From) || db.Dialect().HasColumn(rename.Table, rename.To) {
+			continue
+		}
+		if err := db.Exec(rename.SQL()).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DemoTypesColumnComments lists the comments of the columns of the ORM types
+// defined in demo_types.proto
+var DemoTypesColumnComments = []types.ColumnComment{
+	{Table: "type_with_ids", Column: "tag_size_test", Comment: "PII - do not log; internal, only"},
+}
+
+// CommentDemoTypesColumns sets the comments of DemoTypesColumnComments, it runs
+// after AutoMigrate, which creates the columns without them. Setting a
+// comment again replaces it, so it runs on every migration.
+func CommentDemoTypesColumns(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	for _, comment := range DemoTypesColumnComments {
+		if err := db.Exec(comment.SQL()).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DemoTypesChecks lists the check constraints of the ORM types defined in
+// demo_types.proto
+var DemoTypesChecks = []types.CheckDef{
+	{Name: "chk_type_with_ids_slug", Table: "type_with_ids", Expression: "slug NOT IN ('new', 'edit') OR state = 'archived'"},
+	{Name: "chk_type_with_ids_tag_test", Table: "type_with_ids", Expression: "tag_test >= 0"},
+}
+
+// AddDemoTypesChecks adds the constraints of DemoTypesChecks, it runs after
+// AutoMigrate, which creates the tables without them. A constraint is
+// skipped if its table has it already, so it runs on every migration.
+func AddDemoTypesChecks(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	for _, check := range DemoTypesChecks {
+		var count int
+		if err := db.Raw("SELECT count(*) FROM pg_constraint WHERE conrelid = to_regclass(?) AND conname = ?", check.Table, check.Name).Row().Scan(&count); err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		if err := db.Exec(check.SQL()).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DemoTypesSchemaHash identifies the schema of the ORM types defined in demo_types.proto
+const DemoTypesSchemaHash = "5b78af76a34d20f2468eb5d42779589e5c11c17a6f4f5033e0b90e18e766f41b"
+
+// RegisterDemoTypesCallbacks registers the GORM callbacks of the ORM types defined
+// in demo_types.proto, registering them again replaces the previous ones
+func RegisterDemoTypesCallbacks(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	returning.Register(db)
+	db.Callback().Update().Before("gorm:assign_updating_attributes").Register("protoc-gen-gorm:example:demo_types:read_only", func(scope *gorm.Scope) {
+		switch scope.Value.(type) {
+		case *TypeWithIDORM, TypeWithIDORM:
+			scope.Search.Omit("created_by", "external_id", "revision")
+		}
+	})
+	timestamps.Skip(db, "protoc-gen-gorm:example:demo_types:no_auto_timestamps", func(value interface{}) bool {
+		switch value.(type) {
+		case *ImportedRecordORM, ImportedRecordORM:
+			return true
+		}
+		return false
+	})
+	return nil
+}
+
+// DefaultCreateTestTypes executes a basic gorm create call
+func DefaultCreateTestTypes(ctx context.Context, in *TestTypes, db *gorm.DB) (*TestTypes, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTypesORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTypesORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTestTypes inserts the objects with a COPY into the smorgasbord table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestTypesSet
+// leaves them to the DB.
+func DefaultCopyFromTestTypes(ctx context.Context, in []*TestTypes, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	now := time.Now()
+	rows := make([]TestTypesORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.JsonField == nil {
+			return 0, fmt.Errorf("COPY into smorgasbord takes no default of json_field, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into smorgasbord needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("smorgasbord", "a_nested_object_type_with_id_id", "array", "array2", "becomes_int", "created_at", "json_field", "nullable_uuid", "optional_count", "optional_string", "things_type_with_id_id", "time_only", "type_with_id_id", "uuid"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.ANestedObjectTypeWithIDId, row.Array, row.Array2, row.BecomesInt, row.CreatedAt, row.JsonField, row.NullableUuid, row.OptionalCount, row.OptionalString, row.ThingsTypeWithIDId, row.TimeOnly, row.TypeWithIdId, row.Uuid); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestTypesORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestTypesSet,
+// postgres takes 65535 bind parameters in a statement and a row has 13
+const TestTypesORMMaxBatchSize = 5041
+
+// DefaultCreateTestTypesSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestTypesORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestTypesSet(ctx context.Context, in []*TestTypes, db *gorm.DB, batchSize int) ([]*TestTypes, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestTypesORMMaxBatchSize {
+		batchSize = TestTypesORMMaxBatchSize
+	}
+	now := time.Now()
+	rows := make([]TestTypesORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestTypesORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*13)
+			for _, row := range batch {
+				values := []interface{}{row.ANestedObjectTypeWithIDId, row.Array, row.Array2, row.BecomesInt, row.CreatedAt, row.JsonField, row.NullableUuid, row.OptionalCount, row.OptionalString, row.ThingsTypeWithIDId, row.TimeOnly, row.TypeWithIdId, row.Uuid}
+				if row.JsonField == nil {
+					values[5] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestTypesORM
+			if err := tx.Raw(`INSERT INTO "smorgasbord" ("a_nested_object_type_with_id_id", "array", "array2", "becomes_int", "created_at", "json_field", "nullable_uuid", "optional_count", "optional_string", "things_type_with_id_id", "time_only", "type_with_id_id", "uuid") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestTypesORMSliceToPB(ctx, created)
+}
+
+// DefaultApplyFieldMaskTestTypes patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, patcher *TestTypes, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTypes, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedOptionalString bool
+	var updatedNothingness bool
+	var updatedCreatedAt bool
+	var updatedJsonField bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"ApiOnlyString" {
+			patchee.ApiOnlyString = patcher.ApiOnlyString
+			continue
+		}
+		if f == prefix+"Numbers" {
+			patchee.Numbers = patcher.Numbers
+			continue
+		}
+		if !updatedOptionalString && strings.HasPrefix(f, prefix+"OptionalString.") {
+			if patcher.OptionalString == nil {
+				patchee.OptionalString = nil
+				continue
+			}
+			if patchee.OptionalString == nil {
+				patchee.OptionalString = &wrapperspb.StringValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"OptionalString."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.OptionalString, patchee.OptionalString, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"OptionalString" {
+			updatedOptionalString = true
+			patchee.OptionalString = patcher.OptionalString
+			continue
+		}
+		if f == prefix+"BecomesInt" {
+			patchee.BecomesInt = patcher.BecomesInt
+			continue
+		}
+		if !updatedNothingness && strings.HasPrefix(f, prefix+"Nothingness.") {
+			if patcher.Nothingness == nil {
+				patchee.Nothingness = nil
+				continue
+			}
+			if patchee.Nothingness == nil {
+				patchee.Nothingness = &emptypb.Empty{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Nothingness."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Nothingness, patchee.Nothingness, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Nothingness" {
+			updatedNothingness = true
+			patchee.Nothingness = patcher.Nothingness
+			continue
+		}
+		if f == prefix+"Uuid" {
+			patchee.Uuid = patcher.Uuid
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if f == prefix+"TypeWithIdId" {
+			patchee.TypeWithIdId = patcher.TypeWithIdId
+			continue
+		}
+		if !updatedJsonField && strings.HasPrefix(f, prefix+"JsonField") {
+			patchee.JsonField = patcher.JsonField
+			updatedJsonField = true
+			continue
+		}
+		if f == prefix+"NullableUuid" {
+			patchee.NullableUuid = patcher.NullableUuid
+			continue
+		}
+		if f == prefix+"TimeOnly" {
+			patchee.TimeOnly = patcher.TimeOnly
+			continue
+		}
+		if f == prefix+"OptionalCount" {
+			patchee.OptionalCount = patcher.OptionalCount
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestTypes executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestTypes(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestTypes, error) {
+	in := TestTypes{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTypesORM{}, &TestTypes{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestTypesConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	ormResponse := []TestTypesORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestTypes{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestTypesORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTypesORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTypesORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTypesORM) error
+}
+
+// DefaultExplainListTestTypes returns the SELECT DefaultListTestTypes would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestTypes(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestTypes{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTypesORM{}, &TestTypes{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestTypesConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return explain.SQL(db, &TestTypesORM{})
+}
+
+// TestTypesORMSelectable lists the columns accepted by DefaultSelectTestTypes
+var TestTypesORMSelectable = map[string]struct{}{
+	"a_nested_object_type_with_id_id": {},
+	"array":                           {},
+	"array2":                          {},
+	"becomes_int":                     {},
+	"created_at":                      {},
+	"json_field":                      {},
+	"nullable_uuid":                   {},
+	"optional_count":                  {},
+	"optional_string":                 {},
+	"things_type_with_id_id":          {},
+	"time_only":                       {},
+	"type_with_id_id":                 {},
+	"uuid":                            {},
+}
+
+// DefaultSelectTestTypes runs the query of DefaultListTestTypes for the columns only, which
+// must be in TestTypesORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestTypes(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestTypesORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "smorgasbord."+column)
+	}
+	in := TestTypes{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTypesORM{}, &TestTypes{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestTypesConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return db.Model(&TestTypesORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTypeWithID executes a basic gorm create call
+func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return nil, err
+	}
+	ormObj.CreatedBy = actor
+	ormObj.UpdatedBy = actor
+	if ormObj.CorrelationId == "" {
+		ormObj.CorrelationId = types.NewUUID()
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, referencesTxn, err := txn.Begin(ctx, db, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer referencesTxn.Rollback()
+	if err = ormObj.ValidateReferences(ctx, db); err != nil {
+		return nil, err
+	}
+	omitted := []string{"revision"}
+	if ormObj.Priority == 0 {
+		omitted = append(omitted, "priority")
+	}
+	written := returning.Set(db).Omit(omitted...).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if !returning.Returned(written) {
+		if err = ormObj.ReloadComputed(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = referencesTxn.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTypeWithID inserts the objects with a COPY into the type_with_ids table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTypeWithIDSet
+// leaves them to the DB.
+func DefaultCopyFromTypeWithID(ctx context.Context, in []*TypeWithID, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	now := time.Now()
+	rows := make([]TypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if row.RegisteredAt == nil {
+			row.RegisteredAt = &now
+		}
+		if row.CorrelationId == "" {
+			row.CorrelationId = types.NewUUID()
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Priority == 0 {
+			return 0, fmt.Errorf("COPY into type_with_ids takes no default of priority, which is blank in object %d", i)
+		}
+		if row.RegisteredAt == nil {
+			return 0, fmt.Errorf("COPY into type_with_ids takes no default of registered_at, which is blank in object %d", i)
+		}
+		if row.SeenAt == nil {
+			return 0, fmt.Errorf("COPY into type_with_ids takes no default of seen_at, which is blank in object %d", i)
+		}
+		if row.State == "" {
+			return 0, fmt.Errorf("COPY into type_with_ids takes no default of state, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into type_with_ids needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("type_with_ids", "active", "address", "correlation_id", "created_by", "currency", "deleted_at", "deleted_by", "details", "display_name", "double_field", "email", "external_id", "float_field", "int_point_id", "ip_addr", "location", "observed_at", "observed_at_nanos", "past_statuses", "price", "priority", "registered_at", "retry_delay", "review_status", "seen_at", "ship_to", "signature", "slug", "start_at", "start_tz", "state", "status", "tag_size_test", "tag_test", "time_only", "timeout", "updated_by", "user_id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Active, row.Address, row.CorrelationId, row.CreatedBy, row.Currency, row.DeletedAt, row.DeletedBy, row.Details, row.DisplayName, row.DoubleField, row.Email, row.ExternalId, row.FloatField, row.IntPointId, row.Ip, row.Location, row.ObservedAt, row.ObservedAtNanos, row.PastStatuses, row.Price, row.Priority, row.RegisteredAt, row.RetryDelay, row.ReviewStatus, row.SeenAt, row.ShipTo, row.Signature, row.Slug, row.StartAt, row.StartTz, row.State, row.Status, row.TagSizeTest, row.TagTest, row.TimeOnly, row.Timeout, row.UpdatedBy, row.UserId); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TypeWithIDORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTypeWithIDSet,
+// postgres takes 65535 bind parameters in a statement and a row has 38
+const TypeWithIDORMMaxBatchSize = 1724
+
+// DefaultCreateTypeWithIDSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TypeWithIDORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB, batchSize int) ([]*TypeWithID, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TypeWithIDORMMaxBatchSize {
+		batchSize = TypeWithIDORMMaxBatchSize
+	}
+	now := time.Now()
+	rows := make([]TypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row.RegisteredAt == nil {
+			row.RegisteredAt = &now
+		}
+		if row.CorrelationId == "" {
+			row.CorrelationId = types.NewUUID()
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TypeWithIDORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*38)
+			for _, row := range batch {
+				values := []interface{}{row.Active, row.Address, row.CorrelationId, row.CreatedBy, row.Currency, row.DeletedAt, row.DeletedBy, row.Details, row.DisplayName, row.DoubleField, row.Email, row.ExternalId, row.FloatField, row.IntPointId, row.Ip, row.Location, row.ObservedAt, row.ObservedAtNanos, row.PastStatuses, row.Price, row.Priority, row.RegisteredAt, row.RetryDelay, row.ReviewStatus, row.SeenAt, row.ShipTo, row.Signature, row.Slug, row.StartAt, row.StartTz, row.State, row.Status, row.TagSizeTest, row.TagTest, row.TimeOnly, row.Timeout, row.UpdatedBy, row.UserId}
+				if row.Priority == 0 {
+					values[20] = types.Default
+				}
+				if row.RegisteredAt == nil {
+					values[21] = types.Default
+				}
+				if row.SeenAt == nil {
+					values[24] = types.Default
+				}
+				if row.State == "" {
+					values[30] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TypeWithIDORM
+			if err := tx.Raw(`INSERT INTO "type_with_ids" ("active", "address", "correlation_id", "created_by", "currency", "deleted_at", "deleted_by", "details", "display_name", "double_field", "email", "external_id", "float_field", "int_point_id", "ip_addr", "location", "observed_at", "observed_at_nanos", "past_statuses", "price", "priority", "registered_at", "retry_delay", "review_status", "seen_at", "ship_to", "signature", "slug", "start_at", "start_tz", "state", "status", "tag_size_test", "tag_test", "time_only", "timeout", "updated_by", "user_id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TypeWithIDORMSliceToPB(ctx, created)
+}
+
+func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TypeWithIDORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TypeWithIDORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TypeWithIDORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadTypeWithIDForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTypeWithIDForUpdate(ctx context.Context, in *TypeWithID, db *gorm.DB, wait types.LockWait) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TypeWithIDORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) error {
+	return defaultDeleteTypeWithID(ctx, in, db, nil)
+}
+
+// DefaultDeleteTypeWithIDWithResult is DefaultDeleteTypeWithID reporting the affected rows,
+// no affected rows means no TypeWithID matched
+func DefaultDeleteTypeWithIDWithResult(ctx context.Context, in *TypeWithID, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTypeWithID(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deletedBy, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return err
+	}
+	deleted := db.Model(&TypeWithIDORM{}).Where(&ormObj).UpdateColumns(map[string]interface{}{
+		"deleted_at": gorm.NowFunc(),
+		"deleted_by": deletedBy,
+	})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TypeWithIDORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint32{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	deletedBy, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return err
+	}
+	deleted := db.Model(&TypeWithIDORM{}).Where("id in (?)", keys).UpdateColumns(map[string]interface{}{
+		"deleted_at": gorm.NowFunc(),
+		"deleted_by": deletedBy,
+	})
+	err = deleted.Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TypeWithIDORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TypeWithID, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TypeWithID, *gorm.DB) error
+}
+
+// DefaultRestoreTypeWithID undoes the soft delete of the TypeWithID, clearing the deleted_at
+// and deleted_by columns, a missing row is gorm.ErrRecordNotFound
+func DefaultRestoreTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	restored := db.Unscoped().Model(&TypeWithIDORM{}).Where(&TypeWithIDORM{Id: ormObj.Id}).UpdateColumns(map[string]interface{}{
+		"deleted_at": nil,
+		"deleted_by": nil,
+	})
+	if err = restored.Error; err != nil {
+		return err
+	}
+	if restored.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DefaultPurgeTypeWithID permanently deletes the TypeWithID, soft deleted or not, together
+// with the rows of its has-one and has-many children, it is not served by the default server
+func DefaultPurgeTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if err = db.Transaction(func(tx *gorm.DB) error {
+		row := TypeWithIDORM{}
+		if err := tx.Unscoped().Where(&TypeWithIDORM{Id: ormObj.Id}).First(&row).Error; err != nil {
+			return err
+		}
+		filterANestedObject := TestTypesORM{}
+		filterANestedObject.ANestedObjectTypeWithIDId = new(uint32)
+		*filterANestedObject.ANestedObjectTypeWithIDId = row.Id
+		if err := tx.Unscoped().Where(filterANestedObject).Delete(TestTypesORM{}).Error; err != nil {
+			return err
+		}
+		filterThings := TestTypesORM{}
+		filterThings.ThingsTypeWithIDId = new(uint32)
+		*filterThings.ThingsTypeWithIDId = row.Id
+		if err := tx.Unscoped().Where(filterThings).Delete(TestTypesORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&row).Error
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultStrictUpdateTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+	return defaultStrictUpdateTypeWithID(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateTypeWithIDWithResult is DefaultStrictUpdateTypeWithID reporting the affected rows
+// and whether the TypeWithID existed before the update
+func DefaultStrictUpdateTypeWithIDWithResult(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTypeWithID(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB, result *types.WriteResult) (*TypeWithID, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTypeWithID")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TypeWithIDORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if count > 0 && (lockedRow.ExternalId != ormObj.ExternalId) {
+		return nil, fmt.Errorf("%w: external_id cannot change after create", errors.ImmutableError)
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterANestedObject := TestTypesORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterANestedObject.ANestedObjectTypeWithIDId = new(uint32)
+	*filterANestedObject.ANestedObjectTypeWithIDId = ormObj.Id
+	if err = db.Where(filterANestedObject).Delete(TestTypesORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterThings := TestTypesORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterThings.ThingsTypeWithIDId = new(uint32)
+	*filterThings.ThingsTypeWithIDId = ormObj.Id
+	if err = db.Where(filterThings).Delete(TestTypesORM{}).Error; err != nil {
+		return nil, err
+	}
+	actor, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return nil, err
+	}
+	ormObj.UpdatedBy = actor
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, referencesTxn, err := txn.Begin(ctx, db, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer referencesTxn.Rollback()
+	if err = ormObj.ValidateReferences(ctx, db); err != nil {
+		return nil, err
+	}
+	saved := returning.Set(db).Omit("created_by", "external_id", "revision").Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if !returning.Returned(saved) {
+		if err = ormObj.ReloadComputed(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = referencesTxn.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTypeWithID executes a basic gorm update call with patch behavior
+func DefaultPatchTypeWithID(ctx context.Context, in *TypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*TypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TypeWithID
+	var err error
+	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTypeWithID(ctx, &TypeWithID{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTypeWithID(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TypeWithIDWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TypeWithIDWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTypeWithID executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTypeWithID(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TypeWithID, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TypeWithID, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTypeWithID(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateTypeWithIDByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, DeletedAt and by-fields cannot be in updateMask.
+func DefaultUpdateTypeWithIDByIds(ctx context.Context, ids []uint32, in *TypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Ip":
+			columns["ip_addr"] = ormObj.Ip
+		case "Address":
+			columns["address"] = ormObj.Address
+		case "TagTest":
+			columns["tag_test"] = ormObj.TagTest
+		case "TagSizeTest":
+			columns["tag_size_test"] = ormObj.TagSizeTest
+		case "FloatField":
+			columns["float_field"] = ormObj.FloatField
+		case "DoubleField":
+			columns["double_field"] = ormObj.DoubleField
+		case "TimeOnly":
+			columns["time_only"] = ormObj.TimeOnly
+		case "Status":
+			columns["status"] = ormObj.Status
+		case "State":
+			columns["state"] = ormObj.State
+		case "SeenAt":
+			columns["seen_at"] = ormObj.SeenAt
+		case "Active":
+			columns["active"] = ormObj.Active
+		case "Slug":
+			columns["slug"] = ormObj.Slug
+		case "Timeout":
+			columns["timeout"] = ormObj.Timeout
+		case "RetryDelay":
+			columns["retry_delay"] = ormObj.RetryDelay
+		case "ObservedAt":
+			columns["observed_at"] = ormObj.ObservedAt
+		case "Details":
+			columns["details"] = ormObj.Details
+		case "RegisteredAt":
+			columns["registered_at"] = ormObj.RegisteredAt
+		case "ReviewStatus":
+			columns["review_status"] = ormObj.ReviewStatus
+		case "Location":
+			columns["location"] = ormObj.Location
+		case "ShipTo":
+			columns["ship_to"] = ormObj.ShipTo
+		case "DisplayName":
+			columns["display_name"] = ormObj.DisplayName
+		case "StartAt":
+			columns["start_at"] = ormObj.StartAt
+		case "StartTz":
+			columns["start_tz"] = ormObj.StartTz
+		case "Priority":
+			columns["priority"] = ormObj.Priority
+		case "Price":
+			columns["price"] = ormObj.Price
+		case "Currency":
+			columns["currency"] = ormObj.Currency
+		case "Email":
+			columns["email"] = ormObj.Email
+		case "PastStatuses":
+			columns["past_statuses"] = ormObj.PastStatuses
+		case "Signature":
+			columns["signature"] = ormObj.Signature
+		case "CorrelationId":
+			columns["correlation_id"] = ormObj.CorrelationId
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	actor, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return 0, err
+	}
+	columns["updated_by"] = actor
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&TypeWithIDORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskTypeWithID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, patcher *TypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TypeWithID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedANestedObject bool
+	var updatedPoint bool
+	var updatedUser bool
+	var updatedSyntheticField bool
+	var updatedFloatField bool
+	var updatedDoubleField bool
+	var updatedDeletedAt bool
+	var updatedSeenAt bool
+	var updatedTimeout bool
+	var updatedRetryDelay bool
+	var updatedObservedAt bool
+	var updatedDetails bool
+	var updatedRegisteredAt bool
+	var updatedLocation bool
+	var updatedShipTo bool
+	var updatedStartAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Ip" {
+			patchee.Ip = patcher.Ip
+			continue
+		}
+		if f == prefix+"Things" {
+			patchee.Things = patcher.Things
+			continue
+		}
+		if !updatedANestedObject && strings.HasPrefix(f, prefix+"ANestedObject.") {
+			updatedANestedObject = true
+			if patcher.ANestedObject == nil {
+				patchee.ANestedObject = nil
+				continue
+			}
+			if patchee.ANestedObject == nil {
+				patchee.ANestedObject = &TestTypes{}
+			}
+			if o, err := DefaultApplyFieldMaskTestTypes(ctx, patchee.ANestedObject, patcher.ANestedObject, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ANestedObject.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.ANestedObject = o
+			}
+			continue
+		}
+		if f == prefix+"ANestedObject" {
+			updatedANestedObject = true
+			patchee.ANestedObject = patcher.ANestedObject
+			continue
+		}
+		if !updatedPoint && strings.HasPrefix(f, prefix+"Point.") {
+			updatedPoint = true
+			if patcher.Point == nil {
+				patchee.Point = nil
+				continue
+			}
+			if patchee.Point == nil {
+				patchee.Point = &IntPoint{}
+			}
+			if o, err := DefaultApplyFieldMaskIntPoint(ctx, patchee.Point, patcher.Point, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Point.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Point = o
+			}
+			continue
+		}
+		if f == prefix+"Point" {
+			updatedPoint = true
+			patchee.Point = patcher.Point
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &user.User{}
+			}
+			if o, err := user.DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"Address" {
+			patchee.Address = patcher.Address
+			continue
+		}
+		if f == prefix+"MultiaccountTypeIds" {
+			patchee.MultiaccountTypeIds = patcher.MultiaccountTypeIds
+			continue
+		}
+		if !updatedSyntheticField && strings.HasPrefix(f, prefix+"SyntheticField.") {
+			if patcher.SyntheticField == nil {
+				patchee.SyntheticField = nil
+				continue
+			}
+			if patchee.SyntheticField == nil {
+				patchee.SyntheticField = &APIOnlyType{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"SyntheticField."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.SyntheticField, patchee.SyntheticField, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"SyntheticField" {
+			updatedSyntheticField = true
+			patchee.SyntheticField = patcher.SyntheticField
+			continue
+		}
+		if f == prefix+"TagTest" {
+			patchee.TagTest = patcher.TagTest
+			continue
+		}
+		if f == prefix+"TagSizeTest" {
+			patchee.TagSizeTest = patcher.TagSizeTest
+			continue
+		}
+		if !updatedFloatField && strings.HasPrefix(f, prefix+"FloatField.") {
+			if patcher.FloatField == nil {
+				patchee.FloatField = nil
+				continue
+			}
+			if patchee.FloatField == nil {
+				patchee.FloatField = &wrapperspb.FloatValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"FloatField."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.FloatField, patchee.FloatField, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"FloatField" {
+			updatedFloatField = true
+			patchee.FloatField = patcher.FloatField
+			continue
+		}
+		if !updatedDoubleField && strings.HasPrefix(f, prefix+"DoubleField.") {
+			if patcher.DoubleField == nil {
+				patchee.DoubleField = nil
+				continue
+			}
+			if patchee.DoubleField == nil {
+				patchee.DoubleField = &wrapperspb.DoubleValue{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DoubleField."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DoubleField, patchee.DoubleField, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"DoubleField" {
+			updatedDoubleField = true
+			patchee.DoubleField = patcher.DoubleField
+			continue
+		}
+		if f == prefix+"TimeOnly" {
+			patchee.TimeOnly = patcher.TimeOnly
+			continue
+		}
+		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
+			if patcher.DeletedAt == nil {
+				patchee.DeletedAt = nil
+				continue
+			}
+			if patchee.DeletedAt == nil {
+				patchee.DeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"DeletedAt" {
+			updatedDeletedAt = true
+			patchee.DeletedAt = patcher.DeletedAt
+			continue
+		}
+		if f == prefix+"CreatedBy" {
+			patchee.CreatedBy = patcher.CreatedBy
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if f == prefix+"State" {
+			patchee.State = patcher.State
+			continue
+		}
+		if !updatedSeenAt && strings.HasPrefix(f, prefix+"SeenAt.") {
+			if patcher.SeenAt == nil {
+				patchee.SeenAt = nil
+				continue
+			}
+			if patchee.SeenAt == nil {
+				patchee.SeenAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"SeenAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.SeenAt, patchee.SeenAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"SeenAt" {
+			updatedSeenAt = true
+			patchee.SeenAt = patcher.SeenAt
+			continue
+		}
+		if f == prefix+"Active" {
+			patchee.Active = patcher.Active
+			continue
+		}
+		if f == prefix+"Slug" {
+			patchee.Slug = patcher.Slug
+			continue
+		}
+		if !updatedTimeout && strings.HasPrefix(f, prefix+"Timeout.") {
+			if patcher.Timeout == nil {
+				patchee.Timeout = nil
+				continue
+			}
+			if patchee.Timeout == nil {
+				patchee.Timeout = &durationpb.Duration{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Timeout."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Timeout, patchee.Timeout, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Timeout" {
+			updatedTimeout = true
+			patchee.Timeout = patcher.Timeout
+			continue
+		}
+		if !updatedRetryDelay && strings.HasPrefix(f, prefix+"RetryDelay.") {
+			if patcher.RetryDelay == nil {
+				patchee.RetryDelay = nil
+				continue
+			}
+			if patchee.RetryDelay == nil {
+				patchee.RetryDelay = &durationpb.Duration{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RetryDelay."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RetryDelay, patchee.RetryDelay, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RetryDelay" {
+			updatedRetryDelay = true
+			patchee.RetryDelay = patcher.RetryDelay
+			continue
+		}
+		if !updatedObservedAt && strings.HasPrefix(f, prefix+"ObservedAt.") {
+			if patcher.ObservedAt == nil {
+				patchee.ObservedAt = nil
+				continue
+			}
+			if patchee.ObservedAt == nil {
+				patchee.ObservedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ObservedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ObservedAt, patchee.ObservedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ObservedAt" {
+			updatedObservedAt = true
+			patchee.ObservedAt = patcher.ObservedAt
+			continue
+		}
+		if !updatedDetails && strings.HasPrefix(f, prefix+"Details.") {
+			if patcher.Details == nil {
+				patchee.Details = nil
+				continue
+			}
+			if patchee.Details == nil {
+				patchee.Details = &anypb.Any{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Details."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Details, patchee.Details, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Details" {
+			updatedDetails = true
+			patchee.Details = patcher.Details
+			continue
+		}
+		if !updatedRegisteredAt && strings.HasPrefix(f, prefix+"RegisteredAt.") {
+			if patcher.RegisteredAt == nil {
+				patchee.RegisteredAt = nil
+				continue
+			}
+			if patchee.RegisteredAt == nil {
+				patchee.RegisteredAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RegisteredAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RegisteredAt, patchee.RegisteredAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RegisteredAt" {
+			updatedRegisteredAt = true
+			patchee.RegisteredAt = patcher.RegisteredAt
+			continue
+		}
+		if f == prefix+"ExternalId" {
+			patchee.ExternalId = patcher.ExternalId
+			continue
+		}
+		if f == prefix+"ReviewStatus" {
+			patchee.ReviewStatus = patcher.ReviewStatus
+			continue
+		}
+		if f == prefix+"Revision" {
+			patchee.Revision = patcher.Revision
+			continue
+		}
+		if !updatedLocation && strings.HasPrefix(f, prefix+"Location.") {
+			if patcher.Location == nil {
+				patchee.Location = nil
+				continue
+			}
+			if patchee.Location == nil {
+				patchee.Location = &types.GeoPoint{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Location."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Location, patchee.Location, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Location" {
+			updatedLocation = true
+			patchee.Location = patcher.Location
+			continue
+		}
+		if !updatedShipTo && strings.HasPrefix(f, prefix+"ShipTo.") {
+			if patcher.ShipTo == nil {
+				patchee.ShipTo = nil
+				continue
+			}
+			if patchee.ShipTo == nil {
+				patchee.ShipTo = &ShippingAddress{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ShipTo."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ShipTo, patchee.ShipTo, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ShipTo" {
+			updatedShipTo = true
+			patchee.ShipTo = patcher.ShipTo
+			continue
+		}
+		if f == prefix+"DisplayName" {
+			patchee.DisplayName = patcher.DisplayName
+			continue
+		}
+		if !updatedStartAt && strings.HasPrefix(f, prefix+"StartAt.") {
+			if patcher.StartAt == nil {
+				patchee.StartAt = nil
+				continue
+			}
+			if patchee.StartAt == nil {
+				patchee.StartAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"StartAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.StartAt, patchee.StartAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"StartAt" {
+			updatedStartAt = true
+			patchee.StartAt = patcher.StartAt
+			continue
+		}
+		if f == prefix+"StartTz" {
+			patchee.StartTz = patcher.StartTz
+			continue
+		}
+		if f == prefix+"Priority" {
+			patchee.Priority = patcher.Priority
+			continue
+		}
+		if f == prefix+"Price" {
+			patchee.Price = patcher.Price
+			continue
+		}
+		if f == prefix+"Currency" {
+			patchee.Currency = patcher.Currency
+			continue
+		}
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if f == prefix+"PastStatuses" {
+			patchee.PastStatuses = patcher.PastStatuses
+			continue
+		}
+		if f == prefix+"Signature" {
+			patchee.Signature = patcher.Signature
+			continue
+		}
+		if f == prefix+"CorrelationId" {
+			patchee.CorrelationId = patcher.CorrelationId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTypeWithID executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTypeWithID(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TypeWithID, error) {
+	in := TypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []TypeWithIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TypeWithID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TypeWithIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TypeWithIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TypeWithIDORM) error
+}
+
+// DefaultListTrashTypeWithID is DefaultListTypeWithID of the soft deleted rows only,
+// it is not served by the default server
+func DefaultListTrashTypeWithID(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TypeWithID, error) {
+	db = db.Unscoped().Where("type_with_ids.deleted_at IS NOT NULL")
+	in := TypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []TypeWithIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TypeWithID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+// DefaultExplainListTypeWithID returns the SELECT DefaultListTypeWithID would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTypeWithID(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TypeWithIDORM{})
+}
+
+// TypeWithIDORMSelectable lists the columns accepted by DefaultSelectTypeWithID
+var TypeWithIDORMSelectable = map[string]struct{}{
+	"active":            {},
+	"address":           {},
+	"correlation_id":    {},
+	"created_by":        {},
+	"currency":          {},
+	"deleted_at":        {},
+	"deleted_by":        {},
+	"details":           {},
+	"display_name":      {},
+	"double_field":      {},
+	"email":             {},
+	"external_id":       {},
+	"float_field":       {},
+	"id":                {},
+	"int_point_id":      {},
+	"ip_addr":           {},
+	"location":          {},
+	"observed_at":       {},
+	"observed_at_nanos": {},
+	"past_statuses":     {},
+	"price":             {},
+	"priority":          {},
+	"registered_at":     {},
+	"retry_delay":       {},
+	"review_status":     {},
+	"revision":          {},
+	"seen_at":           {},
+	"ship_to":           {},
+	"signature":         {},
+	"slug":              {},
+	"start_at":          {},
+	"start_tz":          {},
+	"state":             {},
+	"status":            {},
+	"tag_size_test":     {},
+	"tag_test":          {},
+	"time_only":         {},
+	"timeout":           {},
+	"updated_by":        {},
+	"user_id":           {},
+}
+
+// DefaultSelectTypeWithID runs the query of DefaultListTypeWithID for the columns only, which
+// must be in TypeWithIDORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTypeWithID(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TypeWithIDORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "type_with_ids."+column)
+	}
+	in := TypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TypeWithIDORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromMultiaccountTypeWithID inserts the objects with a COPY into the multiaccount_type_with_ids table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromMultiaccountTypeWithID(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]MultiaccountTypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into multiaccount_type_with_ids needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("multiaccount_type_with_ids", "account_id", "some_field"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.SomeField); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// MultiaccountTypeWithIDORMMaxBatchSize is the most rows of an INSERT of DefaultCreateMultiaccountTypeWithIDSet,
+// postgres takes 65535 bind parameters in a statement and a row has 2
+const MultiaccountTypeWithIDORMMaxBatchSize = 32767
+
+// DefaultCreateMultiaccountTypeWithIDSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most MultiaccountTypeWithIDORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB, batchSize int) ([]*MultiaccountTypeWithID, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > MultiaccountTypeWithIDORMMaxBatchSize {
+		batchSize = MultiaccountTypeWithIDORMMaxBatchSize
+	}
+	rows := make([]MultiaccountTypeWithIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*MultiaccountTypeWithIDORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?)")
+				args = append(args, row.AccountID, row.SomeField)
+			}
+			var stored []*MultiaccountTypeWithIDORM
+			if err := tx.Raw(`INSERT INTO "multiaccount_type_with_ids" ("account_id", "some_field") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return MultiaccountTypeWithIDORMSliceToPB(ctx, created)
+}
+
+func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &MultiaccountTypeWithIDORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyMultiaccountTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := MultiaccountTypeWithIDORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadMultiaccountTypeWithIDForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadMultiaccountTypeWithIDForUpdate(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB, wait types.LockWait) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := MultiaccountTypeWithIDORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) error {
+	return defaultDeleteMultiaccountTypeWithID(ctx, in, db, nil)
+}
+
+// DefaultDeleteMultiaccountTypeWithIDWithResult is DefaultDeleteMultiaccountTypeWithID reporting the affected rows,
+// no affected rows means no MultiaccountTypeWithID matched
+func DefaultDeleteMultiaccountTypeWithIDWithResult(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteMultiaccountTypeWithID(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) error
+}
+
+// DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	return defaultStrictUpdateMultiaccountTypeWithID(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateMultiaccountTypeWithIDWithResult is DefaultStrictUpdateMultiaccountTypeWithID reporting the affected rows
+// and whether the MultiaccountTypeWithID existed before the update
+func DefaultStrictUpdateMultiaccountTypeWithIDWithResult(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateMultiaccountTypeWithID(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB, result *types.WriteResult) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
+	lockedRow := &MultiaccountTypeWithIDORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchMultiaccountTypeWithID executes a basic gorm update call with patch behavior
+func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj MultiaccountTypeWithID
+	var err error
+	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithID(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithIDWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetMultiaccountTypeWithID executes a bulk gorm update call with patch behavior
+func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*MultiaccountTypeWithID, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchMultiaccountTypeWithID(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateMultiaccountTypeWithIDByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, account and by-fields cannot be in updateMask.
+func DefaultUpdateMultiaccountTypeWithIDByIds(ctx context.Context, ids []uint64, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "SomeField":
+			columns["some_field"] = ormObj.SomeField
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&MultiaccountTypeWithIDORM{}).Where("account_id = ? AND id IN (?)", acctId, batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskMultiaccountTypeWithID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *MultiaccountTypeWithID, patcher *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListMultiaccountTypeWithID executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+	in := MultiaccountTypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithIDORM{}, &MultiaccountTypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyMultiaccountTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []MultiaccountTypeWithIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MultiaccountTypeWithID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithIDORM) error
+}
+
+// DefaultExplainListMultiaccountTypeWithID returns the SELECT DefaultListMultiaccountTypeWithID would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := MultiaccountTypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithIDORM{}, &MultiaccountTypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyMultiaccountTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &MultiaccountTypeWithIDORM{})
+}
+
+// MultiaccountTypeWithIDORMSelectable lists the columns accepted by DefaultSelectMultiaccountTypeWithID
+var MultiaccountTypeWithIDORMSelectable = map[string]struct{}{
+	"account_id": {},
+	"id":         {},
+	"some_field": {},
+}
+
+// DefaultSelectMultiaccountTypeWithID runs the query of DefaultListMultiaccountTypeWithID for the columns only, which
+// must be in MultiaccountTypeWithIDORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectMultiaccountTypeWithID(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := MultiaccountTypeWithIDORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "multiaccount_type_with_ids."+column)
+	}
+	in := MultiaccountTypeWithID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithIDORM{}, &MultiaccountTypeWithID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyMultiaccountTypeWithIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&MultiaccountTypeWithIDORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateMultiaccountTypeWithoutID executes a basic gorm create call
+func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *MultiaccountTypeWithoutID, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type MultiaccountTypeWithoutIDORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromMultiaccountTypeWithoutID inserts the objects with a COPY into the multiaccount_type_without_ids table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromMultiaccountTypeWithoutID(ctx context.Context, in []*MultiaccountTypeWithoutID, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]MultiaccountTypeWithoutIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into multiaccount_type_without_ids needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("multiaccount_type_without_ids", "account_id", "some_field"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.SomeField); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// MultiaccountTypeWithoutIDORMMaxBatchSize is the most rows of an INSERT of DefaultCreateMultiaccountTypeWithoutIDSet,
+// postgres takes 65535 bind parameters in a statement and a row has 2
+const MultiaccountTypeWithoutIDORMMaxBatchSize = 32767
+
+// DefaultCreateMultiaccountTypeWithoutIDSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most MultiaccountTypeWithoutIDORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateMultiaccountTypeWithoutIDSet(ctx context.Context, in []*MultiaccountTypeWithoutID, db *gorm.DB, batchSize int) ([]*MultiaccountTypeWithoutID, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > MultiaccountTypeWithoutIDORMMaxBatchSize {
+		batchSize = MultiaccountTypeWithoutIDORMMaxBatchSize
+	}
+	rows := make([]MultiaccountTypeWithoutIDORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*MultiaccountTypeWithoutIDORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?)")
+				args = append(args, row.AccountID, row.SomeField)
+			}
+			var stored []*MultiaccountTypeWithoutIDORM
+			if err := tx.Raw(`INSERT INTO "multiaccount_type_without_ids" ("account_id", "some_field") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return MultiaccountTypeWithoutIDORMSliceToPB(ctx, created)
+}
+
+// DefaultApplyFieldMaskMultiaccountTypeWithoutID patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee *MultiaccountTypeWithoutID, patcher *MultiaccountTypeWithoutID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListMultiaccountTypeWithoutID executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
+	in := MultiaccountTypeWithoutID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithoutIDORM{}, &MultiaccountTypeWithoutID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyMultiaccountTypeWithoutIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	ormResponse := []MultiaccountTypeWithoutIDORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*MultiaccountTypeWithoutID{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
+}
+
+// DefaultExplainListMultiaccountTypeWithoutID returns the SELECT DefaultListMultiaccountTypeWithoutID would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := MultiaccountTypeWithoutID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithoutIDORM{}, &MultiaccountTypeWithoutID{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyMultiaccountTypeWithoutIDConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return explain.SQL(db, &MultiaccountTypeWithoutIDORM{})
+}
+
+// MultiaccountTypeWithoutIDORMSelectable lists the columns accepted by DefaultSelectMultiaccountTypeWithoutID
+var MultiaccountTypeWithoutIDORMSelectable = map[string]struct{}{
+	"account_id": {},
+	"some_field": {},
+}
+
+// DefaultSelectMultiaccountTypeWithoutID runs the query of DefaultListMultiaccountTypeWithoutID for the columns only, which
+// must be in MultiaccountTypeWithoutIDORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := MultiaccountTypeWithoutIDORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "multiaccount_type_without_ids."+column)
+	}
+	in := MultiaccountTypeWithoutID{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithoutIDORM{}, &MultiaccountTypeWithoutID{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyMultiaccountTypeWithoutIDConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return db.Model(&MultiaccountTypeWithoutIDORM{}).Select(selected).Rows()
+}
+
+// DefaultCreatePrimaryUUIDType executes a basic gorm create call
+func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromPrimaryUUIDType inserts the objects with a COPY into the primary_uuid_types table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreatePrimaryUUIDTypeSet
+// leaves them to the DB.
+func DefaultCopyFromPrimaryUUIDType(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]PrimaryUUIDTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == nil {
+			return 0, fmt.Errorf("COPY into primary_uuid_types takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into primary_uuid_types needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("primary_uuid_types", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// PrimaryUUIDTypeORMMaxBatchSize is the most rows of an INSERT of DefaultCreatePrimaryUUIDTypeSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const PrimaryUUIDTypeORMMaxBatchSize = 65535
+
+// DefaultCreatePrimaryUUIDTypeSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most PrimaryUUIDTypeORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreatePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB, batchSize int) ([]*PrimaryUUIDType, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > PrimaryUUIDTypeORMMaxBatchSize {
+		batchSize = PrimaryUUIDTypeORMMaxBatchSize
+	}
+	rows := make([]PrimaryUUIDTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*PrimaryUUIDTypeORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == nil {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*PrimaryUUIDTypeORM
+			if err := tx.Raw(`INSERT INTO "primary_uuid_types" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return PrimaryUUIDTypeORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertPrimaryUUIDTypeSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most PrimaryUUIDTypeORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertPrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > PrimaryUUIDTypeORMMaxBatchSize {
+		batchSize = PrimaryUUIDTypeORMMaxBatchSize
+	}
+	rows := make([]PrimaryUUIDTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == nil {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "primary_uuid_types" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryUUIDTypeORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryUUIDTypeConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PrimaryUUIDTypeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadPrimaryUUIDTypeForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadPrimaryUUIDTypeForUpdate(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB, wait types.LockWait) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := PrimaryUUIDTypeORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) error {
+	return defaultDeletePrimaryUUIDType(ctx, in, db, nil)
+}
+
+// DefaultDeletePrimaryUUIDTypeWithResult is DefaultDeletePrimaryUUIDType reporting the affected rows,
+// no affected rows means no PrimaryUUIDType matched
+func DefaultDeletePrimaryUUIDTypeWithResult(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeletePrimaryUUIDType(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PrimaryUUIDTypeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []*go_uuid.UUID{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PrimaryUUIDTypeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+	return defaultStrictUpdatePrimaryUUIDType(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdatePrimaryUUIDTypeWithResult is DefaultStrictUpdatePrimaryUUIDType reporting the affected rows
+// and whether the PrimaryUUIDType existed before the update
+func DefaultStrictUpdatePrimaryUUIDTypeWithResult(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdatePrimaryUUIDType(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB, result *types.WriteResult) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &PrimaryUUIDTypeORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
+	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrimaryUUIDType executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj PrimaryUUIDType
+	var err error
+	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrimaryUUIDType(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrimaryUUIDType executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*PrimaryUUIDType, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPrimaryUUIDType(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrimaryUUIDType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryUUIDType, patcher *PrimaryUUIDType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryUUIDType, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
+				continue
+			}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
+			}
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
+			}
+			continue
+		}
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPrimaryUUIDType executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*PrimaryUUIDType, error) {
+	in := PrimaryUUIDType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryUUIDTypeORM{}, &PrimaryUUIDType{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryUUIDTypeConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []PrimaryUUIDTypeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryUUIDType{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryUUIDTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+}
+
+// DefaultExplainListPrimaryUUIDType returns the SELECT DefaultListPrimaryUUIDType would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListPrimaryUUIDType(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := PrimaryUUIDType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryUUIDTypeORM{}, &PrimaryUUIDType{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyPrimaryUUIDTypeConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &PrimaryUUIDTypeORM{})
+}
+
+// PrimaryUUIDTypeORMSelectable lists the columns accepted by DefaultSelectPrimaryUUIDType
+var PrimaryUUIDTypeORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectPrimaryUUIDType runs the query of DefaultListPrimaryUUIDType for the columns only, which
+// must be in PrimaryUUIDTypeORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectPrimaryUUIDType(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := PrimaryUUIDTypeORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "primary_uuid_types."+column)
+	}
+	in := PrimaryUUIDType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryUUIDTypeORM{}, &PrimaryUUIDType{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryUUIDTypeConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&PrimaryUUIDTypeORM{}).Select(selected).Rows()
+}
+
+// DefaultCreatePrimaryStringType executes a basic gorm create call
+func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromPrimaryStringType inserts the objects with a COPY into the primary_string_types table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreatePrimaryStringTypeSet
+// leaves them to the DB.
+func DefaultCopyFromPrimaryStringType(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]PrimaryStringTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into primary_string_types takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into primary_string_types needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("primary_string_types", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// PrimaryStringTypeORMMaxBatchSize is the most rows of an INSERT of DefaultCreatePrimaryStringTypeSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const PrimaryStringTypeORMMaxBatchSize = 65535
+
+// DefaultCreatePrimaryStringTypeSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most PrimaryStringTypeORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreatePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB, batchSize int) ([]*PrimaryStringType, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > PrimaryStringTypeORMMaxBatchSize {
+		batchSize = PrimaryStringTypeORMMaxBatchSize
+	}
+	rows := make([]PrimaryStringTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*PrimaryStringTypeORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*PrimaryStringTypeORM
+			if err := tx.Raw(`INSERT INTO "primary_string_types" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return PrimaryStringTypeORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertPrimaryStringTypeSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most PrimaryStringTypeORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertPrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > PrimaryStringTypeORMMaxBatchSize {
+		batchSize = PrimaryStringTypeORMMaxBatchSize
+	}
+	rows := make([]PrimaryStringTypeORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "primary_string_types" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryStringTypeORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryStringTypeConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PrimaryStringTypeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadPrimaryStringTypeForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadPrimaryStringTypeForUpdate(ctx context.Context, in *PrimaryStringType, db *gorm.DB, wait types.LockWait) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := PrimaryStringTypeORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) error {
+	return defaultDeletePrimaryStringType(ctx, in, db, nil)
+}
+
+// DefaultDeletePrimaryStringTypeWithResult is DefaultDeletePrimaryStringType reporting the affected rows,
+// no affected rows means no PrimaryStringType matched
+func DefaultDeletePrimaryStringTypeWithResult(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeletePrimaryStringType(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&PrimaryStringTypeORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PrimaryStringTypeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PrimaryStringTypeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PrimaryStringTypeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+	return defaultStrictUpdatePrimaryStringType(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdatePrimaryStringTypeWithResult is DefaultStrictUpdatePrimaryStringType reporting the affected rows
+// and whether the PrimaryStringType existed before the update
+func DefaultStrictUpdatePrimaryStringTypeWithResult(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdatePrimaryStringType(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB, result *types.WriteResult) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &PrimaryStringTypeORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterChild := ExternalChildORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterChild.PrimaryStringTypeId = new(string)
+	*filterChild.PrimaryStringTypeId = ormObj.Id
+	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type PrimaryStringTypeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPrimaryStringType executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj PrimaryStringType
+	var err error
+	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePrimaryStringType(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PrimaryStringTypeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPrimaryStringType executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryStringType, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*PrimaryStringType, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPrimaryStringType(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPrimaryStringType patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *PrimaryStringType, patcher *PrimaryStringType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryStringType, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedChild bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
+			updatedChild = true
+			if patcher.Child == nil {
+				patchee.Child = nil
+				continue
+			}
+			if patchee.Child == nil {
+				patchee.Child = &ExternalChild{}
+			}
+			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Child = o
+			}
+			continue
+		}
+		if f == prefix+"Child" {
+			updatedChild = true
+			patchee.Child = patcher.Child
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPrimaryStringType executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*PrimaryStringType, error) {
+	in := PrimaryStringType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryStringTypeORM{}, &PrimaryStringType{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryStringTypeConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []PrimaryStringTypeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryStringType{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryStringTypeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
+}
+
+// DefaultExplainListPrimaryStringType returns the SELECT DefaultListPrimaryStringType would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListPrimaryStringType(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := PrimaryStringType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryStringTypeORM{}, &PrimaryStringType{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyPrimaryStringTypeConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &PrimaryStringTypeORM{})
+}
+
+// PrimaryStringTypeORMSelectable lists the columns accepted by DefaultSelectPrimaryStringType
+var PrimaryStringTypeORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectPrimaryStringType runs the query of DefaultListPrimaryStringType for the columns only, which
+// must be in PrimaryStringTypeORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectPrimaryStringType(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := PrimaryStringTypeORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "primary_string_types."+column)
+	}
+	in := PrimaryStringType{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryStringTypeORM{}, &PrimaryStringType{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryStringTypeConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&PrimaryStringTypeORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestTag executes a basic gorm create call
+func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTestTag inserts the objects with a COPY into the test_tags table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestTagSet
+// leaves them to the DB.
+func DefaultCopyFromTestTag(ctx context.Context, in []*TestTag, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestTagORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into test_tags takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_tags needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_tags", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestTagORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestTagSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const TestTagORMMaxBatchSize = 65535
+
+// DefaultCreateTestTagSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestTagORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB, batchSize int) ([]*TestTag, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestTagORMMaxBatchSize {
+		batchSize = TestTagORMMaxBatchSize
+	}
+	rows := make([]TestTagORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestTagORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestTagORM
+			if err := tx.Raw(`INSERT INTO "test_tags" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestTagORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertTestTagSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestTagORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestTagORMMaxBatchSize {
+		batchSize = TestTagORMMaxBatchSize
+	}
+	rows := make([]TestTagORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "test_tags" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestTagORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyTestTagConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestTagORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadTestTagForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTestTagForUpdate(ctx context.Context, in *TestTag, db *gorm.DB, wait types.LockWait) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TestTagORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
+	return defaultDeleteTestTag(ctx, in, db, nil)
+}
+
+// DefaultDeleteTestTagWithResult is DefaultDeleteTestTag reporting the affected rows,
+// no affected rows means no TestTag matched
+func DefaultDeleteTestTagWithResult(ctx context.Context, in *TestTag, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTestTag(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&TestTagORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestTagORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestTagORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestTagORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestTag, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestTag, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+	return defaultStrictUpdateTestTag(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateTestTagWithResult is DefaultStrictUpdateTestTag reporting the affected rows
+// and whether the TestTag existed before the update
+func DefaultStrictUpdateTestTagWithResult(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTestTag(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB, result *types.WriteResult) (*TestTag, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestTagORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterTestTagAssoc := TestTagAssociationORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterTestTagAssoc.TestTagId = new(string)
+	*filterTestTagAssoc.TestTagId = ormObj.Id
+	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestTagORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestTag executes a basic gorm update call with patch behavior
+func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestTag
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestTag(ctx, &TestTag{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestTag(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestTag(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestTagWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestTag executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestTag(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestTag, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestTag, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestTag(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestTag patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher *TestTag, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTag, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedTestTagAssoc bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedTestTagAssoc && strings.HasPrefix(f, prefix+"TestTagAssoc.") {
+			updatedTestTagAssoc = true
+			if patcher.TestTagAssoc == nil {
+				patchee.TestTagAssoc = nil
+				continue
+			}
+			if patchee.TestTagAssoc == nil {
+				patchee.TestTagAssoc = &TestTagAssociation{}
+			}
+			if o, err := DefaultApplyFieldMaskTestTagAssociation(ctx, patchee.TestTagAssoc, patcher.TestTagAssoc, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"TestTagAssoc.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.TestTagAssoc = o
+			}
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			updatedTestTagAssoc = true
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestTag executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestTag(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestTag, error) {
+	in := TestTag{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagORM{}, &TestTag{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestTagConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []TestTagORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestTag{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestTagORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
+}
+
+// DefaultExplainListTestTag returns the SELECT DefaultListTestTag would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestTag(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestTag{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagORM{}, &TestTag{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestTagConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TestTagORM{})
+}
+
+// TestTagORMSelectable lists the columns accepted by DefaultSelectTestTag
+var TestTagORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectTestTag runs the query of DefaultListTestTag for the columns only, which
+// must be in TestTagORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestTag(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestTagORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "test_tags."+column)
+	}
+	in := TestTag{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagORM{}, &TestTag{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestTagConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TestTagORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
+func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTestAssocHandlerDefault inserts the objects with a COPY into the test_assoc_handler_defaults table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestAssocHandlerDefaultSet
+// leaves them to the DB.
+func DefaultCopyFromTestAssocHandlerDefault(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestAssocHandlerDefaultORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into test_assoc_handler_defaults takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_assoc_handler_defaults needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_assoc_handler_defaults", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestAssocHandlerDefaultORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestAssocHandlerDefaultSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const TestAssocHandlerDefaultORMMaxBatchSize = 65535
+
+// DefaultCreateTestAssocHandlerDefaultSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerDefaultORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB, batchSize int) ([]*TestAssocHandlerDefault, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerDefaultORMMaxBatchSize {
+		batchSize = TestAssocHandlerDefaultORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerDefaultORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestAssocHandlerDefaultORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestAssocHandlerDefaultORM
+			if err := tx.Raw(`INSERT INTO "test_assoc_handler_defaults" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestAssocHandlerDefaultORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertTestAssocHandlerDefaultSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerDefaultORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerDefaultORMMaxBatchSize {
+		batchSize = TestAssocHandlerDefaultORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerDefaultORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "test_assoc_handler_defaults" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerDefaultORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerDefaultConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestAssocHandlerDefaultORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadTestAssocHandlerDefaultForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTestAssocHandlerDefaultForUpdate(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB, wait types.LockWait) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TestAssocHandlerDefaultORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) error {
+	return defaultDeleteTestAssocHandlerDefault(ctx, in, db, nil)
+}
+
+// DefaultDeleteTestAssocHandlerDefaultWithResult is DefaultDeleteTestAssocHandlerDefault reporting the affected rows,
+// no affected rows means no TestAssocHandlerDefault matched
+func DefaultDeleteTestAssocHandlerDefaultWithResult(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTestAssocHandlerDefault(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	return defaultStrictUpdateTestAssocHandlerDefault(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateTestAssocHandlerDefaultWithResult is DefaultStrictUpdateTestAssocHandlerDefault reporting the affected rows
+// and whether the TestAssocHandlerDefault existed before the update
+func DefaultStrictUpdateTestAssocHandlerDefaultWithResult(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTestAssocHandlerDefault(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB, result *types.WriteResult) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestAssocHandlerDefaultORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterTestTagAssoc := TestTagAssociationORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
+	*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
+	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerDefault executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestAssocHandlerDefault
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerDefault(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerDefaultWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerDefault executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestAssocHandlerDefault, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestAssocHandlerDefault(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestAssocHandlerDefault patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *TestAssocHandlerDefault, patcher *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestAssocHandlerDefault executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+	in := TestAssocHandlerDefault{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerDefaultORM{}, &TestAssocHandlerDefault{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerDefaultConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerDefaultORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestAssocHandlerDefault{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerDefaultORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerDefaultORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerDefaultORM) error
+}
+
+// DefaultExplainListTestAssocHandlerDefault returns the SELECT DefaultListTestAssocHandlerDefault would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestAssocHandlerDefault{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerDefaultORM{}, &TestAssocHandlerDefault{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestAssocHandlerDefaultConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TestAssocHandlerDefaultORM{})
+}
+
+// TestAssocHandlerDefaultORMSelectable lists the columns accepted by DefaultSelectTestAssocHandlerDefault
+var TestAssocHandlerDefaultORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectTestAssocHandlerDefault runs the query of DefaultListTestAssocHandlerDefault for the columns only, which
+// must be in TestAssocHandlerDefaultORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestAssocHandlerDefault(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestAssocHandlerDefaultORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "test_assoc_handler_defaults."+column)
+	}
+	in := TestAssocHandlerDefault{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerDefaultORM{}, &TestAssocHandlerDefault{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerDefaultConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TestAssocHandlerDefaultORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestAssocHandlerReplace executes a basic gorm create call
+func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTestAssocHandlerReplace inserts the objects with a COPY into the test_assoc_handler_replaces table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestAssocHandlerReplaceSet
+// leaves them to the DB.
+func DefaultCopyFromTestAssocHandlerReplace(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestAssocHandlerReplaceORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into test_assoc_handler_replaces takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_assoc_handler_replaces needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_assoc_handler_replaces", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestAssocHandlerReplaceORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestAssocHandlerReplaceSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const TestAssocHandlerReplaceORMMaxBatchSize = 65535
+
+// DefaultCreateTestAssocHandlerReplaceSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerReplaceORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB, batchSize int) ([]*TestAssocHandlerReplace, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerReplaceORMMaxBatchSize {
+		batchSize = TestAssocHandlerReplaceORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerReplaceORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestAssocHandlerReplaceORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestAssocHandlerReplaceORM
+			if err := tx.Raw(`INSERT INTO "test_assoc_handler_replaces" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestAssocHandlerReplaceORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertTestAssocHandlerReplaceSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerReplaceORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerReplaceORMMaxBatchSize {
+		batchSize = TestAssocHandlerReplaceORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerReplaceORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "test_assoc_handler_replaces" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerReplaceORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerReplaceConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestAssocHandlerReplaceORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadTestAssocHandlerReplaceForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTestAssocHandlerReplaceForUpdate(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB, wait types.LockWait) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TestAssocHandlerReplaceORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) error {
+	return defaultDeleteTestAssocHandlerReplace(ctx, in, db, nil)
+}
+
+// DefaultDeleteTestAssocHandlerReplaceWithResult is DefaultDeleteTestAssocHandlerReplace reporting the affected rows,
+// no affected rows means no TestAssocHandlerReplace matched
+func DefaultDeleteTestAssocHandlerReplaceWithResult(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTestAssocHandlerReplace(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	return defaultStrictUpdateTestAssocHandlerReplace(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateTestAssocHandlerReplaceWithResult is DefaultStrictUpdateTestAssocHandlerReplace reporting the affected rows
+// and whether the TestAssocHandlerReplace existed before the update
+func DefaultStrictUpdateTestAssocHandlerReplaceWithResult(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTestAssocHandlerReplace(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB, result *types.WriteResult) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &TestAssocHandlerReplaceORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Replace(ormObj.TestTagAssoc).Error; err != nil {
+		return nil, err
+	}
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTestAssocHandlerReplace executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TestAssocHandlerReplace
+	var err error
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerReplace(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerReplaceWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerReplace executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestAssocHandlerReplace, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestAssocHandlerReplace(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestAssocHandlerReplace patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *TestAssocHandlerReplace, patcher *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestAssocHandlerReplace executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+	in := TestAssocHandlerReplace{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerReplaceORM{}, &TestAssocHandlerReplace{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerReplaceConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerReplaceORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TestAssocHandlerReplace{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerReplaceORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerReplaceORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerReplaceORM) error
+}
+
+// DefaultExplainListTestAssocHandlerReplace returns the SELECT DefaultListTestAssocHandlerReplace would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestAssocHandlerReplace{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerReplaceORM{}, &TestAssocHandlerReplace{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestAssocHandlerReplaceConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TestAssocHandlerReplaceORM{})
+}
+
+// TestAssocHandlerReplaceORMSelectable lists the columns accepted by DefaultSelectTestAssocHandlerReplace
+var TestAssocHandlerReplaceORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectTestAssocHandlerReplace runs the query of DefaultListTestAssocHandlerReplace for the columns only, which
+// must be in TestAssocHandlerReplaceORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestAssocHandlerReplace(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestAssocHandlerReplaceORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "test_assoc_handler_replaces."+column)
+	}
+	in := TestAssocHandlerReplace{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerReplaceORM{}, &TestAssocHandlerReplace{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerReplaceConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TestAssocHandlerReplaceORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestAssocHandlerClear executes a basic gorm create call
+func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTestAssocHandlerClear inserts the objects with a COPY into the test_assoc_handler_clears table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestAssocHandlerClearSet
+// leaves them to the DB.
+func DefaultCopyFromTestAssocHandlerClear(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestAssocHandlerClearORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into test_assoc_handler_clears takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_assoc_handler_clears needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_assoc_handler_clears", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestAssocHandlerClearORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestAssocHandlerClearSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const TestAssocHandlerClearORMMaxBatchSize = 65535
+
+// DefaultCreateTestAssocHandlerClearSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerClearORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB, batchSize int) ([]*TestAssocHandlerClear, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerClearORMMaxBatchSize {
+		batchSize = TestAssocHandlerClearORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerClearORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestAssocHandlerClearORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestAssocHandlerClearORM
+			if err := tx.Raw(`INSERT INTO "test_assoc_handler_clears" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestAssocHandlerClearORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertTestAssocHandlerClearSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerClearORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerClearORMMaxBatchSize {
+		batchSize = TestAssocHandlerClearORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerClearORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "test_assoc_handler_clears" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerClearORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerClearConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TestAssocHandlerClearORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocHandlerClearORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadTestAssocHandlerClearForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTestAssocHandlerClearForUpdate(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB, wait types.LockWait) (*TestAssocHandlerClear, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TestAssocHandlerClearORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) error {
+	return defaultDeleteTestAssocHandlerClear(ctx, in, db, nil)
+}
+
+// DefaultDeleteTestAssocHandlerClearWithResult is DefaultDeleteTestAssocHandlerClear reporting the affected rows,
+// no affected rows means no TestAssocHandlerClear matched
+func DefaultDeleteTestAssocHandlerClearWithResult(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTestAssocHandlerClear(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TestAssocHandlerClearORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
 
-// PrimaryIncludedBeforeToPB called before default ToPB code
-type PrimaryIncludedWithBeforeToPB interface {
-	BeforeToPB(context.Context, *PrimaryIncluded) error
+type TestAssocHandlerClearORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) error
 }
 
-// PrimaryIncludedAfterToPB called after default ToPB code
-type PrimaryIncludedWithAfterToPB interface {
-	AfterToPB(context.Context, *PrimaryIncluded) error
+// DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	return defaultStrictUpdateTestAssocHandlerClear(ctx, in, db, nil)
 }
 
-// DefaultCreateTestTypes executes a basic gorm create call
-func DefaultCreateTestTypes(ctx context.Context, in *TestTypes, db *gorm.DB) (*TestTypes, error) {
+// DefaultStrictUpdateTestAssocHandlerClearWithResult is DefaultStrictUpdateTestAssocHandlerClear reporting the affected rows
+// and whether the TestAssocHandlerClear existed before the update
+func DefaultStrictUpdateTestAssocHandlerClearWithResult(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTestAssocHandlerClear(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB, result *types.WriteResult) (*TestAssocHandlerClear, error) {
 	if in == nil {
-		return nil, errors.NilArgumentError
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	var count int64
+	lockedRow := &TestAssocHandlerClearORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Clear().Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
 	return &pbResponse, err
 }
 
-type TestTypesORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTypesORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type TestAssocHandlerClearORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultApplyFieldMaskTestTypes patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, patcher *TestTypes, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTypes, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultPatchTestAssocHandlerClear executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	var pbObj TestAssocHandlerClear
 	var err error
-	var updatedOptionalString bool
-	var updatedNothingness bool
-	var updatedCreatedAt bool
-	var updatedJsonField bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"ApiOnlyString" {
-			patchee.ApiOnlyString = patcher.ApiOnlyString
-			continue
-		}
-		if f == prefix+"Numbers" {
-			patchee.Numbers = patcher.Numbers
-			continue
-		}
-		if !updatedOptionalString && strings.HasPrefix(f, prefix+"OptionalString.") {
-			if patcher.OptionalString == nil {
-				patchee.OptionalString = nil
-				continue
-			}
-			if patchee.OptionalString == nil {
-				patchee.OptionalString = &wrapperspb.StringValue{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"OptionalString."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.OptionalString, patchee.OptionalString, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"OptionalString" {
-			updatedOptionalString = true
-			patchee.OptionalString = patcher.OptionalString
-			continue
-		}
-		if f == prefix+"BecomesInt" {
-			patchee.BecomesInt = patcher.BecomesInt
-			continue
-		}
-		if !updatedNothingness && strings.HasPrefix(f, prefix+"Nothingness.") {
-			if patcher.Nothingness == nil {
-				patchee.Nothingness = nil
-				continue
-			}
-			if patchee.Nothingness == nil {
-				patchee.Nothingness = &emptypb.Empty{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Nothingness."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.Nothingness, patchee.Nothingness, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"Nothingness" {
-			updatedNothingness = true
-			patchee.Nothingness = patcher.Nothingness
-			continue
-		}
-		if f == prefix+"Uuid" {
-			patchee.Uuid = patcher.Uuid
-			continue
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
-			if patcher.CreatedAt == nil {
-				patchee.CreatedAt = nil
-				continue
-			}
-			if patchee.CreatedAt == nil {
-				patchee.CreatedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
-				return nil, nil
-			}
+	}
+	pbReadRes, err := DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"CreatedAt" {
-			updatedCreatedAt = true
-			patchee.CreatedAt = patcher.CreatedAt
-			continue
+	}
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"TypeWithIdId" {
-			patchee.TypeWithIdId = patcher.TypeWithIdId
-			continue
+	}
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if !updatedJsonField && strings.HasPrefix(f, prefix+"JsonField") {
-			patchee.JsonField = patcher.JsonField
-			updatedJsonField = true
-			continue
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerClearWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerClearWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTestAssocHandlerClear executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TestAssocHandlerClear, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTestAssocHandlerClear(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
 		}
-		if f == prefix+"NullableUuid" {
-			patchee.NullableUuid = patcher.NullableUuid
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTestAssocHandlerClear patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *TestAssocHandlerClear, patcher *TestAssocHandlerClear, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerClear, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"TimeOnly" {
-			patchee.TimeOnly = patcher.TimeOnly
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
 			continue
 		}
 	}
@@ -1401,38 +13954,43 @@ func DefaultApplyFieldMaskTestTypes(ctx context.Context, patchee *TestTypes, pat
 	return patchee, nil
 }
 
-// DefaultListTestTypes executes a gorm list call
-func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error) {
-	in := TestTypes{}
+// DefaultListTestAssocHandlerClear executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestAssocHandlerClear, error) {
+	in := TestAssocHandlerClear{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTypesORM{}, &TestTypes{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerClearORM{}, &TestAssocHandlerClear{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithBeforeListFind); ok {
+	if db, err = applyTestAssocHandlerClearConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []TestTypesORM{}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerClearORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTypesORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestTypes{}
+	pbResponse := []*TestAssocHandlerClear{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1443,18 +14001,94 @@ func DefaultListTestTypes(ctx context.Context, db *gorm.DB) ([]*TestTypes, error
 	return pbResponse, nil
 }
 
-type TestTypesORMWithBeforeListApplyQuery interface {
+type TestAssocHandlerClearORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTypesORMWithBeforeListFind interface {
+type TestAssocHandlerClearORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTypesORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTypesORM) error
+type TestAssocHandlerClearORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerClearORM) error
 }
 
-// DefaultCreateTypeWithID executes a basic gorm create call
-func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+// DefaultExplainListTestAssocHandlerClear returns the SELECT DefaultListTestAssocHandlerClear would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestAssocHandlerClear(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestAssocHandlerClear{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerClearORM{}, &TestAssocHandlerClear{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestAssocHandlerClearConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TestAssocHandlerClearORM{})
+}
+
+// TestAssocHandlerClearORMSelectable lists the columns accepted by DefaultSelectTestAssocHandlerClear
+var TestAssocHandlerClearORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectTestAssocHandlerClear runs the query of DefaultListTestAssocHandlerClear for the columns only, which
+// must be in TestAssocHandlerClearORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestAssocHandlerClear(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestAssocHandlerClearORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "test_assoc_handler_clears."+column)
+	}
+	in := TestAssocHandlerClear{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerClearORM{}, &TestAssocHandlerClear{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerClearConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TestAssocHandlerClearORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestAssocHandlerAppend executes a basic gorm create call
+func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1462,15 +14096,16 @@ func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1479,14 +14114,182 @@ func DefaultCreateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type TypeWithIDORMWithBeforeCreate_ interface {
+type TestAssocHandlerAppendORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterCreate_ interface {
+type TestAssocHandlerAppendORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+// DefaultCopyFromTestAssocHandlerAppend inserts the objects with a COPY into the test_assoc_handler_appends table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestAssocHandlerAppendSet
+// leaves them to the DB.
+func DefaultCopyFromTestAssocHandlerAppend(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestAssocHandlerAppendORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into test_assoc_handler_appends takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_assoc_handler_appends needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_assoc_handler_appends", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestAssocHandlerAppendORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestAssocHandlerAppendSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const TestAssocHandlerAppendORMMaxBatchSize = 65535
+
+// DefaultCreateTestAssocHandlerAppendSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerAppendORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB, batchSize int) ([]*TestAssocHandlerAppend, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerAppendORMMaxBatchSize {
+		batchSize = TestAssocHandlerAppendORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerAppendORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestAssocHandlerAppendORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestAssocHandlerAppendORM
+			if err := tx.Raw(`INSERT INTO "test_assoc_handler_appends" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestAssocHandlerAppendORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertTestAssocHandlerAppendSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocHandlerAppendORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocHandlerAppendORMMaxBatchSize {
+		batchSize = TestAssocHandlerAppendORMMaxBatchSize
+	}
+	rows := make([]TestAssocHandlerAppendORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "test_assoc_handler_appends" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1494,27 +14297,30 @@ func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*T
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TypeWithIDORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerAppendORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeReadFind); ok {
+	if db, err = applyTestAssocHandlerAppendConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TypeWithIDORM{}
+	ormResponse := TestAssocHandlerAppendORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TypeWithIDORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1523,17 +14329,54 @@ func DefaultReadTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*T
 	return &pbResponse, err
 }
 
-type TypeWithIDORMWithBeforeReadApplyQuery interface {
+type TestAssocHandlerAppendORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithBeforeReadFind interface {
+type TestAssocHandlerAppendORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterReadFind interface {
+type TestAssocHandlerAppendORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) error {
+// DefaultReadTestAssocHandlerAppendForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTestAssocHandlerAppendForUpdate(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB, wait types.LockWait) (*TestAssocHandlerAppend, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TestAssocHandlerAppendORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) error {
+	return defaultDeleteTestAssocHandlerAppend(ctx, in, db, nil)
+}
+
+// DefaultDeleteTestAssocHandlerAppendWithResult is DefaultDeleteTestAssocHandlerAppend reporting the affected rows,
+// no affected rows means no TestAssocHandlerAppend matched
+func DefaultDeleteTestAssocHandlerAppendWithResult(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTestAssocHandlerAppend(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1541,113 +14384,120 @@ func DefaultDeleteTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) e
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TypeWithIDORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TypeWithIDORMWithBeforeDelete_ interface {
+type TestAssocHandlerAppendORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterDelete_ interface {
+type TestAssocHandlerAppendORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTypeWithIDSet(ctx context.Context, in []*TypeWithID, db *gorm.DB) error {
+func DefaultDeleteTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []uint32{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Id == "" {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TypeWithIDORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TypeWithIDORM{})).(TypeWithIDORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TypeWithIDORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TypeWithID, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TypeWithID, *gorm.DB) error
+type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm.DB) (*TypeWithID, error) {
+// DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+	return defaultStrictUpdateTestAssocHandlerAppend(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateTestAssocHandlerAppendWithResult is DefaultStrictUpdateTestAssocHandlerAppend reporting the affected rows
+// and whether the TestAssocHandlerAppend existed before the update
+func DefaultStrictUpdateTestAssocHandlerAppendWithResult(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTestAssocHandlerAppend(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB, result *types.WriteResult) (*TestAssocHandlerAppend, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTypeWithID")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TypeWithIDORM{}
+	lockedRow := &TestAssocHandlerAppendORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterANestedObject := TestTypesORM{}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	filterANestedObject.ANestedObjectTypeWithIDId = new(uint32)
-	*filterANestedObject.ANestedObjectTypeWithIDId = ormObj.Id
-	if err = db.Where(filterANestedObject).Delete(TestTypesORM{}).Error; err != nil {
-		return nil, err
-	}
-	filterThings := TestTypesORM{}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	filterThings.ThingsTypeWithIDId = new(uint32)
-	*filterThings.ThingsTypeWithIDId = ormObj.Id
-	if err = db.Where(filterThings).Delete(TestTypesORM{}).Error; err != nil {
+	if err = db.Model(&ormObj).Association("TestTagAssoc").Append(ormObj.TestTagAssoc).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeStrictUpdateSave); ok {
+	ormObj.TestTagAssoc = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1662,51 +14512,51 @@ func DefaultStrictUpdateTypeWithID(ctx context.Context, in *TypeWithID, db *gorm
 	return &pbResponse, err
 }
 
-type TypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+type TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithBeforeStrictUpdateSave interface {
+type TestAssocHandlerAppendORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterStrictUpdateSave interface {
+type TestAssocHandlerAppendORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTypeWithID executes a basic gorm update call with patch behavior
-func DefaultPatchTypeWithID(ctx context.Context, in *TypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*TypeWithID, error) {
+// DefaultPatchTestAssocHandlerAppend executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TypeWithID
+	var pbObj TestAssocHandlerAppend
 	var err error
-	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTypeWithID(ctx, &TypeWithID{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TypeWithIDWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTypeWithID(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTestAssocHandlerAppend(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TypeWithIDWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1714,28 +14564,28 @@ func DefaultPatchTypeWithID(ctx context.Context, in *TypeWithID, updateMask *fie
 	return pbResponse, nil
 }
 
-type TypeWithIDWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocHandlerAppendWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TypeWithID, *field_mask.FieldMask, *gorm.DB) error
+type TestAssocHandlerAppendWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTypeWithID executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTypeWithID(ctx context.Context, objects []*TypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TypeWithID, error) {
+// DefaultPatchSetTestAssocHandlerAppend executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*TypeWithID, 0, len(objects))
+	results := make([]*TestAssocHandlerAppend, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTypeWithID(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchTestAssocHandlerAppend(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1746,207 +14596,307 @@ func DefaultPatchSetTypeWithID(ctx context.Context, objects []*TypeWithID, updat
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTypeWithID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, patcher *TypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TypeWithID, error) {
+// DefaultApplyFieldMaskTestAssocHandlerAppend patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *TestAssocHandlerAppend, patcher *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerAppend, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	var updatedANestedObject bool
-	var updatedPoint bool
-	var updatedUser bool
-	var updatedSyntheticField bool
-	var updatedFloatField bool
-	var updatedDoubleField bool
-	var updatedDeletedAt bool
-	for i, f := range updateMask.Paths {
+	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Ip" {
-			patchee.Ip = patcher.Ip
+		if f == prefix+"TestTagAssoc" {
+			patchee.TestTagAssoc = patcher.TestTagAssoc
 			continue
 		}
-		if f == prefix+"Things" {
-			patchee.Things = patcher.Things
-			continue
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTestAssocHandlerAppend executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+	in := TestAssocHandlerAppend{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
 		}
-		if !updatedANestedObject && strings.HasPrefix(f, prefix+"ANestedObject.") {
-			updatedANestedObject = true
-			if patcher.ANestedObject == nil {
-				patchee.ANestedObject = nil
-				continue
-			}
-			if patchee.ANestedObject == nil {
-				patchee.ANestedObject = &TestTypes{}
-			}
-			if o, err := DefaultApplyFieldMaskTestTypes(ctx, patchee.ANestedObject, patcher.ANestedObject, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ANestedObject.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.ANestedObject = o
-			}
-			continue
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerAppendORM{}, &TestAssocHandlerAppend{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerAppendConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"ANestedObject" {
-			updatedANestedObject = true
-			patchee.ANestedObject = patcher.ANestedObject
-			continue
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []TestAssocHandlerAppendORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
 		}
-		if !updatedPoint && strings.HasPrefix(f, prefix+"Point.") {
-			updatedPoint = true
-			if patcher.Point == nil {
-				patchee.Point = nil
-				continue
-			}
-			if patchee.Point == nil {
-				patchee.Point = &IntPoint{}
-			}
-			if o, err := DefaultApplyFieldMaskIntPoint(ctx, patchee.Point, patcher.Point, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Point.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Point = o
-			}
-			continue
+	}
+	pbResponse := []*TestAssocHandlerAppend{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
 		}
-		if f == prefix+"Point" {
-			updatedPoint = true
-			patchee.Point = patcher.Point
-			continue
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TestAssocHandlerAppendORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocHandlerAppendORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerAppendORM) error
+}
+
+// DefaultExplainListTestAssocHandlerAppend returns the SELECT DefaultListTestAssocHandlerAppend would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestAssocHandlerAppend{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
 		}
-		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
-			updatedUser = true
-			if patcher.User == nil {
-				patchee.User = nil
-				continue
-			}
-			if patchee.User == nil {
-				patchee.User = &user.User{}
-			}
-			if o, err := user.DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.User = o
-			}
-			continue
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerAppendORM{}, &TestAssocHandlerAppend{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestAssocHandlerAppendConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
 		}
-		if f == prefix+"User" {
-			updatedUser = true
-			patchee.User = patcher.User
-			continue
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TestAssocHandlerAppendORM{})
+}
+
+// TestAssocHandlerAppendORMSelectable lists the columns accepted by DefaultSelectTestAssocHandlerAppend
+var TestAssocHandlerAppendORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectTestAssocHandlerAppend runs the query of DefaultListTestAssocHandlerAppend for the columns only, which
+// must be in TestAssocHandlerAppendORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestAssocHandlerAppend(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestAssocHandlerAppendORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
 		}
-		if f == prefix+"Address" {
-			patchee.Address = patcher.Address
-			continue
+		selected = append(selected, "test_assoc_handler_appends."+column)
+	}
+	in := TestAssocHandlerAppend{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"MultiaccountTypeIds" {
-			patchee.MultiaccountTypeIds = patcher.MultiaccountTypeIds
-			continue
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerAppendORM{}, &TestAssocHandlerAppend{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocHandlerAppendConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
 		}
-		if !updatedSyntheticField && strings.HasPrefix(f, prefix+"SyntheticField.") {
-			if patcher.SyntheticField == nil {
-				patchee.SyntheticField = nil
-				continue
-			}
-			if patchee.SyntheticField == nil {
-				patchee.SyntheticField = &APIOnlyType{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"SyntheticField."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.SyntheticField, patchee.SyntheticField, childMask); err != nil {
-				return nil, nil
-			}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TestAssocHandlerAppendORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestTagAssociation executes a basic gorm create call
+func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation, db *gorm.DB) (*TestTagAssociation, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"SyntheticField" {
-			updatedSyntheticField = true
-			patchee.SyntheticField = patcher.SyntheticField
-			continue
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"TagTest" {
-			patchee.TagTest = patcher.TagTest
-			continue
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestTagAssociationORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestTagAssociationORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTestTagAssociation inserts the objects with a COPY into the test_tag_associations table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromTestTagAssociation(ctx context.Context, in []*TestTagAssociation, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestTagAssociationORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
 		}
-		if f == prefix+"TagSizeTest" {
-			patchee.TagSizeTest = patcher.TagSizeTest
-			continue
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
 		}
-		if !updatedFloatField && strings.HasPrefix(f, prefix+"FloatField.") {
-			if patcher.FloatField == nil {
-				patchee.FloatField = nil
-				continue
-			}
-			if patchee.FloatField == nil {
-				patchee.FloatField = &wrapperspb.FloatValue{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"FloatField."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.FloatField, patchee.FloatField, childMask); err != nil {
-				return nil, nil
-			}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_tag_associations needs a *sql.Tx, got %T", tx.CommonDB())
 		}
-		if f == prefix+"FloatField" {
-			updatedFloatField = true
-			patchee.FloatField = patcher.FloatField
-			continue
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_tag_associations", "some_field", "test_assoc_handler_append_id", "test_assoc_handler_clear_id", "test_assoc_handler_default_id", "test_assoc_handler_replace_id", "test_tag_id"))
+		if err != nil {
+			return err
 		}
-		if !updatedDoubleField && strings.HasPrefix(f, prefix+"DoubleField.") {
-			if patcher.DoubleField == nil {
-				patchee.DoubleField = nil
-				continue
-			}
-			if patchee.DoubleField == nil {
-				patchee.DoubleField = &wrapperspb.DoubleValue{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DoubleField."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.DoubleField, patchee.DoubleField, childMask); err != nil {
-				return nil, nil
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.SomeField, row.TestAssocHandlerAppendId, row.TestAssocHandlerClearId, row.TestAssocHandlerDefaultId, row.TestAssocHandlerReplaceId, row.TestTagId); err != nil {
+				stmt.Close()
+				return err
 			}
 		}
-		if f == prefix+"DoubleField" {
-			updatedDoubleField = true
-			patchee.DoubleField = patcher.DoubleField
-			continue
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
 		}
-		if f == prefix+"TimeOnly" {
-			patchee.TimeOnly = patcher.TimeOnly
-			continue
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestTagAssociationORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestTagAssociationSet,
+// postgres takes 65535 bind parameters in a statement and a row has 6
+const TestTagAssociationORMMaxBatchSize = 10922
+
+// DefaultCreateTestTagAssociationSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestTagAssociationORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestTagAssociationSet(ctx context.Context, in []*TestTagAssociation, db *gorm.DB, batchSize int) ([]*TestTagAssociation, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestTagAssociationORMMaxBatchSize {
+		batchSize = TestTagAssociationORMMaxBatchSize
+	}
+	rows := make([]TestTagAssociationORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
 		}
-		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
-			if patcher.DeletedAt == nil {
-				patchee.DeletedAt = nil
-				continue
-			}
-			if patchee.DeletedAt == nil {
-				patchee.DeletedAt = &timestamppb.Timestamp{}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestTagAssociationORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
 			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*6)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?, ?, ?, ?)")
+				args = append(args, row.SomeField, row.TestAssocHandlerAppendId, row.TestAssocHandlerClearId, row.TestAssocHandlerDefaultId, row.TestAssocHandlerReplaceId, row.TestTagId)
 			}
-			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
-				return nil, nil
+			var stored []*TestTagAssociationORM
+			if err := tx.Raw(`INSERT INTO "test_tag_associations" ("some_field", "test_assoc_handler_append_id", "test_assoc_handler_clear_id", "test_assoc_handler_default_id", "test_assoc_handler_replace_id", "test_tag_id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
 			}
+			created = append(created, stored...)
 		}
-		if f == prefix+"DeletedAt" {
-			updatedDeletedAt = true
-			patchee.DeletedAt = patcher.DeletedAt
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestTagAssociationORMSliceToPB(ctx, created)
+}
+
+// DefaultApplyFieldMaskTestTagAssociation patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestTagAssociation, patcher *TestTagAssociation, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTagAssociation, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"SomeField" {
+			patchee.SomeField = patcher.SomeField
 			continue
 		}
 	}
@@ -1956,39 +14906,42 @@ func DefaultApplyFieldMaskTypeWithID(ctx context.Context, patchee *TypeWithID, p
 	return patchee, nil
 }
 
-// DefaultListTypeWithID executes a gorm list call
-func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, error) {
-	in := TypeWithID{}
+// DefaultListTestTagAssociation executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestTagAssociation, error) {
+	in := TestTagAssociation{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TypeWithIDORM{}, &TypeWithID{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagAssociationORM{}, &TestTagAssociation{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithBeforeListFind); ok {
+	if db, err = applyTestTagAssociationConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TypeWithIDORM{}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	ormResponse := []TestTagAssociationORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TypeWithIDORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TypeWithID{}
+	pbResponse := []*TestTagAssociation{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1999,18 +14952,97 @@ func DefaultListTypeWithID(ctx context.Context, db *gorm.DB) ([]*TypeWithID, err
 	return pbResponse, nil
 }
 
-type TypeWithIDORMWithBeforeListApplyQuery interface {
+type TestTagAssociationORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithBeforeListFind interface {
+type TestTagAssociationORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TypeWithIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TypeWithIDORM) error
+type TestTagAssociationORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestTagAssociationORM) error
 }
 
-// DefaultCreateMultiaccountTypeWithID executes a basic gorm create call
-func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultExplainListTestTagAssociation returns the SELECT DefaultListTestTagAssociation would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestTagAssociation(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestTagAssociation{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagAssociationORM{}, &TestTagAssociation{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestTagAssociationConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return explain.SQL(db, &TestTagAssociationORM{})
+}
+
+// TestTagAssociationORMSelectable lists the columns accepted by DefaultSelectTestTagAssociation
+var TestTagAssociationORMSelectable = map[string]struct{}{
+	"some_field":                    {},
+	"test_assoc_handler_append_id":  {},
+	"test_assoc_handler_clear_id":   {},
+	"test_assoc_handler_default_id": {},
+	"test_assoc_handler_replace_id": {},
+	"test_tag_id":                   {},
+}
+
+// DefaultSelectTestTagAssociation runs the query of DefaultListTestTagAssociation for the columns only, which
+// must be in TestTagAssociationORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestTagAssociation(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestTagAssociationORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "test_tag_associations."+column)
+	}
+	in := TestTagAssociation{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagAssociationORM{}, &TestTagAssociation{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestTagAssociationConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return db.Model(&TestTagAssociationORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestAssocLabeled executes a basic gorm create call
+func DefaultCreateTestAssocLabeled(ctx context.Context, in *TestAssocLabeled, db *gorm.DB) (*TestAssocLabeled, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2018,31 +15050,200 @@ func DefaultCreateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type MultiaccountTypeWithIDORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithIDORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TestAssocLabeledORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TestAssocLabeledORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromTestAssocLabeled inserts the objects with a COPY into the test_assoc_labeleds table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestAssocLabeledSet
+// leaves them to the DB.
+func DefaultCopyFromTestAssocLabeled(ctx context.Context, in []*TestAssocLabeled, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestAssocLabeledORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into test_assoc_labeleds takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_assoc_labeleds needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_assoc_labeleds", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// TestAssocLabeledORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestAssocLabeledSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const TestAssocLabeledORMMaxBatchSize = 65535
+
+// DefaultCreateTestAssocLabeledSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocLabeledORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestAssocLabeledSet(ctx context.Context, in []*TestAssocLabeled, db *gorm.DB, batchSize int) ([]*TestAssocLabeled, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocLabeledORMMaxBatchSize {
+		batchSize = TestAssocLabeledORMMaxBatchSize
+	}
+	rows := make([]TestAssocLabeledORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*TestAssocLabeledORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestAssocLabeledORM
+			if err := tx.Raw(`INSERT INTO "test_assoc_labeleds" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TestAssocLabeledORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertTestAssocLabeledSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocLabeledORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertTestAssocLabeledSet(ctx context.Context, in []*TestAssocLabeled, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocLabeledORMMaxBatchSize {
+		batchSize = TestAssocLabeledORMMaxBatchSize
+	}
+	rows := make([]TestAssocLabeledORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "test_assoc_labeleds" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
 }
 
-func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+func DefaultReadTestAssocLabeled(ctx context.Context, in *TestAssocLabeled, db *gorm.DB) (*TestAssocLabeled, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2050,27 +15251,30 @@ func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountType
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &MultiaccountTypeWithIDORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocLabeledORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeReadFind); ok {
+	if db, err = applyTestAssocLabeledConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := MultiaccountTypeWithIDORM{}
+	ormResponse := TestAssocLabeledORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(MultiaccountTypeWithIDORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestAssocLabeledORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2079,17 +15283,54 @@ func DefaultReadMultiaccountTypeWithID(ctx context.Context, in *MultiaccountType
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeReadApplyQuery interface {
+type TestAssocLabeledORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithBeforeReadFind interface {
+type TestAssocLabeledORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterReadFind interface {
+type TestAssocLabeledORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) error {
+// DefaultReadTestAssocLabeledForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTestAssocLabeledForUpdate(ctx context.Context, in *TestAssocLabeled, db *gorm.DB, wait types.LockWait) (*TestAssocLabeled, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TestAssocLabeledORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTestAssocLabeled(ctx context.Context, in *TestAssocLabeled, db *gorm.DB) error {
+	return defaultDeleteTestAssocLabeled(ctx, in, db, nil)
+}
+
+// DefaultDeleteTestAssocLabeledWithResult is DefaultDeleteTestAssocLabeled reporting the affected rows,
+// no affected rows means no TestAssocLabeled matched
+func DefaultDeleteTestAssocLabeledWithResult(ctx context.Context, in *TestAssocLabeled, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTestAssocLabeled(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTestAssocLabeled(ctx context.Context, in *TestAssocLabeled, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2097,104 +15338,120 @@ func DefaultDeleteMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTy
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&MultiaccountTypeWithIDORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&TestAssocLabeledORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeDelete_ interface {
+type TestAssocLabeledORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterDelete_ interface {
+type TestAssocLabeledORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteMultiaccountTypeWithIDSet(ctx context.Context, in []*MultiaccountTypeWithID, db *gorm.DB) error {
+func DefaultDeleteTestAssocLabeledSet(ctx context.Context, in []*TestAssocLabeled, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []uint64{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Id == "" {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocLabeledORM{})).(TestAssocLabeledORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&MultiaccountTypeWithIDORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TestAssocLabeledORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&MultiaccountTypeWithIDORM{})).(MultiaccountTypeWithIDORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocLabeledORM{})).(TestAssocLabeledORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabeledORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocLabeled, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*MultiaccountTypeWithID, *gorm.DB) error
+type TestAssocLabeledORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocLabeled, *gorm.DB) error
 }
 
-// DefaultStrictUpdateMultiaccountTypeWithID clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultStrictUpdateTestAssocLabeled clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocLabeled(ctx context.Context, in *TestAssocLabeled, db *gorm.DB) (*TestAssocLabeled, error) {
+	return defaultStrictUpdateTestAssocLabeled(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateTestAssocLabeledWithResult is DefaultStrictUpdateTestAssocLabeled reporting the affected rows
+// and whether the TestAssocLabeled existed before the update
+func DefaultStrictUpdateTestAssocLabeledWithResult(ctx context.Context, in *TestAssocLabeled, db *gorm.DB) (*TestAssocLabeled, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTestAssocLabeled(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTestAssocLabeled(ctx context.Context, in *TestAssocLabeled, db *gorm.DB, result *types.WriteResult) (*TestAssocLabeled, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateMultiaccountTypeWithID")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocLabeled")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
 	var count int64
-	lockedRow := &MultiaccountTypeWithIDORM{}
+	lockedRow := &TestAssocLabeledORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave); ok {
+	if err = db.Model(&ormObj).Association("Labels").Replace(ormObj.Labels).Error; err != nil {
+		return nil, err
+	}
+	ormObj.Labels = nil
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2209,51 +15466,51 @@ func DefaultStrictUpdateMultiaccountTypeWithID(ctx context.Context, in *Multiacc
 	return &pbResponse, err
 }
 
-type MultiaccountTypeWithIDORMWithBeforeStrictUpdateCleanup interface {
+type TestAssocLabeledORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithBeforeStrictUpdateSave interface {
+type TestAssocLabeledORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterStrictUpdateSave interface {
+type TestAssocLabeledORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchMultiaccountTypeWithID executes a basic gorm update call with patch behavior
-func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultPatchTestAssocLabeled executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocLabeled(ctx context.Context, in *TestAssocLabeled, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocLabeled, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj MultiaccountTypeWithID
+	var pbObj TestAssocLabeled
 	var err error
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocLabeledWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadMultiaccountTypeWithID(ctx, &MultiaccountTypeWithID{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestAssocLabeled(ctx, &TestAssocLabeled{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocLabeledWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskMultiaccountTypeWithID(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestAssocLabeled(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(MultiaccountTypeWithIDWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocLabeledWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateMultiaccountTypeWithID(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTestAssocLabeled(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(MultiaccountTypeWithIDWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TestAssocLabeledWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2261,28 +15518,28 @@ func DefaultPatchMultiaccountTypeWithID(ctx context.Context, in *MultiaccountTyp
 	return pbResponse, nil
 }
 
-type MultiaccountTypeWithIDWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabeledWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocLabeled, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabeledWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocLabeled, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabeledWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocLabeled, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *MultiaccountTypeWithID, *field_mask.FieldMask, *gorm.DB) error
+type TestAssocLabeledWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocLabeled, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetMultiaccountTypeWithID executes a bulk gorm update call with patch behavior
-func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*MultiaccountTypeWithID, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
+// DefaultPatchSetTestAssocLabeled executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocLabeled(ctx context.Context, objects []*TestAssocLabeled, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocLabeled, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*MultiaccountTypeWithID, 0, len(objects))
+	results := make([]*TestAssocLabeled, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchMultiaccountTypeWithID(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchTestAssocLabeled(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2293,8 +15550,8 @@ func DefaultPatchSetMultiaccountTypeWithID(ctx context.Context, objects []*Multi
 	return results, nil
 }
 
-// DefaultApplyFieldMaskMultiaccountTypeWithID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *MultiaccountTypeWithID, patcher *MultiaccountTypeWithID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithID, error) {
+// DefaultApplyFieldMaskTestAssocLabeled patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocLabeled(ctx context.Context, patchee *TestAssocLabeled, patcher *TestAssocLabeled, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocLabeled, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2306,8 +15563,8 @@ func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *M
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
+		if f == prefix+"Labels" {
+			patchee.Labels = patcher.Labels
 			continue
 		}
 	}
@@ -2317,39 +15574,43 @@ func DefaultApplyFieldMaskMultiaccountTypeWithID(ctx context.Context, patchee *M
 	return patchee, nil
 }
 
-// DefaultListMultiaccountTypeWithID executes a gorm list call
-func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithID, error) {
-	in := MultiaccountTypeWithID{}
+// DefaultListTestAssocLabeled executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestAssocLabeled(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestAssocLabeled, error) {
+	in := TestAssocLabeled{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithIDORM{}, &MultiaccountTypeWithID{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocLabeledORM{}, &TestAssocLabeled{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithBeforeListFind); ok {
+	if db, err = applyTestAssocLabeledConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []MultiaccountTypeWithIDORM{}
+	ormResponse := []TestAssocLabeledORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithIDORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*MultiaccountTypeWithID{}
+	pbResponse := []*TestAssocLabeled{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2360,155 +15621,295 @@ func DefaultListMultiaccountTypeWithID(ctx context.Context, db *gorm.DB) ([]*Mul
 	return pbResponse, nil
 }
 
-type MultiaccountTypeWithIDORMWithBeforeListApplyQuery interface {
+type TestAssocLabeledORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithBeforeListFind interface {
+type TestAssocLabeledORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithIDORM) error
+type TestAssocLabeledORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocLabeledORM) error
 }
 
-// DefaultCreateMultiaccountTypeWithoutID executes a basic gorm create call
-func DefaultCreateMultiaccountTypeWithoutID(ctx context.Context, in *MultiaccountTypeWithoutID, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultExplainListTestAssocLabeled returns the SELECT DefaultListTestAssocLabeled would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestAssocLabeled(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestAssocLabeled{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocLabeledORM{}, &TestAssocLabeled{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if db, err = applyTestAssocLabeledConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TestAssocLabeledORM{})
 }
 
-type MultiaccountTypeWithoutIDORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type MultiaccountTypeWithoutIDORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// TestAssocLabeledORMSelectable lists the columns accepted by DefaultSelectTestAssocLabeled
+var TestAssocLabeledORMSelectable = map[string]struct{}{
+	"id": {},
 }
 
-// DefaultApplyFieldMaskMultiaccountTypeWithoutID patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskMultiaccountTypeWithoutID(ctx context.Context, patchee *MultiaccountTypeWithoutID, patcher *MultiaccountTypeWithoutID, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*MultiaccountTypeWithoutID, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultSelectTestAssocLabeled runs the query of DefaultListTestAssocLabeled for the columns only, which
+// must be in TestAssocLabeledORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestAssocLabeled(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
-			continue
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestAssocLabeledORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
 		}
+		selected = append(selected, "test_assoc_labeleds."+column)
 	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListMultiaccountTypeWithoutID executes a gorm list call
-func DefaultListMultiaccountTypeWithoutID(ctx context.Context, db *gorm.DB) ([]*MultiaccountTypeWithoutID, error) {
-	in := MultiaccountTypeWithoutID{}
+	in := TestAssocLabeled{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &MultiaccountTypeWithoutIDORM{}, &MultiaccountTypeWithoutID{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocLabeledORM{}, &TestAssocLabeled{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithBeforeListFind); ok {
+	if db, err = applyTestAssocLabeledConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabeledORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []MultiaccountTypeWithoutIDORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TestAssocLabeledORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTestAssocLabel executes a basic gorm create call
+func DefaultCreateTestAssocLabel(ctx context.Context, in *TestAssocLabel, db *gorm.DB) (*TestAssocLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(MultiaccountTypeWithoutIDORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*MultiaccountTypeWithoutID{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-type MultiaccountTypeWithoutIDORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabelORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type MultiaccountTypeWithoutIDORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabelORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
-type MultiaccountTypeWithoutIDORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]MultiaccountTypeWithoutIDORM) error
+
+// DefaultCopyFromTestAssocLabel inserts the objects with a COPY into the test_assoc_labels table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateTestAssocLabelSet
+// leaves them to the DB.
+func DefaultCopyFromTestAssocLabel(ctx context.Context, in []*TestAssocLabel, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TestAssocLabelORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into test_assoc_labels takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into test_assoc_labels needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("test_assoc_labels", "id", "name"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id, row.Name); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
 }
 
-// DefaultCreatePrimaryUUIDType executes a basic gorm create call
-func DefaultCreatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// TestAssocLabelORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTestAssocLabelSet,
+// postgres takes 65535 bind parameters in a statement and a row has 2
+const TestAssocLabelORMMaxBatchSize = 32767
+
+// DefaultCreateTestAssocLabelSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocLabelORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTestAssocLabelSet(ctx context.Context, in []*TestAssocLabel, db *gorm.DB, batchSize int) ([]*TestAssocLabel, error) {
+	if len(in) == 0 {
+		return nil, nil
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if batchSize <= 0 {
+		batchSize = 500
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if batchSize > TestAssocLabelORMMaxBatchSize {
+		batchSize = TestAssocLabelORMMaxBatchSize
+	}
+	rows := make([]TestAssocLabelORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	created := make([]*TestAssocLabelORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, row := range batch {
+				values := []interface{}{row.Id, row.Name}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*TestAssocLabelORM
+			if err := tx.Raw(`INSERT INTO "test_assoc_labels" ("id", "name") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	return TestAssocLabelORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertTestAssocLabelSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TestAssocLabelORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertTestAssocLabelSet(ctx context.Context, in []*TestAssocLabel, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TestAssocLabelORMMaxBatchSize {
+		batchSize = TestAssocLabelORMMaxBatchSize
+	}
+	rows := make([]TestAssocLabelORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, row := range batch {
+				values := []interface{}{row.Id, row.Name}
+				if row.Id == "" {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "test_assoc_labels" ("id", "name") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO UPDATE SET "name" = EXCLUDED."name"`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
 		}
+		return nil
+	}); err != nil {
+		return 0, err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type PrimaryUUIDTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryUUIDTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	return upserted, nil
 }
 
-func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+func DefaultReadTestAssocLabel(ctx context.Context, in *TestAssocLabel, db *gorm.DB) (*TestAssocLabel, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2516,27 +15917,30 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryUUIDTypeORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocLabelORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeReadFind); ok {
+	if db, err = applyTestAssocLabelConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryUUIDTypeORM{}
+	ormResponse := TestAssocLabelORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryUUIDTypeORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(TestAssocLabelORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2545,17 +15949,54 @@ func DefaultReadPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *go
 	return &pbResponse, err
 }
 
-type PrimaryUUIDTypeORMWithBeforeReadApplyQuery interface {
+type TestAssocLabelORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeReadFind interface {
+type TestAssocLabelORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterReadFind interface {
+type TestAssocLabelORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) error {
+// DefaultReadTestAssocLabelForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadTestAssocLabelForUpdate(ctx context.Context, in *TestAssocLabel, db *gorm.DB, wait types.LockWait) (*TestAssocLabel, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := TestAssocLabelORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteTestAssocLabel(ctx context.Context, in *TestAssocLabel, db *gorm.DB) error {
+	return defaultDeleteTestAssocLabel(ctx, in, db, nil)
+}
+
+// DefaultDeleteTestAssocLabelWithResult is DefaultDeleteTestAssocLabel reporting the affected rows,
+// no affected rows means no TestAssocLabel matched
+func DefaultDeleteTestAssocLabelWithResult(ctx context.Context, in *TestAssocLabel, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteTestAssocLabel(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteTestAssocLabel(ctx context.Context, in *TestAssocLabel, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2563,104 +16004,116 @@ func DefaultDeletePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryUUIDTypeORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&TestAssocLabelORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PrimaryUUIDTypeORMWithBeforeDelete_ interface {
+type TestAssocLabelORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterDelete_ interface {
+type TestAssocLabelORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryUUIDTypeSet(ctx context.Context, in []*PrimaryUUIDType, db *gorm.DB) error {
+func DefaultDeleteTestAssocLabelSet(ctx context.Context, in []*TestAssocLabel, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []*go_uuid.UUID{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
+		if ormObj.Id == "" {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocLabelORM{})).(TestAssocLabelORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryUUIDTypeORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&TestAssocLabelORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PrimaryUUIDTypeORM{})).(PrimaryUUIDTypeORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&TestAssocLabelORM{})).(TestAssocLabelORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PrimaryUUIDTypeORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabelORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TestAssocLabel, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryUUIDType, *gorm.DB) error
+type TestAssocLabelORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TestAssocLabel, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePrimaryUUIDType clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultStrictUpdateTestAssocLabel clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTestAssocLabel(ctx context.Context, in *TestAssocLabel, db *gorm.DB) (*TestAssocLabel, error) {
+	return defaultStrictUpdateTestAssocLabel(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateTestAssocLabelWithResult is DefaultStrictUpdateTestAssocLabel reporting the affected rows
+// and whether the TestAssocLabel existed before the update
+func DefaultStrictUpdateTestAssocLabelWithResult(ctx context.Context, in *TestAssocLabel, db *gorm.DB) (*TestAssocLabel, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateTestAssocLabel(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateTestAssocLabel(ctx context.Context, in *TestAssocLabel, db *gorm.DB, result *types.WriteResult) (*TestAssocLabel, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryUUIDType")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocLabel")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &PrimaryUUIDTypeORM{}
+	lockedRow := &TestAssocLabelORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == nil || *ormObj.Id == go_uuid.Nil {
-		return nil, errors.EmptyIdError
-	}
-	filterChild.PrimaryUUIDTypeId = new(go_uuid.UUID)
-	*filterChild.PrimaryUUIDTypeId = *ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2675,51 +16128,51 @@ func DefaultStrictUpdatePrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType
 	return &pbResponse, err
 }
 
-type PrimaryUUIDTypeORMWithBeforeStrictUpdateCleanup interface {
+type TestAssocLabelORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeStrictUpdateSave interface {
+type TestAssocLabelORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterStrictUpdateSave interface {
+type TestAssocLabelORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPrimaryUUIDType executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultPatchTestAssocLabel executes a basic gorm update call with patch behavior
+func DefaultPatchTestAssocLabel(ctx context.Context, in *TestAssocLabel, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocLabel, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryUUIDType
+	var pbObj TestAssocLabel
 	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocLabelWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPrimaryUUIDType(ctx, &PrimaryUUIDType{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadTestAssocLabel(ctx, &TestAssocLabel{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocLabelWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryUUIDType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskTestAssocLabel(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryUUIDTypeWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(TestAssocLabelWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryUUIDType(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateTestAssocLabel(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryUUIDTypeWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(TestAssocLabelWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2727,28 +16180,28 @@ func DefaultPatchPrimaryUUIDType(ctx context.Context, in *PrimaryUUIDType, updat
 	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabelWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TestAssocLabel, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabelWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TestAssocLabel, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TestAssocLabelWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TestAssocLabel, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryUUIDType, *field_mask.FieldMask, *gorm.DB) error
+type TestAssocLabelWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TestAssocLabel, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryUUIDType executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryUUIDType, error) {
+// DefaultPatchSetTestAssocLabel executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTestAssocLabel(ctx context.Context, objects []*TestAssocLabel, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocLabel, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*PrimaryUUIDType, 0, len(objects))
+	results := make([]*TestAssocLabel, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryUUIDType(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchTestAssocLabel(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2759,39 +16212,64 @@ func DefaultPatchSetPrimaryUUIDType(ctx context.Context, objects []*PrimaryUUIDT
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPrimaryUUIDType patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryUUIDType, patcher *PrimaryUUIDType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryUUIDType, error) {
+// DefaultUpdateTestAssocLabelByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key and by-fields cannot be in updateMask.
+func DefaultUpdateTestAssocLabelByIds(ctx context.Context, ids []string, in *TestAssocLabel, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Name":
+			columns["name"] = ormObj.Name
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&TestAssocLabelORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskTestAssocLabel patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTestAssocLabel(ctx context.Context, patchee *TestAssocLabel, patcher *TestAssocLabel, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocLabel, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	var updatedChild bool
-	for i, f := range updateMask.Paths {
+	for _, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
-				continue
-			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
-			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
-			}
-			continue
-		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
 	}
@@ -2801,39 +16279,43 @@ func DefaultApplyFieldMaskPrimaryUUIDType(ctx context.Context, patchee *PrimaryU
 	return patchee, nil
 }
 
-// DefaultListPrimaryUUIDType executes a gorm list call
-func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUIDType, error) {
-	in := PrimaryUUIDType{}
+// DefaultListTestAssocLabel executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTestAssocLabel(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*TestAssocLabel, error) {
+	in := TestAssocLabel{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryUUIDTypeORM{}, &PrimaryUUIDType{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocLabelORM{}, &TestAssocLabel{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithBeforeListFind); ok {
+	if db, err = applyTestAssocLabelConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []PrimaryUUIDTypeORM{}
+	ormResponse := []TestAssocLabelORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryUUIDTypeORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryUUIDType{}
+	pbResponse := []*TestAssocLabel{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2844,50 +16326,296 @@ func DefaultListPrimaryUUIDType(ctx context.Context, db *gorm.DB) ([]*PrimaryUUI
 	return pbResponse, nil
 }
 
-type PrimaryUUIDTypeORMWithBeforeListApplyQuery interface {
+type TestAssocLabelORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithBeforeListFind interface {
+type TestAssocLabelORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryUUIDTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryUUIDTypeORM) error
+type TestAssocLabelORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TestAssocLabelORM) error
 }
 
-// DefaultCreatePrimaryStringType executes a basic gorm create call
-func DefaultCreatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultExplainListTestAssocLabel returns the SELECT DefaultListTestAssocLabel would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTestAssocLabel(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := TestAssocLabel{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocLabelORM{}, &TestAssocLabel{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTestAssocLabelConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &TestAssocLabelORM{})
+}
+
+// TestAssocLabelORMSelectable lists the columns accepted by DefaultSelectTestAssocLabel
+var TestAssocLabelORMSelectable = map[string]struct{}{
+	"id":   {},
+	"name": {},
+}
+
+// DefaultSelectTestAssocLabel runs the query of DefaultListTestAssocLabel for the columns only, which
+// must be in TestAssocLabelORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTestAssocLabel(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TestAssocLabelORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "test_assoc_labels."+column)
+	}
+	in := TestAssocLabel{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocLabelORM{}, &TestAssocLabel{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTestAssocLabelConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TestAssocLabelORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&TestAssocLabelORM{}).Select(selected).Rows()
+}
+
+// DefaultCreatePrimaryIncluded executes a basic gorm create call
+func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PrimaryIncludedORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryIncludedORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromPrimaryIncluded inserts the objects with a COPY into the primary_includeds table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreatePrimaryIncludedSet
+// leaves them to the DB.
+func DefaultCopyFromPrimaryIncluded(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]PrimaryIncludedORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if reflect.ValueOf(row.Id).IsZero() {
+			return 0, fmt.Errorf("COPY into primary_includeds takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into primary_includeds needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("primary_includeds", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// PrimaryIncludedORMMaxBatchSize is the most rows of an INSERT of DefaultCreatePrimaryIncludedSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const PrimaryIncludedORMMaxBatchSize = 65535
+
+// DefaultCreatePrimaryIncludedSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most PrimaryIncludedORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreatePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB, batchSize int) ([]*PrimaryIncluded, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if batchSize > PrimaryIncludedORMMaxBatchSize {
+		batchSize = PrimaryIncludedORMMaxBatchSize
+	}
+	rows := make([]PrimaryIncludedORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	created := make([]*PrimaryIncludedORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if reflect.ValueOf(row.Id).IsZero() {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*PrimaryIncludedORM
+			if err := tx.Raw(`INSERT INTO "primary_includeds" ("id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	return PrimaryIncludedORMSliceToPB(ctx, created)
 }
 
-type PrimaryStringTypeORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// DefaultUpsertPrimaryIncludedSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most PrimaryIncludedORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertPrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > PrimaryIncludedORMMaxBatchSize {
+		batchSize = PrimaryIncludedORMMaxBatchSize
+	}
+	rows := make([]PrimaryIncludedORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				values := []interface{}{row.Id}
+				if reflect.ValueOf(row.Id).IsZero() {
+					values[0] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "primary_includeds" ("id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
 }
 
-func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2895,27 +16623,30 @@ func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == go_uuid.Nil {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryStringTypeORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryIncludedORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeReadFind); ok {
+	if db, err = applyPrimaryIncludedConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryStringTypeORM{}
+	ormResponse := PrimaryIncludedORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryStringTypeORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(PrimaryIncludedORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2924,17 +16655,54 @@ func DefaultReadPrimaryStringType(ctx context.Context, in *PrimaryStringType, db
 	return &pbResponse, err
 }
 
-type PrimaryStringTypeORMWithBeforeReadApplyQuery interface {
+type PrimaryIncludedORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithBeforeReadFind interface {
+type PrimaryIncludedORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterReadFind interface {
+type PrimaryIncludedORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) error {
+// DefaultReadPrimaryIncludedForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadPrimaryIncludedForUpdate(ctx context.Context, in *PrimaryIncluded, db *gorm.DB, wait types.LockWait) (*PrimaryIncluded, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == go_uuid.Nil {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := PrimaryIncludedORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) error {
+	return defaultDeletePrimaryIncluded(ctx, in, db, nil)
+}
+
+// DefaultDeletePrimaryIncludedWithResult is DefaultDeletePrimaryIncluded reporting the affected rows,
+// no affected rows means no PrimaryIncluded matched
+func DefaultDeletePrimaryIncludedWithResult(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeletePrimaryIncluded(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2942,104 +16710,125 @@ func DefaultDeletePrimaryStringType(ctx context.Context, in *PrimaryStringType,
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == go_uuid.Nil {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryStringTypeORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&PrimaryIncludedORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PrimaryStringTypeORMWithBeforeDelete_ interface {
+type PrimaryIncludedORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterDelete_ interface {
+type PrimaryIncludedORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryStringTypeSet(ctx context.Context, in []*PrimaryStringType, db *gorm.DB) error {
+func DefaultDeletePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []go_uuid.UUID{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == go_uuid.Nil {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryStringTypeORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&PrimaryIncludedORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PrimaryStringTypeORM{})).(PrimaryStringTypeORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PrimaryStringTypeORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryStringType, *gorm.DB) error
+type PrimaryIncludedORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePrimaryStringType clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryStringType, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultStrictUpdatePrimaryIncluded clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+	return defaultStrictUpdatePrimaryIncluded(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdatePrimaryIncludedWithResult is DefaultStrictUpdatePrimaryIncluded reporting the affected rows
+// and whether the PrimaryIncluded existed before the update
+func DefaultStrictUpdatePrimaryIncludedWithResult(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdatePrimaryIncluded(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB, result *types.WriteResult) (*PrimaryIncluded, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryStringType")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryIncluded")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &PrimaryStringTypeORM{}
+	lockedRow := &PrimaryIncludedORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
 	filterChild := ExternalChildORM{}
-	if ormObj.Id == "" {
+	if ormObj.Id == go_uuid.Nil {
 		return nil, errors.EmptyIdError
 	}
-	filterChild.PrimaryStringTypeId = new(string)
-	*filterChild.PrimaryStringTypeId = ormObj.Id
+	filterChild.PrimaryIncludedId = new(go_uuid.UUID)
+	*filterChild.PrimaryIncludedId = ormObj.Id
 	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3054,51 +16843,46 @@ func DefaultStrictUpdatePrimaryStringType(ctx context.Context, in *PrimaryString
 	return &pbResponse, err
 }
 
-type PrimaryStringTypeORMWithBeforeStrictUpdateCleanup interface {
+type PrimaryIncludedORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithBeforeStrictUpdateSave interface {
+type PrimaryIncludedORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeORMWithAfterStrictUpdateSave interface {
+type PrimaryIncludedORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPrimaryStringType executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultPatchPrimaryIncluded executes a basic gorm update call with patch behavior
+func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryStringType
+	var pbObj PrimaryIncluded
 	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadPrimaryStringType(ctx, &PrimaryStringType{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryStringType(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryStringTypeWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryStringType(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdatePrimaryIncluded(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryStringTypeWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(PrimaryIncludedWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -3106,28 +16890,28 @@ func DefaultPatchPrimaryStringType(ctx context.Context, in *PrimaryStringType, u
 	return pbResponse, nil
 }
 
-type PrimaryStringTypeWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PrimaryIncludedWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryStringTypeWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryStringType, *field_mask.FieldMask, *gorm.DB) error
+type PrimaryIncludedWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryStringType executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStringType, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryStringType, error) {
+// DefaultPatchSetPrimaryIncluded executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPrimaryIncluded(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryIncluded, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*PrimaryStringType, 0, len(objects))
+	results := make([]*PrimaryIncluded, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryStringType(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchPrimaryIncluded(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -3138,8 +16922,8 @@ func DefaultPatchSetPrimaryStringType(ctx context.Context, objects []*PrimaryStr
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPrimaryStringType patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *PrimaryStringType, patcher *PrimaryStringType, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryStringType, error) {
+// DefaultApplyFieldMaskPrimaryIncluded patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryIncluded, patcher *PrimaryIncluded, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryIncluded, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -3148,10 +16932,6 @@ func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *Primar
 	var err error
 	var updatedChild bool
 	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
 		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
 			updatedChild = true
 			if patcher.Child == nil {
@@ -3180,61 +16960,141 @@ func DefaultApplyFieldMaskPrimaryStringType(ctx context.Context, patchee *Primar
 	return patchee, nil
 }
 
-// DefaultListPrimaryStringType executes a gorm list call
-func DefaultListPrimaryStringType(ctx context.Context, db *gorm.DB) ([]*PrimaryStringType, error) {
-	in := PrimaryStringType{}
+// DefaultListPrimaryIncluded executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*PrimaryIncluded, error) {
+	in := PrimaryIncluded{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryIncludedORM{}, &PrimaryIncluded{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryIncludedConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []PrimaryIncludedORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PrimaryIncluded{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PrimaryIncludedORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryIncludedORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PrimaryIncludedORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PrimaryIncludedORM) error
+}
+
+// DefaultExplainListPrimaryIncluded returns the SELECT DefaultListPrimaryIncluded would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListPrimaryIncluded(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := PrimaryIncluded{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
-			return nil, err
+			return "", err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryStringTypeORM{}, &PrimaryStringType{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryIncludedORM{}, &PrimaryIncluded{}, nil, nil, nil, nil)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithBeforeListFind); ok {
+	if db, err = applyPrimaryIncludedConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
+			return "", err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []PrimaryStringTypeORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	return explain.SQL(db, &PrimaryIncludedORM{})
+}
+
+// PrimaryIncludedORMSelectable lists the columns accepted by DefaultSelectPrimaryIncluded
+var PrimaryIncludedORMSelectable = map[string]struct{}{
+	"id": {},
+}
+
+// DefaultSelectPrimaryIncluded runs the query of DefaultListPrimaryIncluded for the columns only, which
+// must be in PrimaryIncludedORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectPrimaryIncluded(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := PrimaryIncludedORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "primary_includeds."+column)
+	}
+	in := PrimaryIncluded{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryStringTypeORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryStringType{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryIncludedORM{}, &PrimaryIncluded{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyPrimaryIncludedConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
-}
-
-type PrimaryStringTypeORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryStringTypeORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryStringTypeORM) error
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&PrimaryIncludedORM{}).Select(selected).Rows()
 }
 
-// DefaultCreateTestTag executes a basic gorm create call
-func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultCreateLedgerEntry executes a basic gorm create call
+func DefaultCreateLedgerEntry(ctx context.Context, in *LedgerEntry, db *gorm.DB) (*LedgerEntry, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3242,15 +17102,16 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3259,14 +17120,121 @@ func DefaultCreateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestT
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeCreate_ interface {
+type LedgerEntryORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterCreate_ interface {
+type LedgerEntryORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
+// DefaultCopyFromLedgerEntry inserts the objects with a COPY into the ledger_entries table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromLedgerEntry(ctx context.Context, in []*LedgerEntry, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	now := time.Now()
+	rows := make([]LedgerEntryORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into ledger_entries needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("ledger_entries", "amount", "created_at", "memo"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Amount, row.CreatedAt, row.Memo); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// LedgerEntryORMMaxBatchSize is the most rows of an INSERT of DefaultCreateLedgerEntrySet,
+// postgres takes 65535 bind parameters in a statement and a row has 3
+const LedgerEntryORMMaxBatchSize = 21845
+
+// DefaultCreateLedgerEntrySet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most LedgerEntryORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateLedgerEntrySet(ctx context.Context, in []*LedgerEntry, db *gorm.DB, batchSize int) ([]*LedgerEntry, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > LedgerEntryORMMaxBatchSize {
+		batchSize = LedgerEntryORMMaxBatchSize
+	}
+	now := time.Now()
+	rows := make([]LedgerEntryORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*LedgerEntryORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?)")
+				args = append(args, row.Amount, row.CreatedAt, row.Memo)
+			}
+			var stored []*LedgerEntryORM
+			if err := tx.Raw(`INSERT INTO "ledger_entries" ("amount", "created_at", "memo") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return LedgerEntryORMSliceToPB(ctx, created)
+}
+
+func DefaultReadLedgerEntry(ctx context.Context, in *LedgerEntry, db *gorm.DB) (*LedgerEntry, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3274,27 +17242,30 @@ func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestTagORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LedgerEntryORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeReadFind); ok {
+	if db, err = applyLedgerEntryConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestTagORM{}
+	ormResponse := LedgerEntryORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestTagORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(LedgerEntryORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3303,17 +17274,54 @@ func DefaultReadTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeReadApplyQuery interface {
+type LedgerEntryORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithBeforeReadFind interface {
+type LedgerEntryORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterReadFind interface {
+type LedgerEntryORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
+// DefaultReadLedgerEntryForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadLedgerEntryForUpdate(ctx context.Context, in *LedgerEntry, db *gorm.DB, wait types.LockWait) (*LedgerEntry, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := LedgerEntryORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteLedgerEntry(ctx context.Context, in *LedgerEntry, db *gorm.DB) error {
+	return defaultDeleteLedgerEntry(ctx, in, db, nil)
+}
+
+// DefaultDeleteLedgerEntryWithResult is DefaultDeleteLedgerEntry reporting the affected rows,
+// no affected rows means no LedgerEntry matched
+func DefaultDeleteLedgerEntryWithResult(ctx context.Context, in *LedgerEntry, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteLedgerEntry(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteLedgerEntry(ctx context.Context, in *LedgerEntry, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -3321,235 +17329,413 @@ func DefaultDeleteTestTag(ctx context.Context, in *TestTag, db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestTagORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&LedgerEntryORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestTagORMWithBeforeDelete_ interface {
+type LedgerEntryORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterDelete_ interface {
+type LedgerEntryORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestTagSet(ctx context.Context, in []*TestTag, db *gorm.DB) error {
+func DefaultDeleteLedgerEntrySet(ctx context.Context, in []*LedgerEntry, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&LedgerEntryORM{})).(LedgerEntryORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestTagORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&LedgerEntryORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestTagORM{})).(TestTagORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&LedgerEntryORM{})).(LedgerEntryORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestTagORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestTag, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestTag, *gorm.DB) error
+type LedgerEntryORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*LedgerEntry, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerEntryORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*LedgerEntry, *gorm.DB) error
+}
+
+// DefaultApplyFieldMaskLedgerEntry patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLedgerEntry(ctx context.Context, patchee *LedgerEntry, patcher *LedgerEntry, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*LedgerEntry, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Amount" {
+			patchee.Amount = patcher.Amount
+			continue
+		}
+		if f == prefix+"Memo" {
+			patchee.Memo = patcher.Memo
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListLedgerEntry executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListLedgerEntry(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*LedgerEntry, error) {
+	in := LedgerEntry{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LedgerEntryORM{}, &LedgerEntry{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyLedgerEntryConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []LedgerEntryORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*LedgerEntry{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type LedgerEntryORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerEntryORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LedgerEntryORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LedgerEntryORM) error
+}
+
+// DefaultExplainListLedgerEntry returns the SELECT DefaultListLedgerEntry would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListLedgerEntry(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := LedgerEntry{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LedgerEntryORM{}, &LedgerEntry{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyLedgerEntryConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &LedgerEntryORM{})
+}
+
+// LedgerEntryORMSelectable lists the columns accepted by DefaultSelectLedgerEntry
+var LedgerEntryORMSelectable = map[string]struct{}{
+	"amount":     {},
+	"created_at": {},
+	"id":         {},
+	"memo":       {},
 }
 
-// DefaultStrictUpdateTestTag clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestTag(ctx context.Context, in *TestTag, db *gorm.DB) (*TestTag, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestTag")
+// DefaultSelectLedgerEntry runs the query of DefaultListLedgerEntry for the columns only, which
+// must be in LedgerEntryORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectLedgerEntry(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := LedgerEntryORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "ledger_entries."+column)
 	}
+	in := LedgerEntry{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	var count int64
-	lockedRow := &TestTagORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterTestTagAssoc := TestTagAssociationORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LedgerEntryORM{}, &LedgerEntry{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
 	}
-	filterTestTagAssoc.TestTagId = new(string)
-	*filterTestTagAssoc.TestTagId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
+	if db, err = applyLedgerEntryConditionalPreloads(ctx, db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(LedgerEntryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&LedgerEntryORM{}).Select(selected).Rows()
+}
+
+// DefaultCreatePostalAddress executes a basic gorm create call
+func DefaultCreatePostalAddress(ctx context.Context, in *PostalAddress, db *gorm.DB) (*PostalAddress, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
 	}
+	pbResponse, err := ormObj.ToPB(ctx)
 	return &pbResponse, err
 }
 
-type TestTagORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+type PostalAddressORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+type PostalAddressORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestTag executes a basic gorm update call with patch behavior
-func DefaultPatchTestTag(ctx context.Context, in *TestTag, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestTag, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// DefaultCopyFromPostalAddress inserts the objects with a COPY into the postal_addresses table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromPostalAddress(ctx context.Context, in []*PostalAddress, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
 	}
-	var pbObj TestTag
-	var err error
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	rows := make([]PostalAddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
 		}
-	}
-	pbReadRes, err := DefaultReadTestTag(ctx, &TestTag{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
-			return nil, err
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
 		}
+		rows = append(rows, row)
 	}
-	if _, err := DefaultApplyFieldMaskTestTag(ctx, &pbObj, in, updateMask, "", db); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&pbObj).(TestTagWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into postal_addresses needs a *sql.Tx, got %T", tx.CommonDB())
 		}
-	}
-	pbResponse, err := DefaultStrictUpdateTestTag(ctx, &pbObj, db)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(pbResponse).(TestTagWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("postal_addresses", "city", "street", "postal_code"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.City, row.Street, row.Zip); err != nil {
+				stmt.Close()
+				return err
+			}
 		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
 	}
-	return pbResponse, nil
+	return int64(len(rows)), nil
 }
 
-type TestTagWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestTag, *field_mask.FieldMask, *gorm.DB) error
-}
+// PostalAddressORMMaxBatchSize is the most rows of an INSERT of DefaultCreatePostalAddressSet,
+// postgres takes 65535 bind parameters in a statement and a row has 3
+const PostalAddressORMMaxBatchSize = 21845
 
-// DefaultPatchSetTestTag executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestTag(ctx context.Context, objects []*TestTag, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestTag, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+// DefaultCreatePostalAddressSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most PostalAddressORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreatePostalAddressSet(ctx context.Context, in []*PostalAddress, db *gorm.DB, batchSize int) ([]*PostalAddress, error) {
+	if len(in) == 0 {
+		return nil, nil
 	}
-
-	results := make([]*TestTag, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestTag(ctx, patcher, updateMasks[i], db)
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > PostalAddressORMMaxBatchSize {
+		batchSize = PostalAddressORMMaxBatchSize
+	}
+	rows := make([]PostalAddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		rows = append(rows, row)
 	}
-
-	return results, nil
+	created := make([]*PostalAddressORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?)")
+				args = append(args, row.City, row.Street, row.Zip)
+			}
+			var stored []*PostalAddressORM
+			if err := tx.Raw(`INSERT INTO "postal_addresses" ("city", "street", "postal_code") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return PostalAddressORMSliceToPB(ctx, created)
 }
 
-// DefaultApplyFieldMaskTestTag patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher *TestTag, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTag, error) {
+// DefaultApplyFieldMaskPostalAddress patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPostalAddress(ctx context.Context, patchee *PostalAddress, patcher *PostalAddress, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PostalAddress, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	var updatedTestTagAssoc bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Street" {
+			patchee.Street = patcher.Street
 			continue
 		}
-		if !updatedTestTagAssoc && strings.HasPrefix(f, prefix+"TestTagAssoc.") {
-			updatedTestTagAssoc = true
-			if patcher.TestTagAssoc == nil {
-				patchee.TestTagAssoc = nil
-				continue
-			}
-			if patchee.TestTagAssoc == nil {
-				patchee.TestTagAssoc = &TestTagAssociation{}
-			}
-			if o, err := DefaultApplyFieldMaskTestTagAssociation(ctx, patchee.TestTagAssoc, patcher.TestTagAssoc, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"TestTagAssoc.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.TestTagAssoc = o
-			}
+		if f == prefix+"City" {
+			patchee.City = patcher.City
 			continue
 		}
-		if f == prefix+"TestTagAssoc" {
-			updatedTestTagAssoc = true
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if f == prefix+"Zip" {
+			patchee.Zip = patcher.Zip
 			continue
 		}
 	}
@@ -3559,39 +17745,42 @@ func DefaultApplyFieldMaskTestTag(ctx context.Context, patchee *TestTag, patcher
 	return patchee, nil
 }
 
-// DefaultListTestTag executes a gorm list call
-func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
-	in := TestTag{}
+// DefaultListPostalAddress executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListPostalAddress(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*PostalAddress, error) {
+	in := PostalAddress{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagORM{}, &TestTag{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PostalAddressORM{}, &PostalAddress{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithBeforeListFind); ok {
+	if db, err = applyPostalAddressConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []TestTagORM{}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	ormResponse := []PostalAddressORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestTag{}
+	pbResponse := []*PostalAddress{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -3602,18 +17791,94 @@ func DefaultListTestTag(ctx context.Context, db *gorm.DB) ([]*TestTag, error) {
 	return pbResponse, nil
 }
 
-type TestTagORMWithBeforeListApplyQuery interface {
+type PostalAddressORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithBeforeListFind interface {
+type PostalAddressORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTagORM) error
+type PostalAddressORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PostalAddressORM) error
 }
 
-// DefaultCreateTestAssocHandlerDefault executes a basic gorm create call
-func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultExplainListPostalAddress returns the SELECT DefaultListPostalAddress would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListPostalAddress(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := PostalAddress{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PostalAddressORM{}, &PostalAddress{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyPostalAddressConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return explain.SQL(db, &PostalAddressORM{})
+}
+
+// PostalAddressORMSelectable lists the columns accepted by DefaultSelectPostalAddress
+var PostalAddressORMSelectable = map[string]struct{}{
+	"city":        {},
+	"postal_code": {},
+	"street":      {},
+}
+
+// DefaultSelectPostalAddress runs the query of DefaultListPostalAddress for the columns only, which
+// must be in PostalAddressORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectPostalAddress(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := PostalAddressORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "postal_addresses."+column)
+	}
+	in := PostalAddress{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PostalAddressORM{}, &PostalAddress{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyPostalAddressConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PostalAddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return db.Model(&PostalAddressORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateWarehouse executes a basic gorm create call
+func DefaultCreateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3621,15 +17886,16 @@ func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHand
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3638,14 +17904,113 @@ func DefaultCreateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHand
 	return &pbResponse, err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeCreate_ interface {
+type WarehouseORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterCreate_ interface {
+type WarehouseORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultCopyFromWarehouse inserts the objects with a COPY into the warehouses table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromWarehouse(ctx context.Context, in []*Warehouse, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]WarehouseORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into warehouses needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("warehouses", "address_city", "address_street", "address_postal_code", "name"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Address.City, row.Address.Street, row.Address.Zip, row.Name); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// WarehouseORMMaxBatchSize is the most rows of an INSERT of DefaultCreateWarehouseSet,
+// postgres takes 65535 bind parameters in a statement and a row has 4
+const WarehouseORMMaxBatchSize = 16383
+
+// DefaultCreateWarehouseSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most WarehouseORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateWarehouseSet(ctx context.Context, in []*Warehouse, db *gorm.DB, batchSize int) ([]*Warehouse, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > WarehouseORMMaxBatchSize {
+		batchSize = WarehouseORMMaxBatchSize
+	}
+	rows := make([]WarehouseORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*WarehouseORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*4)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?, ?)")
+				args = append(args, row.Address.City, row.Address.Street, row.Address.Zip, row.Name)
+			}
+			var stored []*WarehouseORM
+			if err := tx.Raw(`INSERT INTO "warehouses" ("address_city", "address_street", "address_postal_code", "name") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return WarehouseORMSliceToPB(ctx, created)
+}
+
+func DefaultReadWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -3653,27 +18018,30 @@ func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandle
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerDefaultORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &WarehouseORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeReadFind); ok {
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerDefaultORM{}
+	ormResponse := WarehouseORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerDefaultORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(WarehouseORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -3682,126 +18050,230 @@ func DefaultReadTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandle
 	return &pbResponse, err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeReadApplyQuery interface {
+type WarehouseORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithBeforeReadFind interface {
+type WarehouseORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterReadFind interface {
+type WarehouseORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) error {
+// DefaultReadWarehouseForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadWarehouseForUpdate(ctx context.Context, in *Warehouse, db *gorm.DB, wait types.LockWait) (*Warehouse, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := WarehouseORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) error {
+	return defaultDeleteWarehouse(ctx, in, db, nil)
+}
+
+// DefaultDeleteWarehouseWithResult is DefaultDeleteWarehouse reporting the affected rows,
+// no affected rows means no Warehouse matched
+func DefaultDeleteWarehouseWithResult(ctx context.Context, in *Warehouse, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteWarehouse(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	db, historyTxn, err := txn.Begin(ctx, db, nil)
+	if err != nil {
+		return err
+	}
+	defer historyTxn.Rollback()
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeDelete_); ok {
+	prior := WarehouseORM{}
+	if err = db.Where(&ormObj).First(&prior).Error; err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	priorFound := err == nil
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerDefaultORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&WarehouseORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if priorFound {
+		if err = writeWarehouseHistory(db, &prior, "delete"); err != nil {
+			return err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
+	if err != nil {
+		return err
+	}
+	if err = historyTxn.Commit(); err != nil {
+		return err
+	}
 	return err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeDelete_ interface {
+type WarehouseORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterDelete_ interface {
+type WarehouseORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerDefaultSet(ctx context.Context, in []*TestAssocHandlerDefault, db *gorm.DB) error {
+func DefaultDeleteWarehouseSet(ctx context.Context, in []*Warehouse, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	db, historyTxn, err := txn.Begin(ctx, db, nil)
+	if err != nil {
+		return err
+	}
+	defer historyTxn.Rollback()
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&WarehouseORM{})).(WarehouseORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerDefaultORM{}).Error
+	priors := []WarehouseORM{}
+	if err = db.Where("id in (?)", keys).Find(&priors).Error; err != nil {
+		return err
+	}
+	err = db.Where("id in (?)", keys).Delete(&WarehouseORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerDefaultORM{})).(TestAssocHandlerDefaultORMWithAfterDeleteSet); ok {
+	for i := range priors {
+		prior := &priors[i]
+		if err = writeWarehouseHistory(db, prior, "delete"); err != nil {
+			return err
+		}
+	}
+	if hook, ok := (interface{}(&WarehouseORM{})).(WarehouseORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
+	if err != nil {
+		return err
+	}
+	if err = historyTxn.Commit(); err != nil {
+		return err
+	}
 	return err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) (*gorm.DB, error)
+type WarehouseORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Warehouse, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerDefault, *gorm.DB) error
+type WarehouseORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Warehouse, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerDefault clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultStrictUpdateWarehouse clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
+	return defaultStrictUpdateWarehouse(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateWarehouseWithResult is DefaultStrictUpdateWarehouse reporting the affected rows
+// and whether the Warehouse existed before the update
+func DefaultStrictUpdateWarehouseWithResult(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateWarehouse(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB, result *types.WriteResult) (*Warehouse, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerDefault")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWarehouse")
+	}
+	db, historyTxn, err := txn.Begin(ctx, db, nil)
+	if err != nil {
+		return nil, err
 	}
+	defer historyTxn.Rollback()
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestAssocHandlerDefaultORM{}
+	lockedRow := &WarehouseORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterTestTagAssoc := TestTagAssociationORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
-	}
-	filterTestTagAssoc.TestAssocHandlerDefaultId = new(string)
-	*filterTestTagAssoc.TestAssocHandlerDefaultId = ormObj.Id
-	if err = db.Where(filterTestTagAssoc).Delete(TestTagAssociationORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if count > 0 {
+		if err = writeWarehouseHistory(db, lockedRow, "update"); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
+	if err = historyTxn.Commit(); err != nil {
+		return nil, err
+	}
 	pbResponse, err := ormObj.ToPB(ctx)
 	if err != nil {
 		return nil, err
@@ -3812,51 +18284,51 @@ func DefaultStrictUpdateTestAssocHandlerDefault(ctx context.Context, in *TestAss
 	return &pbResponse, err
 }
 
-type TestAssocHandlerDefaultORMWithBeforeStrictUpdateCleanup interface {
+type WarehouseORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithBeforeStrictUpdateSave interface {
+type WarehouseORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterStrictUpdateSave interface {
+type WarehouseORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerDefault executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultPatchWarehouse executes a basic gorm update call with patch behavior
+func DefaultPatchWarehouse(ctx context.Context, in *Warehouse, updateMask *field_mask.FieldMask, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerDefault
+	var pbObj Warehouse
 	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(WarehouseWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerDefault(ctx, &TestAssocHandlerDefault{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadWarehouse(ctx, &Warehouse{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(WarehouseWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerDefault(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskWarehouse(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerDefaultWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(WarehouseWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerDefault(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateWarehouse(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerDefaultWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(WarehouseWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -3864,28 +18336,28 @@ func DefaultPatchTestAssocHandlerDefault(ctx context.Context, in *TestAssocHandl
 	return pbResponse, nil
 }
 
-type TestAssocHandlerDefaultWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerDefault, *field_mask.FieldMask, *gorm.DB) error
+type WarehouseWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerDefault executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*TestAssocHandlerDefault, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
+// DefaultPatchSetWarehouse executes a bulk gorm update call with patch behavior
+func DefaultPatchSetWarehouse(ctx context.Context, objects []*Warehouse, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Warehouse, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*TestAssocHandlerDefault, 0, len(objects))
+	results := make([]*Warehouse, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerDefault(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchWarehouse(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -3896,21 +18368,55 @@ func DefaultPatchSetTestAssocHandlerDefault(ctx context.Context, objects []*Test
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerDefault patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *TestAssocHandlerDefault, patcher *TestAssocHandlerDefault, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerDefault, error) {
+// DefaultHistoryOfWarehouse returns the prior versions of the Warehouse of the id from
+// the oldest, the current version is the row itself. For a sharded type db
+// is the handle of the shard of the row.
+func DefaultHistoryOfWarehouse(ctx context.Context, db *gorm.DB, id uint64) ([]*WarehouseHistoryORM, error) {
+	db = db.Unscoped().Where("id = ?", id)
+	versions := []*WarehouseHistoryORM{}
+	if err := db.Order("valid_to, history_id").Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// DefaultApplyFieldMaskWarehouse patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWarehouse(ctx context.Context, patchee *Warehouse, patcher *Warehouse, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Warehouse, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	for _, f := range updateMask.Paths {
+	var updatedAddress bool
+	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if !updatedAddress && strings.HasPrefix(f, prefix+"Address.") {
+			updatedAddress = true
+			if patcher.Address == nil {
+				patchee.Address = nil
+				continue
+			}
+			if patchee.Address == nil {
+				patchee.Address = &PostalAddress{}
+			}
+			if o, err := DefaultApplyFieldMaskPostalAddress(ctx, patchee.Address, patcher.Address, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Address.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Address = o
+			}
+			continue
+		}
+		if f == prefix+"Address" {
+			updatedAddress = true
+			patchee.Address = patcher.Address
 			continue
 		}
 	}
@@ -3920,39 +18426,43 @@ func DefaultApplyFieldMaskTestAssocHandlerDefault(ctx context.Context, patchee *
 	return patchee, nil
 }
 
-// DefaultListTestAssocHandlerDefault executes a gorm list call
-func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerDefault, error) {
-	in := TestAssocHandlerDefault{}
+// DefaultListWarehouse executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListWarehouse(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Warehouse, error) {
+	in := Warehouse{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerDefaultORM{}, &TestAssocHandlerDefault{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &WarehouseORM{}, &Warehouse{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithBeforeListFind); ok {
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []TestAssocHandlerDefaultORM{}
+	ormResponse := []WarehouseORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerDefaultORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerDefault{}
+	pbResponse := []*Warehouse{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -3963,78 +18473,275 @@ func DefaultListTestAssocHandlerDefault(ctx context.Context, db *gorm.DB) ([]*Te
 	return pbResponse, nil
 }
 
-type TestAssocHandlerDefaultORMWithBeforeListApplyQuery interface {
+type WarehouseORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithBeforeListFind interface {
+type WarehouseORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerDefaultORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerDefaultORM) error
+type WarehouseORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WarehouseORM) error
+}
+
+// DefaultExplainListWarehouse returns the SELECT DefaultListWarehouse would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListWarehouse(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Warehouse{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &WarehouseORM{}, &Warehouse{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &WarehouseORM{})
+}
+
+// WarehouseORMSelectable lists the columns accepted by DefaultSelectWarehouse
+var WarehouseORMSelectable = map[string]struct{}{
+	"address_city":        {},
+	"address_postal_code": {},
+	"address_street":      {},
+	"id":                  {},
+	"name":                {},
+}
+
+// DefaultSelectWarehouse runs the query of DefaultListWarehouse for the columns only, which
+// must be in WarehouseORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectWarehouse(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := WarehouseORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "warehouses."+column)
+	}
+	in := Warehouse{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &WarehouseORM{}, &Warehouse{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&WarehouseORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateShardedNote executes a basic gorm create call
+func DefaultCreateShardedNote(ctx context.Context, in *ShardedNote, db *gorm.DB) (*ShardedNote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		shard, err := ResolveNoteShard(ctx, db, in.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		db = shard
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ShardedNoteORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ShardedNoteORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromShardedNote inserts the objects with a COPY into the sharded_notes table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromShardedNote(ctx context.Context, in []*ShardedNote, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]ShardedNoteORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into sharded_notes needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("sharded_notes", "body", "tenant_id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Body, row.TenantId); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
 }
 
-// DefaultCreateTestAssocHandlerReplace executes a basic gorm create call
-func DefaultCreateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// ShardedNoteORMMaxBatchSize is the most rows of an INSERT of DefaultCreateShardedNoteSet,
+// postgres takes 65535 bind parameters in a statement and a row has 2
+const ShardedNoteORMMaxBatchSize = 32767
+
+// DefaultCreateShardedNoteSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most ShardedNoteORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateShardedNoteSet(ctx context.Context, in []*ShardedNote, db *gorm.DB, batchSize int) ([]*ShardedNote, error) {
+	if len(in) == 0 {
+		return nil, nil
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if batchSize <= 0 {
+		batchSize = 500
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if batchSize > ShardedNoteORMMaxBatchSize {
+		batchSize = ShardedNoteORMMaxBatchSize
+	}
+	rows := make([]ShardedNoteORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	created := make([]*ShardedNoteORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?)")
+				args = append(args, row.Body, row.TenantId)
+			}
+			var stored []*ShardedNoteORM
+			if err := tx.Raw(`INSERT INTO "sharded_notes" ("body", "tenant_id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type TestAssocHandlerReplaceORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerReplaceORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	return ShardedNoteORMSliceToPB(ctx, created)
 }
 
-func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+func DefaultReadShardedNote(ctx context.Context, in *ShardedNote, db *gorm.DB) (*ShardedNote, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		shard, err := ResolveNoteShard(ctx, db, in.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		db = shard
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerReplaceORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ShardedNoteORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeReadFind); ok {
+	if db, err = applyShardedNoteConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerReplaceORM{}
+	ormResponse := ShardedNoteORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerReplaceORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(ShardedNoteORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4043,117 +18750,185 @@ func DefaultReadTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandle
 	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeReadApplyQuery interface {
+type ShardedNoteORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeReadFind interface {
+type ShardedNoteORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterReadFind interface {
+type ShardedNoteORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) error {
+// DefaultReadShardedNoteForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadShardedNoteForUpdate(ctx context.Context, in *ShardedNote, db *gorm.DB, wait types.LockWait) (*ShardedNote, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := ShardedNoteORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteShardedNote(ctx context.Context, in *ShardedNote, db *gorm.DB) error {
+	return defaultDeleteShardedNote(ctx, in, db, nil)
+}
+
+// DefaultDeleteShardedNoteWithResult is DefaultDeleteShardedNote reporting the affected rows,
+// no affected rows means no ShardedNote matched
+func DefaultDeleteShardedNoteWithResult(ctx context.Context, in *ShardedNote, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteShardedNote(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteShardedNote(ctx context.Context, in *ShardedNote, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		shard, err := ResolveNoteShard(ctx, db, in.GetTenantId())
+		if err != nil {
+			return err
+		}
+		db = shard
+	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerReplaceORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&ShardedNoteORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeDelete_ interface {
+type ShardedNoteORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterDelete_ interface {
+type ShardedNoteORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerReplaceSet(ctx context.Context, in []*TestAssocHandlerReplace, db *gorm.DB) error {
+func DefaultDeleteShardedNoteSet(ctx context.Context, in []*ShardedNote, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ShardedNoteORM{})).(ShardedNoteORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerReplaceORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ShardedNoteORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerReplaceORM{})).(TestAssocHandlerReplaceORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ShardedNoteORM{})).(ShardedNoteORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) (*gorm.DB, error)
+type ShardedNoteORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ShardedNote, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerReplace, *gorm.DB) error
+type ShardedNoteORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ShardedNote, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerReplace clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultStrictUpdateShardedNote clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateShardedNote(ctx context.Context, in *ShardedNote, db *gorm.DB) (*ShardedNote, error) {
+	return defaultStrictUpdateShardedNote(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateShardedNoteWithResult is DefaultStrictUpdateShardedNote reporting the affected rows
+// and whether the ShardedNote existed before the update
+func DefaultStrictUpdateShardedNoteWithResult(ctx context.Context, in *ShardedNote, db *gorm.DB) (*ShardedNote, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateShardedNote(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateShardedNote(ctx context.Context, in *ShardedNote, db *gorm.DB, result *types.WriteResult) (*ShardedNote, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerReplace")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateShardedNote")
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		shard, err := ResolveNoteShard(ctx, db, in.GetTenantId())
+		if err != nil {
+			return nil, err
+		}
+		db = shard
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestAssocHandlerReplaceORM{}
+	lockedRow := &ShardedNoteORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Replace(ormObj.TestTagAssoc).Error; err != nil {
-		return nil, err
-	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4168,51 +18943,51 @@ func DefaultStrictUpdateTestAssocHandlerReplace(ctx context.Context, in *TestAss
 	return &pbResponse, err
 }
 
-type TestAssocHandlerReplaceORMWithBeforeStrictUpdateCleanup interface {
+type ShardedNoteORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeStrictUpdateSave interface {
+type ShardedNoteORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterStrictUpdateSave interface {
+type ShardedNoteORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerReplace executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultPatchShardedNote executes a basic gorm update call with patch behavior
+func DefaultPatchShardedNote(ctx context.Context, in *ShardedNote, updateMask *field_mask.FieldMask, db *gorm.DB) (*ShardedNote, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerReplace
+	var pbObj ShardedNote
 	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ShardedNoteWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerReplace(ctx, &TestAssocHandlerReplace{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadShardedNote(ctx, &ShardedNote{Id: in.GetId(), TenantId: in.GetTenantId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(ShardedNoteWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerReplace(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskShardedNote(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerReplaceWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ShardedNoteWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerReplace(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateShardedNote(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerReplaceWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ShardedNoteWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -4220,28 +18995,28 @@ func DefaultPatchTestAssocHandlerReplace(ctx context.Context, in *TestAssocHandl
 	return pbResponse, nil
 }
 
-type TestAssocHandlerReplaceWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ShardedNoteWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ShardedNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ShardedNoteWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ShardedNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ShardedNoteWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ShardedNote, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerReplace, *field_mask.FieldMask, *gorm.DB) error
+type ShardedNoteWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ShardedNote, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerReplace executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*TestAssocHandlerReplace, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
+// DefaultPatchSetShardedNote executes a bulk gorm update call with patch behavior
+func DefaultPatchSetShardedNote(ctx context.Context, objects []*ShardedNote, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ShardedNote, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*TestAssocHandlerReplace, 0, len(objects))
+	results := make([]*ShardedNote, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerReplace(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchShardedNote(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -4252,8 +19027,51 @@ func DefaultPatchSetTestAssocHandlerReplace(ctx context.Context, objects []*Test
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerReplace patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *TestAssocHandlerReplace, patcher *TestAssocHandlerReplace, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerReplace, error) {
+// DefaultUpdateShardedNoteByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, shard key and by-fields cannot be in updateMask.
+func DefaultUpdateShardedNoteByIds(ctx context.Context, ids []uint64, in *ShardedNote, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Body":
+			columns["body"] = ormObj.Body
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&ShardedNoteORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskShardedNote patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskShardedNote(ctx context.Context, patchee *ShardedNote, patcher *ShardedNote, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ShardedNote, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -4265,8 +19083,12 @@ func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if f == prefix+"TenantId" {
+			patchee.TenantId = patcher.TenantId
+			continue
+		}
+		if f == prefix+"Body" {
+			patchee.Body = patcher.Body
 			continue
 		}
 	}
@@ -4276,39 +19098,43 @@ func DefaultApplyFieldMaskTestAssocHandlerReplace(ctx context.Context, patchee *
 	return patchee, nil
 }
 
-// DefaultListTestAssocHandlerReplace executes a gorm list call
-func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerReplace, error) {
-	in := TestAssocHandlerReplace{}
+// DefaultListShardedNote executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListShardedNote(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*ShardedNote, error) {
+	in := ShardedNote{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerReplaceORM{}, &TestAssocHandlerReplace{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ShardedNoteORM{}, &ShardedNote{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithBeforeListFind); ok {
+	if db, err = applyShardedNoteConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []TestAssocHandlerReplaceORM{}
+	ormResponse := []ShardedNoteORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerReplaceORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerReplace{}
+	pbResponse := []*ShardedNote{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -4319,18 +19145,96 @@ func DefaultListTestAssocHandlerReplace(ctx context.Context, db *gorm.DB) ([]*Te
 	return pbResponse, nil
 }
 
-type TestAssocHandlerReplaceORMWithBeforeListApplyQuery interface {
+type ShardedNoteORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithBeforeListFind interface {
+type ShardedNoteORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerReplaceORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerReplaceORM) error
+type ShardedNoteORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ShardedNoteORM) error
 }
 
-// DefaultCreateTestAssocHandlerClear executes a basic gorm create call
-func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// DefaultExplainListShardedNote returns the SELECT DefaultListShardedNote would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListShardedNote(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := ShardedNote{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ShardedNoteORM{}, &ShardedNote{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyShardedNoteConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &ShardedNoteORM{})
+}
+
+// ShardedNoteORMSelectable lists the columns accepted by DefaultSelectShardedNote
+var ShardedNoteORMSelectable = map[string]struct{}{
+	"body":      {},
+	"id":        {},
+	"tenant_id": {},
+}
+
+// DefaultSelectShardedNote runs the query of DefaultListShardedNote for the columns only, which
+// must be in ShardedNoteORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectShardedNote(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := ShardedNoteORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "sharded_notes."+column)
+	}
+	in := ShardedNote{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ShardedNoteORM{}, &ShardedNote{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyShardedNoteConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ShardedNoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&ShardedNoteORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateFolder executes a basic gorm create call
+func DefaultCreateFolder(ctx context.Context, in *Folder, db *gorm.DB) (*Folder, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4338,31 +19242,131 @@ func DefaultCreateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandle
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type FolderORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type FolderORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromFolder inserts the objects with a COPY into the folders table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromFolder(ctx context.Context, in []*Folder, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]FolderORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into folders needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("folders", "name"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Name); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// FolderORMMaxBatchSize is the most rows of an INSERT of DefaultCreateFolderSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const FolderORMMaxBatchSize = 65535
+
+// DefaultCreateFolderSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most FolderORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateFolderSet(ctx context.Context, in []*Folder, db *gorm.DB, batchSize int) ([]*Folder, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > FolderORMMaxBatchSize {
+		batchSize = FolderORMMaxBatchSize
+	}
+	rows := make([]FolderORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*FolderORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				tuples = append(tuples, "(?)")
+				args = append(args, row.Name)
+			}
+			var stored []*FolderORM
+			if err := tx.Raw(`INSERT INTO "folders" ("name") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type TestAssocHandlerClearORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestAssocHandlerClearORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	return FolderORMSliceToPB(ctx, created)
 }
 
-func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+func DefaultReadFolder(ctx context.Context, in *Folder, db *gorm.DB) (*Folder, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4370,27 +19374,30 @@ func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerC
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerClearORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &FolderORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeReadFind); ok {
+	if db, err = applyFolderConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerClearORM{}
+	ormResponse := FolderORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerClearORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(FolderORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4399,17 +19406,54 @@ func DefaultReadTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerC
 	return &pbResponse, err
 }
 
-type TestAssocHandlerClearORMWithBeforeReadApplyQuery interface {
+type FolderORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithBeforeReadFind interface {
+type FolderORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterReadFind interface {
+type FolderORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) error {
+// DefaultReadFolderForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadFolderForUpdate(ctx context.Context, in *Folder, db *gorm.DB, wait types.LockWait) (*Folder, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := FolderORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteFolder(ctx context.Context, in *Folder, db *gorm.DB) error {
+	return defaultDeleteFolder(ctx, in, db, nil)
+}
+
+// DefaultDeleteFolderWithResult is DefaultDeleteFolder reporting the affected rows,
+// no affected rows means no Folder matched
+func DefaultDeleteFolderWithResult(ctx context.Context, in *Folder, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteFolder(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteFolder(ctx context.Context, in *Folder, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -4417,99 +19461,125 @@ func DefaultDeleteTestAssocHandlerClear(ctx context.Context, in *TestAssocHandle
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerClearORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&FolderORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestAssocHandlerClearORMWithBeforeDelete_ interface {
+type FolderORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterDelete_ interface {
+type FolderORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerClearSet(ctx context.Context, in []*TestAssocHandlerClear, db *gorm.DB) error {
+func DefaultDeleteFolderSet(ctx context.Context, in []*Folder, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&FolderORM{})).(FolderORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerClearORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&FolderORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerClearORM{})).(TestAssocHandlerClearORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&FolderORM{})).(FolderORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestAssocHandlerClearORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) (*gorm.DB, error)
+type FolderORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Folder, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerClear, *gorm.DB) error
+type FolderORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Folder, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerClear clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// DefaultStrictUpdateFolder clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateFolder(ctx context.Context, in *Folder, db *gorm.DB) (*Folder, error) {
+	return defaultStrictUpdateFolder(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateFolderWithResult is DefaultStrictUpdateFolder reporting the affected rows
+// and whether the Folder existed before the update
+func DefaultStrictUpdateFolderWithResult(ctx context.Context, in *Folder, db *gorm.DB) (*Folder, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateFolder(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateFolder(ctx context.Context, in *Folder, db *gorm.DB, result *types.WriteResult) (*Folder, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerClear")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateFolder")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestAssocHandlerClearORM{}
+	lockedRow := &FolderORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Clear().Error; err != nil {
+	filterDocuments := DocumentORM{}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	filterDocuments.FolderId = new(uint64)
+	*filterDocuments.FolderId = ormObj.Id
+	if err = db.Where(filterDocuments).Delete(DocumentORM{}).Error; err != nil {
 		return nil, err
 	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4524,51 +19594,51 @@ func DefaultStrictUpdateTestAssocHandlerClear(ctx context.Context, in *TestAssoc
 	return &pbResponse, err
 }
 
-type TestAssocHandlerClearORMWithBeforeStrictUpdateCleanup interface {
+type FolderORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithBeforeStrictUpdateSave interface {
+type FolderORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterStrictUpdateSave interface {
+type FolderORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerClear executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandlerClear, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// DefaultPatchFolder executes a basic gorm update call with patch behavior
+func DefaultPatchFolder(ctx context.Context, in *Folder, updateMask *field_mask.FieldMask, db *gorm.DB) (*Folder, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerClear
+	var pbObj Folder
 	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(FolderWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerClear(ctx, &TestAssocHandlerClear{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadFolder(ctx, &Folder{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(FolderWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerClear(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskFolder(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerClearWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(FolderWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerClear(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateFolder(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerClearWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(FolderWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -4576,28 +19646,28 @@ func DefaultPatchTestAssocHandlerClear(ctx context.Context, in *TestAssocHandler
 	return pbResponse, nil
 }
 
-type TestAssocHandlerClearWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type FolderWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type FolderWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type FolderWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerClear, *field_mask.FieldMask, *gorm.DB) error
+type FolderWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Folder, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerClear executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAssocHandlerClear, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
+// DefaultPatchSetFolder executes a bulk gorm update call with patch behavior
+func DefaultPatchSetFolder(ctx context.Context, objects []*Folder, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Folder, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*TestAssocHandlerClear, 0, len(objects))
+	results := make([]*Folder, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerClear(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchFolder(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -4608,8 +19678,51 @@ func DefaultPatchSetTestAssocHandlerClear(ctx context.Context, objects []*TestAs
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerClear patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *TestAssocHandlerClear, patcher *TestAssocHandlerClear, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerClear, error) {
+// DefaultUpdateFolderByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key and by-fields cannot be in updateMask.
+func DefaultUpdateFolderByIds(ctx context.Context, ids []uint64, in *Folder, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Name":
+			columns["name"] = ormObj.Name
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&FolderORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskFolder patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskFolder(ctx context.Context, patchee *Folder, patcher *Folder, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Folder, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -4621,8 +19734,12 @@ func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *Te
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Documents" {
+			patchee.Documents = patcher.Documents
 			continue
 		}
 	}
@@ -4632,40 +19749,45 @@ func DefaultApplyFieldMaskTestAssocHandlerClear(ctx context.Context, patchee *Te
 	return patchee, nil
 }
 
-// DefaultListTestAssocHandlerClear executes a gorm list call
-func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerClear, error) {
-	in := TestAssocHandlerClear{}
+// DefaultListFolder executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListFolder(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Folder, error) {
+	in := Folder{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerClearORM{}, &TestAssocHandlerClear{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &FolderORM{}, &Folder{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithBeforeListFind); ok {
+	if db, err = applyFolderConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []TestAssocHandlerClearORM{}
+	ormResponse := []FolderORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerClearORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(FolderORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerClear{}
-	for _, responseEntry := range ormResponse {
+	pbResponse := []*Folder{}
+	for i := range ormResponse {
+		responseEntry := &ormResponse[i]
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
 			return nil, err
@@ -4675,18 +19797,178 @@ func DefaultListTestAssocHandlerClear(ctx context.Context, db *gorm.DB) ([]*Test
 	return pbResponse, nil
 }
 
-type TestAssocHandlerClearORMWithBeforeListApplyQuery interface {
+type FolderORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithBeforeListFind interface {
+type FolderORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerClearORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerClearORM) error
+type FolderORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]FolderORM) error
 }
 
-// DefaultCreateTestAssocHandlerAppend executes a basic gorm create call
-func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultExplainListFolder returns the SELECT DefaultListFolder would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListFolder(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Folder{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &FolderORM{}, &Folder{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyFolderConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &FolderORM{})
+}
+
+// FolderORMSelectable lists the columns accepted by DefaultSelectFolder
+var FolderORMSelectable = map[string]struct{}{
+	"id":   {},
+	"name": {},
+}
+
+// DefaultSelectFolder runs the query of DefaultListFolder for the columns only, which
+// must be in FolderORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectFolder(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := FolderORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "folders."+column)
+	}
+	in := Folder{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &FolderORM{}, &Folder{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyFolderConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(FolderORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&FolderORM{}).Select(selected).Rows()
+}
+
+// DefaultReparentFolderDocuments moves the Document of childID to the Documents of the
+// Folder of newParentID, which has to exist. The Document is placed last among
+// its new siblings and the position it leaves is closed.
+func DefaultReparentFolderDocuments(ctx context.Context, db *gorm.DB, childID uint64, newParentID uint64) error {
+	if childID == 0 || newParentID == 0 {
+		return errors.EmptyIdError
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		var parents int
+		if err := tx.Model(&FolderORM{}).Where("id = ?", newParentID).Count(&parents).Error; err != nil {
+			return err
+		}
+		if parents == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		child := DocumentORM{}
+		if err := tx.Where("id = ?", childID).First(&child).Error; err != nil {
+			return err
+		}
+		if child.FolderId != nil && *child.FolderId == newParentID {
+			return nil
+		}
+		var siblings int
+		if err := tx.Model(&DocumentORM{}).Where("folder_id = ?", newParentID).Count(&siblings).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&DocumentORM{}).Where("id = ?", childID).UpdateColumns(map[string]interface{}{"folder_id": newParentID, "position": siblings}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&DocumentORM{}).Where("folder_id = ? AND position > ?", child.FolderId, child.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultReorderFolderDocuments sets the Position of the Documents of the Folder of
+// parentID to their index in childIDs, in one transaction. childIDs has to
+// list each of them once, otherwise it fails with errors.InvalidOrderError.
+func DefaultReorderFolderDocuments(ctx context.Context, db *gorm.DB, parentID uint64, childIDs []uint64) error {
+	if parentID == 0 {
+		return errors.EmptyIdError
+	}
+	listed := make(map[uint64]bool, len(childIDs))
+	for _, id := range childIDs {
+		if listed[id] {
+			return fmt.Errorf("%w: %v is listed twice", errors.InvalidOrderError, id)
+		}
+		listed[id] = true
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		var parents int
+		if err := tx.Model(&FolderORM{}).Where("id = ?", parentID).Count(&parents).Error; err != nil {
+			return err
+		}
+		if parents == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		var current []uint64
+		if err := tx.Model(&DocumentORM{}).Where("folder_id = ?", parentID).Pluck("id", &current).Error; err != nil {
+			return err
+		}
+		for _, id := range current {
+			if !listed[id] {
+				return fmt.Errorf("%w: %v is missing", errors.InvalidOrderError, id)
+			}
+		}
+		if len(current) != len(childIDs) {
+			return fmt.Errorf("%w: %d ids for %d Documents", errors.InvalidOrderError, len(childIDs), len(current))
+		}
+		for i, id := range childIDs {
+			if err := tx.Model(&DocumentORM{}).Where("id = ?", id).UpdateColumn("position", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultCreateDocument executes a basic gorm create call
+func DefaultCreateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4694,15 +19976,16 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4711,14 +19994,113 @@ func DefaultCreateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeCreate_ interface {
+type DocumentORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterCreate_ interface {
+type DocumentORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultCopyFromDocument inserts the objects with a COPY into the documents table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromDocument(ctx context.Context, in []*Document, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]DocumentORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into documents needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("documents", "deleted_at", "folder_id", "position", "title"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.DeletedAt, row.FolderId, row.Position, row.Title); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// DocumentORMMaxBatchSize is the most rows of an INSERT of DefaultCreateDocumentSet,
+// postgres takes 65535 bind parameters in a statement and a row has 4
+const DocumentORMMaxBatchSize = 16383
+
+// DefaultCreateDocumentSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most DocumentORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateDocumentSet(ctx context.Context, in []*Document, db *gorm.DB, batchSize int) ([]*Document, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > DocumentORMMaxBatchSize {
+		batchSize = DocumentORMMaxBatchSize
+	}
+	rows := make([]DocumentORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*DocumentORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*4)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?, ?)")
+				args = append(args, row.DeletedAt, row.FolderId, row.Position, row.Title)
+			}
+			var stored []*DocumentORM
+			if err := tx.Raw(`INSERT INTO "documents" ("deleted_at", "folder_id", "position", "title") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return DocumentORMSliceToPB(ctx, created)
+}
+
+func DefaultReadDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -4726,27 +20108,30 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TestAssocHandlerAppendORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &DocumentORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeReadFind); ok {
+	if db, err = applyDocumentConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := TestAssocHandlerAppendORM{}
+	ormResponse := DocumentORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(TestAssocHandlerAppendORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(DocumentORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4755,17 +20140,54 @@ func DefaultReadTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandler
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeReadApplyQuery interface {
+type DocumentORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeReadFind interface {
+type DocumentORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterReadFind interface {
+type DocumentORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) error {
+// DefaultReadDocumentForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadDocumentForUpdate(ctx context.Context, in *Document, db *gorm.DB, wait types.LockWait) (*Document, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := DocumentORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteDocument(ctx context.Context, in *Document, db *gorm.DB) error {
+	return defaultDeleteDocument(ctx, in, db, nil)
+}
+
+// DefaultDeleteDocumentWithResult is DefaultDeleteDocument reporting the affected rows,
+// no affected rows means no Document matched
+func DefaultDeleteDocumentWithResult(ctx context.Context, in *Document, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteDocument(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteDocument(ctx context.Context, in *Document, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -4773,99 +20195,116 @@ func DefaultDeleteTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandl
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&TestAssocHandlerAppendORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&DocumentORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type TestAssocHandlerAppendORMWithBeforeDelete_ interface {
+type DocumentORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterDelete_ interface {
+type DocumentORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteTestAssocHandlerAppendSet(ctx context.Context, in []*TestAssocHandlerAppend, db *gorm.DB) error {
+func DefaultDeleteDocumentSet(ctx context.Context, in []*Document, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&TestAssocHandlerAppendORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&DocumentORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&TestAssocHandlerAppendORM{})).(TestAssocHandlerAppendORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&DocumentORM{})).(DocumentORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type TestAssocHandlerAppendORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) (*gorm.DB, error)
+type DocumentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Document, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*TestAssocHandlerAppend, *gorm.DB) error
+type DocumentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Document, *gorm.DB) error
 }
 
-// DefaultStrictUpdateTestAssocHandlerAppend clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultStrictUpdateDocument clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB) (*Document, error) {
+	return defaultStrictUpdateDocument(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateDocumentWithResult is DefaultStrictUpdateDocument reporting the affected rows
+// and whether the Document existed before the update
+func DefaultStrictUpdateDocumentWithResult(ctx context.Context, in *Document, db *gorm.DB) (*Document, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateDocument(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateDocument(ctx context.Context, in *Document, db *gorm.DB, result *types.WriteResult) (*Document, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTestAssocHandlerAppend")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateDocument")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &TestAssocHandlerAppendORM{}
+	lockedRow := &DocumentORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Model(&ormObj).Association("TestTagAssoc").Append(ormObj.TestTagAssoc).Error; err != nil {
-		return nil, err
-	}
-	ormObj.TestTagAssoc = nil
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -4880,51 +20319,51 @@ func DefaultStrictUpdateTestAssocHandlerAppend(ctx context.Context, in *TestAsso
 	return &pbResponse, err
 }
 
-type TestAssocHandlerAppendORMWithBeforeStrictUpdateCleanup interface {
+type DocumentORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeStrictUpdateSave interface {
+type DocumentORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterStrictUpdateSave interface {
+type DocumentORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchTestAssocHandlerAppend executes a basic gorm update call with patch behavior
-func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultPatchDocument executes a basic gorm update call with patch behavior
+func DefaultPatchDocument(ctx context.Context, in *Document, updateMask *field_mask.FieldMask, db *gorm.DB) (*Document, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj TestAssocHandlerAppend
+	var pbObj Document
 	var err error
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadTestAssocHandlerAppend(ctx, &TestAssocHandlerAppend{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadDocument(ctx, &Document{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskTestAssocHandlerAppend(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskDocument(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(TestAssocHandlerAppendWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(DocumentWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateTestAssocHandlerAppend(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateDocument(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(TestAssocHandlerAppendWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(DocumentWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -4932,28 +20371,28 @@ func DefaultPatchTestAssocHandlerAppend(ctx context.Context, in *TestAssocHandle
 	return pbResponse, nil
 }
 
-type TestAssocHandlerAppendWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type DocumentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type DocumentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type DocumentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *TestAssocHandlerAppend, *field_mask.FieldMask, *gorm.DB) error
+type DocumentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Document, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetTestAssocHandlerAppend executes a bulk gorm update call with patch behavior
-func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestAssocHandlerAppend, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
+// DefaultPatchSetDocument executes a bulk gorm update call with patch behavior
+func DefaultPatchSetDocument(ctx context.Context, objects []*Document, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Document, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*TestAssocHandlerAppend, 0, len(objects))
+	results := make([]*Document, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchTestAssocHandlerAppend(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchDocument(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -4964,21 +20403,110 @@ func DefaultPatchSetTestAssocHandlerAppend(ctx context.Context, objects []*TestA
 	return results, nil
 }
 
-// DefaultApplyFieldMaskTestAssocHandlerAppend patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *TestAssocHandlerAppend, patcher *TestAssocHandlerAppend, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestAssocHandlerAppend, error) {
+// DefaultUpdateDocumentByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, DeletedAt and by-fields cannot be in updateMask.
+func DefaultUpdateDocumentByIds(ctx context.Context, ids []uint64, in *Document, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Title":
+			columns["title"] = ormObj.Title
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&DocumentORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskDocument patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskDocument(ctx context.Context, patchee *Document, patcher *Document, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Document, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	for _, f := range updateMask.Paths {
+	var updatedFolder bool
+	var updatedDeletedAt bool
+	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"TestTagAssoc" {
-			patchee.TestTagAssoc = patcher.TestTagAssoc
+		if f == prefix+"Title" {
+			patchee.Title = patcher.Title
+			continue
+		}
+		if !updatedFolder && strings.HasPrefix(f, prefix+"Folder.") {
+			updatedFolder = true
+			if patcher.Folder == nil {
+				patchee.Folder = nil
+				continue
+			}
+			if patchee.Folder == nil {
+				patchee.Folder = &Folder{}
+			}
+			if o, err := DefaultApplyFieldMaskFolder(ctx, patchee.Folder, patcher.Folder, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Folder.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Folder = o
+			}
+			continue
+		}
+		if f == prefix+"Folder" {
+			updatedFolder = true
+			patchee.Folder = patcher.Folder
+			continue
+		}
+		if !updatedDeletedAt && strings.HasPrefix(f, prefix+"DeletedAt.") {
+			if patcher.DeletedAt == nil {
+				patchee.DeletedAt = nil
+				continue
+			}
+			if patchee.DeletedAt == nil {
+				patchee.DeletedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"DeletedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.DeletedAt, patchee.DeletedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"DeletedAt" {
+			updatedDeletedAt = true
+			patchee.DeletedAt = patcher.DeletedAt
 			continue
 		}
 	}
@@ -4988,39 +20516,43 @@ func DefaultApplyFieldMaskTestAssocHandlerAppend(ctx context.Context, patchee *T
 	return patchee, nil
 }
 
-// DefaultListTestAssocHandlerAppend executes a gorm list call
-func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*TestAssocHandlerAppend, error) {
-	in := TestAssocHandlerAppend{}
+// DefaultListDocument executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListDocument(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Document, error) {
+	in := Document{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestAssocHandlerAppendORM{}, &TestAssocHandlerAppend{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &DocumentORM{}, &Document{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithBeforeListFind); ok {
+	if db, err = applyDocumentConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []TestAssocHandlerAppendORM{}
+	ormResponse := []DocumentORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestAssocHandlerAppendORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestAssocHandlerAppend{}
+	pbResponse := []*Document{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -5031,155 +20563,230 @@ func DefaultListTestAssocHandlerAppend(ctx context.Context, db *gorm.DB) ([]*Tes
 	return pbResponse, nil
 }
 
-type TestAssocHandlerAppendORMWithBeforeListApplyQuery interface {
+type DocumentORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithBeforeListFind interface {
+type DocumentORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestAssocHandlerAppendORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestAssocHandlerAppendORM) error
+type DocumentORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]DocumentORM) error
 }
 
-// DefaultCreateTestTagAssociation executes a basic gorm create call
-func DefaultCreateTestTagAssociation(ctx context.Context, in *TestTagAssociation, db *gorm.DB) (*TestTagAssociation, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultExplainListDocument returns the SELECT DefaultListDocument would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListDocument(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Document{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &DocumentORM{}, &Document{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if db, err = applyDocumentConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &DocumentORM{})
 }
 
-type TestTagAssociationORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TestTagAssociationORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// DocumentORMSelectable lists the columns accepted by DefaultSelectDocument
+var DocumentORMSelectable = map[string]struct{}{
+	"deleted_at": {},
+	"folder_id":  {},
+	"id":         {},
+	"position":   {},
+	"title":      {},
 }
 
-// DefaultApplyFieldMaskTestTagAssociation patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTestTagAssociation(ctx context.Context, patchee *TestTagAssociation, patcher *TestTagAssociation, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TestTagAssociation, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultSelectDocument runs the query of DefaultListDocument for the columns only, which
+// must be in DocumentORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectDocument(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"SomeField" {
-			patchee.SomeField = patcher.SomeField
-			continue
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := DocumentORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
 		}
+		selected = append(selected, "documents."+column)
 	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListTestTagAssociation executes a gorm list call
-func DefaultListTestTagAssociation(ctx context.Context, db *gorm.DB) ([]*TestTagAssociation, error) {
-	in := TestTagAssociation{}
+	in := Document{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TestTagAssociationORM{}, &TestTagAssociation{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &DocumentORM{}, &Document{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithBeforeListFind); ok {
+	if db, err = applyDocumentConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(DocumentORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	ormResponse := []TestTagAssociationORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&DocumentORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateImportedRecord executes a basic gorm create call
+func DefaultCreateImportedRecord(ctx context.Context, in *ImportedRecord, db *gorm.DB) (*ImportedRecord, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TestTagAssociationORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*TestTagAssociation{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-type TestTagAssociationORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+type ImportedRecordORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type TestTagAssociationORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+type ImportedRecordORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
-type TestTagAssociationORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TestTagAssociationORM) error
+
+// DefaultCopyFromImportedRecord inserts the objects with a COPY into the imported_records table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromImportedRecord(ctx context.Context, in []*ImportedRecord, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]ImportedRecordORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into imported_records needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("imported_records", "created_at", "payload", "updated_at"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.CreatedAt, row.Payload, row.UpdatedAt); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
 }
 
-// DefaultCreatePrimaryIncluded executes a basic gorm create call
-func DefaultCreatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// ImportedRecordORMMaxBatchSize is the most rows of an INSERT of DefaultCreateImportedRecordSet,
+// postgres takes 65535 bind parameters in a statement and a row has 3
+const ImportedRecordORMMaxBatchSize = 21845
+
+// DefaultCreateImportedRecordSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most ImportedRecordORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateImportedRecordSet(ctx context.Context, in []*ImportedRecord, db *gorm.DB, batchSize int) ([]*ImportedRecord, error) {
+	if len(in) == 0 {
+		return nil, nil
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if batchSize <= 0 {
+		batchSize = 500
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+	if batchSize > ImportedRecordORMMaxBatchSize {
+		batchSize = ImportedRecordORMMaxBatchSize
+	}
+	rows := make([]ImportedRecordORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
 			return nil, err
 		}
+		rows = append(rows, row)
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	created := make([]*ImportedRecordORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?)")
+				args = append(args, row.CreatedAt, row.Payload, row.UpdatedAt)
+			}
+			var stored []*ImportedRecordORM
+			if err := tx.Raw(`INSERT INTO "imported_records" ("created_at", "payload", "updated_at") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type PrimaryIncludedORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type PrimaryIncludedORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	return ImportedRecordORMSliceToPB(ctx, created)
 }
 
-func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+func DefaultReadImportedRecord(ctx context.Context, in *ImportedRecord, db *gorm.DB) (*ImportedRecord, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -5187,27 +20794,30 @@ func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *go
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PrimaryIncludedORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ImportedRecordORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeReadFind); ok {
+	if db, err = applyImportedRecordConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := PrimaryIncludedORM{}
+	ormResponse := ImportedRecordORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(PrimaryIncludedORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(ImportedRecordORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -5216,17 +20826,54 @@ func DefaultReadPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *go
 	return &pbResponse, err
 }
 
-type PrimaryIncludedORMWithBeforeReadApplyQuery interface {
+type ImportedRecordORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithBeforeReadFind interface {
+type ImportedRecordORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterReadFind interface {
+type ImportedRecordORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) error {
+// DefaultReadImportedRecordForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadImportedRecordForUpdate(ctx context.Context, in *ImportedRecord, db *gorm.DB, wait types.LockWait) (*ImportedRecord, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := ImportedRecordORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteImportedRecord(ctx context.Context, in *ImportedRecord, db *gorm.DB) error {
+	return defaultDeleteImportedRecord(ctx, in, db, nil)
+}
+
+// DefaultDeleteImportedRecordWithResult is DefaultDeleteImportedRecord reporting the affected rows,
+// no affected rows means no ImportedRecord matched
+func DefaultDeleteImportedRecordWithResult(ctx context.Context, in *ImportedRecord, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteImportedRecord(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteImportedRecord(ctx context.Context, in *ImportedRecord, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -5234,104 +20881,116 @@ func DefaultDeletePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == go_uuid.Nil {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&PrimaryIncludedORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&ImportedRecordORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type PrimaryIncludedORMWithBeforeDelete_ interface {
+type ImportedRecordORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterDelete_ interface {
+type ImportedRecordORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeletePrimaryIncludedSet(ctx context.Context, in []*PrimaryIncluded, db *gorm.DB) error {
+func DefaultDeleteImportedRecordSet(ctx context.Context, in []*ImportedRecord, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []go_uuid.UUID{}
+	keys := []uint64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == go_uuid.Nil {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&ImportedRecordORM{})).(ImportedRecordORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&PrimaryIncludedORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&ImportedRecordORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&PrimaryIncludedORM{})).(PrimaryIncludedORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&ImportedRecordORM{})).(ImportedRecordORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type PrimaryIncludedORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) (*gorm.DB, error)
+type ImportedRecordORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ImportedRecord, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*PrimaryIncluded, *gorm.DB) error
+type ImportedRecordORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ImportedRecord, *gorm.DB) error
 }
 
-// DefaultStrictUpdatePrimaryIncluded clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultStrictUpdateImportedRecord clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateImportedRecord(ctx context.Context, in *ImportedRecord, db *gorm.DB) (*ImportedRecord, error) {
+	return defaultStrictUpdateImportedRecord(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateImportedRecordWithResult is DefaultStrictUpdateImportedRecord reporting the affected rows
+// and whether the ImportedRecord existed before the update
+func DefaultStrictUpdateImportedRecordWithResult(ctx context.Context, in *ImportedRecord, db *gorm.DB) (*ImportedRecord, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateImportedRecord(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateImportedRecord(ctx context.Context, in *ImportedRecord, db *gorm.DB, result *types.WriteResult) (*ImportedRecord, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePrimaryIncluded")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateImportedRecord")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
 	var count int64
-	lockedRow := &PrimaryIncludedORM{}
+	lockedRow := &ImportedRecordORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	filterChild := ExternalChildORM{}
-	if ormObj.Id == go_uuid.Nil {
-		return nil, errors.EmptyIdError
-	}
-	filterChild.PrimaryIncludedId = new(go_uuid.UUID)
-	*filterChild.PrimaryIncludedId = ormObj.Id
-	if err = db.Where(filterChild).Delete(ExternalChildORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -5346,46 +21005,51 @@ func DefaultStrictUpdatePrimaryIncluded(ctx context.Context, in *PrimaryIncluded
 	return &pbResponse, err
 }
 
-type PrimaryIncludedORMWithBeforeStrictUpdateCleanup interface {
+type ImportedRecordORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithBeforeStrictUpdateSave interface {
+type ImportedRecordORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterStrictUpdateSave interface {
+type ImportedRecordORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchPrimaryIncluded executes a basic gorm update call with patch behavior
-func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updateMask *field_mask.FieldMask, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultPatchImportedRecord executes a basic gorm update call with patch behavior
+func DefaultPatchImportedRecord(ctx context.Context, in *ImportedRecord, updateMask *field_mask.FieldMask, db *gorm.DB) (*ImportedRecord, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj PrimaryIncluded
+	var pbObj ImportedRecord
 	var err error
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(ImportedRecordWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchApplyFieldMask); ok {
+	pbReadRes, err := DefaultReadImportedRecord(ctx, &ImportedRecord{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ImportedRecordWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskPrimaryIncluded(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskImportedRecord(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(PrimaryIncludedWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(ImportedRecordWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdatePrimaryIncluded(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateImportedRecord(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(PrimaryIncludedWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(ImportedRecordWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -5393,28 +21057,28 @@ func DefaultPatchPrimaryIncluded(ctx context.Context, in *PrimaryIncluded, updat
 	return pbResponse, nil
 }
 
-type PrimaryIncludedWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ImportedRecordWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ImportedRecord, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ImportedRecordWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ImportedRecord, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type ImportedRecordWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ImportedRecord, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *PrimaryIncluded, *field_mask.FieldMask, *gorm.DB) error
+type ImportedRecordWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ImportedRecord, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetPrimaryIncluded executes a bulk gorm update call with patch behavior
-func DefaultPatchSetPrimaryIncluded(ctx context.Context, objects []*PrimaryIncluded, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PrimaryIncluded, error) {
+// DefaultPatchSetImportedRecord executes a bulk gorm update call with patch behavior
+func DefaultPatchSetImportedRecord(ctx context.Context, objects []*ImportedRecord, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ImportedRecord, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*PrimaryIncluded, 0, len(objects))
+	results := make([]*ImportedRecord, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchPrimaryIncluded(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchImportedRecord(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -5425,35 +21089,116 @@ func DefaultPatchSetPrimaryIncluded(ctx context.Context, objects []*PrimaryInclu
 	return results, nil
 }
 
-// DefaultApplyFieldMaskPrimaryIncluded patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryIncluded, patcher *PrimaryIncluded, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PrimaryIncluded, error) {
+// DefaultUpdateImportedRecordByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key and by-fields cannot be in updateMask.
+func DefaultUpdateImportedRecordByIds(ctx context.Context, ids []uint64, in *ImportedRecord, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Payload":
+			columns["payload"] = ormObj.Payload
+		case "CreatedAt":
+			columns["created_at"] = ormObj.CreatedAt
+		case "UpdatedAt":
+			columns["updated_at"] = ormObj.UpdatedAt
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&ImportedRecordORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskImportedRecord patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskImportedRecord(ctx context.Context, patchee *ImportedRecord, patcher *ImportedRecord, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ImportedRecord, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	var updatedChild bool
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
 	for i, f := range updateMask.Paths {
-		if !updatedChild && strings.HasPrefix(f, prefix+"Child.") {
-			updatedChild = true
-			if patcher.Child == nil {
-				patchee.Child = nil
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Payload" {
+			patchee.Payload = patcher.Payload
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
 				continue
 			}
-			if patchee.Child == nil {
-				patchee.Child = &ExternalChild{}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
 			}
-			if o, err := DefaultApplyFieldMaskExternalChild(ctx, patchee.Child, patcher.Child, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Child.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.Child = o
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
 			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
 			continue
 		}
-		if f == prefix+"Child" {
-			updatedChild = true
-			patchee.Child = patcher.Child
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
 	}
@@ -5463,39 +21208,43 @@ func DefaultApplyFieldMaskPrimaryIncluded(ctx context.Context, patchee *PrimaryI
 	return patchee, nil
 }
 
-// DefaultListPrimaryIncluded executes a gorm list call
-func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryIncluded, error) {
-	in := PrimaryIncluded{}
+// DefaultListImportedRecord executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListImportedRecord(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*ImportedRecord, error) {
+	in := ImportedRecord{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PrimaryIncludedORM{}, &PrimaryIncluded{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ImportedRecordORM{}, &ImportedRecord{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithBeforeListFind); ok {
+	if db, err = applyImportedRecordConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []PrimaryIncludedORM{}
+	ormResponse := []ImportedRecordORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(PrimaryIncludedORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*PrimaryIncluded{}
+	pbResponse := []*ImportedRecord{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -5506,12 +21255,91 @@ func DefaultListPrimaryIncluded(ctx context.Context, db *gorm.DB) ([]*PrimaryInc
 	return pbResponse, nil
 }
 
-type PrimaryIncludedORMWithBeforeListApplyQuery interface {
+type ImportedRecordORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithBeforeListFind interface {
+type ImportedRecordORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type PrimaryIncludedORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]PrimaryIncludedORM) error
+type ImportedRecordORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ImportedRecordORM) error
+}
+
+// DefaultExplainListImportedRecord returns the SELECT DefaultListImportedRecord would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListImportedRecord(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := ImportedRecord{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ImportedRecordORM{}, &ImportedRecord{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyImportedRecordConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &ImportedRecordORM{})
+}
+
+// ImportedRecordORMSelectable lists the columns accepted by DefaultSelectImportedRecord
+var ImportedRecordORMSelectable = map[string]struct{}{
+	"created_at": {},
+	"id":         {},
+	"payload":    {},
+	"updated_at": {},
+}
+
+// DefaultSelectImportedRecord runs the query of DefaultListImportedRecord for the columns only, which
+// must be in ImportedRecordORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectImportedRecord(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := ImportedRecordORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "imported_records."+column)
+	}
+	in := ImportedRecord{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ImportedRecordORM{}, &ImportedRecord{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyImportedRecordConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ImportedRecordORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&ImportedRecordORM{}).Select(selected).Rows()
 }
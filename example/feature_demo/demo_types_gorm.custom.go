@@ -1,6 +1,11 @@
 package example
 
-import "context"
+import (
+	"context"
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+)
 
 type JoinTable struct {
 	TypeWithIDID           uint32
@@ -24,4 +29,15 @@ func (orm *TypeWithIDORM) AfterToPB(ctx context.Context, pb *TypeWithID) error {
 	pb.MultiaccountTypeIds = ids
 
 	return nil
-}
\ No newline at end of file
+}
+
+// noteShards are the handles of the shards of the ShardedNote rows by tenant
+var noteShards = map[string]*gorm.DB{}
+
+// ResolveNoteShard is the shard_resolver of ShardedNote
+func ResolveNoteShard(ctx context.Context, db *gorm.DB, tenantID string) (*gorm.DB, error) {
+	if shard, ok := noteShards[tenantID]; ok {
+		return shard, nil
+	}
+	return nil, fmt.Errorf("no shard of tenant %q", tenantID)
+}
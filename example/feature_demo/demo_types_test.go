@@ -1,10 +1,28 @@
 package example
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	goerrors "errors"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/infobloxopen/atlas-app-toolkit/query"
+	"github.com/infobloxopen/protoc-gen-gorm/errors"
 	"github.com/infobloxopen/protoc-gen-gorm/types"
+	"github.com/jinzhu/gorm"
+	"github.com/jinzhu/gorm/dialects/postgres"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestInet(t *testing.T) {
@@ -108,4 +126,888 @@ func TestTypeWithIDORM_ToPB(t *testing.T) {
 			}
 		}
 	})
-}
\ No newline at end of file
+}
+func TestTypeWithIDPreserveNanos(t *testing.T) {
+	observed := time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	pb := &TypeWithID{ObservedAt: timestamppb.New(observed)}
+	orm, err := pb.ToORM(context.Background())
+	if err != nil {
+		t.Fatalf("pb.ToORM=%v; want success", err)
+	}
+	if got, want := *orm.ObservedAt, observed.Truncate(time.Microsecond); !got.Equal(want) {
+		t.Errorf("orm.ObservedAt=%v; want %v", got, want)
+	}
+	if got, want := orm.ObservedAtNanos, int32(789); got != want {
+		t.Errorf("orm.ObservedAtNanos=%d; want %d", got, want)
+	}
+	back, err := orm.ToPB(context.Background())
+	if err != nil {
+		t.Fatalf("orm.ToPB=%v; want success", err)
+	}
+	if !proto.Equal(back.ObservedAt, pb.ObservedAt) {
+		t.Errorf("ToPB(ToORM(pb)).ObservedAt=%v; want %v", back.ObservedAt, pb.ObservedAt)
+	}
+}
+
+func TestTypeWithIDAnyDetails(t *testing.T) {
+	details, err := anypb.New(&IntPoint{Id: 3, X: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	orm, err := (&TypeWithID{Details: details}).ToORM(context.Background())
+	if err != nil {
+		t.Fatalf("pb.ToORM=%v; want success", err)
+	}
+	back, err := orm.ToPB(context.Background())
+	if err != nil {
+		t.Fatalf("orm.ToPB=%v; want success", err)
+	}
+	if !proto.Equal(back.Details, details) {
+		t.Errorf("ToPB(ToORM(pb)).Details=%v; want %v", back.Details, details)
+	}
+	unknown := &TypeWithID{Details: &anypb.Any{TypeUrl: "type.googleapis.com/example.Missing"}}
+	if _, err := unknown.ToORM(context.Background()); err == nil {
+		t.Error("ToORM of an Any of an unregistered type should fail")
+	}
+}
+
+func TestTypeWithIDORM_CommentTag(t *testing.T) {
+	var field *gorm.StructField
+	for _, f := range (&gorm.Scope{Value: &TypeWithIDORM{}}).GetModelStruct().StructFields {
+		if f.Name == "TagSizeTest" {
+			field = f
+		}
+	}
+	if field == nil {
+		t.Fatal("TypeWithIDORM.TagSizeTest field is missing")
+	}
+	// postgres takes no COMMENT clause in the column definition
+	if comment, ok := field.TagSettingsGet("COMMENT"); ok {
+		t.Errorf("COMMENT tag setting=%q; want none", comment)
+	}
+	dialect, _ := gorm.GetDialect("postgres")
+	if got, want := dialect.DataTypeOf(field), "varchar(512)"; got != want {
+		t.Errorf("DataTypeOf(TagSizeTest)=%q; want %q", got, want)
+	}
+	want := types.ColumnComment{Table: "type_with_ids", Column: "tag_size_test", Comment: "PII - do not log; internal, only"}
+	if len(DemoTypesColumnComments) != 1 || DemoTypesColumnComments[0] != want {
+		t.Fatalf("DemoTypesColumnComments=%v; want [%v]", DemoTypesColumnComments, want)
+	}
+	if got, want := DemoTypesColumnComments[0].SQL(), "COMMENT ON COLUMN type_with_ids.tag_size_test IS 'PII - do not log; internal, only'"; got != want {
+		t.Errorf("SQL()=%q; want %q", got, want)
+	}
+	if err := CommentDemoTypesColumns(nil); !goerrors.Is(err, errors.NilArgumentError) {
+		t.Errorf("CommentDemoTypesColumns(nil)=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestTypeWithIDORM_Checks(t *testing.T) {
+	// GORM v1 ignores a check tag setting, the constraints are added by
+	// AddDemoTypesChecks instead
+	for _, field := range (&gorm.Scope{Value: &TypeWithIDORM{}}).GetModelStruct().StructFields {
+		if check, ok := field.TagSettingsGet("CHECK"); ok {
+			t.Errorf("CHECK tag setting of %s=%q; want none", field.Name, check)
+		}
+	}
+	want := []struct {
+		check types.CheckDef
+		sql   string
+	}{
+		{types.CheckDef{Name: "chk_type_with_ids_slug", Table: "type_with_ids", Expression: "slug NOT IN ('new', 'edit') OR state = 'archived'"},
+			"ALTER TABLE type_with_ids ADD CONSTRAINT chk_type_with_ids_slug CHECK (slug NOT IN ('new', 'edit') OR state = 'archived')"},
+		{types.CheckDef{Name: "chk_type_with_ids_tag_test", Table: "type_with_ids", Expression: "tag_test >= 0"},
+			"ALTER TABLE type_with_ids ADD CONSTRAINT chk_type_with_ids_tag_test CHECK (tag_test >= 0)"},
+	}
+	if len(DemoTypesChecks) != len(want) {
+		t.Fatalf("DemoTypesChecks=%v; want %d checks", DemoTypesChecks, len(want))
+	}
+	for i, w := range want {
+		if DemoTypesChecks[i] != w.check {
+			t.Errorf("DemoTypesChecks[%d]=%v; want %v", i, DemoTypesChecks[i], w.check)
+		}
+		if got := DemoTypesChecks[i].SQL(); got != w.sql {
+			t.Errorf("DemoTypesChecks[%d].SQL()=%q; want %q", i, got, w.sql)
+		}
+	}
+	if err := AddDemoTypesChecks(nil); !goerrors.Is(err, errors.NilArgumentError) {
+		t.Errorf("AddDemoTypesChecks(nil)=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestTypeWithIDORMIndexes_PartialIndex(t *testing.T) {
+	var index *types.IndexDef
+	for i := range TypeWithIDORMIndexes {
+		if TypeWithIDORMIndexes[i].Name == "uix_type_with_ids_live_slug" {
+			index = &TypeWithIDORMIndexes[i]
+		}
+	}
+	if index == nil {
+		t.Fatalf("TypeWithIDORMIndexes=%v; want uix_type_with_ids_live_slug", TypeWithIDORMIndexes)
+	}
+	if got, want := index.SQL("type_with_ids"), "CREATE UNIQUE INDEX IF NOT EXISTS uix_type_with_ids_live_slug ON type_with_ids (slug) WHERE state <> 'archived'"; got != want {
+		t.Errorf("SQL()=%q; want %q", got, want)
+	}
+	// GORM would create the index of all rows
+	field, _ := reflect.TypeOf(TypeWithIDORM{}).FieldByName("Slug")
+	if tag := field.Tag.Get("gorm"); strings.Contains(tag, "index") {
+		t.Errorf("gorm tag of Slug=%q; want no index", tag)
+	}
+}
+
+func TestTypeWithIDORM_CollationTag(t *testing.T) {
+	field, ok := reflect.TypeOf(TypeWithIDORM{}).FieldByName("DisplayName")
+	if !ok {
+		t.Fatal("TypeWithIDORM.DisplayName field is missing")
+	}
+	if got, want := field.Tag.Get("gorm"), `type:text COLLATE "und-x-icu"`; got != want {
+		t.Errorf("gorm tag=%q; want %q", got, want)
+	}
+}
+
+func TestTypeWithIDORM_CaseInsensitiveTag(t *testing.T) {
+	field, ok := reflect.TypeOf(TypeWithIDORM{}).FieldByName("Email")
+	if !ok {
+		t.Fatal("TypeWithIDORM.Email field is missing")
+	}
+	if got, want := field.Tag.Get("gorm"), `type:citext;unique_index:uix_type_with_ids_email`; got != want {
+		t.Errorf("gorm tag=%q; want %q", got, want)
+	}
+	orm, err := (&TypeWithID{Email: "Ada@Example.com"}).ToORM(context.Background())
+	if err != nil || orm.Email != "Ada@Example.com" {
+		t.Errorf("ToORM Email=%q, %v; want Ada@Example.com as given", orm.Email, err)
+	}
+}
+
+func TestTestTypesSliceToORM(t *testing.T) {
+	t.Run("nil slice", func(t *testing.T) {
+		orms, err := TestTypesSliceToORM(context.Background(), nil)
+		if err != nil {
+			t.Fatalf("TestTypesSliceToORM=%v; want success", err)
+		}
+		if orms != nil {
+			t.Errorf("orms=%v; want nil", orms)
+		}
+	})
+	t.Run("first error with index", func(t *testing.T) {
+		in := []*TestTypes{{}, {Uuid: &types.UUID{Value: "not-a-uuid"}}}
+		if _, err := TestTypesSliceToORM(context.Background(), in); err == nil || !strings.HasPrefix(err.Error(), "1: ") {
+			t.Errorf("TestTypesSliceToORM=%v; want error of element 1", err)
+		}
+	})
+	t.Run("converts every element", func(t *testing.T) {
+		orms, err := TestTypesSliceToORM(context.Background(), []*TestTypes{{BecomesInt: TestTypes_GOOD}, nil})
+		if err != nil {
+			t.Fatalf("TestTypesSliceToORM=%v; want success", err)
+		}
+		if len(orms) != 2 || orms[0].BecomesInt != "GOOD" || orms[1] != nil {
+			t.Errorf("orms=%v; want [GOOD nil]", orms)
+		}
+	})
+}
+
+func TestTestTypesOptionalCount(t *testing.T) {
+	t.Run("absent", func(t *testing.T) {
+		orm, err := (&TestTypes{}).ToORM(context.Background())
+		if err != nil {
+			t.Fatalf("ToORM=%v; want success", err)
+		}
+		if orm.OptionalCount != nil {
+			t.Errorf("OptionalCount=%v; want nil", *orm.OptionalCount)
+		}
+	})
+	t.Run("present zero", func(t *testing.T) {
+		zero := int32(0)
+		orm, err := (&TestTypes{OptionalCount: &zero}).ToORM(context.Background())
+		if err != nil {
+			t.Fatalf("ToORM=%v; want success", err)
+		}
+		if orm.OptionalCount == nil || *orm.OptionalCount != 0 {
+			t.Fatalf("OptionalCount=%v; want pointer to 0", orm.OptionalCount)
+		}
+		pb, err := orm.ToPB(context.Background())
+		if err != nil {
+			t.Fatalf("ToPB=%v; want success", err)
+		}
+		if pb.OptionalCount == nil || *pb.OptionalCount != 0 || pb.OptionalCount == orm.OptionalCount {
+			t.Errorf("OptionalCount=%v; want a copy of 0", pb.OptionalCount)
+		}
+	})
+}
+
+func TestTestTypesMergeToORM(t *testing.T) {
+	count, loaded := int32(3), "loaded"
+	dst := TestTypesORM{BecomesInt: "BAD", OptionalCount: &count, OptionalString: &loaded, Array: []string{"kept"}}
+	if err := (&TestTypes{BecomesInt: TestTypes_GOOD}).MergeToORM(context.Background(), &dst, false); err != nil {
+		t.Fatalf("MergeToORM=%v; want success", err)
+	}
+	if dst.BecomesInt != "GOOD" {
+		t.Errorf("BecomesInt=%q; want %q", dst.BecomesInt, "GOOD")
+	}
+	if dst.OptionalCount == nil || *dst.OptionalCount != 3 || dst.OptionalString == nil || *dst.OptionalString != "loaded" {
+		t.Errorf("OptionalCount=%v, OptionalString=%v; want the loaded values", dst.OptionalCount, dst.OptionalString)
+	}
+	if len(dst.Array) != 1 {
+		t.Errorf("Array=%v; want the ORM only field intact", dst.Array)
+	}
+	if err := (&TestTypes{}).MergeToORM(context.Background(), nil, false); err != errors.NilArgumentError {
+		t.Errorf("MergeToORM(nil)=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestDemoTypesSchemaHash(t *testing.T) {
+	if len(DemoTypesSchemaHash) != 64 || strings.Trim(DemoTypesSchemaHash, "0123456789abcdef") != "" {
+		t.Errorf("DemoTypesSchemaHash=%q; want a hex encoded SHA-256", DemoTypesSchemaHash)
+	}
+	if DemoTypesSchemaHash == DemoMultiFileSchemaHash {
+		t.Error("schema hashes of different files should differ")
+	}
+}
+
+func TestRegisterDemoTypesCallbacks(t *testing.T) {
+	if err := RegisterDemoTypesCallbacks(nil); err != errors.NilArgumentError {
+		t.Errorf("RegisterDemoTypesCallbacks(nil)=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestTypeWithIDSmallintStatus(t *testing.T) {
+	orm, err := (&TypeWithID{Status: TestTypes_BAD}).ToORM(context.Background())
+	if err != nil {
+		t.Fatalf("ToORM=%v; want success", err)
+	}
+	if orm.Status != int16(TestTypes_BAD) {
+		t.Errorf("Status=%d; want %d", orm.Status, TestTypes_BAD)
+	}
+	pb, err := orm.ToPB(context.Background())
+	if err != nil {
+		t.Fatalf("ToPB=%v; want success", err)
+	}
+	if pb.Status != TestTypes_BAD {
+		t.Errorf("Status=%v; want %v", pb.Status, TestTypes_BAD)
+	}
+}
+
+func TestTypeWithIDORM_DefaultTags(t *testing.T) {
+	for name, want := range map[string]string{
+		"State":        `default:'active'`,
+		"SeenAt":       `default:now()`,
+		"RegisteredAt": `default:now();not null`,
+	} {
+		field, ok := reflect.TypeOf(TypeWithIDORM{}).FieldByName(name)
+		if !ok {
+			t.Fatalf("TypeWithIDORM.%s field is missing", name)
+		}
+		if got := field.Tag.Get("gorm"); got != want {
+			t.Errorf("gorm tag of %s=%q; want %q", name, got, want)
+		}
+	}
+}
+
+func TestTypeWithIDSlugTransform(t *testing.T) {
+	orm, err := (&TypeWithID{Slug: "  Hello-World "}).ToORM(context.Background())
+	if err != nil {
+		t.Fatalf("ToORM=%v; want success", err)
+	}
+	if orm.Slug != "hello-world" {
+		t.Errorf("Slug=%q; want %q", orm.Slug, "hello-world")
+	}
+}
+
+func TestTypeWithIDDurations(t *testing.T) {
+	in := &TypeWithID{Timeout: durationpb.New(90 * time.Second), RetryDelay: durationpb.New(1500 * time.Nanosecond)}
+	orm, err := in.ToORM(context.Background())
+	if err != nil {
+		t.Fatalf("ToORM=%v; want success", err)
+	}
+	if orm.RetryDelay == nil || *orm.RetryDelay != 1500 {
+		t.Errorf("RetryDelay=%v; want 1500", orm.RetryDelay)
+	}
+	pb, err := orm.ToPB(context.Background())
+	if err != nil {
+		t.Fatalf("ToPB=%v; want success", err)
+	}
+	if !proto.Equal(pb.Timeout, in.Timeout) || !proto.Equal(pb.RetryDelay, in.RetryDelay) {
+		t.Errorf("durations=%v, %v; want %v, %v", pb.Timeout, pb.RetryDelay, in.Timeout, in.RetryDelay)
+	}
+	if _, err := (&TypeWithID{Timeout: durationpb.New(time.Nanosecond)}).ToORM(context.Background()); err == nil {
+		t.Error("ToORM of a 1ns interval succeeded; want an error")
+	}
+	if orm, err := (&TypeWithID{}).ToORM(context.Background()); err != nil || orm.Timeout != nil || orm.RetryDelay != nil {
+		t.Errorf("ToORM of nil durations=%v, %v, %v; want nil", orm.Timeout, orm.RetryDelay, err)
+	}
+}
+
+func TestDefaultExplainList(t *testing.T) {
+	db, err := gorm.Open("postgres", connless{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DefaultExplainListTypeWithID(context.Background(), db, func(db *gorm.DB) *gorm.DB {
+		return db.Where("state = ?", "it's")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `SELECT * FROM "type_with_ids"  WHERE "type_with_ids"."deleted_at" IS NULL AND ((state = 'it''s')) ORDER BY "id"`
+	if got != want {
+		t.Errorf("DefaultExplainListTypeWithID=%s; want %s", got, want)
+	}
+
+	f, err := query.ParseFiltering("x > 2 and y == 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = DefaultExplainListIntPoint(context.Background(), db, f, nil, &query.Pagination{Offset: 5, Limit: 10}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `SELECT * FROM "int_points"  WHERE (((int_points.x > 2) AND (int_points.y = 3))) ORDER BY "id" LIMIT 10 OFFSET 5`
+	if got != want {
+		t.Errorf("DefaultExplainListIntPoint=%s; want %s", got, want)
+	}
+}
+
+func TestTypeWithIDConditionalPreload(t *testing.T) {
+	db, err := gorm.Open("postgres", connless{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	asked := false
+	ctx := WithTypeWithIDConditionalPreload(context.Background(), "Things", func(ctx context.Context) bool {
+		asked = true
+		return false
+	})
+	if _, err := DefaultExplainListTypeWithID(ctx, db); err != nil || !asked {
+		t.Errorf("DefaultExplainListTypeWithID=%v, asked %v; want the condition asked", err, asked)
+	}
+	ctx = WithTypeWithIDConditionalPreload(ctx, "Secrets", func(context.Context) bool { return true })
+	if _, err := DefaultExplainListTypeWithID(ctx, db); err == nil || !strings.Contains(err.Error(), errors.UnknownPreloadError.Error()) {
+		t.Errorf("DefaultExplainListTypeWithID of an unknown association=%v; want %v", err, errors.UnknownPreloadError)
+	}
+	if _, err := DefaultReadTypeWithID(ctx, &TypeWithID{Id: 1}, db); err == nil || !strings.Contains(err.Error(), errors.UnknownPreloadError.Error()) {
+		t.Errorf("DefaultReadTypeWithID of an unknown association=%v; want %v", err, errors.UnknownPreloadError)
+	}
+}
+
+// connless is a database never reached, the dialect is enough to render SQL
+type connless struct {
+	gorm.SQLCommon
+}
+
+func TestLedgerEntryImmutable(t *testing.T) {
+	var orm interface{} = &LedgerEntryORM{}
+	hook, ok := orm.(interface{ BeforeUpdate() error })
+	if !ok {
+		t.Fatal("LedgerEntryORM has no BeforeUpdate hook")
+	}
+	if err := hook.BeforeUpdate(); err != errors.ImmutableError {
+		t.Errorf("BeforeUpdate=%v; want %v", err, errors.ImmutableError)
+	}
+}
+
+func TestDefaultPurgeTypeWithIDArguments(t *testing.T) {
+	if err := DefaultPurgeTypeWithID(context.Background(), nil, nil); err != errors.NilArgumentError {
+		t.Errorf("DefaultPurgeTypeWithID(nil)=%v; want %v", err, errors.NilArgumentError)
+	}
+	if err := DefaultPurgeTypeWithID(context.Background(), &TypeWithID{}, nil); err != errors.EmptyIdError {
+		t.Errorf("DefaultPurgeTypeWithID(no id)=%v; want %v", err, errors.EmptyIdError)
+	}
+}
+
+func TestWarehouseHistoryToPB(t *testing.T) {
+	version := &WarehouseHistoryORM{HistoryID: 7, Operation: "update", Id: 1, Name: "north", AddressStreet: "1 Main St", AddressZip: "12345"}
+	out, err := version.ToPB(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&Warehouse{Id: 1, Name: "north", Address: &PostalAddress{Street: "1 Main St", Zip: "12345"}}); !proto.Equal(&out, want) {
+		t.Errorf("ToPB=%v; want %v", &out, want)
+	}
+	if table := (WarehouseHistoryORM{}).TableName(); table != "warehouses_history" {
+		t.Errorf("TableName=%s; want warehouses_history", table)
+	}
+}
+
+func TestWarehouseApplyFieldMaskToORM(t *testing.T) {
+	ctx := context.Background()
+	dst := WarehouseORM{Id: 1, Name: "north", Address: PostalAddressORM{Street: "1 Main St", City: "Springfield"}}
+	in := &Warehouse{Id: 2, Name: "south", Address: &PostalAddress{Street: "2 Side St", City: "Shelbyville"}}
+	if err := in.ApplyFieldMaskToORM(ctx, &dst, &fieldmaskpb.FieldMask{Paths: []string{"Name", "Address.Street"}}); err != nil {
+		t.Fatal(err)
+	}
+	if want := (WarehouseORM{Id: 1, Name: "south", Address: PostalAddressORM{Street: "2 Side St", City: "Springfield"}}); dst != want {
+		t.Errorf("ApplyFieldMaskToORM=%+v; want %+v", dst, want)
+	}
+	for _, path := range []string{"Missing", "Name.Sub", "Address.Missing"} {
+		err := in.ApplyFieldMaskToORM(ctx, &dst, &fieldmaskpb.FieldMask{Paths: []string{path}})
+		if !goerrors.Is(err, errors.UnknownUpdateFieldError) {
+			t.Errorf("ApplyFieldMaskToORM(%s) err=%v; want %v", path, err, errors.UnknownUpdateFieldError)
+		}
+	}
+	if err := in.ApplyFieldMaskToORM(ctx, nil, nil); err != errors.NilArgumentError {
+		t.Errorf("ApplyFieldMaskToORM(nil) err=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestWarehouseEmbeddedAddress(t *testing.T) {
+	ctx := context.Background()
+	in := &Warehouse{Id: 1, Name: "north", Address: &PostalAddress{Street: "1 Main St", City: "Springfield", Zip: "12345"}}
+	orm, err := in.ToORM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (PostalAddressORM{Street: "1 Main St", City: "Springfield", Zip: "12345"}); orm.Address != want {
+		t.Errorf("ToORM Address=%+v; want %+v", orm.Address, want)
+	}
+	out, err := orm.ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(&out, in) {
+		t.Errorf("ToPB=%v; want %v", &out, in)
+	}
+
+	orm, err = (&Warehouse{Id: 2}).ToORM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orm.Address != (PostalAddressORM{}) {
+		t.Errorf("ToORM without address Address=%+v; want the zero value", orm.Address)
+	}
+
+	// the embedded struct is a column set of the row, not an association
+	if err := in.MergeToORM(ctx, &orm, false); err != nil {
+		t.Fatal(err)
+	}
+	if orm.Address.Street != "1 Main St" {
+		t.Errorf("MergeToORM Address=%+v; want the address of %v", orm.Address, in)
+	}
+}
+
+func TestTypeWithIDDefaultNow(t *testing.T) {
+	ctx := context.Background()
+	for _, ts := range []*timestamppb.Timestamp{nil, {}} {
+		orm, err := (&TypeWithID{RegisteredAt: ts}).ToORM(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if orm.RegisteredAt != nil {
+			t.Errorf("ToORM RegisteredAt of %v=%v; want nil for the DB default", ts, orm.RegisteredAt)
+		}
+	}
+	registered := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	orm, err := (&TypeWithID{RegisteredAt: timestamppb.New(registered)}).ToORM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orm.RegisteredAt == nil || !orm.RegisteredAt.Equal(registered) {
+		t.Errorf("ToORM RegisteredAt=%v; want %v", orm.RegisteredAt, registered)
+	}
+	if err := (&TypeWithID{RegisteredAt: &timestamppb.Timestamp{}}).MergeToORM(ctx, &orm, false); err != nil {
+		t.Fatal(err)
+	}
+	if orm.RegisteredAt == nil || !orm.RegisteredAt.Equal(registered) {
+		t.Errorf("MergeToORM of a zero RegisteredAt=%v; want %v kept", orm.RegisteredAt, registered)
+	}
+}
+
+func TestShardedNoteResolvesShard(t *testing.T) {
+	ctx := context.Background()
+	note := &ShardedNote{Id: 1, TenantId: "t1"}
+	if _, err := DefaultCreateShardedNote(ctx, note, &gorm.DB{}); err == nil || !strings.Contains(err.Error(), `no shard of tenant "t1"`) {
+		t.Errorf("DefaultCreateShardedNote=%v; want the error of ResolveNoteShard", err)
+	}
+	if err := DefaultDeleteShardedNote(ctx, note, &gorm.DB{}); err == nil || !strings.Contains(err.Error(), `no shard of tenant "t1"`) {
+		t.Errorf("DefaultDeleteShardedNote=%v; want the error of ResolveNoteShard", err)
+	}
+}
+
+func TestTestTypesJSONAccessors(t *testing.T) {
+	orm := TestTypesORM{}
+	if region, ok := orm.Region(); ok || region != "" {
+		t.Errorf("Region of no JSON=%q, %v; want missing", region, ok)
+	}
+	orm.JsonField = &postgres.Jsonb{RawMessage: []byte(`{"region": "eu", "limits": {"max": "ten"}}`)}
+	if region, ok := orm.Region(); !ok || region != "eu" {
+		t.Errorf("Region=%q, %v; want eu", region, ok)
+	}
+	if max, ok := orm.MaxLimit(); ok || max != 0 {
+		t.Errorf("MaxLimit of a string=%d, %v; want missing", max, ok)
+	}
+	orm.JsonField.RawMessage = []byte(`{"limits": {"max": 10}}`)
+	if max, ok := orm.MaxLimit(); !ok || max != 10 {
+		t.Errorf("MaxLimit=%d, %v; want 10", max, ok)
+	}
+}
+
+func TestTestTypesJSONDefault(t *testing.T) {
+	ctx := context.Background()
+	for _, in := range []*types.JSONValue{nil, {}} {
+		orm, err := (&TestTypes{JsonField: in}).ToORM(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if orm.JsonField == nil || string(orm.JsonField.RawMessage) != "{}" {
+			t.Errorf("JsonField of %v=%v; want the default {}", in, orm.JsonField)
+		}
+	}
+	pb, err := (&TestTypesORM{}).ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb.JsonField.GetValue() != "{}" {
+		t.Errorf("JsonField of NULL=%v; want the default {}", pb.JsonField)
+	}
+	pb, err = (&TestTypesORM{JsonField: &postgres.Jsonb{RawMessage: []byte(`{"region": "eu"}`)}}).ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb.JsonField.GetValue() != `{"region": "eu"}` {
+		t.Errorf("JsonField=%v; want the stored JSON", pb.JsonField)
+	}
+}
+
+func TestFolderToPBLeavesOutDeletedDocuments(t *testing.T) {
+	deleted := time.Now()
+	folder := FolderORM{Id: 1, Documents: []*DocumentORM{{Id: 2}, {Id: 3, DeletedAt: &deleted}}}
+	pb, err := folder.ToPB(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pb.Documents) != 1 || pb.Documents[0].Id != 2 {
+		t.Errorf("Documents=%v; want document 2 only", pb.Documents)
+	}
+	pb, err = folder.ToPB(types.WithDeleted(context.Background()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pb.Documents) != 2 {
+		t.Errorf("Documents=%v; want the deleted document too", pb.Documents)
+	}
+}
+
+func TestTypeWithIDLocation(t *testing.T) {
+	ctx := context.Background()
+	orm, err := (&TypeWithID{Location: &types.GeoPoint{Lat: 52.52, Lng: 13.405}}).ToORM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orm.Location == nil || *orm.Location != (types.Point{Lat: 52.52, Lng: 13.405}) {
+		t.Errorf("Location=%v; want lat 52.52, lng 13.405", orm.Location)
+	}
+	pb, err := orm.ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb.Location.GetLat() != 52.52 || pb.Location.GetLng() != 13.405 {
+		t.Errorf("Location=%v; want lat 52.52, lng 13.405", pb.Location)
+	}
+	if _, err := (&TypeWithID{Location: &types.GeoPoint{Lat: 100}}).ToORM(ctx); err == nil {
+		t.Error("ToORM of latitude 100=nil; want an error")
+	}
+}
+
+func TestTypeWithIDShipTo(t *testing.T) {
+	ctx := context.Background()
+	address := &ShippingAddress{Street: `1 "Main", St`, City: "", Zip: 12345}
+	orm, err := (&TypeWithID{ShipTo: address}).ToORM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := orm.ShipTo.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `("1 \"Main\", St","",12345)`; value != want {
+		t.Errorf("ShipTo.Value()=%s; want %s", value, want)
+	}
+	var scanned ShippingAddressComposite
+	if err := scanned.Scan([]byte(`("1 ""Main"", St",,12345)`)); err != nil {
+		t.Fatal(err)
+	}
+	pb, err := (&TypeWithIDORM{ShipTo: &scanned}).ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(pb.ShipTo, address) {
+		t.Errorf("ShipTo=%v; want %v", pb.ShipTo, address)
+	}
+	if err := CreateShippingAddressCompositeType(nil); err != errors.NilArgumentError {
+		t.Errorf("CreateShippingAddressCompositeType(nil)=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestDiffTypeWithID(t *testing.T) {
+	seen := time.Date(2024, 5, 1, 12, 0, 0, 1000, time.UTC)
+	// postgres keeps the microseconds only
+	seenAgain := seen.Add(400 * time.Nanosecond)
+	old := &TypeWithIDORM{Id: 1, Slug: "a", SeenAt: &seen, Point: &IntPointORM{Id: 1, X: 1}, Things: []*TestTypesORM{{BecomesInt: "1"}}}
+	new := &TypeWithIDORM{Id: 1, Slug: "a", SeenAt: &seenAgain, Point: &IntPointORM{Id: 1, X: 2}, Things: []*TestTypesORM{{BecomesInt: "1"}}}
+	if mask := DiffTypeWithID(old, new); len(mask.Paths) != 0 {
+		t.Errorf("DiffTypeWithID=%v; want no paths", mask.Paths)
+	}
+	new.Slug = "b"
+	new.Point = &IntPointORM{Id: 2}
+	new.Things[0].BecomesInt = "2"
+	if mask, want := DiffTypeWithID(old, new), []string{"Things", "Point", "Slug"}; !reflect.DeepEqual(mask.Paths, want) {
+		t.Errorf("DiffTypeWithID=%v; want %v", mask.Paths, want)
+	}
+	if mask, want := DiffTypeWithID(nil, &TypeWithIDORM{Id: 3}), []string{"Id"}; !reflect.DeepEqual(mask.Paths, want) {
+		t.Errorf("DiffTypeWithID of nil=%v; want %v", mask.Paths, want)
+	}
+}
+
+func TestRenameDemoTypesColumns(t *testing.T) {
+	want := types.ColumnRename{Table: "smorgasbord", From: "maybe_string", To: "optional_string"}
+	if len(DemoTypesColumnRenames) != 1 || DemoTypesColumnRenames[0] != want {
+		t.Errorf("DemoTypesColumnRenames=%v; want [%v]", DemoTypesColumnRenames, want)
+	}
+	if err := RenameDemoTypesColumns(nil); err != errors.NilArgumentError {
+		t.Errorf("RenameDemoTypesColumns(nil)=%v; want %v", err, errors.NilArgumentError)
+	}
+}
+
+func TestFolderSetsBackReferences(t *testing.T) {
+	ctx := context.Background()
+	folder := FolderORM{Id: 1, Documents: []*DocumentORM{{Id: 2}, nil}}
+	if err := folder.AfterFind(); err != nil {
+		t.Fatal(err)
+	}
+	if folder.Documents[0].Folder != &folder {
+		t.Fatalf("Folder of the document=%v; want the loaded folder", folder.Documents[0].Folder)
+	}
+	pb, err := folder.ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pb.Documents) != 2 || pb.Documents[0].GetFolder() != nil {
+		t.Errorf("ToPB Documents=%v; want the documents without their folder", pb.Documents)
+	}
+	doc, err := folder.Documents[0].ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.GetFolder().GetId() != 1 || len(doc.GetFolder().GetDocuments()) != 2 || doc.GetFolder().GetDocuments()[0].GetFolder() != nil {
+		t.Errorf("ToPB of the document=%v; want its folder with the documents without their folder", doc.GetFolder())
+	}
+}
+
+func TestTypeWithIDReviewStatusLookup(t *testing.T) {
+	ctx := context.Background()
+	orm, err := (&TypeWithID{ReviewStatus: TestTypes_BAD}).ToORM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orm.ReviewStatus != int32(TestTypes_BAD) {
+		t.Errorf("ToORM ReviewStatus=%d; want the id %d of BAD", orm.ReviewStatus, TestTypes_BAD)
+	}
+	pb, err := orm.ToPB(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb.ReviewStatus != TestTypes_BAD {
+		t.Errorf("ToPB ReviewStatus=%v; want BAD", pb.ReviewStatus)
+	}
+	if len(TestTypesStatusORMRows) != len(TestTypesStatus_name) {
+		t.Fatalf("TestTypesStatusORMRows=%v; want a row of each value", TestTypesStatusORMRows)
+	}
+	for _, row := range TestTypesStatusORMRows {
+		if TestTypesStatus_name[row.Id] != row.Name {
+			t.Errorf("row %d is %s; want %s", row.Id, row.Name, TestTypesStatus_name[row.Id])
+		}
+	}
+}
+
+func TestTypeWithIDComputedRevision(t *testing.T) {
+	ctx := context.Background()
+	orm, err := (&TypeWithID{Id: 1, Revision: 5}).ToORM(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if orm.Revision != 0 {
+		t.Errorf("ToORM Revision=%d; want the computed field left to the DB", orm.Revision)
+	}
+	if err := (&TypeWithIDORM{}).ReloadComputed(ctx, &gorm.DB{}); err != errors.EmptyIdError {
+		t.Errorf("ReloadComputed without an id=%v; want EmptyIdError", err)
+	}
+}
+
+func TestTypeWithIDStartAtInZone(t *testing.T) {
+	start := time.Date(2024, 3, 10, 9, 30, 0, 0, time.UTC)
+	pb := &TypeWithID{StartAt: timestamppb.New(start), StartTz: "America/New_York"}
+	orm, err := pb.ToORM(context.Background())
+	if err != nil {
+		t.Fatalf("ToORM: %v", err)
+	}
+	if orm.StartAt.Location() != time.UTC || !orm.StartAt.Equal(start) || orm.StartTz != "America/New_York" {
+		t.Errorf("ToORM stored %v in %s; want %v UTC in America/New_York", orm.StartAt, orm.StartTz, start)
+	}
+	zoned, err := pb.StartAtInZone()
+	if err != nil {
+		t.Fatalf("StartAtInZone: %v", err)
+	}
+	if zoned.Location().String() != "America/New_York" || zoned.Hour() != 5 || !zoned.Equal(start) {
+		t.Errorf("StartAtInZone=%v; want 05:30 in America/New_York", zoned)
+	}
+	pb.StartTz = "Mars/Olympus_Mons"
+	if _, err := pb.ToORM(context.Background()); err == nil {
+		t.Error("ToORM of an unknown zone should fail")
+	}
+	if zoned, err := (&TypeWithID{}).StartAtInZone(); err != nil || !zoned.IsZero() {
+		t.Errorf("StartAtInZone of no time=%v, %v; want the zero time", zoned, err)
+	}
+}
+
+func TestTypeWithIDPrice(t *testing.T) {
+	ctx := context.Background()
+	orm, err := (&TypeWithID{Price: "12.5", Currency: "EUR"}).ToORM(ctx)
+	if err != nil {
+		t.Fatalf("ToORM: %v", err)
+	}
+	if orm.Price != 1250 {
+		t.Errorf("ToORM stored the price as %d; want 1250 cents", orm.Price)
+	}
+	pb, err := orm.ToPB(ctx)
+	if err != nil {
+		t.Fatalf("ToPB: %v", err)
+	}
+	if pb.Price != "12.50" || pb.Currency != "EUR" {
+		t.Errorf("ToPB price=%s %s; want 12.50 EUR", pb.Price, pb.Currency)
+	}
+	for _, in := range []*TypeWithID{{Price: "12.505", Currency: "EUR"}, {Price: "12,50", Currency: "EUR"}, {Price: "1"}} {
+		if _, err := in.ToORM(ctx); err == nil {
+			t.Errorf("ToORM of price %q %q should fail", in.Price, in.Currency)
+		}
+	}
+	if orm, err := (&TypeWithID{}).ToORM(ctx); err != nil || orm.Price != 0 {
+		t.Errorf("ToORM of no price=%d, %v; want 0", orm.Price, err)
+	}
+}
+
+func TestTypeWithIDPastStatuses(t *testing.T) {
+	ctx := context.Background()
+	in := &TypeWithID{PastStatuses: []TestTypesStatus{TestTypes_GOOD, TestTypes_BAD}}
+	orm, err := in.ToORM(ctx)
+	if err != nil {
+		t.Fatalf("ToORM: %v", err)
+	}
+	if want := (types.EnumNames{"GOOD", "BAD"}); !reflect.DeepEqual(orm.PastStatuses, want) {
+		t.Errorf("ToORM stored the statuses as %v; want %v", orm.PastStatuses, want)
+	}
+	pb, err := orm.ToPB(ctx)
+	if err != nil {
+		t.Fatalf("ToPB: %v", err)
+	}
+	if !reflect.DeepEqual(pb.PastStatuses, in.PastStatuses) {
+		t.Errorf("ToPB statuses=%v; want %v", pb.PastStatuses, in.PastStatuses)
+	}
+	if orm, err := (&TypeWithID{}).ToORM(ctx); err != nil || orm.PastStatuses != nil {
+		t.Errorf("ToORM of no statuses=%v, %v; want nil", orm.PastStatuses, err)
+	}
+	if _, err := (&TypeWithID{PastStatuses: []TestTypesStatus{7}}).ToORM(ctx); err == nil {
+		t.Error("ToORM of status 7 should fail")
+	}
+	if _, err := (&TypeWithIDORM{PastStatuses: types.EnumNames{"UGLY"}}).ToPB(ctx); err == nil {
+		t.Error("ToPB of status UGLY should fail")
+	}
+}
+
+func TestTypeWithIDSignature(t *testing.T) {
+	ctx := context.Background()
+	in := &TypeWithID{Signature: []byte{0, 0xff, 'a'}}
+	orm, err := in.ToORM(ctx)
+	if err != nil {
+		t.Fatalf("ToORM: %v", err)
+	}
+	if orm.Signature != "AP9h" {
+		t.Errorf("ToORM stored the signature as %q; want AP9h", orm.Signature)
+	}
+	pb, err := orm.ToPB(ctx)
+	if err != nil {
+		t.Fatalf("ToPB: %v", err)
+	}
+	if !bytes.Equal(pb.Signature, in.Signature) {
+		t.Errorf("ToPB signature=%v; want %v", pb.Signature, in.Signature)
+	}
+	if pb, err := (&TypeWithIDORM{}).ToPB(ctx); err != nil || pb.Signature != nil {
+		t.Errorf("ToPB of no signature=%v, %v; want nil", pb.Signature, err)
+	}
+	_, err = (&TypeWithIDORM{Signature: "not base64!"}).ToPB(ctx)
+	var corrupt base64.CorruptInputError
+	if !goerrors.As(err, &corrupt) {
+		t.Errorf("ToPB of an invalid signature=%v; want a base64.CorruptInputError", err)
+	}
+}
+
+func TestTypeWithIDValidateReferences(t *testing.T) {
+	ctx := context.Background()
+	if err := (&TypeWithIDORM{}).ValidateReferences(ctx, nil); err != nil {
+		t.Errorf("ValidateReferences without a point=%v; want nil", err)
+	}
+	id := uint32(7)
+	// a point written along with the object need not exist yet
+	if err := (&TypeWithIDORM{Point: &IntPointORM{}, IntPointId: &id}).ValidateReferences(ctx, nil); err != nil {
+		t.Errorf("ValidateReferences of a set point=%v; want nil", err)
+	}
+}
+
+func TestDefaultStrictUpdateTestAssocLabeledEmptySet(t *testing.T) {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("gorm.Open=%v; want success", err)
+	}
+	defer db.Close()
+	if err := db.AutoMigrate(&TestAssocLabeledORM{}, &TestAssocLabelORM{}).Error; err != nil {
+		t.Fatalf("AutoMigrate=%v; want success", err)
+	}
+	// sqlite takes no FOR UPDATE, the update does not find the row locked and
+	// sets the Created status of the gateway, which needs a grpc stream
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), headerStream{})
+	in := &TestAssocLabeled{Id: "labeled", Labels: []*TestAssocLabel{{Id: "a", Name: "A"}, {Id: "b", Name: "B"}}}
+	if _, err := DefaultCreateTestAssocLabeled(ctx, in, db); err != nil {
+		t.Fatalf("DefaultCreateTestAssocLabeled=%v; want success", err)
+	}
+	joinRows := func() int {
+		var count int
+		if err := db.Table("test_assoc_labeled_test_assoc_labels").Count(&count).Error; err != nil {
+			t.Fatalf("counting the join rows=%v; want success", err)
+		}
+		return count
+	}
+	if got := joinRows(); got != 2 {
+		t.Fatalf("join rows after create=%d; want 2", got)
+	}
+
+	out, err := DefaultStrictUpdateTestAssocLabeled(ctx, &TestAssocLabeled{Id: "labeled"}, db)
+	if err != nil {
+		t.Fatalf("DefaultStrictUpdateTestAssocLabeled=%v; want success", err)
+	}
+	if len(out.Labels) != 0 {
+		t.Errorf("Labels=%v; want none", out.Labels)
+	}
+	if got := joinRows(); got != 0 {
+		t.Errorf("join rows after replacing with an empty set=%d; want 0", got)
+	}
+	// the labels themselves are kept
+	var labels int
+	if err := db.Model(&TestAssocLabelORM{}).Count(&labels).Error; err != nil {
+		t.Fatalf("counting the labels=%v; want success", err)
+	}
+	if labels != 2 {
+		t.Errorf("labels=%d; want 2", labels)
+	}
+	read, err := DefaultReadTestAssocLabeled(ctx, &TestAssocLabeled{Id: "labeled"}, db.Preload("Labels"))
+	if err != nil {
+		t.Fatalf("DefaultReadTestAssocLabeled=%v; want success", err)
+	}
+	if len(read.Labels) != 0 {
+		t.Errorf("Labels read back=%v; want none", read.Labels)
+	}
+}
+
+// headerStream is the grpc stream of a handler setting the gateway status
+type headerStream struct{}
+
+func (headerStream) Method() string               { return "" }
+func (headerStream) SetHeader(metadata.MD) error  { return nil }
+func (headerStream) SendHeader(metadata.MD) error { return nil }
+func (headerStream) SetTrailer(metadata.MD) error { return nil }
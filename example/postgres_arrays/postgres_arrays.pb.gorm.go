@@ -2,13 +2,19 @@ package postgres_arrays
 
 import (
 	context "context"
+	sql "database/sql"
 	fmt "fmt"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	explain "github.com/infobloxopen/protoc-gen-gorm/explain"
+	returning "github.com/infobloxopen/protoc-gen-gorm/returning"
+	types "github.com/infobloxopen/protoc-gen-gorm/types"
 	gorm "github.com/jinzhu/gorm"
 	pq "github.com/lib/pq"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	reflect "reflect"
+	strings "strings"
 )
 
 type ExampleORM struct {
@@ -25,6 +31,37 @@ func (ExampleORM) TableName() string {
 	return "examples"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *ExampleORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *ExampleORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := ExampleORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// ExampleORMIndexes lists the indexes declared by the gorm tags of ExampleORM
+var ExampleORMIndexes = []types.IndexDef{}
+
+// applyExampleConditionalPreloads preloads the associations of the conditional
+// preloads of Example in ctx whose condition holds
+func applyExampleConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Example") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *Example) ToORM(ctx context.Context) (ExampleORM, error) {
@@ -93,6 +130,169 @@ func (m *ExampleORM) ToPB(ctx context.Context) (Example, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Example) MergeToORM(ctx context.Context, dst *ExampleORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Description = to.Description
+	dst.ArrayOfBools = to.ArrayOfBools
+	dst.ArrayOfFloat64 = to.ArrayOfFloat64
+	dst.ArrayOfInt64 = to.ArrayOfInt64
+	dst.ArrayOfString = to.ArrayOfString
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Example) ApplyFieldMaskToORM(ctx context.Context, dst *ExampleORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *ExampleORM) applyFieldMaskPath(from *ExampleORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Description":
+		if rest != "" {
+			return false
+		}
+		m.Description = from.Description
+		return true
+	case "ArrayOfBools":
+		if rest != "" {
+			return false
+		}
+		m.ArrayOfBools = from.ArrayOfBools
+		return true
+	case "ArrayOfFloat64":
+		if rest != "" {
+			return false
+		}
+		m.ArrayOfFloat64 = from.ArrayOfFloat64
+		return true
+	case "ArrayOfInt64":
+		if rest != "" {
+			return false
+		}
+		m.ArrayOfInt64 = from.ArrayOfInt64
+		return true
+	case "ArrayOfString":
+		if rest != "" {
+			return false
+		}
+		m.ArrayOfString = from.ArrayOfString
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffExample returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffExample(old, new *ExampleORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &ExampleORM{}
+	}
+	if new == nil {
+		new = &ExampleORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Description != new.Description {
+		mask.Paths = append(mask.Paths, "Description")
+	}
+	if !reflect.DeepEqual(old.ArrayOfBools, new.ArrayOfBools) {
+		mask.Paths = append(mask.Paths, "ArrayOfBools")
+	}
+	if !reflect.DeepEqual(old.ArrayOfFloat64, new.ArrayOfFloat64) {
+		mask.Paths = append(mask.Paths, "ArrayOfFloat64")
+	}
+	if !reflect.DeepEqual(old.ArrayOfInt64, new.ArrayOfInt64) {
+		mask.Paths = append(mask.Paths, "ArrayOfInt64")
+	}
+	if !reflect.DeepEqual(old.ArrayOfString, new.ArrayOfString) {
+		mask.Paths = append(mask.Paths, "ArrayOfString")
+	}
+	return mask
+}
+
+// ExampleSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func ExampleSliceToORM(ctx context.Context, in []*Example) ([]*ExampleORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*ExampleORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// ExampleORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func ExampleORMSliceToPB(ctx context.Context, in []*ExampleORM) ([]*Example, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Example, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Example the arg will be the target, the caller the one being converted from
 
@@ -116,6 +316,19 @@ type ExampleWithAfterToPB interface {
 	AfterToPB(context.Context, *Example) error
 }
 
+// PostgresArraysSchemaHash identifies the schema of the ORM types defined in postgres_arrays.proto
+const PostgresArraysSchemaHash = "1dd95ad071ddb252207ee228862013df34f87f7600fb5744e54f83f3077bc477"
+
+// RegisterPostgresArraysCallbacks registers the GORM callbacks of the ORM types defined
+// in postgres_arrays.proto, registering them again replaces the previous ones
+func RegisterPostgresArraysCallbacks(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	returning.Register(db)
+	return nil
+}
+
 // DefaultCreateExample executes a basic gorm create call
 func DefaultCreateExample(ctx context.Context, in *Example, db *gorm.DB) (*Example, error) {
 	if in == nil {
@@ -130,7 +343,8 @@ func DefaultCreateExample(ctx context.Context, in *Example, db *gorm.DB) (*Examp
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
 	if hook, ok := interface{}(&ormObj).(ExampleORMWithAfterCreate_); ok {
@@ -149,6 +363,174 @@ type ExampleORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
+// DefaultCopyFromExample inserts the objects with a COPY into the examples table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateExampleSet
+// leaves them to the DB.
+func DefaultCopyFromExample(ctx context.Context, in []*Example, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]ExampleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into examples takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into examples needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("examples", "array_of_bools", "array_of_float64", "array_of_int64", "array_of_string", "description", "id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.ArrayOfBools, row.ArrayOfFloat64, row.ArrayOfInt64, row.ArrayOfString, row.Description, row.Id); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// ExampleORMMaxBatchSize is the most rows of an INSERT of DefaultCreateExampleSet,
+// postgres takes 65535 bind parameters in a statement and a row has 6
+const ExampleORMMaxBatchSize = 10922
+
+// DefaultCreateExampleSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most ExampleORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateExampleSet(ctx context.Context, in []*Example, db *gorm.DB, batchSize int) ([]*Example, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > ExampleORMMaxBatchSize {
+		batchSize = ExampleORMMaxBatchSize
+	}
+	rows := make([]ExampleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*ExampleORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*6)
+			for _, row := range batch {
+				values := []interface{}{row.ArrayOfBools, row.ArrayOfFloat64, row.ArrayOfInt64, row.ArrayOfString, row.Description, row.Id}
+				if row.Id == "" {
+					values[5] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*ExampleORM
+			if err := tx.Raw(`INSERT INTO "examples" ("array_of_bools", "array_of_float64", "array_of_int64", "array_of_string", "description", "id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return ExampleORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertExampleSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most ExampleORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertExampleSet(ctx context.Context, in []*Example, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > ExampleORMMaxBatchSize {
+		batchSize = ExampleORMMaxBatchSize
+	}
+	rows := make([]ExampleORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*6)
+			for _, row := range batch {
+				values := []interface{}{row.ArrayOfBools, row.ArrayOfFloat64, row.ArrayOfInt64, row.ArrayOfString, row.Description, row.Id}
+				if row.Id == "" {
+					values[5] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "examples" ("array_of_bools", "array_of_float64", "array_of_int64", "array_of_string", "description", "id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO UPDATE SET "array_of_bools" = EXCLUDED."array_of_bools", "array_of_float64" = EXCLUDED."array_of_float64", "array_of_int64" = EXCLUDED."array_of_int64", "array_of_string" = EXCLUDED."array_of_string", "description" = EXCLUDED."description"`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
 func DefaultReadExample(ctx context.Context, in *Example, db *gorm.DB) (*Example, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
@@ -168,6 +550,9 @@ func DefaultReadExample(ctx context.Context, in *Example, db *gorm.DB) (*Example
 	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ExampleORM{}); err != nil {
 		return nil, err
 	}
+	if db, err = applyExampleConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
@@ -196,7 +581,44 @@ type ExampleORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
+// DefaultReadExampleForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadExampleForUpdate(ctx context.Context, in *Example, db *gorm.DB, wait types.LockWait) (*Example, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := ExampleORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
 func DefaultDeleteExample(ctx context.Context, in *Example, db *gorm.DB) error {
+	return defaultDeleteExample(ctx, in, db, nil)
+}
+
+// DefaultDeleteExampleWithResult is DefaultDeleteExample reporting the affected rows,
+// no affected rows means no Example matched
+func DefaultDeleteExampleWithResult(ctx context.Context, in *Example, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteExample(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteExample(ctx context.Context, in *Example, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -212,10 +634,14 @@ func DefaultDeleteExample(ctx context.Context, in *Example, db *gorm.DB) error {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&ExampleORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&ExampleORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
 	if hook, ok := interface{}(&ormObj).(ExampleORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
@@ -269,6 +695,18 @@ type ExampleORMWithAfterDeleteSet interface {
 
 // DefaultStrictUpdateExample clears / replaces / appends first level 1:many children and then executes a gorm update call
 func DefaultStrictUpdateExample(ctx context.Context, in *Example, db *gorm.DB) (*Example, error) {
+	return defaultStrictUpdateExample(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateExampleWithResult is DefaultStrictUpdateExample reporting the affected rows
+// and whether the Example existed before the update
+func DefaultStrictUpdateExampleWithResult(ctx context.Context, in *Example, db *gorm.DB) (*Example, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateExample(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateExample(ctx context.Context, in *Example, db *gorm.DB, result *types.WriteResult) (*Example, error) {
 	if in == nil {
 		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateExample")
 	}
@@ -289,9 +727,14 @@ func DefaultStrictUpdateExample(ctx context.Context, in *Example, db *gorm.DB) (
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
 	if hook, ok := interface{}(&ormObj).(ExampleORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
@@ -391,6 +834,57 @@ func DefaultPatchSetExample(ctx context.Context, objects []*Example, updateMasks
 	return results, nil
 }
 
+// DefaultUpdateExampleByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key and by-fields cannot be in updateMask.
+func DefaultUpdateExampleByIds(ctx context.Context, ids []string, in *Example, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Description":
+			columns["description"] = ormObj.Description
+		case "ArrayOfBools":
+			columns["array_of_bools"] = ormObj.ArrayOfBools
+		case "ArrayOfFloat64":
+			columns["array_of_float64"] = ormObj.ArrayOfFloat64
+		case "ArrayOfInt64":
+			columns["array_of_int64"] = ormObj.ArrayOfInt64
+		case "ArrayOfString":
+			columns["array_of_string"] = ormObj.ArrayOfString
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&ExampleORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
 // DefaultApplyFieldMaskExample patches an pbObject with patcher according to a field mask.
 func DefaultApplyFieldMaskExample(ctx context.Context, patchee *Example, patcher *Example, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Example, error) {
 	if patcher == nil {
@@ -431,8 +925,9 @@ func DefaultApplyFieldMaskExample(ctx context.Context, patchee *Example, patcher
 	return patchee, nil
 }
 
-// DefaultListExample executes a gorm list call
-func DefaultListExample(ctx context.Context, db *gorm.DB) ([]*Example, error) {
+// DefaultListExample executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListExample(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Example, error) {
 	in := Example{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -447,12 +942,15 @@ func DefaultListExample(ctx context.Context, db *gorm.DB) ([]*Example, error) {
 	if err != nil {
 		return nil, err
 	}
+	if db, err = applyExampleConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
 	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
 	ormResponse := []ExampleORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
@@ -483,3 +981,84 @@ type ExampleORMWithBeforeListFind interface {
 type ExampleORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]ExampleORM) error
 }
+
+// DefaultExplainListExample returns the SELECT DefaultListExample would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListExample(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Example{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ExampleORM{}, &Example{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyExampleConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &ExampleORM{})
+}
+
+// ExampleORMSelectable lists the columns accepted by DefaultSelectExample
+var ExampleORMSelectable = map[string]struct{}{
+	"array_of_bools":   {},
+	"array_of_float64": {},
+	"array_of_int64":   {},
+	"array_of_string":  {},
+	"description":      {},
+	"id":               {},
+}
+
+// DefaultSelectExample runs the query of DefaultListExample for the columns only, which
+// must be in ExampleORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectExample(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := ExampleORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "examples."+column)
+	}
+	in := Example{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ExampleORM{}, &Example{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyExampleConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ExampleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&ExampleORM{}).Select(selected).Rows()
+}
@@ -0,0 +1,40 @@
+// Package caller carries the account and tenant schema of the caller in the
+// context, for the extractors of the session example
+package caller
+
+import (
+	"context"
+	"errors"
+)
+
+type contextKey int
+
+const (
+	accountKey contextKey = iota
+	schemaKey
+)
+
+// MissingAccountError is returned by AccountID for a context without one
+var MissingAccountError = errors.New("no account in the context")
+
+// WithAccount returns ctx carrying the account and tenant schema of a caller
+func WithAccount(ctx context.Context, accountID, schema string) context.Context {
+	return context.WithValue(context.WithValue(ctx, accountKey, accountID), schemaKey, schema)
+}
+
+// AccountID extracts the value of the row-level security session variable,
+// the rls_extractor of the example
+func AccountID(ctx context.Context) (string, error) {
+	accountID, _ := ctx.Value(accountKey).(string)
+	if accountID == "" {
+		return "", MissingAccountError
+	}
+	return accountID, nil
+}
+
+// TenantSchema extracts the schema of the tenant, the tenant_schema_extractor
+// of the example
+func TenantSchema(ctx context.Context) (string, error) {
+	schema, _ := ctx.Value(schemaKey).(string)
+	return schema, nil
+}
@@ -0,0 +1,1427 @@
+package session
+
+import (
+	context "context"
+	sql "database/sql"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	caller "github.com/infobloxopen/protoc-gen-gorm/example/session/caller"
+	explain "github.com/infobloxopen/protoc-gen-gorm/explain"
+	metrics "github.com/infobloxopen/protoc-gen-gorm/metrics"
+	returning "github.com/infobloxopen/protoc-gen-gorm/returning"
+	rls "github.com/infobloxopen/protoc-gen-gorm/rls"
+	tenant "github.com/infobloxopen/protoc-gen-gorm/tenant"
+	timeout "github.com/infobloxopen/protoc-gen-gorm/timeout"
+	types "github.com/infobloxopen/protoc-gen-gorm/types"
+	gorm "github.com/jinzhu/gorm"
+	pq "github.com/lib/pq"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	strings "strings"
+	time "time"
+)
+
+type NoteORM struct {
+	Id   uint64 `gorm:"primary_key"`
+	Text string `gorm:"not null"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (NoteORM) TableName() string {
+	return "notes"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *NoteORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *NoteORM) Reload(ctx context.Context, db *gorm.DB) (err error) {
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	reloaded := NoteORM{}
+	if err := db.Where("id = ?", m.Id).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// NoteORMIndexes lists the indexes declared by the gorm tags of NoteORM
+var NoteORMIndexes = []types.IndexDef{}
+
+// Describe returns the table, columns and associations of NoteORM
+func (NoteORM) Describe() types.TableInfo {
+	return types.TableInfo{
+		Name: "notes",
+		Columns: []types.ColumnInfo{
+			{Name: "id", GoType: "uint64", Nullable: false, PrimaryKey: true},
+			{Name: "text", GoType: "string", Nullable: false, PrimaryKey: false},
+		},
+	}
+}
+
+// applyNoteConditionalPreloads preloads the associations of the conditional
+// preloads of Note in ctx whose condition holds
+func applyNoteConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Note") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Note) ToORM(ctx context.Context) (NoteORM, error) {
+	to := NoteORM{}
+	var err error
+	if prehook, ok := interface{}(m).(NoteWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(NoteWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *NoteORM) ToPB(ctx context.Context) (Note, error) {
+	to := Note{}
+	var err error
+	if prehook, ok := interface{}(m).(NoteWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Text = m.Text
+	if posthook, ok := interface{}(m).(NoteWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Note) MergeToORM(ctx context.Context, dst *NoteORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Id = to.Id
+	dst.Text = to.Text
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Note) ApplyFieldMaskToORM(ctx context.Context, dst *NoteORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *NoteORM) applyFieldMaskPath(from *NoteORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Text":
+		if rest != "" {
+			return false
+		}
+		m.Text = from.Text
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffNote returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffNote(old, new *NoteORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &NoteORM{}
+	}
+	if new == nil {
+		new = &NoteORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Text != new.Text {
+		mask.Paths = append(mask.Paths, "Text")
+	}
+	return mask
+}
+
+// NoteSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func NoteSliceToORM(ctx context.Context, in []*Note) ([]*NoteORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*NoteORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// NoteORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func NoteORMSliceToPB(ctx context.Context, in []*NoteORM) ([]*Note, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Note, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Note the arg will be the target, the caller the one being converted from
+
+// NoteBeforeToORM called before default ToORM code
+type NoteWithBeforeToORM interface {
+	BeforeToORM(context.Context, *NoteORM) error
+}
+
+// NoteAfterToORM called after default ToORM code
+type NoteWithAfterToORM interface {
+	AfterToORM(context.Context, *NoteORM) error
+}
+
+// NoteBeforeToPB called before default ToPB code
+type NoteWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Note) error
+}
+
+// NoteAfterToPB called after default ToPB code
+type NoteWithAfterToPB interface {
+	AfterToPB(context.Context, *Note) error
+}
+
+// SessionSchemaHash identifies the schema of the ORM types defined in session.proto
+const SessionSchemaHash = "5b12487813d63a7aba930fd3cb34d5737f65bf82a436f8b15227ba74ce26f2f8"
+
+// RegisterSessionCallbacks registers the GORM callbacks of the ORM types defined
+// in session.proto, registering them again replaces the previous ones
+func RegisterSessionCallbacks(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	returning.Register(db)
+	return nil
+}
+
+// DefaultCreateNote executes a basic gorm create call
+func DefaultCreateNote(ctx context.Context, in *Note, db *gorm.DB) (_ *Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "create", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer tenantSession.Rollback()
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return nil, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NoteORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromNote inserts the objects with a COPY into the notes table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromNote(ctx context.Context, in []*Note, db *gorm.DB) (_ int64, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "copy_from", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]NoteORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return 0, err
+	}
+	defer tenantSession.Rollback()
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into notes needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("notes", "text"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Text); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return 0, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// NoteORMMaxBatchSize is the most rows of an INSERT of DefaultCreateNoteSet,
+// postgres takes 65535 bind parameters in a statement and a row has 1
+const NoteORMMaxBatchSize = 65535
+
+// DefaultCreateNoteSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most NoteORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateNoteSet(ctx context.Context, in []*Note, db *gorm.DB, batchSize int) (_ []*Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "create_set", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > NoteORMMaxBatchSize {
+		batchSize = NoteORMMaxBatchSize
+	}
+	rows := make([]NoteORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer tenantSession.Rollback()
+	created := make([]*NoteORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*1)
+			for _, row := range batch {
+				tuples = append(tuples, "(?)")
+				args = append(args, row.Text)
+			}
+			var stored []*NoteORM
+			if err := tx.Raw(`INSERT INTO "notes" ("text") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return nil, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return nil, err
+	}
+	return NoteORMSliceToPB(ctx, created)
+}
+
+func DefaultReadNote(ctx context.Context, in *Note, db *gorm.DB) (_ *Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "read", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer tenantSession.Rollback()
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &NoteORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyNoteConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := NoteORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(NoteORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return nil, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type NoteORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadNoteForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadNoteForUpdate(ctx context.Context, in *Note, db *gorm.DB, wait types.LockWait) (_ *Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "read_for_update", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer tenantSession.Rollback()
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := NoteORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return nil, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteNote(ctx context.Context, in *Note, db *gorm.DB) error {
+	return defaultDeleteNote(ctx, in, db, nil)
+}
+
+// DefaultDeleteNoteWithResult is DefaultDeleteNote reporting the affected rows,
+// no affected rows means no Note matched
+func DefaultDeleteNoteWithResult(ctx context.Context, in *Note, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteNote(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteNote(ctx context.Context, in *Note, db *gorm.DB, result *types.WriteResult) (err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "delete", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return err
+	}
+	defer tenantSession.Rollback()
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&NoteORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	if err != nil {
+		return err
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return err
+	}
+	return err
+}
+
+type NoteORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteNoteSet(ctx context.Context, in []*Note, db *gorm.DB) (err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "delete_set", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return err
+	}
+	defer tenantSession.Rollback()
+	keys := []uint64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&NoteORM{})).(NoteORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&NoteORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&NoteORM{})).(NoteORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	if err != nil {
+		return err
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return err
+	}
+	return err
+}
+
+type NoteORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Note, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Note, *gorm.DB) error
+}
+
+// DefaultStrictUpdateNote clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateNote(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	return defaultStrictUpdateNote(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateNoteWithResult is DefaultStrictUpdateNote reporting the affected rows
+// and whether the Note existed before the update
+func DefaultStrictUpdateNoteWithResult(ctx context.Context, in *Note, db *gorm.DB) (*Note, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateNote(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateNote(ctx context.Context, in *Note, db *gorm.DB, result *types.WriteResult) (_ *Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "update", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateNote")
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer tenantSession.Rollback()
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var count int64
+	lockedRow := &NoteORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return nil, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type NoteORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchNote executes a basic gorm update call with patch behavior
+func DefaultPatchNote(ctx context.Context, in *Note, updateMask *field_mask.FieldMask, db *gorm.DB) (_ *Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "patch", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Note
+	if hook, ok := interface{}(&pbObj).(NoteWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadNote(ctx, &Note{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(NoteWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskNote(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(NoteWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateNote(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(NoteWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type NoteWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NoteWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NoteWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type NoteWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Note, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetNote executes a bulk gorm update call with patch behavior
+func DefaultPatchSetNote(ctx context.Context, objects []*Note, updateMasks []*field_mask.FieldMask, db *gorm.DB) (_ []*Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "patch_set", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Note, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchNote(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateNoteByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key and by-fields cannot be in updateMask.
+func DefaultUpdateNoteByIds(ctx context.Context, ids []uint64, in *Note, updateMask *field_mask.FieldMask, db *gorm.DB) (_ int64, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "update_by_ids", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Text":
+			columns["text"] = ormObj.Text
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return 0, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return 0, err
+	}
+	defer tenantSession.Rollback()
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&NoteORM{}).Where("id IN (?)", batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return 0, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskNote patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskNote(ctx context.Context, patchee *Note, patcher *Note, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Note, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Text" {
+			patchee.Text = patcher.Text
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListNote executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListNote(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (_ []*Note, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "list", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer tenantSession.Rollback()
+	in := Note{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &NoteORM{}, &Note{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyNoteConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []NoteORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	if err = tenantSession.Commit(); err != nil {
+		return nil, err
+	}
+	if err = rlsSession.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse := []*Note{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type NoteORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type NoteORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]NoteORM) error
+}
+
+// DefaultExplainListNote returns the SELECT DefaultListNote would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListNote(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Note{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &NoteORM{}, &Note{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyNoteConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &NoteORM{})
+}
+
+// NoteORMSelectable lists the columns accepted by DefaultSelectNote
+var NoteORMSelectable = map[string]struct{}{
+	"id":   {},
+	"text": {},
+}
+
+// DefaultSelectNote runs the query of DefaultListNote for the columns only, which
+// must be in NoteORMSelectable, and returns the rows for the caller to scan and close.
+// The rows are read in the transaction of db, it fails with NoTransactionError outside of one.
+func DefaultSelectNote(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (_ *sql.Rows, err error) {
+	defer func(start time.Time) {
+		metrics.Observe("session.Note", "select", start, err)
+	}(time.Now())
+	defer func() {
+		err = errors.Status(err)
+	}()
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := NoteORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "notes."+column)
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	db, rlsSession, err := rls.Begin(ctx, db, "app.account_id", caller.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rlsSession.Rollback()
+	db, tenantSession, err := tenant.Begin(ctx, db, caller.TenantSchema)
+	if err != nil {
+		return nil, err
+	}
+	defer tenantSession.Rollback()
+	in := Note{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &NoteORM{}, &Note{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyNoteConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(NoteORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&NoteORM{}).Select(selected).Rows()
+}
+
+// NoteORMRepository is the interface of the default CRUD handlers of Note,
+// implemented by NoteORMDefaultRepository and to be mocked in tests
+type NoteORMRepository interface {
+	Create(ctx context.Context, in *Note, db *gorm.DB) (*Note, error)
+	Read(ctx context.Context, in *Note, db *gorm.DB) (*Note, error)
+	StrictUpdate(ctx context.Context, in *Note, db *gorm.DB) (*Note, error)
+	Patch(ctx context.Context, in *Note, updateMask *field_mask.FieldMask, db *gorm.DB) (*Note, error)
+	Delete(ctx context.Context, in *Note, db *gorm.DB) error
+	List(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Note, error)
+}
+
+// NoteORMDefaultRepository calls the default handlers of Note
+type NoteORMDefaultRepository struct{}
+
+var _ NoteORMRepository = NoteORMDefaultRepository{}
+
+func (NoteORMDefaultRepository) Create(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	return DefaultCreateNote(ctx, in, db)
+}
+
+func (NoteORMDefaultRepository) Read(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	return DefaultReadNote(ctx, in, db)
+}
+
+func (NoteORMDefaultRepository) StrictUpdate(ctx context.Context, in *Note, db *gorm.DB) (*Note, error) {
+	return DefaultStrictUpdateNote(ctx, in, db)
+}
+
+func (NoteORMDefaultRepository) Patch(ctx context.Context, in *Note, updateMask *field_mask.FieldMask, db *gorm.DB) (*Note, error) {
+	return DefaultPatchNote(ctx, in, updateMask, db)
+}
+
+func (NoteORMDefaultRepository) Delete(ctx context.Context, in *Note, db *gorm.DB) error {
+	return DefaultDeleteNote(ctx, in, db)
+}
+
+func (NoteORMDefaultRepository) List(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Note, error) {
+	return DefaultListNote(ctx, db, scopes...)
+}
+
+type NoteServiceDefaultServer struct {
+	DB *gorm.DB
+}
+
+// Create ...
+func (m *NoteServiceDefaultServer) Create(ctx context.Context, in *CreateNoteRequest) (*CreateNoteResponse, error) {
+	db := m.DB
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	db, timeoutTxn, errTimeout := timeout.Begin(ctx, db, 5*time.Second)
+	if errTimeout != nil {
+		return nil, errTimeout
+	}
+	defer timeoutTxn.Rollback()
+	if custom, ok := interface{}(in).(NoteServiceNoteWithBeforeCreate); ok {
+		var err error
+		if db, err = custom.BeforeCreate(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultCreateNote(ctx, in.GetPayload(), db)
+	if err != nil {
+		return nil, err
+	}
+	out := &CreateNoteResponse{Result: res}
+	if custom, ok := interface{}(in).(NoteServiceNoteWithAfterCreate); ok {
+		var err error
+		if err = custom.AfterCreate(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	if err := timeoutTxn.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NoteServiceNoteWithBeforeCreate called before DefaultCreateNote in the default Create handler
+type NoteServiceNoteWithBeforeCreate interface {
+	BeforeCreate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// NoteServiceNoteWithAfterCreate called before DefaultCreateNote in the default Create handler
+type NoteServiceNoteWithAfterCreate interface {
+	AfterCreate(context.Context, *CreateNoteResponse, *gorm.DB) error
+}
+
+// Read ...
+func (m *NoteServiceDefaultServer) Read(ctx context.Context, in *ReadNoteRequest) (*ReadNoteResponse, error) {
+	db := m.DB
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	db, timeoutTxn, errTimeout := timeout.Begin(ctx, db, 5*time.Second)
+	if errTimeout != nil {
+		return nil, errTimeout
+	}
+	defer timeoutTxn.Rollback()
+	if custom, ok := interface{}(in).(NoteServiceNoteWithBeforeRead); ok {
+		var err error
+		if db, err = custom.BeforeRead(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultReadNote(ctx, &Note{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &ReadNoteResponse{Result: res}
+	if custom, ok := interface{}(in).(NoteServiceNoteWithAfterRead); ok {
+		var err error
+		if err = custom.AfterRead(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	if err := timeoutTxn.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NoteServiceNoteWithBeforeRead called before DefaultReadNote in the default Read handler
+type NoteServiceNoteWithBeforeRead interface {
+	BeforeRead(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// NoteServiceNoteWithAfterRead called before DefaultReadNote in the default Read handler
+type NoteServiceNoteWithAfterRead interface {
+	AfterRead(context.Context, *ReadNoteResponse, *gorm.DB) error
+}
+
+// Update ...
+func (m *NoteServiceDefaultServer) Update(ctx context.Context, in *UpdateNoteRequest) (*UpdateNoteResponse, error) {
+	var err error
+	var res *Note
+	db := m.DB
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	db, timeoutTxn, errTimeout := timeout.Begin(ctx, db, 5*time.Second)
+	if errTimeout != nil {
+		return nil, errTimeout
+	}
+	defer timeoutTxn.Rollback()
+	if custom, ok := interface{}(in).(NoteServiceNoteWithBeforeUpdate); ok {
+		var err error
+		if db, err = custom.BeforeUpdate(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if in.GetUpdateMask() == nil {
+		res, err = DefaultStrictUpdateNote(ctx, in.GetPayload(), db)
+	} else {
+		res, err = DefaultPatchNote(ctx, in.GetPayload(), in.GetUpdateMask(), db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &UpdateNoteResponse{Result: res}
+	if custom, ok := interface{}(in).(NoteServiceNoteWithAfterUpdate); ok {
+		var err error
+		if err = custom.AfterUpdate(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	if err := timeoutTxn.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NoteServiceNoteWithBeforeUpdate called before DefaultUpdateNote in the default Update handler
+type NoteServiceNoteWithBeforeUpdate interface {
+	BeforeUpdate(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// NoteServiceNoteWithAfterUpdate called before DefaultUpdateNote in the default Update handler
+type NoteServiceNoteWithAfterUpdate interface {
+	AfterUpdate(context.Context, *UpdateNoteResponse, *gorm.DB) error
+}
+
+// Delete ...
+func (m *NoteServiceDefaultServer) Delete(ctx context.Context, in *DeleteNoteRequest) (*DeleteNoteResponse, error) {
+	db := m.DB
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	db, timeoutTxn, errTimeout := timeout.Begin(ctx, db, 5*time.Second)
+	if errTimeout != nil {
+		return nil, errTimeout
+	}
+	defer timeoutTxn.Rollback()
+	if custom, ok := interface{}(in).(NoteServiceNoteWithBeforeDelete); ok {
+		var err error
+		if db, err = custom.BeforeDelete(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	err := DefaultDeleteNote(ctx, &Note{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &DeleteNoteResponse{}
+	if custom, ok := interface{}(in).(NoteServiceNoteWithAfterDelete); ok {
+		var err error
+		if err = custom.AfterDelete(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	if err := timeoutTxn.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NoteServiceNoteWithBeforeDelete called before DefaultDeleteNote in the default Delete handler
+type NoteServiceNoteWithBeforeDelete interface {
+	BeforeDelete(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// NoteServiceNoteWithAfterDelete called before DefaultDeleteNote in the default Delete handler
+type NoteServiceNoteWithAfterDelete interface {
+	AfterDelete(context.Context, *DeleteNoteResponse, *gorm.DB) error
+}
+
+// List ...
+func (m *NoteServiceDefaultServer) List(ctx context.Context, in *ListNoteRequest) (*ListNoteResponse, error) {
+	db := m.DB
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	db, timeoutTxn, errTimeout := timeout.Begin(ctx, db, 5*time.Second)
+	if errTimeout != nil {
+		return nil, errTimeout
+	}
+	defer timeoutTxn.Rollback()
+	if custom, ok := interface{}(in).(NoteServiceNoteWithBeforeList); ok {
+		var err error
+		if db, err = custom.BeforeList(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	res, err := DefaultListNote(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	out := &ListNoteResponse{Results: res}
+	if custom, ok := interface{}(in).(NoteServiceNoteWithAfterList); ok {
+		var err error
+		if err = custom.AfterList(ctx, out, db); err != nil {
+			return nil, err
+		}
+	}
+	if err := timeoutTxn.Commit(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NoteServiceNoteWithBeforeList called before DefaultListNote in the default List handler
+type NoteServiceNoteWithBeforeList interface {
+	BeforeList(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+
+// NoteServiceNoteWithAfterList called before DefaultListNote in the default List handler
+type NoteServiceNoteWithAfterList interface {
+	AfterList(context.Context, *ListNoteResponse, *gorm.DB) error
+}
@@ -0,0 +1,148 @@
+package session
+
+import (
+	"context"
+	"database/sql/driver"
+	goerrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/infobloxopen/protoc-gen-gorm/example/session/caller"
+	"github.com/infobloxopen/protoc-gen-gorm/internal/dbtest"
+	"github.com/infobloxopen/protoc-gen-gorm/metrics"
+	"github.com/jinzhu/gorm"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func openSession(t *testing.T) (*gorm.DB, *dbtest.Recorder) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterSessionCallbacks(db); err != nil {
+		t.Fatal(err)
+	}
+	return db, recorder
+}
+
+func TestServerCreate(t *testing.T) {
+	db, recorder := openSession(t)
+	recorder.Return(`INSERT INTO "notes"`, []string{"id", "text"}, []driver.Value{int64(1), "hello"})
+	server := &NoteServiceDefaultServer{DB: db}
+	ctx := caller.WithAccount(context.Background(), "account-1", "tenant_1")
+	res, err := server.Create(ctx, &CreateNoteRequest{Payload: &Note{Text: "hello"}})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	if res.GetResult().GetId() != 1 {
+		t.Errorf("Create=%v; want the note 1", res.GetResult())
+	}
+	// default_timeout, rls_session_var and tenant_schema_extractor all set
+	// their session variables in the transaction of the handler
+	want := []dbtest.Statement{
+		{Query: "BEGIN"},
+		{Query: "SELECT set_config('statement_timeout', $1, true)", Args: []interface{}{"5000"}},
+		{Query: "SELECT set_config($1, $2, true)", Args: []interface{}{"app.account_id", "account-1"}},
+		{Query: "SELECT set_config('search_path', quote_ident($1) || ', public', true)", Args: []interface{}{"tenant_1"}},
+		{Query: `INSERT INTO "notes" ("text") VALUES ($1) RETURNING *`, Args: []interface{}{"hello"}},
+		{Query: "COMMIT"},
+	}
+	if got := recorder.Statements(); !reflect.DeepEqual(got, want) {
+		t.Errorf("statements=%v; want %v", got, want)
+	}
+}
+
+func TestServerCreateMissingAccount(t *testing.T) {
+	db, recorder := openSession(t)
+	server := &NoteServiceDefaultServer{DB: db}
+	_, err := server.Create(context.Background(), &CreateNoteRequest{Payload: &Note{Text: "hello"}})
+	if !goerrors.Is(err, caller.MissingAccountError) {
+		t.Fatalf("Create=%v; want %v", err, caller.MissingAccountError)
+	}
+	queries := recorder.Queries()
+	if last := queries[len(queries)-1]; last != "ROLLBACK" {
+		t.Errorf("queries=%q; want a rollback of the transaction", queries)
+	}
+}
+
+func TestServerReadNotFound(t *testing.T) {
+	db, _ := openSession(t)
+	server := &NoteServiceDefaultServer{DB: db}
+	ctx := caller.WithAccount(context.Background(), "account-1", "tenant_1")
+	// grpc_status_errors turns the missing record into a NotFound status
+	if _, err := server.Read(ctx, &ReadNoteRequest{Id: 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("Read=%v; want code %v", err, codes.NotFound)
+	}
+}
+
+func opsTotal(t *testing.T, reg *prometheus.Registry, op string) float64 {
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+	for _, family := range families {
+		if family.GetName() != "gorm_ops_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["message"] == "session.Note" && labels["op"] == op && labels["status"] == "ok" {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if err := metrics.Register(reg); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	db, recorder := openSession(t)
+	recorder.Return(`INSERT INTO "notes"`, []string{"id", "text"}, []driver.Value{int64(1), "hello"})
+	before := opsTotal(t, reg, "create")
+	ctx := caller.WithAccount(context.Background(), "account-1", "tenant_1")
+	if _, err := DefaultCreateNote(ctx, &Note{Text: "hello"}, db); err != nil {
+		t.Fatalf("DefaultCreateNote: %v", err)
+	}
+	if got := opsTotal(t, reg, "create") - before; got != 1 {
+		t.Errorf("gorm_ops_total{message=session.Note,op=create}=+%v; want +1", got)
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	info := NoteORM{}.Describe()
+	if info.Name != "notes" {
+		t.Errorf("Name=%q; want notes", info.Name)
+	}
+	var columns []string
+	for _, column := range info.Columns {
+		columns = append(columns, column.Name)
+	}
+	if want := []string{"id", "text"}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("Columns=%q; want %q", columns, want)
+	}
+	if !info.Columns[0].PrimaryKey || info.Columns[1].Nullable {
+		t.Errorf("Columns=%+v; want the primary key id and a not null text", info.Columns)
+	}
+}
+
+func TestRepository(t *testing.T) {
+	db, recorder := openSession(t)
+	recorder.Return(`FROM "notes"`, []string{"id", "text"}, []driver.Value{int64(1), "a"}, []driver.Value{int64(2), "b"})
+	var repository NoteORMRepository = NoteORMDefaultRepository{}
+	ctx := caller.WithAccount(context.Background(), "account-1", "tenant_1")
+	notes, err := repository.List(ctx, db)
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(notes) != 2 || notes[0].GetText() != "a" || notes[1].GetText() != "b" {
+		t.Errorf("List=%v; want the notes a and b", notes)
+	}
+}
@@ -2,31 +2,42 @@ package user
 
 import (
 	context "context"
+	sql "database/sql"
 	fmt "fmt"
 	auth "github.com/infobloxopen/atlas-app-toolkit/auth"
 	gateway "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
 	resource "github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
+	query "github.com/infobloxopen/atlas-app-toolkit/query"
+	audit "github.com/infobloxopen/protoc-gen-gorm/audit"
 	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	explain "github.com/infobloxopen/protoc-gen-gorm/explain"
+	returning "github.com/infobloxopen/protoc-gen-gorm/returning"
+	types "github.com/infobloxopen/protoc-gen-gorm/types"
 	gorm "github.com/jinzhu/gorm"
+	pq "github.com/lib/pq"
 	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	proto "google.golang.org/protobuf/proto"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
 	strings "strings"
 	time "time"
 )
 
 type UserORM struct {
 	AccountID         string
-	BillingAddress    *AddressORM `gorm:"foreignkey:BillingAddressId;association_foreignkey:Id"`
+	BillingAddress    *AddressORM `gorm:"foreignkey:BillingAddressId;association_foreignkey:Id;preload:true"`
 	BillingAddressId  *int64
 	Birthday          *time.Time
 	CreatedAt         *time.Time
-	CreditCard        *CreditCardORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
-	Emails            []*EmailORM    `gorm:"foreignkey:UserId;association_foreignkey:Id"`
-	ExternalUuid      *string        `gorm:"type:uuid"`
-	Friends           []*UserORM     `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_friends;jointable_foreignkey:UserId;association_jointable_foreignkey:FriendId"`
-	Id                string         `gorm:"type:uuid;primary_key"`
-	Languages         []*LanguageORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_languages;jointable_foreignkey:UserId;association_jointable_foreignkey:LanguageId"`
+	CreditCard        *CreditCardORM   `gorm:"foreignkey:UserId;association_foreignkey:Id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	EmailAttachments  []*AttachmentORM `gorm:"-"`
+	Emails            []*EmailORM      `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	ExternalUuid      *string          `gorm:"type:uuid"`
+	Friends           []*UserORM       `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_friends;jointable_foreignkey:UserId;association_jointable_foreignkey:FriendId"`
+	Id                string           `gorm:"type:uuid;primary_key"`
+	Languages         []*LanguageORM   `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_languages;jointable_foreignkey:UserId;association_jointable_foreignkey:LanguageId"`
+	LegacyLanguages   []*LanguageORM   `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:user_langs;jointable_foreignkey:uid;association_jointable_foreignkey:lang"`
 	Num               uint32
 	ShippingAddress   *AddressORM `gorm:"foreignkey:ShippingAddressId;association_foreignkey:Id"`
 	ShippingAddressId *int64
@@ -39,6 +50,271 @@ func (UserORM) TableName() string {
 	return "users"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *UserORM) ClearAssociations() {
+	m.BillingAddress = nil
+	m.CreditCard = nil
+	m.Emails = nil
+	m.Friends = nil
+	m.Languages = nil
+	m.LegacyLanguages = nil
+	m.ShippingAddress = nil
+	m.Tasks = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *UserORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	db = db.Preload("BillingAddress")
+	reloaded := UserORM{}
+	if err := db.Where("id = ? AND account_id = ?", m.Id, accountID).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// BeforeSave is called by gorm before creating or updating the row, it sets UpdatedAt
+// to the current time so that User changes on every save
+func (m *UserORM) BeforeSave(scope *gorm.Scope) error {
+	now := gorm.NowFunc()
+	if err := scope.SetColumn("UpdatedAt", &now); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UserORMIndexes lists the indexes declared by the gorm tags of UserORM
+var UserORMIndexes = []types.IndexDef{}
+
+// UserORMForeignKeys lists the foreign keys of the associations of UserORM
+var UserORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_users_billing_address_id", Table: "users", Columns: []string{"billing_address_id"}, References: "geo.addresses", ReferencedColumns: []string{"id"}},
+	{Name: "fk_credit_cards_user_id", Table: "credit_cards", Columns: []string{"user_id"}, References: "users", ReferencedColumns: []string{"id"}, OnDelete: "CASCADE", OnUpdate: "CASCADE"},
+	{Name: "fk_emails_user_id", Table: "emails", Columns: []string{"user_id"}, References: "users", ReferencedColumns: []string{"id"}},
+	{Name: "fk_users_shipping_address_id", Table: "users", Columns: []string{"shipping_address_id"}, References: "geo.addresses", ReferencedColumns: []string{"id"}},
+	{Name: "fk_tasks_user_id", Table: "tasks", Columns: []string{"user_id"}, References: "users", ReferencedColumns: []string{"id"}},
+}
+
+// LoadEmailAttachmentsThroughEmails loads the EmailAttachments of all the Emails of the object
+// with two queries: one for the Emails keys and one for the EmailAttachments
+func (m *UserORM) LoadEmailAttachmentsThroughEmails(ctx context.Context, db *gorm.DB) error {
+	var keys []string
+	if err := db.Model(&EmailORM{}).Where("user_id = ?", m.Id).Pluck("id", &keys).Error; err != nil {
+		return err
+	}
+	m.EmailAttachments = nil
+	if len(keys) == 0 {
+		return nil
+	}
+	return db.Where("email_id in (?)", keys).Find(&m.EmailAttachments).Error
+}
+
+// LoadUserCreditCardForAll loads the CreditCard of all the parents with a single query and
+// sets them on each parent by UserId and AccountID, in the order of id. The parents
+// lose the CreditCard they held, a nil parent is skipped.
+func LoadUserCreditCardForAll(ctx context.Context, db *gorm.DB, parents []*UserORM) error {
+	type key struct {
+		Id        string
+		AccountID string
+	}
+	byKey := make(map[key][]*UserORM, len(parents))
+	loaded := make(map[*UserORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	var accountIDs []string
+	accountIDSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.CreditCard = nil
+		k := key{parent.Id, parent.AccountID}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		if !accountIDSeen[k.AccountID] {
+			accountIDSeen[k.AccountID] = true
+			accountIDs = append(accountIDs, k.AccountID)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*CreditCardORM
+	if err := db.Where("user_id IN (?) AND account_id IN (?)", ids, accountIDs).Order("id").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.UserId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.UserId, child.AccountID}] {
+			if parent.CreditCard == nil {
+				parent.CreditCard = child
+			}
+		}
+	}
+	return nil
+}
+
+// LoadUserEmailsForAll loads the Emails of all the parents with a single query and
+// sets them on each parent by UserId and AccountID, in the order of id. The parents
+// lose the Emails they held, a nil parent is skipped.
+func LoadUserEmailsForAll(ctx context.Context, db *gorm.DB, parents []*UserORM) error {
+	type key struct {
+		Id        string
+		AccountID string
+	}
+	byKey := make(map[key][]*UserORM, len(parents))
+	loaded := make(map[*UserORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	var accountIDs []string
+	accountIDSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Emails = nil
+		k := key{parent.Id, parent.AccountID}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		if !accountIDSeen[k.AccountID] {
+			accountIDSeen[k.AccountID] = true
+			accountIDs = append(accountIDs, k.AccountID)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*EmailORM
+	if err := db.Where("user_id IN (?) AND account_id IN (?)", ids, accountIDs).Order("id").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.UserId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.UserId, child.AccountID}] {
+			parent.Emails = append(parent.Emails, child)
+		}
+	}
+	return nil
+}
+
+// LoadUserTasksForAll loads the Tasks of all the parents with a single query and
+// sets them on each parent by UserId and AccountID, in the order of priority. The parents
+// lose the Tasks they held, a nil parent is skipped.
+func LoadUserTasksForAll(ctx context.Context, db *gorm.DB, parents []*UserORM) error {
+	type key struct {
+		Id        string
+		AccountID string
+	}
+	byKey := make(map[key][]*UserORM, len(parents))
+	loaded := make(map[*UserORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	var accountIDs []string
+	accountIDSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Tasks = nil
+		k := key{parent.Id, parent.AccountID}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		if !accountIDSeen[k.AccountID] {
+			accountIDSeen[k.AccountID] = true
+			accountIDs = append(accountIDs, k.AccountID)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*TaskORM
+	if err := db.Where("user_id IN (?) AND account_id IN (?)", ids, accountIDs).Order("priority").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		for _, parent := range byKey[key{child.UserId, child.AccountID}] {
+			parent.Tasks = append(parent.Tasks, child)
+		}
+	}
+	return nil
+}
+
+// WithUserConditionalPreload returns a context making the Read and List
+// handlers of User preload the association name, e.g. BillingAddress, when cond holds
+// for their context
+func WithUserConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "User", name, cond)
+}
+
+// applyUserConditionalPreloads preloads the associations of the conditional
+// preloads of User in ctx whose condition holds
+func applyUserConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "User") {
+		switch preload.Name {
+		case "BillingAddress", "CreditCard", "Emails", "Friends", "Languages", "LegacyLanguages", "ShippingAddress", "Tasks":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
+// UpsertEmailsByEmail inserts the new Emails or updates the rows already stored with
+// the same "email", "account_id" in place, taking their primary key, in one statement per
+// object whether it has a primary key or not. Their associations are saved on their own. The table
+// needs a unique index of these columns.
+func (m *UserORM) UpsertEmailsByEmail(ctx context.Context, db *gorm.DB) error {
+	for _, child := range m.Emails {
+		if child == nil {
+			continue
+		}
+		child.UserId = new(string)
+		*child.UserId = m.Id
+		if err := db.Set("gorm:insert_option", `ON CONFLICT ("email", "account_id") DO UPDATE SET "external_not_null" = EXCLUDED."external_not_null", "subscribed" = EXCLUDED."subscribed", "user_id" = EXCLUDED."user_id"`).Set("gorm:save_associations", false).Create(child).Error; err != nil {
+			return err
+		}
+		for _, elem := range child.Attachments {
+			if elem == nil {
+				continue
+			}
+			elem.EmailId = new(string)
+			*elem.EmailId = child.Id
+			if err := db.Save(elem).Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *User) ToORM(ctx context.Context) (UserORM, error) {
@@ -147,6 +423,28 @@ func (m *User) ToORM(ctx context.Context) (UserORM, error) {
 			to.ExternalUuid = &vv
 		}
 	}
+	for _, v := range m.EmailAttachments {
+		if v != nil {
+			if tempEmailAttachments, cErr := v.ToORM(ctx); cErr == nil {
+				to.EmailAttachments = append(to.EmailAttachments, &tempEmailAttachments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.EmailAttachments = append(to.EmailAttachments, nil)
+		}
+	}
+	for _, v := range m.LegacyLanguages {
+		if v != nil {
+			if tempLegacyLanguages, cErr := v.ToORM(ctx); cErr == nil {
+				to.LegacyLanguages = append(to.LegacyLanguages, &tempLegacyLanguages)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.LegacyLanguages = append(to.LegacyLanguages, nil)
+		}
+	}
 	accountID, err := auth.GetAccountID(ctx, nil)
 	if err != nil {
 		return to, err
@@ -265,12 +563,349 @@ func (m *UserORM) ToPB(ctx context.Context) (User, error) {
 			to.ExternalUuid = v
 		}
 	}
+	for _, v := range m.EmailAttachments {
+		if v != nil {
+			if tempEmailAttachments, cErr := v.ToPB(ctx); cErr == nil {
+				to.EmailAttachments = append(to.EmailAttachments, &tempEmailAttachments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.EmailAttachments = append(to.EmailAttachments, nil)
+		}
+	}
+	for _, v := range m.LegacyLanguages {
+		if v != nil {
+			if tempLegacyLanguages, cErr := v.ToPB(ctx); cErr == nil {
+				to.LegacyLanguages = append(to.LegacyLanguages, &tempLegacyLanguages)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.LegacyLanguages = append(to.LegacyLanguages, nil)
+		}
+	}
 	if posthook, ok := interface{}(m).(UserWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *User) MergeToORM(ctx context.Context, dst *UserORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Id != nil {
+		dst.Id = to.Id
+	}
+	if m.CreatedAt != nil {
+		dst.CreatedAt = to.CreatedAt
+	}
+	if m.UpdatedAt != nil {
+		dst.UpdatedAt = to.UpdatedAt
+	}
+	if m.Birthday != nil {
+		dst.Birthday = to.Birthday
+	}
+	dst.Num = to.Num
+	if m.ShippingAddressId != nil {
+		dst.ShippingAddressId = to.ShippingAddressId
+	}
+	if m.ExternalUuid != nil {
+		dst.ExternalUuid = to.ExternalUuid
+	}
+	if associations {
+		dst.CreditCard = to.CreditCard
+		dst.Emails = to.Emails
+		dst.Tasks = to.Tasks
+		dst.BillingAddress = to.BillingAddress
+		dst.ShippingAddress = to.ShippingAddress
+		dst.Languages = to.Languages
+		dst.Friends = to.Friends
+		dst.EmailAttachments = to.EmailAttachments
+		dst.LegacyLanguages = to.LegacyLanguages
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *User) ApplyFieldMaskToORM(ctx context.Context, dst *UserORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *UserORM) applyFieldMaskPath(from *UserORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "CreatedAt":
+		if rest != "" {
+			return false
+		}
+		m.CreatedAt = from.CreatedAt
+		return true
+	case "UpdatedAt":
+		if rest != "" {
+			return false
+		}
+		m.UpdatedAt = from.UpdatedAt
+		return true
+	case "Birthday":
+		if rest != "" {
+			return false
+		}
+		m.Birthday = from.Birthday
+		return true
+	case "Num":
+		if rest != "" {
+			return false
+		}
+		m.Num = from.Num
+		return true
+	case "CreditCard":
+		if rest == "" {
+			m.CreditCard = from.CreditCard
+			return true
+		}
+		nested := from.CreditCard
+		if nested == nil {
+			nested = &CreditCardORM{}
+		}
+		if m.CreditCard == nil {
+			m.CreditCard = &CreditCardORM{}
+		}
+		return m.CreditCard.applyFieldMaskPath(nested, rest)
+	case "Emails":
+		if rest != "" {
+			return false
+		}
+		m.Emails = from.Emails
+		return true
+	case "Tasks":
+		if rest != "" {
+			return false
+		}
+		m.Tasks = from.Tasks
+		return true
+	case "BillingAddress":
+		if rest == "" {
+			m.BillingAddress = from.BillingAddress
+			return true
+		}
+		nested := from.BillingAddress
+		if nested == nil {
+			nested = &AddressORM{}
+		}
+		if m.BillingAddress == nil {
+			m.BillingAddress = &AddressORM{}
+		}
+		return m.BillingAddress.applyFieldMaskPath(nested, rest)
+	case "ShippingAddress":
+		if rest == "" {
+			m.ShippingAddress = from.ShippingAddress
+			return true
+		}
+		nested := from.ShippingAddress
+		if nested == nil {
+			nested = &AddressORM{}
+		}
+		if m.ShippingAddress == nil {
+			m.ShippingAddress = &AddressORM{}
+		}
+		return m.ShippingAddress.applyFieldMaskPath(nested, rest)
+	case "Languages":
+		if rest != "" {
+			return false
+		}
+		m.Languages = from.Languages
+		return true
+	case "Friends":
+		if rest != "" {
+			return false
+		}
+		m.Friends = from.Friends
+		return true
+	case "ShippingAddressId":
+		if rest != "" {
+			return false
+		}
+		m.ShippingAddressId = from.ShippingAddressId
+		return true
+	case "ExternalUuid":
+		if rest != "" {
+			return false
+		}
+		m.ExternalUuid = from.ExternalUuid
+		return true
+	case "EmailAttachments":
+		if rest != "" {
+			return false
+		}
+		m.EmailAttachments = from.EmailAttachments
+		return true
+	case "LegacyLanguages":
+		if rest != "" {
+			return false
+		}
+		m.LegacyLanguages = from.LegacyLanguages
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffUser returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffUser(old, new *UserORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &UserORM{}
+	}
+	if new == nil {
+		new = &UserORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if (old.CreatedAt == nil) != (new.CreatedAt == nil) || old.CreatedAt != nil && !old.CreatedAt.Truncate(time.Microsecond).Equal(new.CreatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "CreatedAt")
+	}
+	if (old.UpdatedAt == nil) != (new.UpdatedAt == nil) || old.UpdatedAt != nil && !old.UpdatedAt.Truncate(time.Microsecond).Equal(new.UpdatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "UpdatedAt")
+	}
+	if (old.Birthday == nil) != (new.Birthday == nil) || old.Birthday != nil && !old.Birthday.Truncate(time.Microsecond).Equal(new.Birthday.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "Birthday")
+	}
+	if old.Num != new.Num {
+		mask.Paths = append(mask.Paths, "Num")
+	}
+	if (old.CreditCard == nil) != (new.CreditCard == nil) || len(DiffCreditCard(old.CreditCard, new.CreditCard).Paths) > 0 {
+		mask.Paths = append(mask.Paths, "CreditCard")
+	}
+	differsEmails := len(old.Emails) != len(new.Emails)
+	for i := 0; !differsEmails && i < len(old.Emails); i++ {
+		differsEmails = (old.Emails[i] == nil) != (new.Emails[i] == nil) || len(DiffEmail(old.Emails[i], new.Emails[i]).Paths) > 0
+	}
+	if differsEmails {
+		mask.Paths = append(mask.Paths, "Emails")
+	}
+	differsTasks := len(old.Tasks) != len(new.Tasks)
+	for i := 0; !differsTasks && i < len(old.Tasks); i++ {
+		differsTasks = (old.Tasks[i] == nil) != (new.Tasks[i] == nil) || len(DiffTask(old.Tasks[i], new.Tasks[i]).Paths) > 0
+	}
+	if differsTasks {
+		mask.Paths = append(mask.Paths, "Tasks")
+	}
+	if (old.BillingAddress == nil) != (new.BillingAddress == nil) || old.BillingAddress != nil && (old.BillingAddress.Id != new.BillingAddress.Id) {
+		mask.Paths = append(mask.Paths, "BillingAddress")
+	}
+	if (old.ShippingAddress == nil) != (new.ShippingAddress == nil) || old.ShippingAddress != nil && (old.ShippingAddress.Id != new.ShippingAddress.Id) {
+		mask.Paths = append(mask.Paths, "ShippingAddress")
+	}
+	differsLanguages := len(old.Languages) != len(new.Languages)
+	for i := 0; !differsLanguages && i < len(old.Languages); i++ {
+		differsLanguages = (old.Languages[i] == nil) != (new.Languages[i] == nil) || old.Languages[i] != nil && (old.Languages[i].Id != new.Languages[i].Id)
+	}
+	if differsLanguages {
+		mask.Paths = append(mask.Paths, "Languages")
+	}
+	differsFriends := len(old.Friends) != len(new.Friends)
+	for i := 0; !differsFriends && i < len(old.Friends); i++ {
+		differsFriends = (old.Friends[i] == nil) != (new.Friends[i] == nil) || old.Friends[i] != nil && (old.Friends[i].Id != new.Friends[i].Id)
+	}
+	if differsFriends {
+		mask.Paths = append(mask.Paths, "Friends")
+	}
+	if (old.ShippingAddressId == nil) != (new.ShippingAddressId == nil) || old.ShippingAddressId != nil && *old.ShippingAddressId != *new.ShippingAddressId {
+		mask.Paths = append(mask.Paths, "ShippingAddressId")
+	}
+	if (old.ExternalUuid == nil) != (new.ExternalUuid == nil) || old.ExternalUuid != nil && *old.ExternalUuid != *new.ExternalUuid {
+		mask.Paths = append(mask.Paths, "ExternalUuid")
+	}
+	differsLegacyLanguages := len(old.LegacyLanguages) != len(new.LegacyLanguages)
+	for i := 0; !differsLegacyLanguages && i < len(old.LegacyLanguages); i++ {
+		differsLegacyLanguages = (old.LegacyLanguages[i] == nil) != (new.LegacyLanguages[i] == nil) || old.LegacyLanguages[i] != nil && (old.LegacyLanguages[i].Id != new.LegacyLanguages[i].Id)
+	}
+	if differsLegacyLanguages {
+		mask.Paths = append(mask.Paths, "LegacyLanguages")
+	}
+	return mask
+}
+
+// UserSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func UserSliceToORM(ctx context.Context, in []*User) ([]*UserORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*UserORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// UserORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func UserORMSliceToPB(ctx context.Context, in []*UserORM) ([]*User, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*User, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type User the arg will be the target, the caller the one being converted from
 
@@ -295,10 +930,11 @@ type UserWithAfterToPB interface {
 }
 
 type EmailORM struct {
-	AccountID       string
-	Email           string
-	ExternalNotNull string `gorm:"type:uuid;not null"`
-	Id              string `gorm:"type:uuid;primary_key"`
+	AccountID       string           `gorm:"unique_index:uix_emails_account_id_email"`
+	Attachments     []*AttachmentORM `gorm:"foreignkey:EmailId;association_foreignkey:Id" atlas:"position:Position"`
+	Email           string           `gorm:"unique_index:idx_email_address,uix_emails_account_id_email"`
+	ExternalNotNull string           `gorm:"type:uuid;not null"`
+	Id              string           `gorm:"type:uuid;primary_key"`
 	Subscribed      bool
 	UserId          *string
 }
@@ -308,6 +944,113 @@ func (EmailORM) TableName() string {
 	return "emails"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *EmailORM) ClearAssociations() {
+	m.Attachments = nil
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *EmailORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	reloaded := EmailORM{}
+	if err := db.Where("id = ? AND account_id = ?", m.Id, accountID).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// EmailORMIndexes lists the indexes declared by the gorm tags of EmailORM
+var EmailORMIndexes = []types.IndexDef{
+	{Name: "idx_email_address", Columns: []string{"email"}, Unique: true},
+	{Name: "uix_emails_account_id_email", Columns: []string{"account_id", "email"}, Unique: true},
+}
+
+// EmailORMForeignKeys lists the foreign keys of the associations of EmailORM
+var EmailORMForeignKeys = []types.ForeignKeyDef{
+	{Name: "fk_attachments_email_id", Table: "attachments", Columns: []string{"email_id"}, References: "emails", ReferencedColumns: []string{"id"}},
+}
+
+// LoadEmailAttachmentsForAll loads the Attachments of all the parents with a single query and
+// sets them on each parent by EmailId and AccountID, in the order of position. The parents
+// lose the Attachments they held, a nil parent is skipped.
+func LoadEmailAttachmentsForAll(ctx context.Context, db *gorm.DB, parents []*EmailORM) error {
+	type key struct {
+		Id        string
+		AccountID string
+	}
+	byKey := make(map[key][]*EmailORM, len(parents))
+	loaded := make(map[*EmailORM]bool, len(parents))
+	var ids []string
+	idSeen := make(map[string]bool)
+	var accountIDs []string
+	accountIDSeen := make(map[string]bool)
+	for _, parent := range parents {
+		if parent == nil || loaded[parent] {
+			continue
+		}
+		loaded[parent] = true
+		parent.Attachments = nil
+		k := key{parent.Id, parent.AccountID}
+		if !idSeen[k.Id] {
+			idSeen[k.Id] = true
+			ids = append(ids, k.Id)
+		}
+		if !accountIDSeen[k.AccountID] {
+			accountIDSeen[k.AccountID] = true
+			accountIDs = append(accountIDs, k.AccountID)
+		}
+		byKey[k] = append(byKey[k], parent)
+	}
+	if len(byKey) == 0 {
+		return nil
+	}
+	var children []*AttachmentORM
+	if err := db.Where("email_id IN (?) AND account_id IN (?)", ids, accountIDs).Order("position").Find(&children).Error; err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.EmailId == nil {
+			continue
+		}
+		for _, parent := range byKey[key{*child.EmailId, child.AccountID}] {
+			parent.Attachments = append(parent.Attachments, child)
+		}
+	}
+	return nil
+}
+
+// WithEmailConditionalPreload returns a context making the Read and List
+// handlers of Email preload the association name, e.g. Attachments, when cond holds
+// for their context
+func WithEmailConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "Email", name, cond)
+}
+
+// applyEmailConditionalPreloads preloads the associations of the conditional
+// preloads of Email in ctx whose condition holds
+func applyEmailConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Email") {
+		switch preload.Name {
+		case "Attachments":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
+		}
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *Email) ToORM(ctx context.Context) (EmailORM, error) {
@@ -324,6 +1067,7 @@ func (m *Email) ToORM(ctx context.Context) (EmailORM, error) {
 		to.Id = v.(string)
 	}
 	to.Email = m.Email
+	to.Email = strings.ToLower(strings.TrimSpace(to.Email))
 	to.Subscribed = m.Subscribed
 	if m.UserId != nil {
 		if v, err := resource.Decode(&User{}, m.UserId); err != nil {
@@ -338,11 +1082,25 @@ func (m *Email) ToORM(ctx context.Context) (EmailORM, error) {
 	} else if v != nil {
 		to.ExternalNotNull = v.(string)
 	}
+	for _, v := range m.Attachments {
+		if v != nil {
+			if tempAttachments, cErr := v.ToORM(ctx); cErr == nil {
+				to.Attachments = append(to.Attachments, &tempAttachments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Attachments = append(to.Attachments, nil)
+		}
+	}
 	accountID, err := auth.GetAccountID(ctx, nil)
 	if err != nil {
 		return to, err
 	}
 	to.AccountID = accountID
+	for i, e := range to.Attachments {
+		e.Position = int(i)
+	}
 	if posthook, ok := interface{}(m).(EmailWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -378,22 +1136,208 @@ func (m *EmailORM) ToPB(ctx context.Context) (Email, error) {
 	} else {
 		to.ExternalNotNull = v
 	}
+	for _, v := range m.Attachments {
+		if v != nil {
+			if tempAttachments, cErr := v.ToPB(ctx); cErr == nil {
+				to.Attachments = append(to.Attachments, &tempAttachments)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Attachments = append(to.Attachments, nil)
+		}
+	}
 	if posthook, ok := interface{}(m).(EmailWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
 	return to, err
 }
 
-// The following are interfaces you can implement for special behavior during ORM/PB conversions
-// of type Email the arg will be the target, the caller the one being converted from
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Email) MergeToORM(ctx context.Context, dst *EmailORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Id != nil {
+		dst.Id = to.Id
+	}
+	dst.Email = to.Email
+	dst.Subscribed = to.Subscribed
+	if m.UserId != nil {
+		dst.UserId = to.UserId
+	}
+	if m.ExternalNotNull != nil {
+		dst.ExternalNotNull = to.ExternalNotNull
+	}
+	if associations {
+		dst.Attachments = to.Attachments
+	}
+	return nil
+}
 
-// EmailBeforeToORM called before default ToORM code
-type EmailWithBeforeToORM interface {
-	BeforeToORM(context.Context, *EmailORM) error
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Email) ApplyFieldMaskToORM(ctx context.Context, dst *EmailORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
 }
 
-// EmailAfterToORM called after default ToORM code
-type EmailWithAfterToORM interface {
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *EmailORM) applyFieldMaskPath(from *EmailORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Email":
+		if rest != "" {
+			return false
+		}
+		m.Email = from.Email
+		return true
+	case "Subscribed":
+		if rest != "" {
+			return false
+		}
+		m.Subscribed = from.Subscribed
+		return true
+	case "UserId":
+		if rest != "" {
+			return false
+		}
+		m.UserId = from.UserId
+		return true
+	case "ExternalNotNull":
+		if rest != "" {
+			return false
+		}
+		m.ExternalNotNull = from.ExternalNotNull
+		return true
+	case "Attachments":
+		if rest != "" {
+			return false
+		}
+		m.Attachments = from.Attachments
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffEmail returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffEmail(old, new *EmailORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &EmailORM{}
+	}
+	if new == nil {
+		new = &EmailORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Email != new.Email {
+		mask.Paths = append(mask.Paths, "Email")
+	}
+	if old.Subscribed != new.Subscribed {
+		mask.Paths = append(mask.Paths, "Subscribed")
+	}
+	if (old.UserId == nil) != (new.UserId == nil) || old.UserId != nil && *old.UserId != *new.UserId {
+		mask.Paths = append(mask.Paths, "UserId")
+	}
+	if old.ExternalNotNull != new.ExternalNotNull {
+		mask.Paths = append(mask.Paths, "ExternalNotNull")
+	}
+	differsAttachments := len(old.Attachments) != len(new.Attachments)
+	for i := 0; !differsAttachments && i < len(old.Attachments); i++ {
+		differsAttachments = (old.Attachments[i] == nil) != (new.Attachments[i] == nil) || len(DiffAttachment(old.Attachments[i], new.Attachments[i]).Paths) > 0
+	}
+	if differsAttachments {
+		mask.Paths = append(mask.Paths, "Attachments")
+	}
+	return mask
+}
+
+// EmailSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func EmailSliceToORM(ctx context.Context, in []*Email) ([]*EmailORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*EmailORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// EmailORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func EmailORMSliceToPB(ctx context.Context, in []*EmailORM) ([]*Email, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Email, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Email the arg will be the target, the caller the one being converted from
+
+// EmailBeforeToORM called before default ToORM code
+type EmailWithBeforeToORM interface {
+	BeforeToORM(context.Context, *EmailORM) error
+}
+
+// EmailAfterToORM called after default ToORM code
+type EmailWithAfterToORM interface {
 	AfterToORM(context.Context, *EmailORM) error
 }
 
@@ -407,19 +1351,301 @@ type EmailWithAfterToPB interface {
 	AfterToPB(context.Context, *Email) error
 }
 
+type AttachmentORM struct {
+	AccountID string
+	EmailId   *string
+	Id        string `gorm:"type:uuid;primary_key"`
+	Name      string
+	Position  int
+}
+
+// TableName overrides the default tablename generated by GORM
+func (AttachmentORM) TableName() string {
+	return "attachments"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *AttachmentORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *AttachmentORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == "" {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	reloaded := AttachmentORM{}
+	if err := db.Where("id = ? AND account_id = ?", m.Id, accountID).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// AttachmentORMIndexes lists the indexes declared by the gorm tags of AttachmentORM
+var AttachmentORMIndexes = []types.IndexDef{}
+
+// applyAttachmentConditionalPreloads preloads the associations of the conditional
+// preloads of Attachment in ctx whose condition holds
+func applyAttachmentConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Attachment") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Attachment) ToORM(ctx context.Context) (AttachmentORM, error) {
+	to := AttachmentORM{}
+	var err error
+	if prehook, ok := interface{}(m).(AttachmentWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if v, err := resource.Decode(&Attachment{}, m.Id); err != nil {
+		return to, err
+	} else if v != nil {
+		to.Id = v.(string)
+	}
+	to.Name = m.Name
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return to, err
+	}
+	to.AccountID = accountID
+	if posthook, ok := interface{}(m).(AttachmentWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *AttachmentORM) ToPB(ctx context.Context) (Attachment, error) {
+	to := Attachment{}
+	var err error
+	if prehook, ok := interface{}(m).(AttachmentWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	if v, err := resource.Encode(&Attachment{}, m.Id); err != nil {
+		return to, err
+	} else {
+		to.Id = v
+	}
+	to.Name = m.Name
+	if posthook, ok := interface{}(m).(AttachmentWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Attachment) MergeToORM(ctx context.Context, dst *AttachmentORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Id != nil {
+		dst.Id = to.Id
+	}
+	dst.Name = to.Name
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Attachment) ApplyFieldMaskToORM(ctx context.Context, dst *AttachmentORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *AttachmentORM) applyFieldMaskPath(from *AttachmentORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Name":
+		if rest != "" {
+			return false
+		}
+		m.Name = from.Name
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffAttachment returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffAttachment(old, new *AttachmentORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &AttachmentORM{}
+	}
+	if new == nil {
+		new = &AttachmentORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Name != new.Name {
+		mask.Paths = append(mask.Paths, "Name")
+	}
+	return mask
+}
+
+// AttachmentSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func AttachmentSliceToORM(ctx context.Context, in []*Attachment) ([]*AttachmentORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*AttachmentORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// AttachmentORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func AttachmentORMSliceToPB(ctx context.Context, in []*AttachmentORM) ([]*Attachment, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Attachment, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Attachment the arg will be the target, the caller the one being converted from
+
+// AttachmentBeforeToORM called before default ToORM code
+type AttachmentWithBeforeToORM interface {
+	BeforeToORM(context.Context, *AttachmentORM) error
+}
+
+// AttachmentAfterToORM called after default ToORM code
+type AttachmentWithAfterToORM interface {
+	AfterToORM(context.Context, *AttachmentORM) error
+}
+
+// AttachmentBeforeToPB called before default ToPB code
+type AttachmentWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Attachment) error
+}
+
+// AttachmentAfterToPB called after default ToPB code
+type AttachmentWithAfterToPB interface {
+	AfterToPB(context.Context, *Attachment) error
+}
+
 type AddressORM struct {
 	AccountID  string
-	Address_1  string
+	Address_1  string `gorm:"index:idx_address_post"`
 	Address_2  string
 	External   []byte  `gorm:"type:jsonb"`
 	Id         int64   `gorm:"type:integer;primary_key"`
 	ImplicitFk *string `gorm:"type:text"`
-	Post       string
+	Post       string  `gorm:"index:idx_address_post"`
 }
 
 // TableName overrides the default tablename generated by GORM
 func (AddressORM) TableName() string {
-	return "addresses"
+	return "geo.addresses"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *AddressORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *AddressORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	reloaded := AddressORM{}
+	if err := db.Where("id = ? AND account_id = ?", m.Id, accountID).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// AddressORMIndexes lists the indexes declared by the gorm tags of AddressORM
+var AddressORMIndexes = []types.IndexDef{
+	{Name: "idx_address_post", Columns: []string{"address_1", "post"}, Unique: false},
+}
+
+// applyAddressConditionalPreloads preloads the associations of the conditional
+// preloads of Address in ctx whose condition holds
+func applyAddressConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Address") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
 }
 
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
@@ -500,6 +1726,175 @@ func (m *AddressORM) ToPB(ctx context.Context) (Address, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Address) MergeToORM(ctx context.Context, dst *AddressORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Id != nil {
+		dst.Id = to.Id
+	}
+	dst.Address_1 = to.Address_1
+	dst.Address_2 = to.Address_2
+	dst.Post = to.Post
+	if m.External != nil {
+		dst.External = to.External
+	}
+	if m.ImplicitFk != nil {
+		dst.ImplicitFk = to.ImplicitFk
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Address) ApplyFieldMaskToORM(ctx context.Context, dst *AddressORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *AddressORM) applyFieldMaskPath(from *AddressORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Address_1":
+		if rest != "" {
+			return false
+		}
+		m.Address_1 = from.Address_1
+		return true
+	case "Address_2":
+		if rest != "" {
+			return false
+		}
+		m.Address_2 = from.Address_2
+		return true
+	case "Post":
+		if rest != "" {
+			return false
+		}
+		m.Post = from.Post
+		return true
+	case "External":
+		if rest != "" {
+			return false
+		}
+		m.External = from.External
+		return true
+	case "ImplicitFk":
+		if rest != "" {
+			return false
+		}
+		m.ImplicitFk = from.ImplicitFk
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffAddress returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffAddress(old, new *AddressORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &AddressORM{}
+	}
+	if new == nil {
+		new = &AddressORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Address_1 != new.Address_1 {
+		mask.Paths = append(mask.Paths, "Address_1")
+	}
+	if old.Address_2 != new.Address_2 {
+		mask.Paths = append(mask.Paths, "Address_2")
+	}
+	if old.Post != new.Post {
+		mask.Paths = append(mask.Paths, "Post")
+	}
+	if !reflect.DeepEqual(old.External, new.External) {
+		mask.Paths = append(mask.Paths, "External")
+	}
+	if (old.ImplicitFk == nil) != (new.ImplicitFk == nil) || old.ImplicitFk != nil && *old.ImplicitFk != *new.ImplicitFk {
+		mask.Paths = append(mask.Paths, "ImplicitFk")
+	}
+	return mask
+}
+
+// AddressSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func AddressSliceToORM(ctx context.Context, in []*Address) ([]*AddressORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*AddressORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// AddressORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func AddressORMSliceToPB(ctx context.Context, in []*AddressORM) ([]*Address, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Address, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Address the arg will be the target, the caller the one being converted from
 
@@ -536,14 +1931,51 @@ func (LanguageORM) TableName() string {
 	return "languages"
 }
 
-// ToORM runs the BeforeToORM hook if present, converts the fields of this
-// object to ORM format, runs the AfterToORM hook, then returns the ORM object
-func (m *Language) ToORM(ctx context.Context) (LanguageORM, error) {
-	to := LanguageORM{}
-	var err error
-	if prehook, ok := interface{}(m).(LanguageWithBeforeToORM); ok {
-		if err = prehook.BeforeToORM(ctx, &to); err != nil {
-			return to, err
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *LanguageORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *LanguageORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	reloaded := LanguageORM{}
+	if err := db.Where("id = ? AND account_id = ?", m.Id, accountID).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// LanguageORMIndexes lists the indexes declared by the gorm tags of LanguageORM
+var LanguageORMIndexes = []types.IndexDef{
+	{Name: "uix_language_code", Columns: []string{"code", "external_int"}, Unique: true, NullsNotDistinct: true},
+}
+
+// applyLanguageConditionalPreloads preloads the associations of the conditional
+// preloads of Language in ctx whose condition holds
+func applyLanguageConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Language") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Language) ToORM(ctx context.Context) (LanguageORM, error) {
+	to := LanguageORM{}
+	var err error
+	if prehook, ok := interface{}(m).(LanguageWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
 	}
 	if v, err := resource.DecodeInt64(&Language{}, m.Id); err != nil {
@@ -601,6 +2033,153 @@ func (m *LanguageORM) ToPB(ctx context.Context) (Language, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Language) MergeToORM(ctx context.Context, dst *LanguageORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Id != nil {
+		dst.Id = to.Id
+	}
+	dst.Name = to.Name
+	dst.Code = to.Code
+	if m.ExternalInt != nil {
+		dst.ExternalInt = to.ExternalInt
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Language) ApplyFieldMaskToORM(ctx context.Context, dst *LanguageORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *LanguageORM) applyFieldMaskPath(from *LanguageORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "Name":
+		if rest != "" {
+			return false
+		}
+		m.Name = from.Name
+		return true
+	case "Code":
+		if rest != "" {
+			return false
+		}
+		m.Code = from.Code
+		return true
+	case "ExternalInt":
+		if rest != "" {
+			return false
+		}
+		m.ExternalInt = from.ExternalInt
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffLanguage returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffLanguage(old, new *LanguageORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &LanguageORM{}
+	}
+	if new == nil {
+		new = &LanguageORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if old.Name != new.Name {
+		mask.Paths = append(mask.Paths, "Name")
+	}
+	if old.Code != new.Code {
+		mask.Paths = append(mask.Paths, "Code")
+	}
+	if (old.ExternalInt == nil) != (new.ExternalInt == nil) || old.ExternalInt != nil && *old.ExternalInt != *new.ExternalInt {
+		mask.Paths = append(mask.Paths, "ExternalInt")
+	}
+	return mask
+}
+
+// LanguageSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func LanguageSliceToORM(ctx context.Context, in []*Language) ([]*LanguageORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*LanguageORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// LanguageORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func LanguageORMSliceToPB(ctx context.Context, in []*LanguageORM) ([]*Language, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Language, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Language the arg will be the target, the caller the one being converted from
 
@@ -638,6 +2217,41 @@ func (CreditCardORM) TableName() string {
 	return "credit_cards"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *CreditCardORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *CreditCardORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Id == 0 {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	reloaded := CreditCardORM{}
+	if err := db.Where("id = ? AND account_id = ?", m.Id, accountID).First(&reloaded).Error; err != nil {
+		return err
+	}
+	*m = reloaded
+	return nil
+}
+
+// CreditCardORMIndexes lists the indexes declared by the gorm tags of CreditCardORM
+var CreditCardORMIndexes = []types.IndexDef{}
+
+// applyCreditCardConditionalPreloads preloads the associations of the conditional
+// preloads of CreditCard in ctx whose condition holds
+func applyCreditCardConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "CreditCard") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *CreditCard) ToORM(ctx context.Context) (CreditCardORM, error) {
@@ -716,6 +2330,167 @@ func (m *CreditCardORM) ToPB(ctx context.Context) (CreditCard, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *CreditCard) MergeToORM(ctx context.Context, dst *CreditCardORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if m.Id != nil {
+		dst.Id = to.Id
+	}
+	if m.CreatedAt != nil {
+		dst.CreatedAt = to.CreatedAt
+	}
+	if m.UpdatedAt != nil {
+		dst.UpdatedAt = to.UpdatedAt
+	}
+	dst.Number = to.Number
+	if m.UserId != nil {
+		dst.UserId = to.UserId
+	}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *CreditCard) ApplyFieldMaskToORM(ctx context.Context, dst *CreditCardORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *CreditCardORM) applyFieldMaskPath(from *CreditCardORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Id":
+		if rest != "" {
+			return false
+		}
+		m.Id = from.Id
+		return true
+	case "CreatedAt":
+		if rest != "" {
+			return false
+		}
+		m.CreatedAt = from.CreatedAt
+		return true
+	case "UpdatedAt":
+		if rest != "" {
+			return false
+		}
+		m.UpdatedAt = from.UpdatedAt
+		return true
+	case "Number":
+		if rest != "" {
+			return false
+		}
+		m.Number = from.Number
+		return true
+	case "UserId":
+		if rest != "" {
+			return false
+		}
+		m.UserId = from.UserId
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffCreditCard returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffCreditCard(old, new *CreditCardORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &CreditCardORM{}
+	}
+	if new == nil {
+		new = &CreditCardORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Id != new.Id {
+		mask.Paths = append(mask.Paths, "Id")
+	}
+	if (old.CreatedAt == nil) != (new.CreatedAt == nil) || old.CreatedAt != nil && !old.CreatedAt.Truncate(time.Microsecond).Equal(new.CreatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "CreatedAt")
+	}
+	if (old.UpdatedAt == nil) != (new.UpdatedAt == nil) || old.UpdatedAt != nil && !old.UpdatedAt.Truncate(time.Microsecond).Equal(new.UpdatedAt.Truncate(time.Microsecond)) {
+		mask.Paths = append(mask.Paths, "UpdatedAt")
+	}
+	if old.Number != new.Number {
+		mask.Paths = append(mask.Paths, "Number")
+	}
+	if (old.UserId == nil) != (new.UserId == nil) || old.UserId != nil && *old.UserId != *new.UserId {
+		mask.Paths = append(mask.Paths, "UserId")
+	}
+	return mask
+}
+
+// CreditCardSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func CreditCardSliceToORM(ctx context.Context, in []*CreditCard) ([]*CreditCardORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*CreditCardORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// CreditCardORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func CreditCardORMSliceToPB(ctx context.Context, in []*CreditCardORM) ([]*CreditCard, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*CreditCard, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type CreditCard the arg will be the target, the caller the one being converted from
 
@@ -752,6 +2527,23 @@ func (TaskORM) TableName() string {
 	return "tasks"
 }
 
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *TaskORM) ClearAssociations() {
+}
+
+// TaskORMIndexes lists the indexes declared by the gorm tags of TaskORM
+var TaskORMIndexes = []types.IndexDef{}
+
+// applyTaskConditionalPreloads preloads the associations of the conditional
+// preloads of Task in ctx whose condition holds
+func applyTaskConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Task") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+	}
+	return db, nil
+}
+
 // ToORM runs the BeforeToORM hook if present, converts the fields of this
 // object to ORM format, runs the AfterToORM hook, then returns the ORM object
 func (m *Task) ToORM(ctx context.Context) (TaskORM, error) {
@@ -795,6 +2587,139 @@ func (m *TaskORM) ToPB(ctx context.Context) (Task, error) {
 	return to, err
 }
 
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Task) MergeToORM(ctx context.Context, dst *TaskORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	to, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	dst.Name = to.Name
+	dst.Description = to.Description
+	dst.Priority = to.Priority
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Task) ApplyFieldMaskToORM(ctx context.Context, dst *TaskORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *TaskORM) applyFieldMaskPath(from *TaskORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Name":
+		if rest != "" {
+			return false
+		}
+		m.Name = from.Name
+		return true
+	case "Description":
+		if rest != "" {
+			return false
+		}
+		m.Description = from.Description
+		return true
+	case "Priority":
+		if rest != "" {
+			return false
+		}
+		m.Priority = from.Priority
+		return true
+	default:
+		return false
+	}
+}
+
+// DiffTask returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffTask(old, new *TaskORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &TaskORM{}
+	}
+	if new == nil {
+		new = &TaskORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Name != new.Name {
+		mask.Paths = append(mask.Paths, "Name")
+	}
+	if old.Description != new.Description {
+		mask.Paths = append(mask.Paths, "Description")
+	}
+	if old.Priority != new.Priority {
+		mask.Paths = append(mask.Paths, "Priority")
+	}
+	return mask
+}
+
+// TaskSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func TaskSliceToORM(ctx context.Context, in []*Task) ([]*TaskORM, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*TaskORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
+// TaskORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func TaskORMSliceToPB(ctx context.Context, in []*TaskORM) ([]*Task, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Task, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
+	}
+	return out, nil
+}
+
 // The following are interfaces you can implement for special behavior during ORM/PB conversions
 // of type Task the arg will be the target, the caller the one being converted from
 
@@ -818,505 +2743,4481 @@ type TaskWithAfterToPB interface {
 	AfterToPB(context.Context, *Task) error
 }
 
-// DefaultCreateUser executes a basic gorm create call
-func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+type RegionORM struct {
+	Code       string          `gorm:"primary_key"`
+	Country    string          `gorm:"primary_key"`
+	Depots     []*WarehouseORM `gorm:"foreignkey:Code,Country;association_foreignkey:Number,Site;many2many:inventory.region_depots;jointable_foreignkey:RegionCode,RegionCountry;association_jointable_foreignkey:WarehouseNumber,WarehouseSite;many2many:inventory.region_depots"`
+	Name       string
+	Warehouses []*WarehouseORM `gorm:"foreignkey:Code,Country;association_foreignkey:Number,Site;many2many:inventory.region_warehouses;jointable_foreignkey:RegionCode,RegionCountry;association_jointable_foreignkey:WarehouseNumber,WarehouseSite"`
 }
 
-type UserORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+// TableName overrides the default tablename generated by GORM
+func (RegionORM) TableName() string {
+	return "inventory.regions"
 }
-type UserORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *RegionORM) ClearAssociations() {
+	m.Depots = nil
+	m.Warehouses = nil
 }
 
-func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *RegionORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Code == "" {
+		return errors.EmptyIdError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	if m.Country == "" {
+		return errors.EmptyIdError
 	}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	reloaded := RegionORM{}
+	if err := db.Where("code = ? AND country = ?", m.Code, m.Country).First(&reloaded).Error; err != nil {
+		return err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
+	*m = reloaded
+	return nil
+}
+
+// RegionORMIndexes lists the indexes declared by the gorm tags of RegionORM
+var RegionORMIndexes = []types.IndexDef{}
+
+// WithRegionConditionalPreload returns a context making the Read and List
+// handlers of Region preload the association name, e.g. Depots, when cond holds
+// for their context
+func WithRegionConditionalPreload(ctx context.Context, name string, cond func(context.Context) bool) context.Context {
+	return types.WithConditionalPreload(ctx, "Region", name, cond)
+}
+
+// applyRegionConditionalPreloads preloads the associations of the conditional
+// preloads of Region in ctx whose condition holds
+func applyRegionConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Region") {
+		switch preload.Name {
+		case "Depots", "Warehouses":
+		default:
+			return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
+		}
+		if preload.Cond(ctx) {
+			db = db.Preload(preload.Name)
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &UserORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
+	return db, nil
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Region) ToORM(ctx context.Context) (RegionORM, error) {
+	to := RegionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RegionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
 		}
 	}
-	ormResponse := UserORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+	to.Country = m.Country
+	to.Code = m.Code
+	to.Name = m.Name
+	for _, v := range m.Warehouses {
+		if v != nil {
+			if tempWarehouses, cErr := v.ToORM(ctx); cErr == nil {
+				to.Warehouses = append(to.Warehouses, &tempWarehouses)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Warehouses = append(to.Warehouses, nil)
+		}
 	}
-	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
+	for _, v := range m.Depots {
+		if v != nil {
+			if tempDepots, cErr := v.ToORM(ctx); cErr == nil {
+				to.Depots = append(to.Depots, &tempDepots)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Depots = append(to.Depots, nil)
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
+	if posthook, ok := interface{}(m).(RegionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
 }
 
-type UserORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type UserORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type UserORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RegionORM) ToPB(ctx context.Context) (Region, error) {
+	to := Region{}
+	var err error
+	if prehook, ok := interface{}(m).(RegionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Country = m.Country
+	to.Code = m.Code
+	to.Name = m.Name
+	for _, v := range m.Warehouses {
+		if v != nil {
+			if tempWarehouses, cErr := v.ToPB(ctx); cErr == nil {
+				to.Warehouses = append(to.Warehouses, &tempWarehouses)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Warehouses = append(to.Warehouses, nil)
+		}
+	}
+	for _, v := range m.Depots {
+		if v != nil {
+			if tempDepots, cErr := v.ToPB(ctx); cErr == nil {
+				to.Depots = append(to.Depots, &tempDepots)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Depots = append(to.Depots, nil)
+		}
+	}
+	if posthook, ok := interface{}(m).(RegionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
-	if in == nil {
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Region) MergeToORM(ctx context.Context, dst *RegionORM, associations bool) error {
+	if dst == nil {
 		return errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
+	to, err := m.ToORM(ctx)
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
-		return errors.EmptyIdError
+	dst.Country = to.Country
+	dst.Code = to.Code
+	dst.Name = to.Name
+	if associations {
+		dst.Warehouses = to.Warehouses
+		dst.Depots = to.Depots
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
-		}
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Region) ApplyFieldMaskToORM(ctx context.Context, dst *RegionORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
 	}
-	err = db.Where(&ormObj).Delete(&UserORM{}).Error
+	from, err := m.ToORM(ctx)
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
 	}
-	return err
+	return nil
 }
 
-type UserORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *RegionORM) applyFieldMaskPath(from *RegionORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
+	}
+	switch head {
+	case "Country":
+		if rest != "" {
+			return false
+		}
+		m.Country = from.Country
+		return true
+	case "Code":
+		if rest != "" {
+			return false
+		}
+		m.Code = from.Code
+		return true
+	case "Name":
+		if rest != "" {
+			return false
+		}
+		m.Name = from.Name
+		return true
+	case "Warehouses":
+		if rest != "" {
+			return false
+		}
+		m.Warehouses = from.Warehouses
+		return true
+	case "Depots":
+		if rest != "" {
+			return false
+		}
+		m.Depots = from.Depots
+		return true
+	default:
+		return false
+	}
 }
-type UserORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+
+// DiffRegion returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffRegion(old, new *RegionORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &RegionORM{}
+	}
+	if new == nil {
+		new = &RegionORM{}
+	}
+	mask := &field_mask.FieldMask{}
+	if old.Country != new.Country {
+		mask.Paths = append(mask.Paths, "Country")
+	}
+	if old.Code != new.Code {
+		mask.Paths = append(mask.Paths, "Code")
+	}
+	if old.Name != new.Name {
+		mask.Paths = append(mask.Paths, "Name")
+	}
+	differsWarehouses := len(old.Warehouses) != len(new.Warehouses)
+	for i := 0; !differsWarehouses && i < len(old.Warehouses); i++ {
+		differsWarehouses = (old.Warehouses[i] == nil) != (new.Warehouses[i] == nil) || old.Warehouses[i] != nil && (old.Warehouses[i].Number != new.Warehouses[i].Number)
+	}
+	if differsWarehouses {
+		mask.Paths = append(mask.Paths, "Warehouses")
+	}
+	differsDepots := len(old.Depots) != len(new.Depots)
+	for i := 0; !differsDepots && i < len(old.Depots); i++ {
+		differsDepots = (old.Depots[i] == nil) != (new.Depots[i] == nil) || old.Depots[i] != nil && (old.Depots[i].Number != new.Depots[i].Number)
+	}
+	if differsDepots {
+		mask.Paths = append(mask.Paths, "Depots")
+	}
+	return mask
 }
 
-func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
+// RegionSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func RegionSliceToORM(ctx context.Context, in []*Region) ([]*RegionORM, error) {
 	if in == nil {
-		return errors.NilArgumentError
+		return nil, nil
 	}
-	var err error
-	keys := []string{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
+	out := make([]*RegionORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
 		}
-		if ormObj.Id == "" {
-			return errors.EmptyIdError
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
 		}
-		keys = append(keys, ormObj.Id)
+		out[i] = &to
 	}
-	if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
+	return out, nil
+}
+
+// RegionORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func RegionORMSliceToPB(ctx context.Context, in []*RegionORM) ([]*Region, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := make([]*Region, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
 		}
+		out[i] = &to
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
+	return out, nil
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Region the arg will be the target, the caller the one being converted from
+
+// RegionBeforeToORM called before default ToORM code
+type RegionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RegionORM) error
+}
+
+// RegionAfterToORM called after default ToORM code
+type RegionWithAfterToORM interface {
+	AfterToORM(context.Context, *RegionORM) error
+}
+
+// RegionBeforeToPB called before default ToPB code
+type RegionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Region) error
+}
+
+// RegionAfterToPB called after default ToPB code
+type RegionWithAfterToPB interface {
+	AfterToPB(context.Context, *Region) error
+}
+
+type WarehouseORM struct {
+	Number int32  `gorm:"primary_key"`
+	Site   string `gorm:"primary_key"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WarehouseORM) TableName() string {
+	return "inventory.warehouses"
+}
+
+// ClearAssociations nils out every association field of the ORM object, leaving
+// scalar fields intact, so that GORM will not cascade saves to the children
+func (m *WarehouseORM) ClearAssociations() {
+}
+
+// Reload reads the row of the primary key of the object again, replacing the
+// object, so that the values set by the DB such as defaults are current
+func (m *WarehouseORM) Reload(ctx context.Context, db *gorm.DB) error {
+	if m.Number == 0 {
+		return errors.EmptyIdError
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&UserORM{}).Error
-	if err != nil {
+	if m.Site == "" {
+		return errors.EmptyIdError
+	}
+	reloaded := WarehouseORM{}
+	if err := db.Where("number = ? AND site = ?", m.Number, m.Site).First(&reloaded).Error; err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
+	*m = reloaded
+	return nil
+}
+
+// WarehouseORMIndexes lists the indexes declared by the gorm tags of WarehouseORM
+var WarehouseORMIndexes = []types.IndexDef{}
+
+// applyWarehouseConditionalPreloads preloads the associations of the conditional
+// preloads of Warehouse in ctx whose condition holds
+func applyWarehouseConditionalPreloads(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	for _, preload := range types.ConditionalPreloads(ctx, "Warehouse") {
+		return nil, fmt.Errorf("%w %q", errors.UnknownPreloadError, preload.Name)
 	}
-	return err
+	return db, nil
 }
 
-type UserORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*User, *gorm.DB) (*gorm.DB, error)
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Warehouse) ToORM(ctx context.Context) (WarehouseORM, error) {
+	to := WarehouseORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WarehouseWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Site = m.Site
+	to.Number = m.Number
+	if posthook, ok := interface{}(m).(WarehouseWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
 }
-type UserORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*User, *gorm.DB) error
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WarehouseORM) ToPB(ctx context.Context) (Warehouse, error) {
+	to := Warehouse{}
+	var err error
+	if prehook, ok := interface{}(m).(WarehouseWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Site = m.Site
+	to.Number = m.Number
+	if posthook, ok := interface{}(m).(WarehouseWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
 }
 
-// DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
+// MergeToORM converts this object with ToORM and writes its fields onto dst,
+// unset optional and message fields leave the dst values intact, as do the
+// read only fields and the ORM only ones. Associations are replaced only
+// when requested
+func (m *Warehouse) MergeToORM(ctx context.Context, dst *WarehouseORM, associations bool) error {
+	if dst == nil {
+		return errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
+	to, err := m.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
+	dst.Site = to.Site
+	dst.Number = to.Number
+	return nil
+}
+
+// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the
+// paths of mask onto dst, the paths are Go field names, dotted into an embedded
+// or nested object, e.g. Address.Street. A path of no field converted by ToORM
+// fails with errors.UnknownUpdateFieldError.
+func (m *Warehouse) ApplyFieldMaskToORM(ctx context.Context, dst *WarehouseORM, mask *field_mask.FieldMask) error {
+	if dst == nil {
+		return errors.NilArgumentError
+	}
+	from, err := m.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
-	var count int64
-	lockedRow := &UserORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
+	for _, path := range mask.GetPaths() {
+		if !dst.applyFieldMaskPath(&from, path) {
+			return fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
 		}
 	}
-	filterCreditCard := CreditCardORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	return nil
+}
+
+// applyFieldMaskPath copies the field of the path from from onto m and reports
+// whether the path is a field
+func (m *WarehouseORM) applyFieldMaskPath(from *WarehouseORM, path string) bool {
+	head, rest := path, ""
+	if i := strings.Index(path, "."); i >= 0 {
+		head, rest = path[:i], path[i+1:]
 	}
-	filterCreditCard.UserId = new(string)
-	*filterCreditCard.UserId = ormObj.Id
-	if err = db.Where(filterCreditCard).Delete(CreditCardORM{}).Error; err != nil {
-		return nil, err
+	switch head {
+	case "Site":
+		if rest != "" {
+			return false
+		}
+		m.Site = from.Site
+		return true
+	case "Number":
+		if rest != "" {
+			return false
+		}
+		m.Number = from.Number
+		return true
+	default:
+		return false
 	}
-	filterEmails := EmailORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+}
+
+// DiffWarehouse returns the paths of the fields of which the objects differ, as
+// taken by the field masks of the patch handlers, a nil object is the zero one.
+// An association differs as a whole, a belongs-to or many-to-many one by the
+// primary keys it refers to, and the times compare at the precision stored.
+func DiffWarehouse(old, new *WarehouseORM) *field_mask.FieldMask {
+	if old == nil {
+		old = &WarehouseORM{}
 	}
-	filterEmails.UserId = new(string)
-	*filterEmails.UserId = ormObj.Id
-	if err = db.Where(filterEmails).Delete(EmailORM{}).Error; err != nil {
-		return nil, err
+	if new == nil {
+		new = &WarehouseORM{}
 	}
-	if err = db.Model(&ormObj).Association("Friends").Replace(ormObj.Friends).Error; err != nil {
-		return nil, err
+	mask := &field_mask.FieldMask{}
+	if old.Site != new.Site {
+		mask.Paths = append(mask.Paths, "Site")
 	}
-	ormObj.Friends = nil
-	if err = db.Model(&ormObj).Association("Languages").Replace(ormObj.Languages).Error; err != nil {
-		return nil, err
+	if old.Number != new.Number {
+		mask.Paths = append(mask.Paths, "Number")
 	}
-	ormObj.Languages = nil
-	filterTasks := TaskORM{}
-	if ormObj.Id == "" {
-		return nil, errors.EmptyIdError
+	return mask
+}
+
+// WarehouseSliceToORM converts a slice of PB objects to ORM format, the error
+// of the first object failing conversion is returned along with its index
+func WarehouseSliceToORM(ctx context.Context, in []*Warehouse) ([]*WarehouseORM, error) {
+	if in == nil {
+		return nil, nil
 	}
-	filterTasks.UserId = ormObj.Id
-	if err = db.Where(filterTasks).Delete(TaskORM{}).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
+	out := make([]*WarehouseORM, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
 		}
-	}
-	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
+		to, err := m.ToORM(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
 		}
+		out[i] = &to
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
+	return out, nil
+}
+
+// WarehouseORMSliceToPB converts a slice of ORM objects to PB format, the error
+// of the first object failing conversion is returned along with its index
+func WarehouseORMSliceToPB(ctx context.Context, in []*WarehouseORM) ([]*Warehouse, error) {
+	if in == nil {
+		return nil, nil
 	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
+	out := make([]*Warehouse, len(in))
+	for i, m := range in {
+		if m == nil {
+			continue
+		}
+		to, err := m.ToPB(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		out[i] = &to
 	}
-	return &pbResponse, err
+	return out, nil
 }
 
-type UserORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Warehouse the arg will be the target, the caller the one being converted from
+
+// WarehouseBeforeToORM called before default ToORM code
+type WarehouseWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WarehouseORM) error
 }
-type UserORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+
+// WarehouseAfterToORM called after default ToORM code
+type WarehouseWithAfterToORM interface {
+	AfterToORM(context.Context, *WarehouseORM) error
 }
-type UserORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+
+// WarehouseBeforeToPB called before default ToPB code
+type WarehouseWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Warehouse) error
 }
 
-// DefaultPatchUser executes a basic gorm update call with patch behavior
-func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+// WarehouseAfterToPB called after default ToPB code
+type WarehouseWithAfterToPB interface {
+	AfterToPB(context.Context, *Warehouse) error
+}
+
+// UserSchemaHash identifies the schema of the ORM types defined in user.proto
+const UserSchemaHash = "8fd30caeca3b547088ffb35aa42f389d09b75f0867985c2c2bd701fd99c80581"
+
+// RegisterUserCallbacks registers the GORM callbacks of the ORM types defined
+// in user.proto, registering them again replaces the previous ones
+func RegisterUserCallbacks(db *gorm.DB) error {
+	if db == nil {
+		return errors.NilArgumentError
+	}
+	returning.Register(db)
+	return nil
+}
+
+// DefaultCreateUser executes a basic gorm create call
+func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj User
-	var err error
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
+	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	upsertEmails := ormObj.Emails
+	ormObj.Emails = nil
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := DefaultStrictUpdateUser(ctx, &pbObj, db)
-	if err != nil {
+	ormObj.Emails = upsertEmails
+	if err = ormObj.UpsertEmailsByEmail(ctx, db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	return pbResponse, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-type UserWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type UserWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type UserWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type UserORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
+type UserORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
-func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+// DefaultCopyFromUser inserts the objects with a COPY into the users table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateUserSet
+// leaves them to the DB.
+func DefaultCopyFromUser(ctx context.Context, in []*User, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
 	}
-
-	results := make([]*User, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
+	now := time.Now()
+	rows := make([]UserORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
-
-		results = append(results, pbResponse)
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		row.UpdatedAt = &now
+		rows = append(rows, row)
 	}
-
-	return results, nil
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into users takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into users needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("users", "account_id", "billing_address_id", "birthday", "created_at", "external_uuid", "id", "num", "shipping_address_id", "updated_at"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.BillingAddressId, row.Birthday, row.CreatedAt, row.ExternalUuid, row.Id, row.Num, row.ShippingAddressId, row.UpdatedAt); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
 }
 
-// DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
-	if patcher == nil {
+// UserORMMaxBatchSize is the most rows of an INSERT of DefaultCreateUserSet,
+// postgres takes 65535 bind parameters in a statement and a row has 9
+const UserORMMaxBatchSize = 7281
+
+// DefaultCreateUserSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most UserORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateUserSet(ctx context.Context, in []*User, db *gorm.DB, batchSize int) ([]*User, error) {
+	if len(in) == 0 {
 		return nil, nil
-	} else if patchee == nil {
-		return nil, errors.NilArgumentError
 	}
-	var err error
-	var updatedCreatedAt bool
-	var updatedUpdatedAt bool
-	var updatedBirthday bool
-	var updatedCreditCard bool
-	var updatedBillingAddress bool
-	var updatedShippingAddress bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > UserORMMaxBatchSize {
+		batchSize = UserORMMaxBatchSize
+	}
+	now := time.Now()
+	rows := make([]UserORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
 		}
-		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
-			if patcher.CreatedAt == nil {
-				patchee.CreatedAt = nil
-				continue
-			}
-			if patchee.CreatedAt == nil {
-				patchee.CreatedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
-				return nil, nil
-			}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
 		}
-		if f == prefix+"CreatedAt" {
-			updatedCreatedAt = true
-			patchee.CreatedAt = patcher.CreatedAt
-			continue
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
 		}
-		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
-			if patcher.UpdatedAt == nil {
-				patchee.UpdatedAt = nil
-				continue
-			}
-			if patchee.UpdatedAt == nil {
-				patchee.UpdatedAt = &timestamppb.Timestamp{}
+		row.UpdatedAt = &now
+		rows = append(rows, row)
+	}
+	created := make([]*UserORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
 			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*9)
+			for _, row := range batch {
+				values := []interface{}{row.AccountID, row.BillingAddressId, row.Birthday, row.CreatedAt, row.ExternalUuid, row.Id, row.Num, row.ShippingAddressId, row.UpdatedAt}
+				if row.Id == "" {
+					values[5] = types.Default
 				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
 			}
-			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
-				return nil, nil
+			var stored []*UserORM
+			if err := tx.Raw(`INSERT INTO "users" ("account_id", "billing_address_id", "birthday", "created_at", "external_uuid", "id", "num", "shipping_address_id", "updated_at") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
 			}
+			created = append(created, stored...)
 		}
-		if f == prefix+"UpdatedAt" {
-			updatedUpdatedAt = true
-			patchee.UpdatedAt = patcher.UpdatedAt
-			continue
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return UserORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertUserSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most UserORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertUserSet(ctx context.Context, in []*User, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > UserORMMaxBatchSize {
+		batchSize = UserORMMaxBatchSize
+	}
+	now := time.Now()
+	rows := make([]UserORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
 		}
-		if !updatedBirthday && strings.HasPrefix(f, prefix+"Birthday.") {
-			if patcher.Birthday == nil {
-				patchee.Birthday = nil
-				continue
-			}
-			if patchee.Birthday == nil {
-				patchee.Birthday = &timestamppb.Timestamp{}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		row.UpdatedAt = &now
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
 			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Birthday."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*9)
+			for _, row := range batch {
+				values := []interface{}{row.AccountID, row.BillingAddressId, row.Birthday, row.CreatedAt, row.ExternalUuid, row.Id, row.Num, row.ShippingAddressId, row.UpdatedAt}
+				if row.Id == "" {
+					values[5] = types.Default
 				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
 			}
-			if err := gorm1.MergeWithMask(patcher.Birthday, patchee.Birthday, childMask); err != nil {
-				return nil, nil
+			written := tx.Exec(`INSERT INTO "users" ("account_id", "billing_address_id", "birthday", "created_at", "external_uuid", "id", "num", "shipping_address_id", "updated_at") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO UPDATE SET "account_id" = EXCLUDED."account_id", "billing_address_id" = EXCLUDED."billing_address_id", "birthday" = EXCLUDED."birthday", "external_uuid" = EXCLUDED."external_uuid", "num" = EXCLUDED."num", "shipping_address_id" = EXCLUDED."shipping_address_id", "updated_at" = EXCLUDED."updated_at" WHERE "users"."account_id" = EXCLUDED."account_id"`, args...)
+			if err := written.Error; err != nil {
+				return err
 			}
+			upserted += written.RowsAffected
 		}
-		if f == prefix+"Birthday" {
-			updatedBirthday = true
-			patchee.Birthday = patcher.Birthday
-			continue
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"Age" {
-			patchee.Age = patcher.Age
-			continue
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &UserORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyUserConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"Num" {
-			patchee.Num = patcher.Num
-			continue
+	}
+	ormResponse := UserORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
 		}
-		if !updatedCreditCard && strings.HasPrefix(f, prefix+"CreditCard.") {
-			updatedCreditCard = true
-			if patcher.CreditCard == nil {
-				patchee.CreditCard = nil
-				continue
-			}
-			if patchee.CreditCard == nil {
-				patchee.CreditCard = &CreditCard{}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadUserForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadUserForUpdate(ctx context.Context, in *User, db *gorm.DB, wait types.LockWait) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := UserORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
+	return defaultDeleteUser(ctx, in, db, nil)
+}
+
+// DefaultDeleteUserWithResult is DefaultDeleteUser reporting the affected rows,
+// no affected rows means no User matched
+func DefaultDeleteUserWithResult(ctx context.Context, in *User, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteUser(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteUser(ctx context.Context, in *User, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&UserORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type UserORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&UserORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type UserORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*User, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*User, *gorm.DB) error
+}
+
+// DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	return defaultStrictUpdateUser(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateUserWithResult is DefaultStrictUpdateUser reporting the affected rows
+// and whether the User existed before the update
+func DefaultStrictUpdateUserWithResult(ctx context.Context, in *User, db *gorm.DB) (*User, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateUser(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB, result *types.WriteResult) (*User, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
+	lockedRow := &UserORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	upsertEmails := ormObj.Emails
+	ormObj.Emails = nil
+	filterCreditCard := CreditCardORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterCreditCard.UserId = new(string)
+	*filterCreditCard.UserId = ormObj.Id
+	if err = db.Where(filterCreditCard).Delete(CreditCardORM{}).Error; err != nil {
+		return nil, err
+	}
+	filterEmails := EmailORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterEmails.UserId = new(string)
+	*filterEmails.UserId = ormObj.Id
+	if err = db.Where(filterEmails).Delete(EmailORM{}).Error; err != nil {
+		return nil, err
+	}
+	if err = db.Model(&ormObj).Association("Friends").Replace(ormObj.Friends).Error; err != nil {
+		return nil, err
+	}
+	ormObj.Friends = nil
+	if err = db.Model(&ormObj).Association("Languages").Replace(ormObj.Languages).Error; err != nil {
+		return nil, err
+	}
+	ormObj.Languages = nil
+	if err = db.Model(&ormObj).Association("LegacyLanguages").Replace(ormObj.LegacyLanguages).Error; err != nil {
+		return nil, err
+	}
+	ormObj.LegacyLanguages = nil
+	filterTasks := TaskORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterTasks.UserId = ormObj.Id
+	if err = db.Where(filterTasks).Delete(TaskORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	ormObj.Emails = upsertEmails
+	if err = ormObj.UpsertEmailsByEmail(ctx, db); err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type UserORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchUser executes a basic gorm update call with patch behavior
+func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj User
+	var err error
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateUser(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type UserWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
+func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*User, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateUserByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, account and by-fields cannot be in updateMask.
+func DefaultUpdateUserByIds(ctx context.Context, ids []string, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "CreatedAt":
+			columns["created_at"] = ormObj.CreatedAt
+		case "UpdatedAt":
+			columns["updated_at"] = ormObj.UpdatedAt
+		case "Birthday":
+			columns["birthday"] = ormObj.Birthday
+		case "Num":
+			columns["num"] = ormObj.Num
+		case "ShippingAddressId":
+			columns["shipping_address_id"] = ormObj.ShippingAddressId
+		case "ExternalUuid":
+			columns["external_uuid"] = ormObj.ExternalUuid
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&UserORM{}).Where("account_id = ? AND id IN (?)", acctId, batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedBirthday bool
+	var updatedCreditCard bool
+	var updatedBillingAddress bool
+	var updatedShippingAddress bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if !updatedBirthday && strings.HasPrefix(f, prefix+"Birthday.") {
+			if patcher.Birthday == nil {
+				patchee.Birthday = nil
+				continue
+			}
+			if patchee.Birthday == nil {
+				patchee.Birthday = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"Birthday."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.Birthday, patchee.Birthday, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"Birthday" {
+			updatedBirthday = true
+			patchee.Birthday = patcher.Birthday
+			continue
+		}
+		if f == prefix+"Age" {
+			patchee.Age = patcher.Age
+			continue
+		}
+		if f == prefix+"Num" {
+			patchee.Num = patcher.Num
+			continue
+		}
+		if !updatedCreditCard && strings.HasPrefix(f, prefix+"CreditCard.") {
+			updatedCreditCard = true
+			if patcher.CreditCard == nil {
+				patchee.CreditCard = nil
+				continue
+			}
+			if patchee.CreditCard == nil {
+				patchee.CreditCard = &CreditCard{}
+			}
+			if o, err := DefaultApplyFieldMaskCreditCard(ctx, patchee.CreditCard, patcher.CreditCard, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"CreditCard.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.CreditCard = o
+			}
+			continue
+		}
+		if f == prefix+"CreditCard" {
+			updatedCreditCard = true
+			patchee.CreditCard = patcher.CreditCard
+			continue
+		}
+		if f == prefix+"Emails" {
+			patchee.Emails = patcher.Emails
+			continue
+		}
+		if f == prefix+"Tasks" {
+			patchee.Tasks = patcher.Tasks
+			continue
+		}
+		if !updatedBillingAddress && strings.HasPrefix(f, prefix+"BillingAddress.") {
+			updatedBillingAddress = true
+			if patcher.BillingAddress == nil {
+				patchee.BillingAddress = nil
+				continue
+			}
+			if patchee.BillingAddress == nil {
+				patchee.BillingAddress = &Address{}
+			}
+			if o, err := DefaultApplyFieldMaskAddress(ctx, patchee.BillingAddress, patcher.BillingAddress, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"BillingAddress.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.BillingAddress = o
+			}
+			continue
+		}
+		if f == prefix+"BillingAddress" {
+			updatedBillingAddress = true
+			patchee.BillingAddress = patcher.BillingAddress
+			continue
+		}
+		if !updatedShippingAddress && strings.HasPrefix(f, prefix+"ShippingAddress.") {
+			updatedShippingAddress = true
+			if patcher.ShippingAddress == nil {
+				patchee.ShippingAddress = nil
+				continue
+			}
+			if patchee.ShippingAddress == nil {
+				patchee.ShippingAddress = &Address{}
+			}
+			if o, err := DefaultApplyFieldMaskAddress(ctx, patchee.ShippingAddress, patcher.ShippingAddress, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ShippingAddress.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.ShippingAddress = o
+			}
+			continue
+		}
+		if f == prefix+"ShippingAddress" {
+			updatedShippingAddress = true
+			patchee.ShippingAddress = patcher.ShippingAddress
+			continue
+		}
+		if f == prefix+"Languages" {
+			patchee.Languages = patcher.Languages
+			continue
+		}
+		if f == prefix+"Friends" {
+			patchee.Friends = patcher.Friends
+			continue
+		}
+		if f == prefix+"ShippingAddressId" {
+			patchee.ShippingAddressId = patcher.ShippingAddressId
+			continue
+		}
+		if f == prefix+"ExternalUuid" {
+			patchee.ExternalUuid = patcher.ExternalUuid
+			continue
+		}
+		if f == prefix+"EmailAttachments" {
+			patchee.EmailAttachments = patcher.EmailAttachments
+			continue
+		}
+		if f == prefix+"LegacyLanguages" {
+			patchee.LegacyLanguages = patcher.LegacyLanguages
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListUser executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListUser(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*User, error) {
+	in := User{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserORM{}, &User{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyUserConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []UserORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*User{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type UserORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]UserORM) error
+}
+
+// DefaultExplainListUser returns the SELECT DefaultListUser would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListUser(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := User{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserORM{}, &User{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyUserConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &UserORM{})
+}
+
+// UserORMSelectable lists the columns accepted by DefaultSelectUser
+var UserORMSelectable = map[string]struct{}{
+	"account_id":          {},
+	"billing_address_id":  {},
+	"birthday":            {},
+	"created_at":          {},
+	"external_uuid":       {},
+	"id":                  {},
+	"num":                 {},
+	"shipping_address_id": {},
+	"updated_at":          {},
+}
+
+// DefaultSelectUser runs the query of DefaultListUser for the columns only, which
+// must be in UserORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectUser(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := UserORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "users."+column)
+	}
+	in := User{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserORM{}, &User{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyUserConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&UserORM{}).Select(selected).Rows()
+}
+
+// DefaultReparentUserEmails moves the Email of childID to the Emails of the
+// User of newParentID, which has to exist
+func DefaultReparentUserEmails(ctx context.Context, db *gorm.DB, childID string, newParentID string) error {
+	if childID == "" || newParentID == "" {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		var parents int
+		if err := tx.Model(&UserORM{}).Where("id = ? AND account_id = ?", newParentID, accountID).Count(&parents).Error; err != nil {
+			return err
+		}
+		if parents == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		child := EmailORM{}
+		if err := tx.Where("id = ? AND account_id = ?", childID, accountID).First(&child).Error; err != nil {
+			return err
+		}
+		return tx.Model(&EmailORM{}).Where("id = ?", childID).UpdateColumn("user_id", newParentID).Error
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultCreateEmail executes a basic gorm create call
+func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EmailORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromEmail inserts the objects with a COPY into the emails table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateEmailSet
+// leaves them to the DB.
+func DefaultCopyFromEmail(ctx context.Context, in []*Email, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]EmailORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into emails takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into emails needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("emails", "account_id", "email", "external_not_null", "id", "subscribed", "user_id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.Email, row.ExternalNotNull, row.Id, row.Subscribed, row.UserId); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// EmailORMMaxBatchSize is the most rows of an INSERT of DefaultCreateEmailSet,
+// postgres takes 65535 bind parameters in a statement and a row has 6
+const EmailORMMaxBatchSize = 10922
+
+// DefaultCreateEmailSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most EmailORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateEmailSet(ctx context.Context, in []*Email, db *gorm.DB, batchSize int) ([]*Email, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > EmailORMMaxBatchSize {
+		batchSize = EmailORMMaxBatchSize
+	}
+	rows := make([]EmailORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*EmailORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*6)
+			for _, row := range batch {
+				values := []interface{}{row.AccountID, row.Email, row.ExternalNotNull, row.Id, row.Subscribed, row.UserId}
+				if row.Id == "" {
+					values[3] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*EmailORM
+			if err := tx.Raw(`INSERT INTO "emails" ("account_id", "email", "external_not_null", "id", "subscribed", "user_id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return EmailORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertEmailSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most EmailORMMaxBatchSize, updating the row of the same
+// "id" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertEmailSet(ctx context.Context, in []*Email, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > EmailORMMaxBatchSize {
+		batchSize = EmailORMMaxBatchSize
+	}
+	rows := make([]EmailORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*6)
+			for _, row := range batch {
+				values := []interface{}{row.AccountID, row.Email, row.ExternalNotNull, row.Id, row.Subscribed, row.UserId}
+				if row.Id == "" {
+					values[3] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			written := tx.Exec(`INSERT INTO "emails" ("account_id", "email", "external_not_null", "id", "subscribed", "user_id") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("id") DO UPDATE SET "account_id" = EXCLUDED."account_id", "email" = EXCLUDED."email", "external_not_null" = EXCLUDED."external_not_null", "subscribed" = EXCLUDED."subscribed", "user_id" = EXCLUDED."user_id" WHERE "emails"."account_id" = EXCLUDED."account_id"`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &EmailORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyEmailConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := EmailORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(EmailORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type EmailORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadEmailForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadEmailForUpdate(ctx context.Context, in *Email, db *gorm.DB, wait types.LockWait) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := EmailORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
+	return defaultDeleteEmail(ctx, in, db, nil)
+}
+
+// DefaultDeleteEmailWithResult is DefaultDeleteEmail reporting the affected rows,
+// no affected rows means no Email matched
+func DefaultDeleteEmailWithResult(ctx context.Context, in *Email, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteEmail(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&EmailORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type EmailORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&EmailORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type EmailORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Email, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Email, *gorm.DB) error
+}
+
+// DefaultStrictUpdateEmail clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+	return defaultStrictUpdateEmail(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateEmailWithResult is DefaultStrictUpdateEmail reporting the affected rows
+// and whether the Email existed before the update
+func DefaultStrictUpdateEmailWithResult(ctx context.Context, in *Email, db *gorm.DB) (*Email, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateEmail(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB, result *types.WriteResult) (*Email, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateEmail")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
+	lockedRow := &EmailORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	filterAttachments := AttachmentORM{}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	filterAttachments.EmailId = new(string)
+	*filterAttachments.EmailId = ormObj.Id
+	if err = db.Where(filterAttachments).Delete(AttachmentORM{}).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type EmailORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchEmail executes a basic gorm update call with patch behavior
+func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Email
+	var err error
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateEmail(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type EmailWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EmailWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EmailWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type EmailWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetEmail executes a bulk gorm update call with patch behavior
+func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Email, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchEmail(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateEmailByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, account and by-fields cannot be in updateMask.
+func DefaultUpdateEmailByIds(ctx context.Context, ids []string, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Email":
+			columns["email"] = ormObj.Email
+		case "Subscribed":
+			columns["subscribed"] = ormObj.Subscribed
+		case "UserId":
+			columns["user_id"] = ormObj.UserId
+		case "ExternalNotNull":
+			columns["external_not_null"] = ormObj.ExternalNotNull
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&EmailORM{}).Where("account_id = ? AND id IN (?)", acctId, batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultGetOrCreateEmail reads the Email with the same email or creates it
+// if it does not exist yet, created reports which of the two happened
+func DefaultGetOrCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (_ *Email, created bool, err error) {
+	if in == nil {
+		return nil, false, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	// inside a transaction a failed insert has to be rolled back to a savepoint
+	_, inTxn := db.CommonDB().(*sql.Tx)
+	var pbResponse *Email
+	for retried := false; ; retried = true {
+		found := EmailORM{}
+		if err = db.Where("email = ? AND account_id = ?", ormObj.Email, ormObj.AccountID).First(&found).Error; err == nil {
+			pbObj, err := found.ToPB(ctx)
+			if err != nil {
+				return nil, false, err
+			}
+			pbResponse = &pbObj
+			break
+		} else if !gorm.IsRecordNotFoundError(err) {
+			return nil, false, err
+		}
+		if inTxn {
+			if err = db.Exec("SAVEPOINT get_or_create_email").Error; err != nil {
+				return nil, false, err
+			}
+		}
+		if pbResponse, err = DefaultCreateEmail(ctx, in, db); err == nil {
+			if inTxn {
+				if err = db.Exec("RELEASE SAVEPOINT get_or_create_email").Error; err != nil {
+					return nil, false, err
+				}
+			}
+			created = true
+			break
+		}
+		if inTxn {
+			if txnErr := db.Exec("ROLLBACK TO SAVEPOINT get_or_create_email").Error; txnErr != nil {
+				return nil, false, txnErr
+			}
+		}
+		// only a concurrent create of the same key is worth reading again
+		if retried || !errors.IsUniqueViolation(err) {
+			return nil, false, err
+		}
+	}
+	return pbResponse, created, nil
+}
+
+// DefaultApplyFieldMaskEmail patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Email, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Email, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if f == prefix+"Subscribed" {
+			patchee.Subscribed = patcher.Subscribed
+			continue
+		}
+		if f == prefix+"UserId" {
+			patchee.UserId = patcher.UserId
+			continue
+		}
+		if f == prefix+"ExternalNotNull" {
+			patchee.ExternalNotNull = patcher.ExternalNotNull
+			continue
+		}
+		if f == prefix+"Attachments" {
+			patchee.Attachments = patcher.Attachments
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListEmail executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListEmail(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Email, error) {
+	in := Email{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyEmailConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []EmailORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Email{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type EmailORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type EmailORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]EmailORM) error
+}
+
+// DefaultExplainListEmail returns the SELECT DefaultListEmail would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListEmail(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Email{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyEmailConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &EmailORM{})
+}
+
+// EmailORMSelectable lists the columns accepted by DefaultSelectEmail
+var EmailORMSelectable = map[string]struct{}{
+	"account_id":        {},
+	"email":             {},
+	"external_not_null": {},
+	"id":                {},
+	"subscribed":        {},
+	"user_id":           {},
+}
+
+// DefaultSelectEmail runs the query of DefaultListEmail for the columns only, which
+// must be in EmailORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectEmail(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := EmailORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "emails."+column)
+	}
+	in := Email{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyEmailConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&EmailORM{}).Select(selected).Rows()
+}
+
+// DefaultListEmailByCursor lists up to limit Email rows matching the filter after the cursor, ordered
+// by email and id. next is the cursor of the following page, empty after the last one, and a
+// non-positive limit lists all the rows after the cursor
+func DefaultListEmailByCursor(ctx context.Context, db *gorm.DB, f *query.Filtering, cursor string, limit int) ([]*Email, string, error) {
+	in := Email{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, f, nil, nil, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	db = db.Where(&ormObj)
+	if cursor != "" {
+		var value string
+		var key string
+		if err := types.DecodeCursor(cursor, &value, &key); err != nil {
+			return nil, "", fmt.Errorf("%w: %v", errors.InvalidCursorError, err)
+		}
+		db = db.Where("(email, id) > (?, ?)", value, key)
+	}
+	db = db.Order("email").Order("id")
+	if limit > 0 {
+		db = db.Limit(limit + 1)
+	}
+	ormResponse := []EmailORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, "", err
+	}
+	var next string
+	if limit > 0 && len(ormResponse) > limit {
+		ormResponse = ormResponse[:limit]
+		last := ormResponse[limit-1]
+		if next, err = types.EncodeCursor(last.Email, last.Id); err != nil {
+			return nil, "", err
+		}
+	}
+	pbResponse := []*Email{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, next, nil
+}
+
+// DefaultReparentEmailAttachments moves the Attachment of childID to the Attachments of the
+// Email of newParentID, which has to exist. The Attachment is placed last among
+// its new siblings and the position it leaves is closed.
+func DefaultReparentEmailAttachments(ctx context.Context, db *gorm.DB, childID string, newParentID string) error {
+	if childID == "" || newParentID == "" {
+		return errors.EmptyIdError
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		var parents int
+		if err := tx.Model(&EmailORM{}).Where("id = ? AND account_id = ?", newParentID, accountID).Count(&parents).Error; err != nil {
+			return err
+		}
+		if parents == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		child := AttachmentORM{}
+		if err := tx.Where("id = ? AND account_id = ?", childID, accountID).First(&child).Error; err != nil {
+			return err
+		}
+		if child.EmailId != nil && *child.EmailId == newParentID {
+			return nil
+		}
+		var siblings int
+		if err := tx.Model(&AttachmentORM{}).Where("email_id = ?", newParentID).Count(&siblings).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&AttachmentORM{}).Where("id = ?", childID).UpdateColumns(map[string]interface{}{"email_id": newParentID, "position": siblings}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&AttachmentORM{}).Where("email_id = ? AND position > ?", child.EmailId, child.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultReorderEmailAttachments sets the Position of the Attachments of the Email of
+// parentID to their index in childIDs, in one transaction. childIDs has to
+// list each of them once, otherwise it fails with errors.InvalidOrderError.
+func DefaultReorderEmailAttachments(ctx context.Context, db *gorm.DB, parentID string, childIDs []string) error {
+	if parentID == "" {
+		return errors.EmptyIdError
+	}
+	listed := make(map[string]bool, len(childIDs))
+	for _, id := range childIDs {
+		if listed[id] {
+			return fmt.Errorf("%w: %v is listed twice", errors.InvalidOrderError, id)
+		}
+		listed[id] = true
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		var parents int
+		if err := tx.Model(&EmailORM{}).Where("id = ? AND account_id = ?", parentID, accountID).Count(&parents).Error; err != nil {
+			return err
+		}
+		if parents == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		var current []string
+		if err := tx.Model(&AttachmentORM{}).Where("email_id = ? AND account_id = ?", parentID, accountID).Pluck("id", &current).Error; err != nil {
+			return err
+		}
+		for _, id := range current {
+			if !listed[id] {
+				return fmt.Errorf("%w: %v is missing", errors.InvalidOrderError, id)
+			}
+		}
+		if len(current) != len(childIDs) {
+			return fmt.Errorf("%w: %d ids for %d Attachments", errors.InvalidOrderError, len(childIDs), len(current))
+		}
+		for i, id := range childIDs {
+			if err := tx.Model(&AttachmentORM{}).Where("id = ?", id).UpdateColumn("position", i).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DefaultCreateAttachment executes a basic gorm create call
+func DefaultCreateAttachment(ctx context.Context, in *Attachment, db *gorm.DB) (*Attachment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AttachmentORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromAttachment inserts the objects with a COPY into the attachments table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object. COPY has no
+// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreateAttachmentSet
+// leaves them to the DB.
+func DefaultCopyFromAttachment(ctx context.Context, in []*Attachment, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]AttachmentORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	for i, row := range rows {
+		if row.Id == "" {
+			return 0, fmt.Errorf("COPY into attachments takes no default of id, which is blank in object %d", i)
+		}
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into attachments needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("attachments", "account_id", "email_id", "id", "name", "position"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.EmailId, row.Id, row.Name, row.Position); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// AttachmentORMMaxBatchSize is the most rows of an INSERT of DefaultCreateAttachmentSet,
+// postgres takes 65535 bind parameters in a statement and a row has 5
+const AttachmentORMMaxBatchSize = 13107
+
+// DefaultCreateAttachmentSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most AttachmentORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateAttachmentSet(ctx context.Context, in []*Attachment, db *gorm.DB, batchSize int) ([]*Attachment, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > AttachmentORMMaxBatchSize {
+		batchSize = AttachmentORMMaxBatchSize
+	}
+	rows := make([]AttachmentORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*AttachmentORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*5)
+			for _, row := range batch {
+				values := []interface{}{row.AccountID, row.EmailId, row.Id, row.Name, row.Position}
+				if row.Id == "" {
+					values[2] = types.Default
+				}
+				tuple, bound := types.InsertTuple(values)
+				tuples = append(tuples, tuple)
+				args = append(args, bound...)
+			}
+			var stored []*AttachmentORM
+			if err := tx.Raw(`INSERT INTO "attachments" ("account_id", "email_id", "id", "name", "position") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return AttachmentORMSliceToPB(ctx, created)
+}
+
+func DefaultReadAttachment(ctx context.Context, in *Attachment, db *gorm.DB) (*Attachment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AttachmentORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyAttachmentConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := AttachmentORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(AttachmentORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AttachmentORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadAttachmentForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadAttachmentForUpdate(ctx context.Context, in *Attachment, db *gorm.DB, wait types.LockWait) (*Attachment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := AttachmentORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteAttachment(ctx context.Context, in *Attachment, db *gorm.DB) error {
+	return defaultDeleteAttachment(ctx, in, db, nil)
+}
+
+// DefaultDeleteAttachmentWithResult is DefaultDeleteAttachment reporting the affected rows,
+// no affected rows means no Attachment matched
+func DefaultDeleteAttachmentWithResult(ctx context.Context, in *Attachment, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteAttachment(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteAttachment(ctx context.Context, in *Attachment, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	db, auditTxn, err := audit.Begin(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer auditTxn.Rollback()
+	auditActor, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	auditRow := AttachmentORM{}
+	if err = db.Where(&ormObj).First(&auditRow).Error; err != nil && !gorm.IsRecordNotFoundError(err) {
+		return err
+	}
+	auditFound := err == nil
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&AttachmentORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if auditFound {
+		var auditBefore, auditAfter proto.Message
+		if pb, err := auditRow.ToPB(ctx); err != nil {
+			return err
+		} else {
+			auditBefore = &pb
+		}
+		auditRecord := audit.Record{Resource: "attachments", Key: fmt.Sprint(auditRow.Id), Operation: "delete", Actor: auditActor}
+		if err = audit.Write(db, auditRecord, auditBefore, auditAfter); err != nil {
+			return err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	if err != nil {
+		return err
+	}
+	if err = auditTxn.Commit(); err != nil {
+		return err
+	}
+	return err
+}
+
+type AttachmentORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAttachmentSet(ctx context.Context, in []*Attachment, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	db, auditTxn, err := audit.Begin(ctx, db)
+	if err != nil {
+		return err
+	}
+	defer auditTxn.Rollback()
+	auditActor, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return err
+	}
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&AttachmentORM{})).(AttachmentORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	auditRows := []AttachmentORM{}
+	if err = db.Where("account_id = ? AND id in (?)", acctId, keys).Find(&auditRows).Error; err != nil {
+		return err
+	}
+	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&AttachmentORM{}).Error
+	if err != nil {
+		return err
+	}
+	for _, auditRow := range auditRows {
+		var auditBefore, auditAfter proto.Message
+		if pb, err := auditRow.ToPB(ctx); err != nil {
+			return err
+		} else {
+			auditBefore = &pb
+		}
+		auditRecord := audit.Record{Resource: "attachments", Key: fmt.Sprint(auditRow.Id), Operation: "delete", Actor: auditActor}
+		if err = audit.Write(db, auditRecord, auditBefore, auditAfter); err != nil {
+			return err
+		}
+	}
+	if hook, ok := (interface{}(&AttachmentORM{})).(AttachmentORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	if err != nil {
+		return err
+	}
+	if err = auditTxn.Commit(); err != nil {
+		return err
+	}
+	return err
+}
+
+type AttachmentORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Attachment, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Attachment, *gorm.DB) error
+}
+
+// DefaultStrictUpdateAttachment clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAttachment(ctx context.Context, in *Attachment, db *gorm.DB) (*Attachment, error) {
+	return defaultStrictUpdateAttachment(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateAttachmentWithResult is DefaultStrictUpdateAttachment reporting the affected rows
+// and whether the Attachment existed before the update
+func DefaultStrictUpdateAttachmentWithResult(ctx context.Context, in *Attachment, db *gorm.DB) (*Attachment, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateAttachment(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateAttachment(ctx context.Context, in *Attachment, db *gorm.DB, result *types.WriteResult) (*Attachment, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAttachment")
+	}
+	db, auditTxn, err := audit.Begin(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	defer auditTxn.Rollback()
+	auditActor, err := auth.GetJWTField(ctx, "sub", nil)
+	if err != nil {
+		return nil, err
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
+	lockedRow := &AttachmentORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	var auditBefore, auditAfter proto.Message
+	if count > 0 {
+		if pb, err := lockedRow.ToPB(ctx); err != nil {
+			return nil, err
+		} else {
+			auditBefore = &pb
+		}
+	}
+	if pb, err := ormObj.ToPB(ctx); err != nil {
+		return nil, err
+	} else {
+		auditAfter = &pb
+	}
+	auditRecord := audit.Record{Resource: "attachments", Key: fmt.Sprint(ormObj.Id), Operation: "update", Actor: auditActor}
+	if err = audit.Write(db, auditRecord, auditBefore, auditAfter); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = auditTxn.Commit(); err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type AttachmentORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAttachment executes a basic gorm update call with patch behavior
+func DefaultPatchAttachment(ctx context.Context, in *Attachment, updateMask *field_mask.FieldMask, db *gorm.DB) (*Attachment, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Attachment
+	var err error
+	if hook, ok := interface{}(&pbObj).(AttachmentWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAttachment(ctx, &Attachment{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AttachmentWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAttachment(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AttachmentWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAttachment(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AttachmentWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AttachmentWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Attachment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Attachment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Attachment, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Attachment, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAttachment executes a bulk gorm update call with patch behavior
+func DefaultPatchSetAttachment(ctx context.Context, objects []*Attachment, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Attachment, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Attachment, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchAttachment(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateAttachmentByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, account and by-fields cannot be in updateMask.
+func DefaultUpdateAttachmentByIds(ctx context.Context, ids []string, in *Attachment, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Name":
+			columns["name"] = ormObj.Name
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&AttachmentORM{}).Where("account_id = ? AND id IN (?)", acctId, batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskAttachment patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAttachment(ctx context.Context, patchee *Attachment, patcher *Attachment, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Attachment, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAttachment executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListAttachment(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Attachment, error) {
+	in := Attachment{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AttachmentORM{}, &Attachment{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyAttachmentConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []AttachmentORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Attachment{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AttachmentORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AttachmentORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AttachmentORM) error
+}
+
+// DefaultExplainListAttachment returns the SELECT DefaultListAttachment would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListAttachment(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Attachment{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AttachmentORM{}, &Attachment{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyAttachmentConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &AttachmentORM{})
+}
+
+// AttachmentORMSelectable lists the columns accepted by DefaultSelectAttachment
+var AttachmentORMSelectable = map[string]struct{}{
+	"account_id": {},
+	"email_id":   {},
+	"id":         {},
+	"name":       {},
+	"position":   {},
+}
+
+// DefaultSelectAttachment runs the query of DefaultListAttachment for the columns only, which
+// must be in AttachmentORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectAttachment(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := AttachmentORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "attachments."+column)
+	}
+	in := Attachment{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AttachmentORM{}, &Attachment{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyAttachmentConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AttachmentORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&AttachmentORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateAddress executes a basic gorm create call
+func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromAddress inserts the objects with a COPY into the geo.addresses table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromAddress(ctx context.Context, in []*Address, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]AddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into geo.addresses needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyInSchema("geo", "addresses", "account_id", "address_1", "address_2", "external", "implicit_fk", "post"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.Address_1, row.Address_2, row.External, row.ImplicitFk, row.Post); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// AddressORMMaxBatchSize is the most rows of an INSERT of DefaultCreateAddressSet,
+// postgres takes 65535 bind parameters in a statement and a row has 6
+const AddressORMMaxBatchSize = 10922
+
+// DefaultCreateAddressSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most AddressORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateAddressSet(ctx context.Context, in []*Address, db *gorm.DB, batchSize int) ([]*Address, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > AddressORMMaxBatchSize {
+		batchSize = AddressORMMaxBatchSize
+	}
+	rows := make([]AddressORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*AddressORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*6)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?, ?, ?, ?)")
+				args = append(args, row.AccountID, row.Address_1, row.Address_2, row.External, row.ImplicitFk, row.Post)
+			}
+			var stored []*AddressORM
+			if err := tx.Raw(`INSERT INTO "geo"."addresses" ("account_id", "address_1", "address_2", "external", "implicit_fk", "post") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return AddressORMSliceToPB(ctx, created)
+}
+
+func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AddressORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyAddressConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := AddressORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadAddressForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadAddressForUpdate(ctx context.Context, in *Address, db *gorm.DB, wait types.LockWait) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := AddressORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
+	return defaultDeleteAddress(ctx, in, db, nil)
+}
+
+// DefaultDeleteAddressWithResult is DefaultDeleteAddress reporting the affected rows,
+// no affected rows means no Address matched
+func DefaultDeleteAddressWithResult(ctx context.Context, in *Address, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteAddress(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&AddressORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type AddressORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&AddressORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type AddressORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Address, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Address, *gorm.DB) error
+}
+
+// DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
+	return defaultStrictUpdateAddress(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateAddressWithResult is DefaultStrictUpdateAddress reporting the affected rows
+// and whether the Address existed before the update
+func DefaultStrictUpdateAddressWithResult(ctx context.Context, in *Address, db *gorm.DB) (*Address, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateAddress(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB, result *types.WriteResult) (*Address, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAddress")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
+	lockedRow := &AddressORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type AddressORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAddress executes a basic gorm update call with patch behavior
+func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Address
+	var err error
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAddress(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AddressWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AddressWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAddress executes a bulk gorm update call with patch behavior
+func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Address, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchAddress(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateAddressByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, account and by-fields cannot be in updateMask.
+func DefaultUpdateAddressByIds(ctx context.Context, ids []int64, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Address_1":
+			columns["address_1"] = ormObj.Address_1
+		case "Address_2":
+			columns["address_2"] = ormObj.Address_2
+		case "Post":
+			columns["post"] = ormObj.Post
+		case "External":
+			columns["external"] = ormObj.External
+		case "ImplicitFk":
+			columns["implicit_fk"] = ormObj.ImplicitFk
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&AddressORM{}).Where("account_id = ? AND id IN (?)", acctId, batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskAddress patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Address_1" {
+			patchee.Address_1 = patcher.Address_1
+			continue
+		}
+		if f == prefix+"Address_2" {
+			patchee.Address_2 = patcher.Address_2
+			continue
+		}
+		if f == prefix+"Post" {
+			patchee.Post = patcher.Post
+			continue
+		}
+		if f == prefix+"External" {
+			patchee.External = patcher.External
+			continue
+		}
+		if f == prefix+"ImplicitFk" {
+			patchee.ImplicitFk = patcher.ImplicitFk
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAddress executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListAddress(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Address, error) {
+	in := Address{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AddressORM{}, &Address{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyAddressConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	ormResponse := []AddressORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Address{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AddressORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AddressORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AddressORM) error
+}
+
+// DefaultExplainListAddress returns the SELECT DefaultListAddress would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListAddress(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Address{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AddressORM{}, &Address{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyAddressConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &AddressORM{})
+}
+
+// AddressORMSelectable lists the columns accepted by DefaultSelectAddress
+var AddressORMSelectable = map[string]struct{}{
+	"account_id":  {},
+	"address_1":   {},
+	"address_2":   {},
+	"external":    {},
+	"id":          {},
+	"implicit_fk": {},
+	"post":        {},
+}
+
+// DefaultSelectAddress runs the query of DefaultListAddress for the columns only, which
+// must be in AddressORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectAddress(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := AddressORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "geo.addresses."+column)
+	}
+	in := Address{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AddressORM{}, &Address{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyAddressConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&AddressORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateLanguage executes a basic gorm create call
+func DefaultCreateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromLanguage inserts the objects with a COPY into the languages table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromLanguage(ctx context.Context, in []*Language, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]LanguageORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into languages needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("languages", "account_id", "code", "external_int", "name"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.Code, row.ExternalInt, row.Name); err != nil {
+				stmt.Close()
+				return err
 			}
-			if o, err := DefaultApplyFieldMaskCreditCard(ctx, patchee.CreditCard, patcher.CreditCard, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"CreditCard.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.CreditCard = o
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// LanguageORMMaxBatchSize is the most rows of an INSERT of DefaultCreateLanguageSet,
+// postgres takes 65535 bind parameters in a statement and a row has 4
+const LanguageORMMaxBatchSize = 16383
+
+// DefaultCreateLanguageSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most LanguageORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateLanguageSet(ctx context.Context, in []*Language, db *gorm.DB, batchSize int) ([]*Language, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > LanguageORMMaxBatchSize {
+		batchSize = LanguageORMMaxBatchSize
+	}
+	rows := make([]LanguageORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*LanguageORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
 			}
-			continue
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*4)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?, ?)")
+				args = append(args, row.AccountID, row.Code, row.ExternalInt, row.Name)
+			}
+			var stored []*LanguageORM
+			if err := tx.Raw(`INSERT INTO "languages" ("account_id", "code", "external_int", "name") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return LanguageORMSliceToPB(ctx, created)
+}
+
+func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LanguageORM{}); err != nil {
+		return nil, err
+	}
+	if db, err = applyLanguageConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := LanguageORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(LanguageORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+// DefaultReadLanguageForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadLanguageForUpdate(ctx context.Context, in *Language, db *gorm.DB, wait types.LockWait) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := LanguageORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error {
+	return defaultDeleteLanguage(ctx, in, db, nil)
+}
+
+// DefaultDeleteLanguageWithResult is DefaultDeleteLanguage reporting the affected rows,
+// no affected rows means no Language matched
+func DefaultDeleteLanguageWithResult(ctx context.Context, in *Language, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteLanguage(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB, result *types.WriteResult) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == 0 {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	deleted := db.Where(&ormObj).Delete(&LanguageORM{})
+	if err = deleted.Error; err != nil {
+		return err
+	}
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type LanguageORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []int64{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == 0 {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return err
+	}
+	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&LanguageORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type LanguageORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Language, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Language, *gorm.DB) error
+}
+
+// DefaultStrictUpdateLanguage clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+	return defaultStrictUpdateLanguage(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateLanguageWithResult is DefaultStrictUpdateLanguage reporting the affected rows
+// and whether the Language existed before the update
+func DefaultStrictUpdateLanguageWithResult(ctx context.Context, in *Language, db *gorm.DB) (*Language, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateLanguage(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB, result *types.WriteResult) (*Language, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLanguage")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	db = db.Where(map[string]interface{}{"account_id": accountID})
+	var count int64
+	lockedRow := &LanguageORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
+		return nil, err
+	}
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"CreditCard" {
-			updatedCreditCard = true
-			patchee.CreditCard = patcher.CreditCard
-			continue
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		err = gateway.SetCreated(ctx, "")
+	}
+	return &pbResponse, err
+}
+
+type LanguageORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchLanguage executes a basic gorm update call with patch behavior
+func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Language
+	var err error
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"Emails" {
-			patchee.Emails = patcher.Emails
-			continue
+	}
+	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"Tasks" {
-			patchee.Tasks = patcher.Tasks
-			continue
+	}
+	if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if !updatedBillingAddress && strings.HasPrefix(f, prefix+"BillingAddress.") {
-			updatedBillingAddress = true
-			if patcher.BillingAddress == nil {
-				patchee.BillingAddress = nil
-				continue
-			}
-			if patchee.BillingAddress == nil {
-				patchee.BillingAddress = &Address{}
-			}
-			if o, err := DefaultApplyFieldMaskAddress(ctx, patchee.BillingAddress, patcher.BillingAddress, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"BillingAddress.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.BillingAddress = o
-			}
-			continue
+	}
+	pbResponse, err := DefaultStrictUpdateLanguage(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
 		}
-		if f == prefix+"BillingAddress" {
-			updatedBillingAddress = true
-			patchee.BillingAddress = patcher.BillingAddress
-			continue
+	}
+	return pbResponse, nil
+}
+
+type LanguageWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetLanguage executes a bulk gorm update call with patch behavior
+func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Language, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchLanguage(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
 		}
-		if !updatedShippingAddress && strings.HasPrefix(f, prefix+"ShippingAddress.") {
-			updatedShippingAddress = true
-			if patcher.ShippingAddress == nil {
-				patchee.ShippingAddress = nil
-				continue
-			}
-			if patchee.ShippingAddress == nil {
-				patchee.ShippingAddress = &Address{}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultUpdateLanguageByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, account and by-fields cannot be in updateMask.
+func DefaultUpdateLanguageByIds(ctx context.Context, ids []int64, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "Name":
+			columns["name"] = ormObj.Name
+		case "Code":
+			columns["code"] = ormObj.Code
+		case "ExternalInt":
+			columns["external_int"] = ormObj.ExternalInt
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
 			}
-			if o, err := DefaultApplyFieldMaskAddress(ctx, patchee.ShippingAddress, patcher.ShippingAddress, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"ShippingAddress.", db); err != nil {
-				return nil, err
-			} else {
-				patchee.ShippingAddress = o
+			res := tx.Model(&LanguageORM{}).Where("account_id = ? AND id IN (?)", acctId, batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
 			}
-			continue
-		}
-		if f == prefix+"ShippingAddress" {
-			updatedShippingAddress = true
-			patchee.ShippingAddress = patcher.ShippingAddress
-			continue
+			updated += res.RowsAffected
 		}
-		if f == prefix+"Languages" {
-			patchee.Languages = patcher.Languages
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Friends" {
-			patchee.Friends = patcher.Friends
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"ShippingAddressId" {
-			patchee.ShippingAddressId = patcher.ShippingAddressId
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
 			continue
 		}
-		if f == prefix+"ExternalUuid" {
-			patchee.ExternalUuid = patcher.ExternalUuid
+		if f == prefix+"ExternalInt" {
+			patchee.ExternalInt = patcher.ExternalInt
 			continue
 		}
 	}
@@ -1326,39 +7227,43 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 	return patchee, nil
 }
 
-// DefaultListUser executes a gorm list call
-func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
-	in := User{}
+// DefaultListLanguage executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListLanguage(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Language, error) {
+	in := Language{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserORM{}, &User{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LanguageORM{}, &Language{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeListFind); ok {
+	if db, err = applyLanguageConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []UserORM{}
+	ormResponse := []LanguageORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*User{}
+	pbResponse := []*Language{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1366,21 +7271,101 @@ func DefaultListUser(ctx context.Context, db *gorm.DB) ([]*User, error) {
 		}
 		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
-}
-
-type UserORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type UserORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type UserORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]UserORM) error
+	return pbResponse, nil
+}
+
+type LanguageORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LanguageORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LanguageORM) error
+}
+
+// DefaultExplainListLanguage returns the SELECT DefaultListLanguage would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListLanguage(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Language{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LanguageORM{}, &Language{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyLanguageConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &LanguageORM{})
+}
+
+// LanguageORMSelectable lists the columns accepted by DefaultSelectLanguage
+var LanguageORMSelectable = map[string]struct{}{
+	"account_id":   {},
+	"code":         {},
+	"external_int": {},
+	"id":           {},
+	"name":         {},
+}
+
+// DefaultSelectLanguage runs the query of DefaultListLanguage for the columns only, which
+// must be in LanguageORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectLanguage(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := LanguageORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "languages."+column)
+	}
+	in := Language{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LanguageORM{}, &Language{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyLanguageConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&LanguageORM{}).Select(selected).Rows()
 }
 
-// DefaultCreateEmail executes a basic gorm create call
-func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+// DefaultCreateCreditCard executes a basic gorm create call
+func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1388,15 +7373,16 @@ func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, er
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1405,14 +7391,127 @@ func DefaultCreateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, er
 	return &pbResponse, err
 }
 
-type EmailORMWithBeforeCreate_ interface {
+type CreditCardORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterCreate_ interface {
+type CreditCardORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+// DefaultCopyFromCreditCard inserts the objects with a COPY into the credit_cards table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromCreditCard(ctx context.Context, in []*CreditCard, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	now := time.Now()
+	rows := make([]CreditCardORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		if row.UpdatedAt == nil {
+			row.UpdatedAt = &now
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into credit_cards needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("credit_cards", "account_id", "created_at", "number", "updated_at", "user_id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.CreatedAt, row.Number, row.UpdatedAt, row.UserId); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// CreditCardORMMaxBatchSize is the most rows of an INSERT of DefaultCreateCreditCardSet,
+// postgres takes 65535 bind parameters in a statement and a row has 5
+const CreditCardORMMaxBatchSize = 13107
+
+// DefaultCreateCreditCardSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most CreditCardORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB, batchSize int) ([]*CreditCard, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > CreditCardORMMaxBatchSize {
+		batchSize = CreditCardORMMaxBatchSize
+	}
+	now := time.Now()
+	rows := make([]CreditCardORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if row.CreatedAt == nil {
+			row.CreatedAt = &now
+		}
+		if row.UpdatedAt == nil {
+			row.UpdatedAt = &now
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*CreditCardORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*5)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?, ?, ?)")
+				args = append(args, row.AccountID, row.CreatedAt, row.Number, row.UpdatedAt, row.UserId)
+			}
+			var stored []*CreditCardORM
+			if err := tx.Raw(`INSERT INTO "credit_cards" ("account_id", "created_at", "number", "updated_at", "user_id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return CreditCardORMSliceToPB(ctx, created)
+}
+
+func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -1420,27 +7519,30 @@ func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, erro
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &EmailORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &CreditCardORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeReadFind); ok {
+	if db, err = applyCreditCardConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := EmailORM{}
+	ormResponse := CreditCardORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(EmailORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(CreditCardORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1449,17 +7551,54 @@ func DefaultReadEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, erro
 	return &pbResponse, err
 }
 
-type EmailORMWithBeforeReadApplyQuery interface {
+type CreditCardORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithBeforeReadFind interface {
+type CreditCardORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterReadFind interface {
+type CreditCardORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
+// DefaultReadCreditCardForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadCreditCardForUpdate(ctx context.Context, in *CreditCard, db *gorm.DB, wait types.LockWait) (*CreditCard, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := CreditCardORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) error {
+	return defaultDeleteCreditCard(ctx, in, db, nil)
+}
+
+// DefaultDeleteCreditCardWithResult is DefaultDeleteCreditCard reporting the affected rows,
+// no affected rows means no CreditCard matched
+func DefaultDeleteCreditCardWithResult(ctx context.Context, in *CreditCard, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteCreditCard(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1467,48 +7606,52 @@ func DefaultDeleteEmail(ctx context.Context, in *Email, db *gorm.DB) error {
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == "" {
+	if ormObj.Id == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&EmailORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&CreditCardORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type EmailORMWithBeforeDelete_ interface {
+type CreditCardORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterDelete_ interface {
+type CreditCardORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error {
+func DefaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []string{}
+	keys := []int64{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == "" {
+		if ormObj.Id == 0 {
 			return errors.EmptyIdError
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
@@ -1517,27 +7660,39 @@ func DefaultDeleteEmailSet(ctx context.Context, in []*Email, db *gorm.DB) error
 	if err != nil {
 		return err
 	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&EmailORM{}).Error
+	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&CreditCardORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&EmailORM{})).(EmailORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type EmailORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Email, *gorm.DB) (*gorm.DB, error)
+type CreditCardORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*CreditCard, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Email, *gorm.DB) error
+type CreditCardORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*CreditCard, *gorm.DB) error
 }
 
-// DefaultStrictUpdateEmail clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Email, error) {
+// DefaultStrictUpdateCreditCard clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+	return defaultStrictUpdateCreditCard(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateCreditCardWithResult is DefaultStrictUpdateCreditCard reporting the affected rows
+// and whether the CreditCard existed before the update
+func DefaultStrictUpdateCreditCardWithResult(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateCreditCard(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB, result *types.WriteResult) (*CreditCard, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateEmail")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCreditCard")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
@@ -1549,22 +7704,27 @@ func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Ema
 	}
 	db = db.Where(map[string]interface{}{"account_id": accountID})
 	var count int64
-	lockedRow := &EmailORM{}
+	lockedRow := &CreditCardORM{}
 	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1579,51 +7739,51 @@ func DefaultStrictUpdateEmail(ctx context.Context, in *Email, db *gorm.DB) (*Ema
 	return &pbResponse, err
 }
 
-type EmailORMWithBeforeStrictUpdateCleanup interface {
+type CreditCardORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithBeforeStrictUpdateSave interface {
+type CreditCardORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterStrictUpdateSave interface {
+type CreditCardORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchEmail executes a basic gorm update call with patch behavior
-func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.FieldMask, db *gorm.DB) (*Email, error) {
+// DefaultPatchCreditCard executes a basic gorm update call with patch behavior
+func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Email
+	var pbObj CreditCard
 	var err error
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadEmail(ctx, &Email{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadCreditCard(ctx, &CreditCard{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskEmail(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskCreditCard(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(EmailWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateEmail(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateCreditCard(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(EmailWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(CreditCardWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1631,28 +7791,28 @@ func DefaultPatchEmail(ctx context.Context, in *Email, updateMask *field_mask.Fi
 	return pbResponse, nil
 }
 
-type EmailWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type CreditCardWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type EmailWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type CreditCardWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type EmailWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type CreditCardWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type EmailWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Email, *field_mask.FieldMask, *gorm.DB) error
+type CreditCardWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetEmail executes a bulk gorm update call with patch behavior
-func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Email, error) {
+// DefaultPatchSetCreditCard executes a bulk gorm update call with patch behavior
+func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CreditCard, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Email, 0, len(objects))
+	results := make([]*CreditCard, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchEmail(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchCreditCard(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1663,35 +7823,128 @@ func DefaultPatchSetEmail(ctx context.Context, objects []*Email, updateMasks []*
 	return results, nil
 }
 
-// DefaultApplyFieldMaskEmail patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Email, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Email, error) {
+// DefaultUpdateCreditCardByIds sets the columns of the fields in updateMask to their values in in
+// for the rows of ids, in one transaction of UPDATEs of at most 500 ids, and returns the
+// number of rows updated. The key, account and by-fields cannot be in updateMask.
+func DefaultUpdateCreditCardByIds(ctx context.Context, ids []int64, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (int64, error) {
+	if in == nil || updateMask == nil {
+		return 0, errors.NilArgumentError
+	}
+	if len(ids) == 0 || len(updateMask.Paths) == 0 {
+		return 0, nil
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return 0, err
+	}
+	columns := make(map[string]interface{}, len(updateMask.Paths))
+	for _, path := range updateMask.Paths {
+		switch path {
+		case "CreatedAt":
+			columns["created_at"] = ormObj.CreatedAt
+		case "UpdatedAt":
+			columns["updated_at"] = ormObj.UpdatedAt
+		case "Number":
+			columns["number"] = ormObj.Number
+		case "UserId":
+			columns["user_id"] = ormObj.UserId
+		default:
+			return 0, fmt.Errorf("%w %q", errors.UnknownUpdateFieldError, path)
+		}
+	}
+	acctId, err := auth.GetAccountID(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	var updated int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(ids); start += 500 {
+			batch := ids[start:]
+			if len(batch) > 500 {
+				batch = batch[:500]
+			}
+			res := tx.Model(&CreditCardORM{}).Where("account_id = ? AND id IN (?)", acctId, batch).Updates(columns)
+			if res.Error != nil {
+				return res.Error
+			}
+			updated += res.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// DefaultApplyFieldMaskCreditCard patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, patcher *CreditCard, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CreditCard, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	for _, f := range updateMask.Paths {
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Email" {
-			patchee.Email = patcher.Email
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
 			continue
 		}
-		if f == prefix+"Subscribed" {
-			patchee.Subscribed = patcher.Subscribed
+		if f == prefix+"Number" {
+			patchee.Number = patcher.Number
 			continue
 		}
 		if f == prefix+"UserId" {
 			patchee.UserId = patcher.UserId
 			continue
 		}
-		if f == prefix+"ExternalNotNull" {
-			patchee.ExternalNotNull = patcher.ExternalNotNull
-			continue
-		}
 	}
 	if err != nil {
 		return nil, err
@@ -1699,39 +7952,43 @@ func DefaultApplyFieldMaskEmail(ctx context.Context, patchee *Email, patcher *Em
 	return patchee, nil
 }
 
-// DefaultListEmail executes a gorm list call
-func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
-	in := Email{}
+// DefaultListCreditCard executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListCreditCard(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*CreditCard, error) {
+	in := CreditCard{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &EmailORM{}, &Email{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CreditCardORM{}, &CreditCard{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithBeforeListFind); ok {
+	if db, err = applyCreditCardConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
+	db = db.Where(&ormObj).Scopes(scopes...)
 	db = db.Order("id")
-	ormResponse := []EmailORM{}
+	ormResponse := []CreditCardORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(EmailORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Email{}
+	pbResponse := []*CreditCard{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -1742,302 +7999,232 @@ func DefaultListEmail(ctx context.Context, db *gorm.DB) ([]*Email, error) {
 	return pbResponse, nil
 }
 
-type EmailORMWithBeforeListApplyQuery interface {
+type CreditCardORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithBeforeListFind interface {
+type CreditCardORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type EmailORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]EmailORM) error
-}
-
-// DefaultCreateAddress executes a basic gorm create call
-func DefaultCreateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type AddressORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+type CreditCardORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]CreditCardORM) error
 }
 
-func DefaultReadAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultExplainListCreditCard returns the SELECT DefaultListCreditCard would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListCreditCard(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := CreditCard{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
-	}
-	if ormObj.Id == 0 {
-		return nil, errors.EmptyIdError
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadApplyQuery); ok {
-		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AddressORM{}); err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeReadFind); ok {
-		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	ormResponse := AddressORM{}
-	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
-		return nil, err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormResponse).(AddressORMWithAfterReadFind); ok {
-		if err = hook.AfterReadFind(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	pbResponse, err := ormResponse.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type AddressORMWithBeforeReadApplyQuery interface {
-	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithBeforeReadFind interface {
-	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithAfterReadFind interface {
-	AfterReadFind(context.Context, *gorm.DB) error
-}
-
-func DefaultDeleteAddress(ctx context.Context, in *Address, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	ormObj, err := in.ToORM(ctx)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CreditCardORM{}, &CreditCard{}, nil, nil, nil, nil)
 	if err != nil {
-		return err
+		return "", err
 	}
-	if ormObj.Id == 0 {
-		return errors.EmptyIdError
+	if db, err = applyCreditCardConditionalPreloads(ctx, db); err != nil {
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeDelete_); ok {
-		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
-			return err
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&AddressORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterDelete_); ok {
-		err = hook.AfterDelete_(ctx, db)
-	}
-	return err
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return explain.SQL(db, &CreditCardORM{})
 }
 
-type AddressORMWithBeforeDelete_ interface {
-	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithAfterDelete_ interface {
-	AfterDelete_(context.Context, *gorm.DB) error
+// CreditCardORMSelectable lists the columns accepted by DefaultSelectCreditCard
+var CreditCardORMSelectable = map[string]struct{}{
+	"account_id": {},
+	"created_at": {},
+	"id":         {},
+	"number":     {},
+	"updated_at": {},
+	"user_id":    {},
 }
 
-func DefaultDeleteAddressSet(ctx context.Context, in []*Address, db *gorm.DB) error {
-	if in == nil {
-		return errors.NilArgumentError
-	}
-	var err error
-	keys := []int64{}
-	for _, obj := range in {
-		ormObj, err := obj.ToORM(ctx)
-		if err != nil {
-			return err
-		}
-		if ormObj.Id == 0 {
-			return errors.EmptyIdError
-		}
-		keys = append(keys, ormObj.Id)
+// DefaultSelectCreditCard runs the query of DefaultListCreditCard for the columns only, which
+// must be in CreditCardORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectCreditCard(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
 	}
-	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithBeforeDeleteSet); ok {
-		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
-			return err
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := CreditCardORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
 		}
+		selected = append(selected, "credit_cards."+column)
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&AddressORM{}).Error
-	if err != nil {
-		return err
-	}
-	if hook, ok := (interface{}(&AddressORM{})).(AddressORMWithAfterDeleteSet); ok {
-		err = hook.AfterDeleteSet(ctx, in, db)
-	}
-	return err
-}
-
-type AddressORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Address, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Address, *gorm.DB) error
-}
-
-// DefaultStrictUpdateAddress clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateAddress(ctx context.Context, in *Address, db *gorm.DB) (*Address, error) {
-	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAddress")
-	}
+	in := CreditCard{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
-	var count int64
-	lockedRow := &AddressORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateCleanup); ok {
-		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeStrictUpdateSave); ok {
-		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	if err = db.Save(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterStrictUpdateSave); ok {
-		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	if err != nil {
-		return nil, err
-	}
-	if count == 0 {
-		err = gateway.SetCreated(ctx, "")
-	}
-	return &pbResponse, err
-}
-
-type AddressORMWithBeforeStrictUpdateCleanup interface {
-	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithBeforeStrictUpdateSave interface {
-	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type AddressORMWithAfterStrictUpdateSave interface {
-	AfterStrictUpdateSave(context.Context, *gorm.DB) error
-}
-
-// DefaultPatchAddress executes a basic gorm update call with patch behavior
-func DefaultPatchAddress(ctx context.Context, in *Address, updateMask *field_mask.FieldMask, db *gorm.DB) (*Address, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
-	var pbObj Address
-	var err error
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchRead); ok {
-		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadAddress(ctx, &Address{Id: in.GetId()}, db)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CreditCardORM{}, &CreditCard{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchApplyFieldMask); ok {
-		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+	if db, err = applyCreditCardConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskAddress(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("id")
+	return db.Model(&CreditCardORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateTask executes a basic gorm create call
+func DefaultCreateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(AddressWithBeforePatchSave); ok {
-		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateAddress(ctx, &pbObj, db)
-	if err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(AddressWithAfterPatchSave); ok {
-		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	return pbResponse, nil
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
 }
 
-type AddressWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type AddressWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
-}
-type AddressWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type TaskORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Address, *field_mask.FieldMask, *gorm.DB) error
+type TaskORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchSetAddress executes a bulk gorm update call with patch behavior
-func DefaultPatchSetAddress(ctx context.Context, objects []*Address, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Address, error) {
-	if len(objects) != len(updateMasks) {
-		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+// DefaultCopyFromTask inserts the objects with a COPY into the tasks table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromTask(ctx context.Context, in []*Task, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]TaskORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into tasks needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyIn("tasks", "account_id", "description", "name", "priority", "user_id"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.AccountID, row.Description, row.Name, row.Priority, row.UserId); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
 	}
+	return int64(len(rows)), nil
+}
 
-	results := make([]*Address, 0, len(objects))
-	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchAddress(ctx, patcher, updateMasks[i], db)
+// TaskORMMaxBatchSize is the most rows of an INSERT of DefaultCreateTaskSet,
+// postgres takes 65535 bind parameters in a statement and a row has 5
+const TaskORMMaxBatchSize = 13107
+
+// DefaultCreateTaskSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most TaskORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateTaskSet(ctx context.Context, in []*Task, db *gorm.DB, batchSize int) ([]*Task, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > TaskORMMaxBatchSize {
+		batchSize = TaskORMMaxBatchSize
+	}
+	rows := make([]TaskORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
 		if err != nil {
 			return nil, err
 		}
-
-		results = append(results, pbResponse)
+		rows = append(rows, row)
 	}
-
-	return results, nil
+	created := make([]*TaskORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*5)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?, ?, ?)")
+				args = append(args, row.AccountID, row.Description, row.Name, row.Priority, row.UserId)
+			}
+			var stored []*TaskORM
+			if err := tx.Raw(`INSERT INTO "tasks" ("account_id", "description", "name", "priority", "user_id") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return TaskORMSliceToPB(ctx, created)
 }
 
-// DefaultApplyFieldMaskAddress patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher *Address, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Address, error) {
+// DefaultApplyFieldMaskTask patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Task, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2045,28 +8232,16 @@ func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher
 	}
 	var err error
 	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if f == prefix+"Address_1" {
-			patchee.Address_1 = patcher.Address_1
-			continue
-		}
-		if f == prefix+"Address_2" {
-			patchee.Address_2 = patcher.Address_2
-			continue
-		}
-		if f == prefix+"Post" {
-			patchee.Post = patcher.Post
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"External" {
-			patchee.External = patcher.External
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
 			continue
 		}
-		if f == prefix+"ImplicitFk" {
-			patchee.ImplicitFk = patcher.ImplicitFk
+		if f == prefix+"Priority" {
+			patchee.Priority = patcher.Priority
 			continue
 		}
 	}
@@ -2076,39 +8251,42 @@ func DefaultApplyFieldMaskAddress(ctx context.Context, patchee *Address, patcher
 	return patchee, nil
 }
 
-// DefaultListAddress executes a gorm list call
-func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
-	in := Address{}
+// DefaultListTask executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListTask(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Task, error) {
+	in := Task{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AddressORM{}, &Address{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TaskORM{}, &Task{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithBeforeListFind); ok {
+	if db, err = applyTaskConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []AddressORM{}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	ormResponse := []TaskORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(AddressORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Address{}
+	pbResponse := []*Task{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2119,50 +8297,280 @@ func DefaultListAddress(ctx context.Context, db *gorm.DB) ([]*Address, error) {
 	return pbResponse, nil
 }
 
-type AddressORMWithBeforeListApplyQuery interface {
+type TaskORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithBeforeListFind interface {
+type TaskORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type AddressORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]AddressORM) error
+type TaskORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TaskORM) error
 }
 
-// DefaultCreateLanguage executes a basic gorm create call
-func DefaultCreateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+// DefaultExplainListTask returns the SELECT DefaultListTask would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListTask(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Task{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TaskORM{}, &Task{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyTaskConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return explain.SQL(db, &TaskORM{})
+}
+
+// TaskORMSelectable lists the columns accepted by DefaultSelectTask
+var TaskORMSelectable = map[string]struct{}{
+	"account_id":  {},
+	"description": {},
+	"name":        {},
+	"priority":    {},
+	"user_id":     {},
+}
+
+// DefaultSelectTask runs the query of DefaultListTask for the columns only, which
+// must be in TaskORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectTask(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := TaskORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "tasks."+column)
+	}
+	in := Task{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TaskORM{}, &Task{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyTaskConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	return db.Model(&TaskORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateRegion executes a basic gorm create call
+func DefaultCreateRegion(ctx context.Context, in *Region, db *gorm.DB) (*Region, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	ormObj, err := in.ToORM(ctx)
-	if err != nil {
-		return nil, err
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RegionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RegionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+// DefaultCopyFromRegion inserts the objects with a COPY into the inventory.regions table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromRegion(ctx context.Context, in []*Region, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]RegionORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into inventory.regions needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyInSchema("inventory", "regions", "code", "country", "name"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Code, row.Country, row.Name); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// RegionORMMaxBatchSize is the most rows of an INSERT of DefaultCreateRegionSet,
+// postgres takes 65535 bind parameters in a statement and a row has 3
+const RegionORMMaxBatchSize = 21845
+
+// DefaultCreateRegionSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most RegionORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateRegionSet(ctx context.Context, in []*Region, db *gorm.DB, batchSize int) ([]*Region, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > RegionORMMaxBatchSize {
+		batchSize = RegionORMMaxBatchSize
+	}
+	rows := make([]RegionORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*RegionORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?)")
+				args = append(args, row.Code, row.Country, row.Name)
+			}
+			var stored []*RegionORM
+			if err := tx.Raw(`INSERT INTO "inventory"."regions" ("code", "country", "name") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return RegionORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertRegionSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most RegionORMMaxBatchSize, updating the row of the same
+// "code", "country" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertRegionSet(ctx context.Context, in []*Region, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > RegionORMMaxBatchSize {
+		batchSize = RegionORMMaxBatchSize
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	rows := make([]RegionORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
 		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
-	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*3)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?, ?)")
+				args = append(args, row.Code, row.Country, row.Name)
+			}
+			written := tx.Exec(`INSERT INTO "inventory"."regions" ("code", "country", "name") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("code", "country") DO UPDATE SET "name" = EXCLUDED."name"`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
 		}
+		return nil
+	}); err != nil {
+		return 0, err
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
-}
-
-type LanguageORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type LanguageORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+	return upserted, nil
 }
 
-func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+func DefaultReadRegion(ctx context.Context, in *Region, db *gorm.DB) (*Region, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2170,27 +8578,30 @@ func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Langu
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Code == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LanguageORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &RegionORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeReadFind); ok {
+	if db, err = applyRegionConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := LanguageORM{}
+	ormResponse := RegionORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(LanguageORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(RegionORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2199,17 +8610,54 @@ func DefaultReadLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Langu
 	return &pbResponse, err
 }
 
-type LanguageORMWithBeforeReadApplyQuery interface {
+type RegionORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithBeforeReadFind interface {
+type RegionORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithAfterReadFind interface {
+type RegionORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error {
+// DefaultReadRegionForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadRegionForUpdate(ctx context.Context, in *Region, db *gorm.DB, wait types.LockWait) (*Region, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Code == "" {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := RegionORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteRegion(ctx context.Context, in *Region, db *gorm.DB) error {
+	return defaultDeleteRegion(ctx, in, db, nil)
+}
+
+// DefaultDeleteRegionWithResult is DefaultDeleteRegion reporting the affected rows,
+// no affected rows means no Region matched
+func DefaultDeleteRegionWithResult(ctx context.Context, in *Region, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteRegion(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteRegion(ctx context.Context, in *Region, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2217,104 +8665,124 @@ func DefaultDeleteLanguage(ctx context.Context, in *Language, db *gorm.DB) error
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Code == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&LanguageORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&RegionORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type LanguageORMWithBeforeDelete_ interface {
+type RegionORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithAfterDelete_ interface {
+type RegionORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteLanguageSet(ctx context.Context, in []*Language, db *gorm.DB) error {
+func DefaultDeleteRegionSet(ctx context.Context, in []*Region, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []int64{}
+	keys := []string{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Code == "" {
 			return errors.EmptyIdError
 		}
-		keys = append(keys, ormObj.Id)
+		keys = append(keys, ormObj.Code)
 	}
-	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&RegionORM{})).(RegionORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&LanguageORM{}).Error
+	err = db.Where("code in (?)", keys).Delete(&RegionORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&LanguageORM{})).(LanguageORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&RegionORM{})).(RegionORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type LanguageORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*Language, *gorm.DB) (*gorm.DB, error)
+type RegionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Region, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*Language, *gorm.DB) error
+type RegionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Region, *gorm.DB) error
 }
 
-// DefaultStrictUpdateLanguage clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB) (*Language, error) {
+// DefaultStrictUpdateRegion clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRegion(ctx context.Context, in *Region, db *gorm.DB) (*Region, error) {
+	return defaultStrictUpdateRegion(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateRegionWithResult is DefaultStrictUpdateRegion reporting the affected rows
+// and whether the Region existed before the update
+func DefaultStrictUpdateRegionWithResult(ctx context.Context, in *Region, db *gorm.DB) (*Region, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateRegion(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateRegion(ctx context.Context, in *Region, db *gorm.DB, result *types.WriteResult) (*Region, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLanguage")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateRegion")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
 	var count int64
-	lockedRow := &LanguageORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateCleanup); ok {
+	lockedRow := &RegionORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("code=?", ormObj.Code).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeStrictUpdateSave); ok {
+	if err = db.Model(&ormObj).Association("Depots").Replace(ormObj.Depots).Error; err != nil {
+		return nil, err
+	}
+	ormObj.Depots = nil
+	if err = db.Model(&ormObj).Association("Warehouses").Replace(ormObj.Warehouses).Error; err != nil {
+		return nil, err
+	}
+	ormObj.Warehouses = nil
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2329,51 +8797,46 @@ func DefaultStrictUpdateLanguage(ctx context.Context, in *Language, db *gorm.DB)
 	return &pbResponse, err
 }
 
-type LanguageORMWithBeforeStrictUpdateCleanup interface {
+type RegionORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithBeforeStrictUpdateSave interface {
+type RegionORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithAfterStrictUpdateSave interface {
+type RegionORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchLanguage executes a basic gorm update call with patch behavior
-func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_mask.FieldMask, db *gorm.DB) (*Language, error) {
+// DefaultPatchRegion executes a basic gorm update call with patch behavior
+func DefaultPatchRegion(ctx context.Context, in *Region, updateMask *field_mask.FieldMask, db *gorm.DB) (*Region, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj Language
+	var pbObj Region
 	var err error
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(RegionWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadLanguage(ctx, &Language{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(RegionWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskLanguage(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskRegion(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(LanguageWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(RegionWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateLanguage(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateRegion(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(LanguageWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(RegionWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2381,28 +8844,28 @@ func DefaultPatchLanguage(ctx context.Context, in *Language, updateMask *field_m
 	return pbResponse, nil
 }
 
-type LanguageWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type RegionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Region, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type RegionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Region, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type RegionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Region, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *Language, *field_mask.FieldMask, *gorm.DB) error
+type RegionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Region, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetLanguage executes a bulk gorm update call with patch behavior
-func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Language, error) {
+// DefaultPatchSetRegion executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRegion(ctx context.Context, objects []*Region, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Region, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*Language, 0, len(objects))
+	results := make([]*Region, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchLanguage(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchRegion(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2413,8 +8876,8 @@ func DefaultPatchSetLanguage(ctx context.Context, objects []*Language, updateMas
 	return results, nil
 }
 
-// DefaultApplyFieldMaskLanguage patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patcher *Language, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Language, error) {
+// DefaultApplyFieldMaskRegion patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRegion(ctx context.Context, patchee *Region, patcher *Region, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Region, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -2422,20 +8885,24 @@ func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patch
 	}
 	var err error
 	for _, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
+		if f == prefix+"Country" {
+			patchee.Country = patcher.Country
+			continue
+		}
+		if f == prefix+"Code" {
+			patchee.Code = patcher.Code
 			continue
 		}
 		if f == prefix+"Name" {
 			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"Code" {
-			patchee.Code = patcher.Code
+		if f == prefix+"Warehouses" {
+			patchee.Warehouses = patcher.Warehouses
 			continue
 		}
-		if f == prefix+"ExternalInt" {
-			patchee.ExternalInt = patcher.ExternalInt
+		if f == prefix+"Depots" {
+			patchee.Depots = patcher.Depots
 			continue
 		}
 	}
@@ -2445,39 +8912,43 @@ func DefaultApplyFieldMaskLanguage(ctx context.Context, patchee *Language, patch
 	return patchee, nil
 }
 
-// DefaultListLanguage executes a gorm list call
-func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error) {
-	in := Language{}
+// DefaultListRegion executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListRegion(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Region, error) {
+	in := Region{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LanguageORM{}, &Language{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RegionORM{}, &Region{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithBeforeListFind); ok {
+	if db, err = applyRegionConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []LanguageORM{}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("code")
+	ormResponse := []RegionORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(LanguageORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(RegionORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*Language{}
+	pbResponse := []*Region{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2488,18 +8959,96 @@ func DefaultListLanguage(ctx context.Context, db *gorm.DB) ([]*Language, error)
 	return pbResponse, nil
 }
 
-type LanguageORMWithBeforeListApplyQuery interface {
+type RegionORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithBeforeListFind interface {
+type RegionORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type LanguageORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]LanguageORM) error
+type RegionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RegionORM) error
 }
 
-// DefaultCreateCreditCard executes a basic gorm create call
-func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+// DefaultExplainListRegion returns the SELECT DefaultListRegion would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListRegion(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Region{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RegionORM{}, &Region{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if db, err = applyRegionConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("code")
+	return explain.SQL(db, &RegionORM{})
+}
+
+// RegionORMSelectable lists the columns accepted by DefaultSelectRegion
+var RegionORMSelectable = map[string]struct{}{
+	"code":    {},
+	"country": {},
+	"name":    {},
+}
+
+// DefaultSelectRegion runs the query of DefaultListRegion for the columns only, which
+// must be in RegionORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectRegion(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
+		return nil, errors.NilArgumentError
+	}
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := RegionORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
+		}
+		selected = append(selected, "inventory.regions."+column)
+	}
+	in := Region{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RegionORM{}, &Region{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if db, err = applyRegionConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RegionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("code")
+	return db.Model(&RegionORM{}).Select(selected).Rows()
+}
+
+// DefaultCreateWarehouse executes a basic gorm create call
+func DefaultCreateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2507,15 +9056,16 @@ func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
+	written := returning.Set(db).Create(&ormObj)
+	if err = written.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2524,14 +9074,165 @@ func DefaultCreateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (
 	return &pbResponse, err
 }
 
-type CreditCardORMWithBeforeCreate_ interface {
+type WarehouseORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterCreate_ interface {
+type WarehouseORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+// DefaultCopyFromWarehouse inserts the objects with a COPY into the inventory.warehouses table, which
+// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the
+// associations are not written and every column takes the value of the object.
+func DefaultCopyFromWarehouse(ctx context.Context, in []*Warehouse, db *gorm.DB) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	rows := make([]WarehouseORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		sqlTx, ok := tx.CommonDB().(*sql.Tx)
+		if !ok {
+			return fmt.Errorf("COPY into inventory.warehouses needs a *sql.Tx, got %T", tx.CommonDB())
+		}
+		stmt, err := sqlTx.PrepareContext(ctx, pq.CopyInSchema("inventory", "warehouses", "number", "site"))
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if _, err := stmt.ExecContext(ctx, row.Number, row.Site); err != nil {
+				stmt.Close()
+				return err
+			}
+		}
+		if _, err := stmt.ExecContext(ctx); err != nil {
+			stmt.Close()
+			return err
+		}
+		return stmt.Close()
+	}); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
+
+// WarehouseORMMaxBatchSize is the most rows of an INSERT of DefaultCreateWarehouseSet,
+// postgres takes 65535 bind parameters in a statement and a row has 2
+const WarehouseORMMaxBatchSize = 32767
+
+// DefaultCreateWarehouseSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most WarehouseORMMaxBatchSize, in one transaction and
+// returns them as stored. The GORM callbacks and hooks do not run and the
+// associations are not written.
+func DefaultCreateWarehouseSet(ctx context.Context, in []*Warehouse, db *gorm.DB, batchSize int) ([]*Warehouse, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > WarehouseORMMaxBatchSize {
+		batchSize = WarehouseORMMaxBatchSize
+	}
+	rows := make([]WarehouseORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return nil, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	created := make([]*WarehouseORM, 0, len(rows))
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?)")
+				args = append(args, row.Number, row.Site)
+			}
+			var stored []*WarehouseORM
+			if err := tx.Raw(`INSERT INTO "inventory"."warehouses" ("number", "site") VALUES `+strings.Join(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {
+				return err
+			}
+			created = append(created, stored...)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return WarehouseORMSliceToPB(ctx, created)
+}
+
+// DefaultUpsertWarehouseSet inserts the objects with INSERTs of batchSize rows, 500 if
+// batchSize is not positive and at most WarehouseORMMaxBatchSize, updating the row of the same
+// "number", "site" instead, in one transaction, e.g. to reconcile a table with external data.
+// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,
+// the GORM callbacks and hooks do not run and the associations are not written.
+func DefaultUpsertWarehouseSet(ctx context.Context, in []*Warehouse, db *gorm.DB, batchSize int) (int64, error) {
+	if len(in) == 0 {
+		return 0, nil
+	}
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	if batchSize > WarehouseORMMaxBatchSize {
+		batchSize = WarehouseORMMaxBatchSize
+	}
+	rows := make([]WarehouseORM, 0, len(in))
+	for _, obj := range in {
+		if obj == nil {
+			return 0, errors.NilArgumentError
+		}
+		row, err := obj.ToORM(ctx)
+		if err != nil {
+			return 0, err
+		}
+		rows = append(rows, row)
+	}
+	var upserted int64
+	if err := db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(rows); start += batchSize {
+			batch := rows[start:]
+			if len(batch) > batchSize {
+				batch = batch[:batchSize]
+			}
+			tuples := make([]string, 0, len(batch))
+			args := make([]interface{}, 0, len(batch)*2)
+			for _, row := range batch {
+				tuples = append(tuples, "(?, ?)")
+				args = append(args, row.Number, row.Site)
+			}
+			written := tx.Exec(`INSERT INTO "inventory"."warehouses" ("number", "site") VALUES `+strings.Join(tuples, ", ")+` ON CONFLICT ("number", "site") DO NOTHING`, args...)
+			if err := written.Error; err != nil {
+				return err
+			}
+			upserted += written.RowsAffected
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return upserted, nil
+}
+
+func DefaultReadWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -2539,27 +9240,30 @@ func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*C
 	if err != nil {
 		return nil, err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Number == 0 {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &CreditCardORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &WarehouseORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeReadFind); ok {
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := CreditCardORM{}
+	ormResponse := WarehouseORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(CreditCardORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(WarehouseORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2568,17 +9272,54 @@ func DefaultReadCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*C
 	return &pbResponse, err
 }
 
-type CreditCardORMWithBeforeReadApplyQuery interface {
+type WarehouseORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithBeforeReadFind interface {
+type WarehouseORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterReadFind interface {
+type WarehouseORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) error {
+// DefaultReadWarehouseForUpdate reads the row of the primary key of in and locks it until the
+// transaction of db ends, it fails with NoTransactionError outside of one. With
+// LockWaitSkipLocked a row locked by another transaction is not found.
+func DefaultReadWarehouseForUpdate(ctx context.Context, in *Warehouse, db *gorm.DB, wait types.LockWait) (*Warehouse, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		return nil, errors.NoTransactionError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Number == 0 {
+		return nil, errors.EmptyIdError
+	}
+	ormResponse := WarehouseORM{}
+	if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+func DefaultDeleteWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) error {
+	return defaultDeleteWarehouse(ctx, in, db, nil)
+}
+
+// DefaultDeleteWarehouseWithResult is DefaultDeleteWarehouse reporting the affected rows,
+// no affected rows means no Warehouse matched
+func DefaultDeleteWarehouseWithResult(ctx context.Context, in *Warehouse, db *gorm.DB) (types.WriteResult, error) {
+	var result types.WriteResult
+	err := defaultDeleteWarehouse(ctx, in, db, &result)
+	return result, err
+}
+
+func defaultDeleteWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB, result *types.WriteResult) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -2586,104 +9327,116 @@ func DefaultDeleteCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) e
 	if err != nil {
 		return err
 	}
-	if ormObj.Id == 0 {
+	if ormObj.Number == 0 {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&CreditCardORM{}).Error
-	if err != nil {
+	deleted := db.Where(&ormObj).Delete(&WarehouseORM{})
+	if err = deleted.Error; err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterDelete_); ok {
+	if result != nil {
+		result.RowsAffected = deleted.RowsAffected
+		result.Found = deleted.RowsAffected > 0
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type CreditCardORMWithBeforeDelete_ interface {
+type WarehouseORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterDelete_ interface {
+type WarehouseORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteCreditCardSet(ctx context.Context, in []*CreditCard, db *gorm.DB) error {
+func DefaultDeleteWarehouseSet(ctx context.Context, in []*Warehouse, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
 	var err error
-	keys := []int64{}
+	keys := []int32{}
 	for _, obj := range in {
 		ormObj, err := obj.ToORM(ctx)
 		if err != nil {
 			return err
 		}
-		if ormObj.Id == 0 {
+		if ormObj.Number == 0 {
 			return errors.EmptyIdError
 		}
-		keys = append(keys, ormObj.Id)
+		keys = append(keys, ormObj.Number)
 	}
-	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&WarehouseORM{})).(WarehouseORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	acctId, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return err
-	}
-	err = db.Where("account_id = ? AND id in (?)", acctId, keys).Delete(&CreditCardORM{}).Error
+	err = db.Where("number in (?)", keys).Delete(&WarehouseORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&CreditCardORM{})).(CreditCardORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&WarehouseORM{})).(WarehouseORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type CreditCardORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*CreditCard, *gorm.DB) (*gorm.DB, error)
+type WarehouseORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Warehouse, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*CreditCard, *gorm.DB) error
+type WarehouseORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Warehouse, *gorm.DB) error
 }
 
-// DefaultStrictUpdateCreditCard clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm.DB) (*CreditCard, error) {
+// DefaultStrictUpdateWarehouse clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, error) {
+	return defaultStrictUpdateWarehouse(ctx, in, db, nil)
+}
+
+// DefaultStrictUpdateWarehouseWithResult is DefaultStrictUpdateWarehouse reporting the affected rows
+// and whether the Warehouse existed before the update
+func DefaultStrictUpdateWarehouseWithResult(ctx context.Context, in *Warehouse, db *gorm.DB) (*Warehouse, types.WriteResult, error) {
+	var result types.WriteResult
+	out, err := defaultStrictUpdateWarehouse(ctx, in, db, &result)
+	return out, result, err
+}
+
+func defaultStrictUpdateWarehouse(ctx context.Context, in *Warehouse, db *gorm.DB, result *types.WriteResult) (*Warehouse, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateCreditCard")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWarehouse")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	accountID, err := auth.GetAccountID(ctx, nil)
-	if err != nil {
-		return nil, err
-	}
-	db = db.Where(map[string]interface{}{"account_id": accountID})
 	var count int64
-	lockedRow := &CreditCardORM{}
-	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow).RowsAffected
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateCleanup); ok {
+	lockedRow := &WarehouseORM{}
+	count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("number=?", ormObj.Number).First(lockedRow).RowsAffected
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if err = db.Save(&ormObj).Error; err != nil {
+	saved := returning.Set(db).Save(&ormObj)
+	if err = saved.Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterStrictUpdateSave); ok {
+	if result != nil {
+		result.RowsAffected = saved.RowsAffected
+		result.Found = count > 0
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -2698,51 +9451,46 @@ func DefaultStrictUpdateCreditCard(ctx context.Context, in *CreditCard, db *gorm
 	return &pbResponse, err
 }
 
-type CreditCardORMWithBeforeStrictUpdateCleanup interface {
+type WarehouseORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithBeforeStrictUpdateSave interface {
+type WarehouseORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterStrictUpdateSave interface {
+type WarehouseORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchCreditCard executes a basic gorm update call with patch behavior
-func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *field_mask.FieldMask, db *gorm.DB) (*CreditCard, error) {
+// DefaultPatchWarehouse executes a basic gorm update call with patch behavior
+func DefaultPatchWarehouse(ctx context.Context, in *Warehouse, updateMask *field_mask.FieldMask, db *gorm.DB) (*Warehouse, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj CreditCard
+	var pbObj Warehouse
 	var err error
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(WarehouseWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadCreditCard(ctx, &CreditCard{Id: in.GetId()}, db)
-	if err != nil {
-		return nil, err
-	}
-	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(WarehouseWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskCreditCard(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskWarehouse(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(CreditCardWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(WarehouseWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateCreditCard(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdateWarehouse(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(CreditCardWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(WarehouseWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -2750,28 +9498,28 @@ func DefaultPatchCreditCard(ctx context.Context, in *CreditCard, updateMask *fie
 	return pbResponse, nil
 }
 
-type CreditCardWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type WarehouseWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *CreditCard, *field_mask.FieldMask, *gorm.DB) error
+type WarehouseWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Warehouse, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetCreditCard executes a bulk gorm update call with patch behavior
-func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*CreditCard, error) {
+// DefaultPatchSetWarehouse executes a bulk gorm update call with patch behavior
+func DefaultPatchSetWarehouse(ctx context.Context, objects []*Warehouse, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Warehouse, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*CreditCard, 0, len(objects))
+	results := make([]*Warehouse, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchCreditCard(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchWarehouse(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -2782,75 +9530,23 @@ func DefaultPatchSetCreditCard(ctx context.Context, objects []*CreditCard, updat
 	return results, nil
 }
 
-// DefaultApplyFieldMaskCreditCard patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, patcher *CreditCard, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*CreditCard, error) {
+// DefaultApplyFieldMaskWarehouse patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWarehouse(ctx context.Context, patchee *Warehouse, patcher *Warehouse, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Warehouse, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
 		return nil, errors.NilArgumentError
 	}
 	var err error
-	var updatedCreatedAt bool
-	var updatedUpdatedAt bool
-	for i, f := range updateMask.Paths {
-		if f == prefix+"Id" {
-			patchee.Id = patcher.Id
-			continue
-		}
-		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
-			if patcher.CreatedAt == nil {
-				patchee.CreatedAt = nil
-				continue
-			}
-			if patchee.CreatedAt == nil {
-				patchee.CreatedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"CreatedAt" {
-			updatedCreatedAt = true
-			patchee.CreatedAt = patcher.CreatedAt
-			continue
-		}
-		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
-			if patcher.UpdatedAt == nil {
-				patchee.UpdatedAt = nil
-				continue
-			}
-			if patchee.UpdatedAt == nil {
-				patchee.UpdatedAt = &timestamppb.Timestamp{}
-			}
-			childMask := &field_mask.FieldMask{}
-			for j := i; j < len(updateMask.Paths); j++ {
-				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
-					childMask.Paths = append(childMask.Paths, trimPath)
-				}
-			}
-			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
-				return nil, nil
-			}
-		}
-		if f == prefix+"UpdatedAt" {
-			updatedUpdatedAt = true
-			patchee.UpdatedAt = patcher.UpdatedAt
+	for _, f := range updateMask.Paths {
+		if f == prefix+"Site" {
+			patchee.Site = patcher.Site
 			continue
 		}
 		if f == prefix+"Number" {
 			patchee.Number = patcher.Number
 			continue
 		}
-		if f == prefix+"UserId" {
-			patchee.UserId = patcher.UserId
-			continue
-		}
 	}
 	if err != nil {
 		return nil, err
@@ -2858,39 +9554,43 @@ func DefaultApplyFieldMaskCreditCard(ctx context.Context, patchee *CreditCard, p
 	return patchee, nil
 }
 
-// DefaultListCreditCard executes a gorm list call
-func DefaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, error) {
-	in := CreditCard{}
+// DefaultListWarehouse executes a gorm list call, the scopes are applied
+// after the collection operators and the account scope
+func DefaultListWarehouse(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) ([]*Warehouse, error) {
+	in := Warehouse{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &CreditCardORM{}, &CreditCard{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &WarehouseORM{}, &Warehouse{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithBeforeListFind); ok {
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListFind); ok {
 		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db = db.Where(&ormObj)
-	db = db.Order("id")
-	ormResponse := []CreditCardORM{}
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("number")
+	ormResponse := []WarehouseORM{}
 	if err := db.Find(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(CreditCardORMWithAfterListFind); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithAfterListFind); ok {
 		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse := []*CreditCard{}
+	pbResponse := []*Warehouse{}
 	for _, responseEntry := range ormResponse {
 		temp, err := responseEntry.ToPB(ctx)
 		if err != nil {
@@ -2901,125 +9601,89 @@ func DefaultListCreditCard(ctx context.Context, db *gorm.DB) ([]*CreditCard, err
 	return pbResponse, nil
 }
 
-type CreditCardORMWithBeforeListApplyQuery interface {
+type WarehouseORMWithBeforeListApplyQuery interface {
 	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithBeforeListFind interface {
+type WarehouseORMWithBeforeListFind interface {
 	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type CreditCardORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]CreditCardORM) error
+type WarehouseORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WarehouseORM) error
 }
 
-// DefaultCreateTask executes a basic gorm create call
-func DefaultCreateTask(ctx context.Context, in *Task, db *gorm.DB) (*Task, error) {
-	if in == nil {
-		return nil, errors.NilArgumentError
-	}
+// DefaultExplainListWarehouse returns the SELECT DefaultListWarehouse would run for the
+// same arguments with the values inlined, nothing is executed. The preloads
+// are queries of their own and are not part of it.
+func DefaultExplainListWarehouse(ctx context.Context, db *gorm.DB, scopes ...func(*gorm.DB) *gorm.DB) (string, error) {
+	in := Warehouse{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeCreate_); ok {
-		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
-			return nil, err
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	if err = db.Create(&ormObj).Error; err != nil {
-		return nil, err
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &WarehouseORM{}, &Warehouse{}, nil, nil, nil, nil)
+	if err != nil {
+		return "", err
 	}
-	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterCreate_); ok {
-		if err = hook.AfterCreate_(ctx, db); err != nil {
-			return nil, err
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
+		return "", err
+	}
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return "", err
 		}
 	}
-	pbResponse, err := ormObj.ToPB(ctx)
-	return &pbResponse, err
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("number")
+	return explain.SQL(db, &WarehouseORM{})
 }
 
-type TaskORMWithBeforeCreate_ interface {
-	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TaskORMWithAfterCreate_ interface {
-	AfterCreate_(context.Context, *gorm.DB) error
+// WarehouseORMSelectable lists the columns accepted by DefaultSelectWarehouse
+var WarehouseORMSelectable = map[string]struct{}{
+	"number": {},
+	"site":   {},
 }
 
-// DefaultApplyFieldMaskTask patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskTask(ctx context.Context, patchee *Task, patcher *Task, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Task, error) {
-	if patcher == nil {
-		return nil, nil
-	} else if patchee == nil {
+// DefaultSelectWarehouse runs the query of DefaultListWarehouse for the columns only, which
+// must be in WarehouseORMSelectable, and returns the rows for the caller to scan and close.
+func DefaultSelectWarehouse(ctx context.Context, db *gorm.DB, columns []string, scopes ...func(*gorm.DB) *gorm.DB) (*sql.Rows, error) {
+	if len(columns) == 0 {
 		return nil, errors.NilArgumentError
 	}
-	var err error
-	for _, f := range updateMask.Paths {
-		if f == prefix+"Name" {
-			patchee.Name = patcher.Name
-			continue
-		}
-		if f == prefix+"Description" {
-			patchee.Description = patcher.Description
-			continue
-		}
-		if f == prefix+"Priority" {
-			patchee.Priority = patcher.Priority
-			continue
+	selected := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if _, ok := WarehouseORMSelectable[column]; !ok {
+			return nil, fmt.Errorf("%w %q", errors.UnknownSelectColumnError, column)
 		}
+		selected = append(selected, "inventory.warehouses."+column)
 	}
-	if err != nil {
-		return nil, err
-	}
-	return patchee, nil
-}
-
-// DefaultListTask executes a gorm list call
-func DefaultListTask(ctx context.Context, db *gorm.DB) ([]*Task, error) {
-	in := Task{}
+	in := Warehouse{}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListApplyQuery); ok {
 		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TaskORM{}, &Task{}, nil, nil, nil, nil)
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &WarehouseORM{}, &Warehouse{}, nil, nil, nil, nil)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TaskORMWithBeforeListFind); ok {
-		if db, err = hook.BeforeListFind(ctx, db); err != nil {
-			return nil, err
-		}
-	}
-	db = db.Where(&ormObj)
-	ormResponse := []TaskORM{}
-	if err := db.Find(&ormResponse).Error; err != nil {
+	if db, err = applyWarehouseConditionalPreloads(ctx, db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(TaskORMWithAfterListFind); ok {
-		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
-			return nil, err
-		}
-	}
-	pbResponse := []*Task{}
-	for _, responseEntry := range ormResponse {
-		temp, err := responseEntry.ToPB(ctx)
-		if err != nil {
+	if hook, ok := interface{}(&ormObj).(WarehouseORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
 			return nil, err
 		}
-		pbResponse = append(pbResponse, &temp)
 	}
-	return pbResponse, nil
-}
-
-type TaskORMWithBeforeListApplyQuery interface {
-	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TaskORMWithBeforeListFind interface {
-	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
-}
-type TaskORMWithAfterListFind interface {
-	AfterListFind(context.Context, *gorm.DB, *[]TaskORM) error
+	db = db.Where(&ormObj).Scopes(scopes...)
+	db = db.Order("number")
+	return db.Model(&WarehouseORM{}).Select(selected).Rows()
 }
@@ -0,0 +1,96 @@
+package explain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+)
+
+var numericPlaceholder = regexp.MustCompile(`\$\d+`)
+
+// SQL renders the SELECT that finding the rows of model would run on db, with
+// the values inlined so that it can be pasted into EXPLAIN ANALYZE. Nothing is
+// executed, and the preloads, which are queries of their own run on the rows
+// read, are not part of it.
+func SQL(db *gorm.DB, model interface{}) (string, error) {
+	scope := db.NewScope(nil)
+	query := scope.AddToVars(db.Model(model).QueryExpr())
+	literals := make([]string, len(scope.SQLVars))
+	for i, value := range scope.SQLVars {
+		literal, err := Literal(value)
+		if err != nil {
+			return "", err
+		}
+		literals[i] = literal
+	}
+	return inline(query, literals), nil
+}
+
+// inline replaces the $n or ? bind variables of query by the literals
+func inline(query string, literals []string) string {
+	if numericPlaceholder.MatchString(query) {
+		return numericPlaceholder.ReplaceAllStringFunc(query, func(placeholder string) string {
+			if n, err := strconv.Atoi(placeholder[1:]); err == nil && n >= 1 && n <= len(literals) {
+				return literals[n-1]
+			}
+			return placeholder
+		})
+	}
+	parts := strings.Split(query, "?")
+	var b strings.Builder
+	for i, part := range parts {
+		b.WriteString(part)
+		if i < len(parts)-1 {
+			if i < len(literals) {
+				b.WriteString(literals[i])
+			} else {
+				b.WriteString("?")
+			}
+		}
+	}
+	return b.String()
+}
+
+// Literal returns the SQL literal of a query value, strings and times are
+// quoted and nil is NULL
+func Literal(value interface{}) (string, error) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "NULL", nil
+		}
+		if _, ok := value.(driver.Valuer); !ok {
+			value = v.Elem().Interface()
+		}
+	}
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return "", err
+		}
+		value = v
+	}
+	switch v := value.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return quote(v), nil
+	case []byte:
+		return quote(string(v)), nil
+	case time.Time:
+		return quote(v.Format("2006-01-02 15:04:05.999999999Z07:00")), nil
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprint(v), nil
+	default:
+		return quote(fmt.Sprint(v)), nil
+	}
+}
+
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
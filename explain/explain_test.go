@@ -0,0 +1,68 @@
+package explain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+)
+
+func TestLiteral(t *testing.T) {
+	owner := "o'brien"
+	for _, tc := range []struct {
+		value interface{}
+		want  string
+	}{
+		{nil, "NULL"},
+		{(*string)(nil), "NULL"},
+		{&owner, "'o''brien'"},
+		{int64(-3), "-3"},
+		{true, "true"},
+		{[]byte("raw"), "'raw'"},
+		{time.Date(2020, 1, 2, 3, 4, 5, 6000, time.UTC), "'2020-01-02 03:04:05.000006Z'"},
+	} {
+		if got, err := Literal(tc.value); err != nil || got != tc.want {
+			t.Errorf("Literal(%#v)=%s, %v; want %s", tc.value, got, err, tc.want)
+		}
+	}
+}
+
+func TestInline(t *testing.T) {
+	literals := []string{"'a'", "7"}
+	for query, want := range map[string]string{
+		`SELECT * FROM "w" WHERE (name = $1) AND (id > $2)`: `SELECT * FROM "w" WHERE (name = 'a') AND (id > 7)`,
+		"SELECT * FROM `w` WHERE (name = ?) AND (id > ?)":   "SELECT * FROM `w` WHERE (name = 'a') AND (id > 7)",
+		"SELECT * FROM w WHERE id IN (?, ?, ?)":             "SELECT * FROM w WHERE id IN ('a', 7, ?)",
+	} {
+		if got := inline(query, literals); got != want {
+			t.Errorf("inline(%s)=%s; want %s", query, got, want)
+		}
+	}
+}
+
+// connless is a database never reached, the dialect is enough to render
+type connless struct {
+	gorm.SQLCommon
+}
+
+type widget struct {
+	ID   uint32
+	Name string
+}
+
+func TestSQL(t *testing.T) {
+	db, err := gorm.Open("postgres", connless{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := db.Where(&widget{Name: "it's"}).Where("id > ?", 7).Order("id").Limit(10)
+	got, err := SQL(query, &widget{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `SELECT * FROM "widgets"  WHERE ("widgets"."name" = 'it''s') AND (id > 7) ORDER BY "id" LIMIT 10`
+	if got != want {
+		t.Errorf("SQL=%s; want %s", got, want)
+	}
+}
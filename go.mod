@@ -4,6 +4,7 @@ require (
 	github.com/denisenkom/go-mssqldb v0.9.0 // indirect
 	github.com/golang/protobuf v1.5.2
 	github.com/google/go-cmp v0.5.5
+	github.com/google/uuid v1.2.0
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.4.0 // indirect
 	github.com/infobloxopen/atlas-app-toolkit v0.24.1-0.20210416193901-4c7518b07e08
 	github.com/jinzhu/gorm v1.9.16
@@ -11,6 +12,8 @@ require (
 	github.com/jinzhu/now v1.1.1 // indirect
 	github.com/lib/pq v1.3.1-0.20200116171513-9eb3fc897d6f
 	github.com/mattn/go-sqlite3 v1.14.6 // indirect
+	github.com/prometheus/client_golang v0.9.3
+	github.com/prometheus/client_model v0.0.0-20190812154241-14fe0d1b01d4
 	github.com/satori/go.uuid v1.2.0
 	go.opencensus.io v0.22.6
 	google.golang.org/genproto v0.0.0-20210426193834-eac7f76ac494
@@ -0,0 +1,238 @@
+// Package dbtest is a database/sql driver recording the statements run on it,
+// for the tests of the runtime packages and of the generated handlers that
+// need the SQL of an engine without a server of it
+package dbtest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Recorder records the statements of the handles of Open and answers them
+// with the results set by Return and Fail
+type Recorder struct {
+	mu         sync.Mutex
+	statements []Statement
+	results    []result
+}
+
+// Statement is a statement run on a Recorder, BEGIN, COMMIT and ROLLBACK
+// stand for the transactions
+type Statement struct {
+	Query string
+	Args  []interface{}
+}
+
+type result struct {
+	substr   string
+	columns  []string
+	rows     [][]driver.Value
+	affected int64
+	err      error
+	// once results answer a single statement
+	once bool
+}
+
+// Open returns a GORM handle of the dialect, e.g. postgres, running its
+// statements on a new Recorder
+func Open(dialect string) (*gorm.DB, *Recorder, error) {
+	recorder := &Recorder{}
+	db, err := gorm.Open(dialect, sql.OpenDB(connector{recorder}))
+	if err != nil {
+		return nil, nil, err
+	}
+	return db, recorder, nil
+}
+
+// Return makes the statements containing substr return the rows of the
+// columns, and an exec of them affect as many rows, the latest result set for
+// a statement wins. The other statements return no rows and affect none.
+func (r *Recorder) Return(substr string, columns []string, rows ...[]driver.Value) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result{substr: substr, columns: columns, rows: rows, affected: int64(len(rows))})
+}
+
+// ReturnOnce is Return for the next statement containing substr only
+func (r *Recorder) ReturnOnce(substr string, columns []string, rows ...[]driver.Value) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result{substr: substr, columns: columns, rows: rows, affected: int64(len(rows)), once: true})
+}
+
+// Fail makes the statements containing substr fail with err
+func (r *Recorder) Fail(substr string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result{substr: substr, err: err})
+}
+
+// FailOnce is Fail for the next statement containing substr only
+func (r *Recorder) FailOnce(substr string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, result{substr: substr, err: err, once: true})
+}
+
+// Statements returns the statements run so far
+func (r *Recorder) Statements() []Statement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Statement(nil), r.statements...)
+}
+
+// Queries returns the queries of the statements run so far
+func (r *Recorder) Queries() []string {
+	var queries []string
+	for _, statement := range r.Statements() {
+		queries = append(queries, statement.Query)
+	}
+	return queries
+}
+
+// Writes returns the queries of the statements run so far other than BEGIN,
+// COMMIT and ROLLBACK
+func (r *Recorder) Writes() []string {
+	var queries []string
+	for _, query := range r.Queries() {
+		if query != "BEGIN" && query != "COMMIT" && query != "ROLLBACK" {
+			queries = append(queries, query)
+		}
+	}
+	return queries
+}
+
+// Reset forgets the statements run so far
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = nil
+}
+
+func (r *Recorder) run(query string, args []driver.NamedValue) result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	statement := Statement{Query: query}
+	for _, arg := range args {
+		statement.Args = append(statement.Args, arg.Value)
+	}
+	r.statements = append(r.statements, statement)
+	for i := len(r.results) - 1; i >= 0; i-- {
+		if res := r.results[i]; strings.Contains(query, res.substr) {
+			if res.once {
+				r.results = append(r.results[:i], r.results[i+1:]...)
+			}
+			return res
+		}
+	}
+	return result{}
+}
+
+type connector struct {
+	recorder *Recorder
+}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return conn{c.recorder}, nil
+}
+
+func (c connector) Driver() driver.Driver {
+	return recordingDriver{c.recorder}
+}
+
+type recordingDriver struct {
+	recorder *Recorder
+}
+
+func (d recordingDriver) Open(string) (driver.Conn, error) {
+	return conn{d.recorder}, nil
+}
+
+type conn struct {
+	recorder *Recorder
+}
+
+func (c conn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("dbtest: prepared statements are not supported")
+}
+
+func (c conn) Close() error {
+	return nil
+}
+
+func (c conn) Begin() (driver.Tx, error) {
+	c.recorder.run("BEGIN", nil)
+	return tx{c.recorder}, nil
+}
+
+func (c conn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	res := c.recorder.run(query, args)
+	if res.err != nil {
+		return nil, res.err
+	}
+	return execResult{res.affected}, nil
+}
+
+// execResult is the result of an exec, the inserted rows get no id
+type execResult struct {
+	affected int64
+}
+
+func (r execResult) LastInsertId() (int64, error) {
+	return 0, nil
+}
+
+func (r execResult) RowsAffected() (int64, error) {
+	return r.affected, nil
+}
+
+func (c conn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	res := c.recorder.run(query, args)
+	if res.err != nil {
+		return nil, res.err
+	}
+	return &rows{columns: res.columns, rows: res.rows}, nil
+}
+
+type tx struct {
+	recorder *Recorder
+}
+
+func (t tx) Commit() error {
+	t.recorder.run("COMMIT", nil)
+	return nil
+}
+
+func (t tx) Rollback() error {
+	t.recorder.run("ROLLBACK", nil)
+	return nil
+}
+
+type rows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *rows) Columns() []string {
+	return r.columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
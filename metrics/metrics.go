@@ -0,0 +1,47 @@
+// Package metrics counts and times the operations of the default gorm
+// handlers generated with metrics=prometheus, the collectors are shared by
+// all the generated packages
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	opsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "gorm_ops_total",
+		Help: "Number of operations executed by the default gorm handlers.",
+	}, []string{"message", "op", "status"})
+	opDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "gorm_op_duration_seconds",
+		Help:    "Duration of operations executed by the default gorm handlers.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"message", "op"})
+)
+
+// Register registers the metrics of the default gorm handlers of all the
+// packages with the registerer, e.g. prometheus.DefaultRegisterer.
+// Registering them again with the same registerer has no effect.
+func Register(reg prometheus.Registerer) error {
+	for _, collector := range []prometheus.Collector{opsTotal, opDuration} {
+		if err := reg.Register(collector); err != nil {
+			if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Observe counts the operation op of the handler of the message, given by
+// its full proto name, and records its duration since start
+func Observe(message, op string, start time.Time, err error) {
+	status := "ok"
+	if err != nil {
+		status = "error"
+	}
+	opsTotal.WithLabelValues(message, op, status).Inc()
+	opDuration.WithLabelValues(message, op).Observe(time.Since(start).Seconds())
+}
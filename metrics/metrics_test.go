@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestRegisterTwice(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if err := Register(reg); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	// the packages of several proto files register the same collectors
+	if err := Register(reg); err != nil {
+		t.Errorf("Register again: %v", err)
+	}
+}
+
+func TestObserve(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if err := Register(reg); err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	Observe("example.feature_demo.TypeWithID", "read", time.Now(), nil)
+	Observe("example.feature_demo.TypeWithID", "read", time.Now(), errors.New("failed"))
+	Observe("example.user.TypeWithID", "read", time.Now(), nil)
+
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather: %v", err)
+	}
+	counts := map[string]float64{}
+	for _, family := range families {
+		if family.GetName() != "gorm_ops_total" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			counts[labels(metric)] = metric.GetCounter().GetValue()
+		}
+	}
+	for key, want := range map[string]float64{
+		"example.feature_demo.TypeWithID read ok":    1,
+		"example.feature_demo.TypeWithID read error": 1,
+		"example.user.TypeWithID read ok":            1,
+	} {
+		if counts[key] != want {
+			t.Errorf("gorm_ops_total{%s}=%v; want %v", key, counts[key], want)
+		}
+	}
+}
+
+func labels(metric *dto.Metric) string {
+	values := map[string]string{}
+	for _, pair := range metric.GetLabel() {
+		values[pair.GetName()] = pair.GetValue()
+	}
+	return values["message"] + " " + values["op"] + " " + values["status"]
+}
@@ -21,6 +21,371 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// TenantIsolation selects how the rows of the tenants are kept apart
+type GormMessageOptions_TenantIsolation int32
+
+const (
+	// in the tables the connection resolves to
+	GormMessageOptions_SHARED GormMessageOptions_TenantIsolation = 0
+	// in a postgres schema per tenant, the handlers set the search_path of
+	// their transaction to the schema returned by the function of the
+	// tenant_schema_extractor parameter, the tables stay unqualified
+	GormMessageOptions_SCHEMA GormMessageOptions_TenantIsolation = 1
+)
+
+// Enum value maps for GormMessageOptions_TenantIsolation.
+var (
+	GormMessageOptions_TenantIsolation_name = map[int32]string{
+		0: "SHARED",
+		1: "SCHEMA",
+	}
+	GormMessageOptions_TenantIsolation_value = map[string]int32{
+		"SHARED": 0,
+		"SCHEMA": 1,
+	}
+)
+
+func (x GormMessageOptions_TenantIsolation) Enum() *GormMessageOptions_TenantIsolation {
+	p := new(GormMessageOptions_TenantIsolation)
+	*p = x
+	return p
+}
+
+func (x GormMessageOptions_TenantIsolation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GormMessageOptions_TenantIsolation) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[0].Descriptor()
+}
+
+func (GormMessageOptions_TenantIsolation) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[0]
+}
+
+func (x GormMessageOptions_TenantIsolation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GormMessageOptions_TenantIsolation.Descriptor instead.
+func (GormMessageOptions_TenantIsolation) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// EnumStorage selects the column representation of an enum field
+type GormFieldOptions_EnumStorage int32
+
+const (
+	// int32, or the name of the value with the enums=string parameter
+	GormFieldOptions_DEFAULT GormFieldOptions_EnumStorage = 0
+	// int16 in a smallint column, every value must fit into 16 bits
+	GormFieldOptions_SMALLINT GormFieldOptions_EnumStorage = 1
+)
+
+// Enum value maps for GormFieldOptions_EnumStorage.
+var (
+	GormFieldOptions_EnumStorage_name = map[int32]string{
+		0: "DEFAULT",
+		1: "SMALLINT",
+	}
+	GormFieldOptions_EnumStorage_value = map[string]int32{
+		"DEFAULT":  0,
+		"SMALLINT": 1,
+	}
+)
+
+func (x GormFieldOptions_EnumStorage) Enum() *GormFieldOptions_EnumStorage {
+	p := new(GormFieldOptions_EnumStorage)
+	*p = x
+	return p
+}
+
+func (x GormFieldOptions_EnumStorage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GormFieldOptions_EnumStorage) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[1].Descriptor()
+}
+
+func (GormFieldOptions_EnumStorage) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[1]
+}
+
+func (x GormFieldOptions_EnumStorage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GormFieldOptions_EnumStorage.Descriptor instead.
+func (GormFieldOptions_EnumStorage) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// ForeignKeyAction is the referential action of the foreign key of a
+// has-one, has-many or belongs-to association
+type GormFieldOptions_ForeignKeyAction int32
+
+const (
+	GormFieldOptions_ACTION_UNSET GormFieldOptions_ForeignKeyAction = 0
+	GormFieldOptions_CASCADE      GormFieldOptions_ForeignKeyAction = 1
+	GormFieldOptions_SET_NULL     GormFieldOptions_ForeignKeyAction = 2
+	GormFieldOptions_SET_DEFAULT  GormFieldOptions_ForeignKeyAction = 3
+	GormFieldOptions_RESTRICT     GormFieldOptions_ForeignKeyAction = 4
+	GormFieldOptions_NO_ACTION    GormFieldOptions_ForeignKeyAction = 5
+)
+
+// Enum value maps for GormFieldOptions_ForeignKeyAction.
+var (
+	GormFieldOptions_ForeignKeyAction_name = map[int32]string{
+		0: "ACTION_UNSET",
+		1: "CASCADE",
+		2: "SET_NULL",
+		3: "SET_DEFAULT",
+		4: "RESTRICT",
+		5: "NO_ACTION",
+	}
+	GormFieldOptions_ForeignKeyAction_value = map[string]int32{
+		"ACTION_UNSET": 0,
+		"CASCADE":      1,
+		"SET_NULL":     2,
+		"SET_DEFAULT":  3,
+		"RESTRICT":     4,
+		"NO_ACTION":    5,
+	}
+)
+
+func (x GormFieldOptions_ForeignKeyAction) Enum() *GormFieldOptions_ForeignKeyAction {
+	p := new(GormFieldOptions_ForeignKeyAction)
+	*p = x
+	return p
+}
+
+func (x GormFieldOptions_ForeignKeyAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GormFieldOptions_ForeignKeyAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[2].Descriptor()
+}
+
+func (GormFieldOptions_ForeignKeyAction) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[2]
+}
+
+func (x GormFieldOptions_ForeignKeyAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GormFieldOptions_ForeignKeyAction.Descriptor instead.
+func (GormFieldOptions_ForeignKeyAction) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{5, 1}
+}
+
+// DurationStorage selects the column of a google.protobuf.Duration field
+type GormFieldOptions_DurationStorage int32
+
+const (
+	// an interval with engine=postgres, the nanoseconds otherwise
+	GormFieldOptions_DURATION_UNSET GormFieldOptions_DurationStorage = 0
+	// a postgres interval, which keeps microseconds
+	GormFieldOptions_INTERVAL GormFieldOptions_DurationStorage = 1
+	// the nanoseconds in a bigint column
+	GormFieldOptions_NANOS GormFieldOptions_DurationStorage = 2
+)
+
+// Enum value maps for GormFieldOptions_DurationStorage.
+var (
+	GormFieldOptions_DurationStorage_name = map[int32]string{
+		0: "DURATION_UNSET",
+		1: "INTERVAL",
+		2: "NANOS",
+	}
+	GormFieldOptions_DurationStorage_value = map[string]int32{
+		"DURATION_UNSET": 0,
+		"INTERVAL":       1,
+		"NANOS":          2,
+	}
+)
+
+func (x GormFieldOptions_DurationStorage) Enum() *GormFieldOptions_DurationStorage {
+	p := new(GormFieldOptions_DurationStorage)
+	*p = x
+	return p
+}
+
+func (x GormFieldOptions_DurationStorage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GormFieldOptions_DurationStorage) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[3].Descriptor()
+}
+
+func (GormFieldOptions_DurationStorage) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[3]
+}
+
+func (x GormFieldOptions_DurationStorage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GormFieldOptions_DurationStorage.Descriptor instead.
+func (GormFieldOptions_DurationStorage) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{5, 2}
+}
+
+// StoreAs selects a column representation other than the one of the type
+type GormFieldOptions_StoreAs int32
+
+const (
+	GormFieldOptions_STORE_AS_UNSET GormFieldOptions_StoreAs = 0
+	// a postgres array of the elements, typed by array_element
+	GormFieldOptions_ARRAY GormFieldOptions_StoreAs = 1
+	// the base64 of a singular bytes field in a text column, e.g. of a
+	// legacy schema, ToPB fails on a value that is not valid base64
+	GormFieldOptions_BASE64_TEXT GormFieldOptions_StoreAs = 2
+)
+
+// Enum value maps for GormFieldOptions_StoreAs.
+var (
+	GormFieldOptions_StoreAs_name = map[int32]string{
+		0: "STORE_AS_UNSET",
+		1: "ARRAY",
+		2: "BASE64_TEXT",
+	}
+	GormFieldOptions_StoreAs_value = map[string]int32{
+		"STORE_AS_UNSET": 0,
+		"ARRAY":          1,
+		"BASE64_TEXT":    2,
+	}
+)
+
+func (x GormFieldOptions_StoreAs) Enum() *GormFieldOptions_StoreAs {
+	p := new(GormFieldOptions_StoreAs)
+	*p = x
+	return p
+}
+
+func (x GormFieldOptions_StoreAs) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GormFieldOptions_StoreAs) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[4].Descriptor()
+}
+
+func (GormFieldOptions_StoreAs) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[4]
+}
+
+func (x GormFieldOptions_StoreAs) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GormFieldOptions_StoreAs.Descriptor instead.
+func (GormFieldOptions_StoreAs) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{5, 3}
+}
+
+// ArrayElement is the element type of a field stored as an ARRAY
+type GormFieldOptions_ArrayElement int32
+
+const (
+	GormFieldOptions_ARRAY_ELEMENT_UNSET GormFieldOptions_ArrayElement = 0
+	// the names of the values of a repeated enum in a text[] column, no
+	// values are written as {}, ToPB fails on a name that is not a value
+	// of the enum
+	GormFieldOptions_ENUM_STRING GormFieldOptions_ArrayElement = 1
+)
+
+// Enum value maps for GormFieldOptions_ArrayElement.
+var (
+	GormFieldOptions_ArrayElement_name = map[int32]string{
+		0: "ARRAY_ELEMENT_UNSET",
+		1: "ENUM_STRING",
+	}
+	GormFieldOptions_ArrayElement_value = map[string]int32{
+		"ARRAY_ELEMENT_UNSET": 0,
+		"ENUM_STRING":         1,
+	}
+)
+
+func (x GormFieldOptions_ArrayElement) Enum() *GormFieldOptions_ArrayElement {
+	p := new(GormFieldOptions_ArrayElement)
+	*p = x
+	return p
+}
+
+func (x GormFieldOptions_ArrayElement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GormFieldOptions_ArrayElement) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[5].Descriptor()
+}
+
+func (GormFieldOptions_ArrayElement) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[5]
+}
+
+func (x GormFieldOptions_ArrayElement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GormFieldOptions_ArrayElement.Descriptor instead.
+func (GormFieldOptions_ArrayElement) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{5, 4}
+}
+
+// CreateMode selects the statement of a generated Create method
+type MethodOptions_CreateMode int32
+
+const (
+	// insert, failing on an existing primary key
+	MethodOptions_INSERT MethodOptions_CreateMode = 0
+	// gorm Save, updating the row of a provided primary key
+	MethodOptions_SAVE MethodOptions_CreateMode = 1
+)
+
+// Enum value maps for MethodOptions_CreateMode.
+var (
+	MethodOptions_CreateMode_name = map[int32]string{
+		0: "INSERT",
+		1: "SAVE",
+	}
+	MethodOptions_CreateMode_value = map[string]int32{
+		"INSERT": 0,
+		"SAVE":   1,
+	}
+)
+
+func (x MethodOptions_CreateMode) Enum() *MethodOptions_CreateMode {
+	p := new(MethodOptions_CreateMode)
+	*p = x
+	return p
+}
+
+func (x MethodOptions_CreateMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MethodOptions_CreateMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_options_gorm_proto_enumTypes[6].Descriptor()
+}
+
+func (MethodOptions_CreateMode) Type() protoreflect.EnumType {
+	return &file_options_gorm_proto_enumTypes[6]
+}
+
+func (x MethodOptions_CreateMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MethodOptions_CreateMode.Descriptor instead.
+func (MethodOptions_CreateMode) EnumDescriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type GormFileOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -68,6 +433,64 @@ type GormMessageOptions struct {
 	Include      []*ExtraField `protobuf:"bytes,2,rep,name=include,proto3" json:"include,omitempty"`
 	Table        string        `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
 	MultiAccount bool          `protobuf:"varint,4,opt,name=multi_account,json=multiAccount,proto3" json:"multi_account,omitempty"`
+	// get_or_create_key lists the fields of a unique key, a DefaultGetOrCreate
+	// handler reading the object by that key or creating it is generated
+	GetOrCreateKey []string           `protobuf:"bytes,5,rep,name=get_or_create_key,json=getOrCreateKey,proto3" json:"get_or_create_key,omitempty"`
+	SoftDelete     *SoftDeleteOptions `protobuf:"bytes,6,opt,name=soft_delete,json=softDelete,proto3" json:"soft_delete,omitempty"`
+	// created_by_field and updated_by_field name the columns stamped with the
+	// actor creating or updating the row, they are added if not defined
+	CreatedByField string `protobuf:"bytes,7,opt,name=created_by_field,json=createdByField,proto3" json:"created_by_field,omitempty"`
+	UpdatedByField string `protobuf:"bytes,8,opt,name=updated_by_field,json=updatedByField,proto3" json:"updated_by_field,omitempty"`
+	// resource_name_pattern is a resource name such as
+	// "accounts/{account}/users/{user}", the Read and Delete handlers then
+	// find the object by the ids of a non-empty name field
+	ResourceNamePattern string `protobuf:"bytes,9,opt,name=resource_name_pattern,json=resourceNamePattern,proto3" json:"resource_name_pattern,omitempty"`
+	// audit makes the update and delete handlers write an audit.Record with
+	// the row before and after the write, in the same transaction
+	Audit bool `protobuf:"varint,10,opt,name=audit,proto3" json:"audit,omitempty"`
+	// schema is the postgres schema of the table, TableName() and the default
+	// many-to-many join tables are then qualified by it
+	Schema string `protobuf:"bytes,11,opt,name=schema,proto3" json:"schema,omitempty"`
+	// cursor_list generates a DefaultList{Type}ByCursor handler paging the
+	// rows by a sort column and the primary key
+	CursorList *CursorListOptions `protobuf:"bytes,12,opt,name=cursor_list,json=cursorList,proto3" json:"cursor_list,omitempty"`
+	// immutable rows are never updated, no update handlers are generated and
+	// the ORM type fails gorm updates with errors.ImmutableError
+	Immutable bool `protobuf:"varint,13,opt,name=immutable,proto3" json:"immutable,omitempty"`
+	// shard_by names the field routing a row to its shard, shard_resolver the
+	// func(context.Context, *gorm.DB, T) (*gorm.DB, error) returning the handle
+	// of the shard of a field value, as {goImportPath}.{FuncName}. The single
+	// object handlers call it unless db is in a transaction already.
+	ShardBy       string `protobuf:"bytes,14,opt,name=shard_by,json=shardBy,proto3" json:"shard_by,omitempty"`
+	ShardResolver string `protobuf:"bytes,15,opt,name=shard_resolver,json=shardResolver,proto3" json:"shard_resolver,omitempty"`
+	// readonly_fields_from_db makes the create and strict update handlers read
+	// the columns set by the DB, the computed fields and those with a default,
+	// of the written row back into the returned object
+	ReadonlyFieldsFromDb bool `protobuf:"varint,16,opt,name=readonly_fields_from_db,json=readonlyFieldsFromDb,proto3" json:"readonly_fields_from_db,omitempty"`
+	// omit_zero_on_create sets the omit_zero_on_create of every field stored
+	// in a string, bool or number column, the optional fields keep NULL for
+	// an absent value
+	OmitZeroOnCreate bool                               `protobuf:"varint,18,opt,name=omit_zero_on_create,json=omitZeroOnCreate,proto3" json:"omit_zero_on_create,omitempty"`
+	TenantIsolation  GormMessageOptions_TenantIsolation `protobuf:"varint,17,opt,name=tenant_isolation,json=tenantIsolation,proto3,enum=gorm.GormMessageOptions_TenantIsolation" json:"tenant_isolation,omitempty"`
+	// history keeps the prior versions of the rows in the {table}_history
+	// table of the generated {Type}HistoryORM, the strict update and delete
+	// handlers write the version they replace in their transaction, valid
+	// until then. DefaultHistoryOf{Type} lists the versions of a row.
+	History bool `protobuf:"varint,19,opt,name=history,proto3" json:"history,omitempty"`
+	// no_auto_timestamps makes GORM leave the created_at and updated_at fields
+	// as they are set, e.g. of a table owned by another application, once
+	// Register{File}Callbacks registered the callbacks keeping them. The
+	// handlers do not set them either.
+	NoAutoTimestamps bool `protobuf:"varint,20,opt,name=no_auto_timestamps,json=noAutoTimestamps,proto3" json:"no_auto_timestamps,omitempty"`
+	// emit_changes publishes the objects created, updated and deleted through
+	// GORM to the channels of the generated Subscribe{Type}, once
+	// Register{File}Callbacks registered the callbacks converting them, e.g.
+	// for subscribers in the process invalidating a cache.
+	EmitChanges bool `protobuf:"varint,21,opt,name=emit_changes,json=emitChanges,proto3" json:"emit_changes,omitempty"`
+	// upsert_key is the conflict target of DefaultUpsert{Type}Set, the fields
+	// or columns of a unique index, e.g. ["external_id"], the primary key by
+	// default
+	UpsertKey []string `protobuf:"bytes,22,rep,name=upsert_key,json=upsertKey,proto3" json:"upsert_key,omitempty"`
 }
 
 func (x *GormMessageOptions) Reset() {
@@ -106,30 +529,254 @@ func (x *GormMessageOptions) GetOrmable() bool {
 	if x != nil {
 		return x.Ormable
 	}
-	return false
+	return false
+}
+
+func (x *GormMessageOptions) GetInclude() []*ExtraField {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *GormMessageOptions) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetMultiAccount() bool {
+	if x != nil {
+		return x.MultiAccount
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetGetOrCreateKey() []string {
+	if x != nil {
+		return x.GetOrCreateKey
+	}
+	return nil
+}
+
+func (x *GormMessageOptions) GetSoftDelete() *SoftDeleteOptions {
+	if x != nil {
+		return x.SoftDelete
+	}
+	return nil
+}
+
+func (x *GormMessageOptions) GetCreatedByField() string {
+	if x != nil {
+		return x.CreatedByField
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetUpdatedByField() string {
+	if x != nil {
+		return x.UpdatedByField
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetResourceNamePattern() string {
+	if x != nil {
+		return x.ResourceNamePattern
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetAudit() bool {
+	if x != nil {
+		return x.Audit
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetCursorList() *CursorListOptions {
+	if x != nil {
+		return x.CursorList
+	}
+	return nil
+}
+
+func (x *GormMessageOptions) GetImmutable() bool {
+	if x != nil {
+		return x.Immutable
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetShardBy() string {
+	if x != nil {
+		return x.ShardBy
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetShardResolver() string {
+	if x != nil {
+		return x.ShardResolver
+	}
+	return ""
+}
+
+func (x *GormMessageOptions) GetReadonlyFieldsFromDb() bool {
+	if x != nil {
+		return x.ReadonlyFieldsFromDb
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetOmitZeroOnCreate() bool {
+	if x != nil {
+		return x.OmitZeroOnCreate
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetTenantIsolation() GormMessageOptions_TenantIsolation {
+	if x != nil {
+		return x.TenantIsolation
+	}
+	return GormMessageOptions_SHARED
+}
+
+func (x *GormMessageOptions) GetHistory() bool {
+	if x != nil {
+		return x.History
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetNoAutoTimestamps() bool {
+	if x != nil {
+		return x.NoAutoTimestamps
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetEmitChanges() bool {
+	if x != nil {
+		return x.EmitChanges
+	}
+	return false
+}
+
+func (x *GormMessageOptions) GetUpsertKey() []string {
+	if x != nil {
+		return x.UpsertKey
+	}
+	return nil
+}
+
+type CursorListOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sort_field is the field the pages are ordered by, by default the
+	// leading column of an index of the type, or the primary key
+	SortField string `protobuf:"bytes,1,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"`
+}
+
+func (x *CursorListOptions) Reset() {
+	*x = CursorListOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CursorListOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CursorListOptions) ProtoMessage() {}
+
+func (x *CursorListOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CursorListOptions.ProtoReflect.Descriptor instead.
+func (*CursorListOptions) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CursorListOptions) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+type SoftDeleteOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// by_field names the column stamped with the actor deleting the row
+	// next to deleted_at, it is cleared again by the restore handler
+	ByField string `protobuf:"bytes,1,opt,name=by_field,json=byField,proto3" json:"by_field,omitempty"`
+}
+
+func (x *SoftDeleteOptions) Reset() {
+	*x = SoftDeleteOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SoftDeleteOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftDeleteOptions) ProtoMessage() {}
+
+func (x *SoftDeleteOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GormMessageOptions) GetInclude() []*ExtraField {
-	if x != nil {
-		return x.Include
-	}
-	return nil
+// Deprecated: Use SoftDeleteOptions.ProtoReflect.Descriptor instead.
+func (*SoftDeleteOptions) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GormMessageOptions) GetTable() string {
+func (x *SoftDeleteOptions) GetByField() string {
 	if x != nil {
-		return x.Table
+		return x.ByField
 	}
 	return ""
 }
 
-func (x *GormMessageOptions) GetMultiAccount() bool {
-	if x != nil {
-		return x.MultiAccount
-	}
-	return false
-}
-
 type ExtraField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -144,7 +791,7 @@ type ExtraField struct {
 func (x *ExtraField) Reset() {
 	*x = ExtraField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[2]
+		mi := &file_options_gorm_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -157,7 +804,7 @@ func (x *ExtraField) String() string {
 func (*ExtraField) ProtoMessage() {}
 
 func (x *ExtraField) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[2]
+	mi := &file_options_gorm_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -170,7 +817,7 @@ func (x *ExtraField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExtraField.ProtoReflect.Descriptor instead.
 func (*ExtraField) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{2}
+	return file_options_gorm_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ExtraField) GetType() string {
@@ -215,12 +862,112 @@ type GormFieldOptions struct {
 	//	*GormFieldOptions_ManyToMany
 	Association isGormFieldOptions_Association `protobuf_oneof:"association"`
 	ReferenceOf string                         `protobuf:"bytes,7,opt,name=reference_of,json=referenceOf,proto3" json:"reference_of,omitempty"`
+	// read_only fields are set by the server, ToORM ignores the incoming
+	// value and updates leave the column intact
+	ReadOnly bool `protobuf:"varint,8,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// through names the has-many field of this message the association
+	// is loaded through, e.g. comments of a user through its posts
+	Through string `protobuf:"bytes,9,opt,name=through,proto3" json:"through,omitempty"`
+	// association_conflict_key names the unique field of the associated
+	// type matching already existing rows, e.g. "ExternalID" on a has-many,
+	// saving the object then updates them instead of inserting duplicates
+	AssociationConflictKey string                       `protobuf:"bytes,10,opt,name=association_conflict_key,json=associationConflictKey,proto3" json:"association_conflict_key,omitempty"`
+	EnumStorage            GormFieldOptions_EnumStorage `protobuf:"varint,11,opt,name=enum_storage,json=enumStorage,proto3,enum=gorm.GormFieldOptions_EnumStorage" json:"enum_storage,omitempty"`
+	// bool_as "char" stores a bool as 'Y' or 'N' in a char(1) column
+	BoolAs   string                            `protobuf:"bytes,12,opt,name=bool_as,json=boolAs,proto3" json:"bool_as,omitempty"`
+	OnDelete GormFieldOptions_ForeignKeyAction `protobuf:"varint,13,opt,name=on_delete,json=onDelete,proto3,enum=gorm.GormFieldOptions_ForeignKeyAction" json:"on_delete,omitempty"`
+	OnUpdate GormFieldOptions_ForeignKeyAction `protobuf:"varint,14,opt,name=on_update,json=onUpdate,proto3,enum=gorm.GormFieldOptions_ForeignKeyAction" json:"on_update,omitempty"`
+	// transform lists the func(T) T functions ToORM applies to the value of
+	// a scalar field in order, as {goImportPath}.{FuncName}, separated by
+	// commas, e.g. "strings.TrimSpace,strings.ToLower"
+	Transform  string                           `protobuf:"bytes,15,opt,name=transform,proto3" json:"transform,omitempty"`
+	DurationAs GormFieldOptions_DurationStorage `protobuf:"varint,16,opt,name=duration_as,json=durationAs,proto3,enum=gorm.GormFieldOptions_DurationStorage" json:"duration_as,omitempty"`
+	// association_tag is appended verbatim to the gorm tag of an association
+	// field, e.g. "many2many:user_roles;jointable_foreignkey:user_id", its
+	// keys override the inferred ones
+	AssociationTag string `protobuf:"bytes,17,opt,name=association_tag,json=associationTag,proto3" json:"association_tag,omitempty"`
+	// preserve_nanos keeps the nanoseconds of a google.protobuf.Timestamp
+	// below the microseconds of the column in an int32 {column}_nanos one
+	PreserveNanos bool `protobuf:"varint,18,opt,name=preserve_nanos,json=preserveNanos,proto3" json:"preserve_nanos,omitempty"`
+	// unique_with lists the other fields of a composite unique index with
+	// this one, e.g. ["org_id"] on email for a unique (email, org_id), by
+	// proto field or column name
+	UniqueWith []string `protobuf:"bytes,19,rep,name=unique_with,json=uniqueWith,proto3" json:"unique_with,omitempty"`
+	// default_now defaults a google.protobuf.Timestamp column to the current
+	// timestamp of the engine, a zero timestamp is left to the default
+	DefaultNow bool `protobuf:"varint,20,opt,name=default_now,json=defaultNow,proto3" json:"default_now,omitempty"`
+	// touch_on_save sets a google.protobuf.Timestamp field, e.g. updated_at,
+	// to the current time in a gorm BeforeSave hook of the ORM type, so that
+	// it changes on every save, also of a save changing associations only
+	TouchOnSave bool `protobuf:"varint,21,opt,name=touch_on_save,json=touchOnSave,proto3" json:"touch_on_save,omitempty"`
+	// immutable_after_create fields are written by the create, updates
+	// leave the stored value intact like for a read_only field
+	ImmutableAfterCreate bool `protobuf:"varint,22,opt,name=immutable_after_create,json=immutableAfterCreate,proto3" json:"immutable_after_create,omitempty"`
+	// reject_change makes the strict update of an immutable_after_create
+	// field fail with ImmutableError if the value differs from the stored one
+	RejectChange bool `protobuf:"varint,23,opt,name=reject_change,json=rejectChange,proto3" json:"reject_change,omitempty"`
+	// json_accessor generates typed getters of values in a JSONValue or Any
+	// field, which is stored as jsonb
+	JsonAccessor []*JSONAccessor `protobuf:"bytes,24,rep,name=json_accessor,json=jsonAccessor,proto3" json:"json_accessor,omitempty"`
+	// set_back_reference names the belongs_to field of the child type of a
+	// has_one or has_many field that is set to the parent once it is loaded
+	// with its children, e.g. "folder" of the documents of a folder
+	SetBackReference string `protobuf:"bytes,25,opt,name=set_back_reference,json=setBackReference,proto3" json:"set_back_reference,omitempty"`
+	// enum_as_fk_table stores an enum as the number of its value in a column
+	// referring to the id of a lookup table of the values, e.g.
+	// "order_statuses", with a generated ORM type and seed function
+	EnumAsFkTable string `protobuf:"bytes,26,opt,name=enum_as_fk_table,json=enumAsFkTable,proto3" json:"enum_as_fk_table,omitempty"`
+	// computed fields are set by the DB on every write, e.g. a generated
+	// column or one set by a trigger, the handlers never write them and
+	// they are not taken from the API object
+	Computed bool `protobuf:"varint,27,opt,name=computed,proto3" json:"computed,omitempty"`
+	// json_default is the JSON of a JSONValue field with no value, e.g. "{}",
+	// it is the column default, written by ToORM for an empty value and
+	// returned by ToPB for a NULL column
+	JsonDefault string `protobuf:"bytes,28,opt,name=json_default,json=jsonDefault,proto3" json:"json_default,omitempty"`
+	// renamed_from is the former column of the field, it is renamed by the
+	// generated Rename{File}Columns ahead of AutoMigrate, which would add
+	// the new column and leave the data in the old one
+	RenamedFrom string `protobuf:"bytes,29,opt,name=renamed_from,json=renamedFrom,proto3" json:"renamed_from,omitempty"`
+	// composite_type stores the singular message field as a value of the
+	// postgres composite type of that name, e.g. address_t, with the scalar
+	// fields of the message as its attributes in order. The type is created
+	// by the generated Create{Message}CompositeType ahead of AutoMigrate.
+	CompositeType string `protobuf:"bytes,30,opt,name=composite_type,json=compositeType,proto3" json:"composite_type,omitempty"`
+	// with_timezone_column names the string field of the message storing
+	// the IANA zone of the google.protobuf.Timestamp, e.g. "start_tz". The
+	// timestamp is stored in UTC, ToORM fails on an unknown zone and a
+	// generated {Field}InZone method gives the time in its zone.
+	WithTimezoneColumn string `protobuf:"bytes,31,opt,name=with_timezone_column,json=withTimezoneColumn,proto3" json:"with_timezone_column,omitempty"`
+	// omit_zero_on_create leaves a zero string, bool or number out of the
+	// INSERT of the create handler, so that the default of the column applies
+	OmitZeroOnCreate bool `protobuf:"varint,32,opt,name=omit_zero_on_create,json=omitZeroOnCreate,proto3" json:"omit_zero_on_create,omitempty"`
+	// validate_reference makes the create and strict update handlers check
+	// that the row a belongs-to field refers to exists, of the account of
+	// the context for a multi-account parent, failing with
+	// errors.MissingReferenceError ahead of the foreign key violation
+	ValidateReference bool `protobuf:"varint,33,opt,name=validate_reference,json=validateReference,proto3" json:"validate_reference,omitempty"`
+	// money stores the decimal string of an amount, e.g. "12.05", as the
+	// integer count of its minor units in a bigint column, 1205 for a scale
+	// of 2. ToORM fails on more decimal places than the scale.
+	Money *MoneyOptions `protobuf:"bytes,34,opt,name=money,proto3" json:"money,omitempty"`
+	// case_insensitive compares and uniquely indexes a string column
+	// regardless of case, as a citext column on postgres, or with the
+	// NOCASE collation on sqlite and utf8mb4_unicode_ci otherwise. The
+	// value is stored as given.
+	CaseInsensitive bool                          `protobuf:"varint,35,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	StoreAs         GormFieldOptions_StoreAs      `protobuf:"varint,36,opt,name=store_as,json=storeAs,proto3,enum=gorm.GormFieldOptions_StoreAs" json:"store_as,omitempty"`
+	ArrayElement    GormFieldOptions_ArrayElement `protobuf:"varint,37,opt,name=array_element,json=arrayElement,proto3,enum=gorm.GormFieldOptions_ArrayElement" json:"array_element,omitempty"`
+	// default_uuid makes the create handlers set the string field to a new
+	// UUID of types.NewUUID when it is empty, e.g. for a correlation id
+	// that is not the primary key
+	DefaultUuid bool `protobuf:"varint,38,opt,name=default_uuid,json=defaultUuid,proto3" json:"default_uuid,omitempty"`
 }
 
 func (x *GormFieldOptions) Reset() {
 	*x = GormFieldOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[3]
+		mi := &file_options_gorm_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +980,7 @@ func (x *GormFieldOptions) String() string {
 func (*GormFieldOptions) ProtoMessage() {}
 
 func (x *GormFieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[3]
+	mi := &file_options_gorm_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +993,7 @@ func (x *GormFieldOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormFieldOptions.ProtoReflect.Descriptor instead.
 func (*GormFieldOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{3}
+	return file_options_gorm_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GormFieldOptions) GetTag() *GormTag {
@@ -305,6 +1052,223 @@ func (x *GormFieldOptions) GetReferenceOf() string {
 	return ""
 }
 
+func (x *GormFieldOptions) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetThrough() string {
+	if x != nil {
+		return x.Through
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetAssociationConflictKey() string {
+	if x != nil {
+		return x.AssociationConflictKey
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetEnumStorage() GormFieldOptions_EnumStorage {
+	if x != nil {
+		return x.EnumStorage
+	}
+	return GormFieldOptions_DEFAULT
+}
+
+func (x *GormFieldOptions) GetBoolAs() string {
+	if x != nil {
+		return x.BoolAs
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetOnDelete() GormFieldOptions_ForeignKeyAction {
+	if x != nil {
+		return x.OnDelete
+	}
+	return GormFieldOptions_ACTION_UNSET
+}
+
+func (x *GormFieldOptions) GetOnUpdate() GormFieldOptions_ForeignKeyAction {
+	if x != nil {
+		return x.OnUpdate
+	}
+	return GormFieldOptions_ACTION_UNSET
+}
+
+func (x *GormFieldOptions) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetDurationAs() GormFieldOptions_DurationStorage {
+	if x != nil {
+		return x.DurationAs
+	}
+	return GormFieldOptions_DURATION_UNSET
+}
+
+func (x *GormFieldOptions) GetAssociationTag() string {
+	if x != nil {
+		return x.AssociationTag
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetPreserveNanos() bool {
+	if x != nil {
+		return x.PreserveNanos
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetUniqueWith() []string {
+	if x != nil {
+		return x.UniqueWith
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetDefaultNow() bool {
+	if x != nil {
+		return x.DefaultNow
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetTouchOnSave() bool {
+	if x != nil {
+		return x.TouchOnSave
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetImmutableAfterCreate() bool {
+	if x != nil {
+		return x.ImmutableAfterCreate
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetRejectChange() bool {
+	if x != nil {
+		return x.RejectChange
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetJsonAccessor() []*JSONAccessor {
+	if x != nil {
+		return x.JsonAccessor
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetSetBackReference() string {
+	if x != nil {
+		return x.SetBackReference
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetEnumAsFkTable() string {
+	if x != nil {
+		return x.EnumAsFkTable
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetComputed() bool {
+	if x != nil {
+		return x.Computed
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetJsonDefault() string {
+	if x != nil {
+		return x.JsonDefault
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetRenamedFrom() string {
+	if x != nil {
+		return x.RenamedFrom
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetCompositeType() string {
+	if x != nil {
+		return x.CompositeType
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetWithTimezoneColumn() string {
+	if x != nil {
+		return x.WithTimezoneColumn
+	}
+	return ""
+}
+
+func (x *GormFieldOptions) GetOmitZeroOnCreate() bool {
+	if x != nil {
+		return x.OmitZeroOnCreate
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetValidateReference() bool {
+	if x != nil {
+		return x.ValidateReference
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetMoney() *MoneyOptions {
+	if x != nil {
+		return x.Money
+	}
+	return nil
+}
+
+func (x *GormFieldOptions) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *GormFieldOptions) GetStoreAs() GormFieldOptions_StoreAs {
+	if x != nil {
+		return x.StoreAs
+	}
+	return GormFieldOptions_STORE_AS_UNSET
+}
+
+func (x *GormFieldOptions) GetArrayElement() GormFieldOptions_ArrayElement {
+	if x != nil {
+		return x.ArrayElement
+	}
+	return GormFieldOptions_ARRAY_ELEMENT_UNSET
+}
+
+func (x *GormFieldOptions) GetDefaultUuid() bool {
+	if x != nil {
+		return x.DefaultUuid
+	}
+	return false
+}
+
 type isGormFieldOptions_Association interface {
 	isGormFieldOptions_Association()
 }
@@ -317,36 +1281,169 @@ type GormFieldOptions_BelongsTo struct {
 	BelongsTo *BelongsToOptions `protobuf:"bytes,4,opt,name=belongs_to,json=belongsTo,proto3,oneof"`
 }
 
-type GormFieldOptions_HasMany struct {
-	HasMany *HasManyOptions `protobuf:"bytes,5,opt,name=has_many,json=hasMany,proto3,oneof"`
+type GormFieldOptions_HasMany struct {
+	HasMany *HasManyOptions `protobuf:"bytes,5,opt,name=has_many,json=hasMany,proto3,oneof"`
+}
+
+type GormFieldOptions_ManyToMany struct {
+	ManyToMany *ManyToManyOptions `protobuf:"bytes,6,opt,name=many_to_many,json=manyToMany,proto3,oneof"`
+}
+
+func (*GormFieldOptions_HasOne) isGormFieldOptions_Association() {}
+
+func (*GormFieldOptions_BelongsTo) isGormFieldOptions_Association() {}
+
+func (*GormFieldOptions_HasMany) isGormFieldOptions_Association() {}
+
+func (*GormFieldOptions_ManyToMany) isGormFieldOptions_Association() {}
+
+// JSONAccessor generates the method As of the ORM type returning the value
+// at the path in the JSON of the field and whether it is present, e.g.
+// Region() (string, bool)
+type JSONAccessor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// path is the key of the value, the keys of nested objects are separated
+	// by dots, e.g. "address.region"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// type is the Go type of the value, string, bool, int64 or float64
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// as is the name of the method
+	As string `protobuf:"bytes,3,opt,name=as,proto3" json:"as,omitempty"`
+}
+
+func (x *JSONAccessor) Reset() {
+	*x = JSONAccessor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JSONAccessor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONAccessor) ProtoMessage() {}
+
+func (x *JSONAccessor) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONAccessor.ProtoReflect.Descriptor instead.
+func (*JSONAccessor) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JSONAccessor) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *JSONAccessor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *JSONAccessor) GetAs() string {
+	if x != nil {
+		return x.As
+	}
+	return ""
+}
+
+// MoneyOptions is the scale of a money field and the field of its currency
+type MoneyOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// currency_field optionally names the string field of the message with
+	// the currency of the amount, e.g. "currency", ToORM fails on a
+	// non-zero amount of no currency
+	CurrencyField string `protobuf:"bytes,1,opt,name=currency_field,json=currencyField,proto3" json:"currency_field,omitempty"`
+	// scale is the number of decimal places of the minor units, 0 to 18,
+	// e.g. 2 for cents
+	Scale int32 `protobuf:"varint,2,opt,name=scale,proto3" json:"scale,omitempty"`
+}
+
+func (x *MoneyOptions) Reset() {
+	*x = MoneyOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoneyOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type GormFieldOptions_ManyToMany struct {
-	ManyToMany *ManyToManyOptions `protobuf:"bytes,6,opt,name=many_to_many,json=manyToMany,proto3,oneof"`
-}
+func (*MoneyOptions) ProtoMessage() {}
 
-func (*GormFieldOptions_HasOne) isGormFieldOptions_Association() {}
+func (x *MoneyOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*GormFieldOptions_BelongsTo) isGormFieldOptions_Association() {}
+// Deprecated: Use MoneyOptions.ProtoReflect.Descriptor instead.
+func (*MoneyOptions) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{7}
+}
 
-func (*GormFieldOptions_HasMany) isGormFieldOptions_Association() {}
+func (x *MoneyOptions) GetCurrencyField() string {
+	if x != nil {
+		return x.CurrencyField
+	}
+	return ""
+}
 
-func (*GormFieldOptions_ManyToMany) isGormFieldOptions_Association() {}
+func (x *MoneyOptions) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
 
 type GormTag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Column                         string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
-	Type                           string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	Size                           int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Precision                      int32  `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
-	PrimaryKey                     bool   `protobuf:"varint,5,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
-	Unique                         bool   `protobuf:"varint,6,opt,name=unique,proto3" json:"unique,omitempty"`
-	Default                        string `protobuf:"bytes,7,opt,name=default,proto3" json:"default,omitempty"`
-	NotNull                        bool   `protobuf:"varint,8,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
-	AutoIncrement                  bool   `protobuf:"varint,9,opt,name=auto_increment,json=autoIncrement,proto3" json:"auto_increment,omitempty"`
+	Column     string `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
+	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Size       int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Precision  int32  `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	PrimaryKey bool   `protobuf:"varint,5,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	Unique     bool   `protobuf:"varint,6,opt,name=unique,proto3" json:"unique,omitempty"`
+	Default    string `protobuf:"bytes,7,opt,name=default,proto3" json:"default,omitempty"`
+	NotNull    bool   `protobuf:"varint,8,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
+	// explicit false disables the identity (serial) column of an
+	// integer primary key, so that its value is set by the application
+	AutoIncrement                  *bool  `protobuf:"varint,9,opt,name=auto_increment,json=autoIncrement,proto3,oneof" json:"auto_increment,omitempty"`
 	Index                          string `protobuf:"bytes,10,opt,name=index,proto3" json:"index,omitempty"`
 	UniqueIndex                    string `protobuf:"bytes,11,opt,name=unique_index,json=uniqueIndex,proto3" json:"unique_index,omitempty"`
 	Embedded                       bool   `protobuf:"varint,12,opt,name=embedded,proto3" json:"embedded,omitempty"`
@@ -361,12 +1458,27 @@ type GormTag struct {
 	AssociationAutocreate          bool   `protobuf:"varint,21,opt,name=association_autocreate,json=associationAutocreate,proto3" json:"association_autocreate,omitempty"`
 	AssociationSaveReference       bool   `protobuf:"varint,22,opt,name=association_save_reference,json=associationSaveReference,proto3" json:"association_save_reference,omitempty"`
 	Preload                        bool   `protobuf:"varint,23,opt,name=preload,proto3" json:"preload,omitempty"`
+	Comment                        string `protobuf:"bytes,24,opt,name=comment,proto3" json:"comment,omitempty"`
+	// default_expr is a raw SQL expression, e.g. now(), whereas default is
+	// a literal value quoted according to the type of the column
+	DefaultExpr string `protobuf:"bytes,25,opt,name=default_expr,json=defaultExpr,proto3" json:"default_expr,omitempty"`
+	// check is the SQL expression of a check constraint on the table, it
+	// may refer to the other columns, e.g. "price >= 0"
+	Check string `protobuf:"bytes,26,opt,name=check,proto3" json:"check,omitempty"`
+	// collation is the collation of a text column, e.g. "und-x-icu" on
+	// postgres or utf8mb4_unicode_ci on mysql, the name is passed to the
+	// engine as is
+	Collation string `protobuf:"bytes,27,opt,name=collation,proto3" json:"collation,omitempty"`
+	// index_where is the predicate of a partial index, e.g.
+	// "state <> 'archived'", it applies to the index and the unique_index of
+	// the field
+	IndexWhere string `protobuf:"bytes,28,opt,name=index_where,json=indexWhere,proto3" json:"index_where,omitempty"`
 }
 
 func (x *GormTag) Reset() {
 	*x = GormTag{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[4]
+		mi := &file_options_gorm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -379,7 +1491,7 @@ func (x *GormTag) String() string {
 func (*GormTag) ProtoMessage() {}
 
 func (x *GormTag) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[4]
+	mi := &file_options_gorm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +1504,7 @@ func (x *GormTag) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GormTag.ProtoReflect.Descriptor instead.
 func (*GormTag) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{4}
+	return file_options_gorm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GormTag) GetColumn() string {
@@ -452,8 +1564,8 @@ func (x *GormTag) GetNotNull() bool {
 }
 
 func (x *GormTag) GetAutoIncrement() bool {
-	if x != nil {
-		return x.AutoIncrement
+	if x != nil && x.AutoIncrement != nil {
+		return *x.AutoIncrement
 	}
 	return false
 }
@@ -556,6 +1668,41 @@ func (x *GormTag) GetPreload() bool {
 	return false
 }
 
+func (x *GormTag) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *GormTag) GetDefaultExpr() string {
+	if x != nil {
+		return x.DefaultExpr
+	}
+	return ""
+}
+
+func (x *GormTag) GetCheck() string {
+	if x != nil {
+		return x.Check
+	}
+	return ""
+}
+
+func (x *GormTag) GetCollation() string {
+	if x != nil {
+		return x.Collation
+	}
+	return ""
+}
+
+func (x *GormTag) GetIndexWhere() string {
+	if x != nil {
+		return x.IndexWhere
+	}
+	return ""
+}
+
 type HasOneOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -576,7 +1723,7 @@ type HasOneOptions struct {
 func (x *HasOneOptions) Reset() {
 	*x = HasOneOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[5]
+		mi := &file_options_gorm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +1736,7 @@ func (x *HasOneOptions) String() string {
 func (*HasOneOptions) ProtoMessage() {}
 
 func (x *HasOneOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[5]
+	mi := &file_options_gorm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +1749,7 @@ func (x *HasOneOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HasOneOptions.ProtoReflect.Descriptor instead.
 func (*HasOneOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{5}
+	return file_options_gorm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *HasOneOptions) GetForeignkey() string {
@@ -692,7 +1839,7 @@ type BelongsToOptions struct {
 func (x *BelongsToOptions) Reset() {
 	*x = BelongsToOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[6]
+		mi := &file_options_gorm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +1852,7 @@ func (x *BelongsToOptions) String() string {
 func (*BelongsToOptions) ProtoMessage() {}
 
 func (x *BelongsToOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[6]
+	mi := &file_options_gorm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1865,7 @@ func (x *BelongsToOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BelongsToOptions.ProtoReflect.Descriptor instead.
 func (*BelongsToOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{6}
+	return file_options_gorm_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BelongsToOptions) GetForeignkey() string {
@@ -792,7 +1939,7 @@ type HasManyOptions struct {
 func (x *HasManyOptions) Reset() {
 	*x = HasManyOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[7]
+		mi := &file_options_gorm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -805,7 +1952,7 @@ func (x *HasManyOptions) String() string {
 func (*HasManyOptions) ProtoMessage() {}
 
 func (x *HasManyOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[7]
+	mi := &file_options_gorm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -818,7 +1965,7 @@ func (x *HasManyOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HasManyOptions.ProtoReflect.Descriptor instead.
 func (*HasManyOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{7}
+	return file_options_gorm_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *HasManyOptions) GetForeignkey() string {
@@ -927,7 +2074,7 @@ type ManyToManyOptions struct {
 func (x *ManyToManyOptions) Reset() {
 	*x = ManyToManyOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[8]
+		mi := &file_options_gorm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -940,7 +2087,7 @@ func (x *ManyToManyOptions) String() string {
 func (*ManyToManyOptions) ProtoMessage() {}
 
 func (x *ManyToManyOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[8]
+	mi := &file_options_gorm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -953,7 +2100,7 @@ func (x *ManyToManyOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ManyToManyOptions.ProtoReflect.Descriptor instead.
 func (*ManyToManyOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{8}
+	return file_options_gorm_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ManyToManyOptions) GetJointable() string {
@@ -1053,7 +2200,7 @@ type AutoServerOptions struct {
 func (x *AutoServerOptions) Reset() {
 	*x = AutoServerOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[9]
+		mi := &file_options_gorm_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1066,7 +2213,7 @@ func (x *AutoServerOptions) String() string {
 func (*AutoServerOptions) ProtoMessage() {}
 
 func (x *AutoServerOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[9]
+	mi := &file_options_gorm_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1079,7 +2226,7 @@ func (x *AutoServerOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AutoServerOptions.ProtoReflect.Descriptor instead.
 func (*AutoServerOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{9}
+	return file_options_gorm_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AutoServerOptions) GetAutogen() bool {
@@ -1108,13 +2255,53 @@ type MethodOptions struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ObjectType string `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	ObjectType string                   `protobuf:"bytes,1,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	CreateMode MethodOptions_CreateMode `protobuf:"varint,2,opt,name=create_mode,json=createMode,proto3,enum=gorm.MethodOptions_CreateMode" json:"create_mode,omitempty"`
+	// aggregate generates a Default{Method} handler grouping the rows of the
+	// object_type, the method is stubbed in the default server
+	Aggregate *AggregateOptions `protobuf:"bytes,3,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	// batch_size is the rows of an INSERT of a CreateSet method, by default
+	// 500 and at most what stays within the 65535 bind parameters of postgres
+	BatchSize int32 `protobuf:"varint,4,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// purge generates a DefaultPurge{object_type} handler deleting a row of a
+	// soft deleted type for good, the method is stubbed in the default server
+	// so that it is only served by an implementation guarding it
+	Purge bool `protobuf:"varint,5,opt,name=purge,proto3" json:"purge,omitempty"`
+	// distinct_on lists the fields of a postgres SELECT DISTINCT ON of a List
+	// method, the rows are ordered by them first and then by the sorting of
+	// the request, so that the first row of a group is e.g. its latest
+	DistinctOn []string `protobuf:"bytes,6,rep,name=distinct_on,json=distinctOn,proto3" json:"distinct_on,omitempty"`
+	// timeout bounds the default server method, e.g. "30s", overriding the
+	// default_timeout parameter, "0s" for none. The handler runs in a
+	// transaction of the deadline, with the statement_timeout on postgres.
+	Timeout string `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// idempotency_field is a unique field of the object_type holding the
+	// idempotency key of a Create method, a retried create returns the row of
+	// its key instead of inserting it again. A payload without a key is
+	// created as usual, an optional field stores it as NULL so that the
+	// unique index allows any number of them.
+	IdempotencyField string `protobuf:"bytes,8,opt,name=idempotency_field,json=idempotencyField,proto3" json:"idempotency_field,omitempty"`
+	// list_trash generates a DefaultListTrash{object_type} handler listing the
+	// soft deleted rows of the object_type only, with the collection operators
+	// and the account scope of DefaultList. The method is stubbed in the
+	// default server so that it is only served by an implementation guarding it.
+	ListTrash bool `protobuf:"varint,9,opt,name=list_trash,json=listTrash,proto3" json:"list_trash,omitempty"`
+	// facet lists the fields of a Default{Method} handler counting the rows
+	// of the object_type matching the filter by each value of each field, one
+	// grouped query per field, e.g. for the facets of a search. The method is
+	// stubbed in the default server.
+	Facet []string `protobuf:"bytes,10,rep,name=facet,proto3" json:"facet,omitempty"`
+	// cas_field makes an Update method a compare and swap of the field, e.g.
+	// "status", calling a generated DefaultUpdate{Type}If{Field} handler. It
+	// updates the row only if its column has the value of the expected_{field}
+	// field of the request, failing with errors.ConditionFailedError otherwise.
+	CasField string `protobuf:"bytes,11,opt,name=cas_field,json=casField,proto3" json:"cas_field,omitempty"`
 }
 
 func (x *MethodOptions) Reset() {
 	*x = MethodOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_options_gorm_proto_msgTypes[10]
+		mi := &file_options_gorm_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1127,7 +2314,7 @@ func (x *MethodOptions) String() string {
 func (*MethodOptions) ProtoMessage() {}
 
 func (x *MethodOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_options_gorm_proto_msgTypes[10]
+	mi := &file_options_gorm_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1140,7 +2327,7 @@ func (x *MethodOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MethodOptions.ProtoReflect.Descriptor instead.
 func (*MethodOptions) Descriptor() ([]byte, []int) {
-	return file_options_gorm_proto_rawDescGZIP(), []int{10}
+	return file_options_gorm_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MethodOptions) GetObjectType() string {
@@ -1150,6 +2337,143 @@ func (x *MethodOptions) GetObjectType() string {
 	return ""
 }
 
+func (x *MethodOptions) GetCreateMode() MethodOptions_CreateMode {
+	if x != nil {
+		return x.CreateMode
+	}
+	return MethodOptions_INSERT
+}
+
+func (x *MethodOptions) GetAggregate() *AggregateOptions {
+	if x != nil {
+		return x.Aggregate
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *MethodOptions) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
+func (x *MethodOptions) GetDistinctOn() []string {
+	if x != nil {
+		return x.DistinctOn
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+func (x *MethodOptions) GetIdempotencyField() string {
+	if x != nil {
+		return x.IdempotencyField
+	}
+	return ""
+}
+
+func (x *MethodOptions) GetListTrash() bool {
+	if x != nil {
+		return x.ListTrash
+	}
+	return false
+}
+
+func (x *MethodOptions) GetFacet() []string {
+	if x != nil {
+		return x.Facet
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetCasField() string {
+	if x != nil {
+		return x.CasField
+	}
+	return ""
+}
+
+// AggregateOptions lists the group columns and the aggregates of an
+// aggregate method, as proto field names of the object_type
+type AggregateOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupBy []string `protobuf:"bytes,1,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// count adds the COUNT(*) of each group
+	Count bool `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// sum adds the SUM of each of the numeric fields
+	Sum []string `protobuf:"bytes,3,rep,name=sum,proto3" json:"sum,omitempty"`
+}
+
+func (x *AggregateOptions) Reset() {
+	*x = AggregateOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_options_gorm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateOptions) ProtoMessage() {}
+
+func (x *AggregateOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_options_gorm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateOptions.ProtoReflect.Descriptor instead.
+func (*AggregateOptions) Descriptor() ([]byte, []int) {
+	return file_options_gorm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AggregateOptions) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateOptions) GetCount() bool {
+	if x != nil {
+		return x.Count
+	}
+	return false
+}
+
+func (x *AggregateOptions) GetSum() []string {
+	if x != nil {
+		return x.Sum
+	}
+	return nil
+}
+
 var file_options_gorm_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FileOptions)(nil),
@@ -1233,7 +2557,7 @@ var file_options_gorm_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63,
 	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x11, 0x0a, 0x0f,
 	0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x95, 0x01, 0x0a, 0x12, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0xba, 0x07, 0x0a, 0x12, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6f, 0x72, 0x6d, 0x61, 0x62, 0x6c, 0x65,
 	0x12, 0x2a, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
@@ -1242,244 +2566,459 @@ var file_options_gorm_proto_rawDesc = []byte{
 	0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62,
 	0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f,
 	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6f, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a,
-	0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72,
-	0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xd2, 0x02, 0x0a, 0x10, 0x47, 0x6f, 0x72,
-	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a,
-	0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72,
-	0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72,
-	0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4f, 0x6e,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4f,
-	0x6e, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x42, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00,
-	0x52, 0x09, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x68,
-	0x61, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x3b,
-	0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x79,
-	0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x66, 0x42, 0x0d,
-	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xce, 0x06,
-	0x0a, 0x07, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65,
-	0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72,
-	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f,
-	0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f,
-	0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e,
-	0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61,
-	0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
-	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65,
-	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65,
-	0x64, 0x64, 0x65, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67,
-	0x6e, 0x6f, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
-	0x65, 0x79, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x6e,
-	0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a,
-	0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x6b, 0x65, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x48,
-	0x0a, 0x20, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x6f,
-	0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
-	0x65, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f,
-	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x67, 0x65, 0x74, 0x5f, 0x6f,
+	0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x53,
+	0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0a, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x42, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x68, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x17,
+	0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x62, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x72,
+	0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x44, 0x62, 0x12, 0x2d, 0x0a, 0x13, 0x6f, 0x6d, 0x69, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f,
+	0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x6f, 0x6d, 0x69, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x4f, 0x6e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x53, 0x0a, 0x10, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x73, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x73, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x73,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6e, 0x6f, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e,
+	0x6f, 0x41, 0x75, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x65, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x16, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x4b, 0x65,
+	0x79, 0x22, 0x29, 0x0a, 0x0f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x73, 0x6f, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x01, 0x22, 0x32, 0x0a, 0x11,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x2e, 0x0a, 0x11, 0x53, 0x6f, 0x66, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x6f, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x72, 0x61, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54,
+	0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x22, 0x9b, 0x10, 0x0a, 0x10, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54,
+	0x61, 0x67, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x2e, 0x0a, 0x07, 0x68,
+	0x61, 0x73, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x48, 0x00, 0x52, 0x06, 0x68, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x62,
+	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x09, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x54, 0x6f, 0x12, 0x31, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x61, 0x6e, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x48, 0x61,
+	0x73, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x68, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x3b, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f,
+	0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x61, 0x6e, 0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x61, 0x6e, 0x79, 0x54, 0x6f,
+	0x4d, 0x61, 0x6e, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x4f, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x12, 0x38,
+	0x0a, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x0c, 0x65, 0x6e, 0x75, 0x6d,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x0b, 0x65, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x61, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6c, 0x41, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x67, 0x6f,
+	0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x44,
+	0x0a, 0x09, 0x6f, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x47, 0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47,
+	0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x61, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x57, 0x69, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x77, 0x12, 0x22, 0x0a,
+	0x0d, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x6f, 0x75, 0x63, 0x68, 0x4f, 0x6e, 0x53, 0x61, 0x76,
+	0x65, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61,
+	0x66, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x69, 0x6d, 0x6d, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65,
+	0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x37, 0x0a, 0x0d,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x18, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x52, 0x0c, 0x6a, 0x73, 0x6f, 0x6e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x73, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x73, 0x5f, 0x66,
+	0x6b, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65,
+	0x6e, 0x75, 0x6d, 0x41, 0x73, 0x46, 0x6b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6a, 0x73, 0x6f, 0x6e,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6a, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x77, 0x69, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x2d, 0x0a, 0x13, 0x6f, 0x6d, 0x69, 0x74, 0x5f,
+	0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6f, 0x6d, 0x69, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x4f, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x21, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x61, 0x73, 0x65, 0x49,
+	0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x61, 0x73, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x41, 0x73, 0x52, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x41, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x61, 0x72, 0x72, 0x61, 0x79, 0x5f, 0x65,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0c, 0x61, 0x72, 0x72, 0x61, 0x79, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x22, 0x28, 0x0a, 0x0b, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x22, 0x6d, 0x0a, 0x10,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x10, 0x0a, 0x0c, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x55, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x0f, 0x0a,
+	0x0b, 0x53, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x03, 0x12, 0x0c,
+	0x0a, 0x08, 0x52, 0x45, 0x53, 0x54, 0x52, 0x49, 0x43, 0x54, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x22, 0x3e, 0x0a, 0x0f, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x12,
+	0x0a, 0x0e, 0x44, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x09, 0x0a, 0x05, 0x4e, 0x41, 0x4e, 0x4f, 0x53, 0x10, 0x02, 0x22, 0x39, 0x0a, 0x07, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x41, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f,
+	0x41, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x52,
+	0x52, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x5f,
+	0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x22, 0x38, 0x0a, 0x0c, 0x41, 0x72, 0x72, 0x61, 0x79, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x52, 0x52, 0x41, 0x59, 0x5f,
+	0x45, 0x4c, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x45, 0x54, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x42, 0x0d, 0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x46, 0x0a, 0x0c, 0x4a, 0x53, 0x4f, 0x4e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x61, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x61, 0x73, 0x22, 0x4b, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x22, 0xf8, 0x07, 0x0a, 0x07, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x0e,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x6f, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21,
+	0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x6f,
+	0x5f, 0x6d, 0x61, 0x6e, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x6e,
+	0x79, 0x54, 0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a, 0x6f, 0x69, 0x6e, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x20, 0x61, 0x73,
+	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73,
+	0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
+	0x65, 0x78, 0x70, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x45, 0x78, 0x70, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x77, 0x68, 0x65, 0x72, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x57, 0x68, 0x65, 0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0xaa, 0x03, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
+	0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75,
+	0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0xe5, 0x02, 0x0a,
+	0x10, 0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
+	0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
+	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63,
+	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75,
+	0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x8f, 0x04, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a,
+	0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x12, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x61,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47,
+	0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f,
 	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
 	0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61,
-	0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f,
 	0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
 	0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xaa,
-	0x03, 0x0a, 0x0d, 0x48, 0x61, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f, 0x74,
-	0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e,
-	0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a,
-	0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74,
-	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
-	0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0xe5, 0x02, 0x0a, 0x10,
-	0x42, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x5f, 0x74,
-	0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e,
-	0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a,
-	0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74,
-	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c,
-	0x6f, 0x61, 0x64, 0x22, 0x8f, 0x04, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x4d, 0x61, 0x6e, 0x79, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
-	0x6e, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
-	0x6e, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x0d, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16,
-	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3b, 0x0a, 0x12, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x74, 0x61, 0x67,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f,
-	0x72, 0x6d, 0x54, 0x61, 0x67, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35,
-	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75,
-	0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
-	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63,
-	0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x93, 0x04, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x79, 0x54, 0x6f,
-	0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6a,
-	0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f, 0x72,
-	0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a, 0x6f, 0x69,
-	0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x16,
-	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x65,
-	0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61, 0x73,
-	0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x20, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72,
-	0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x74, 0x61,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x93, 0x04, 0x0a, 0x11, 0x4d, 0x61, 0x6e, 0x79, 0x54,
+	0x6f, 0x4d, 0x61, 0x6e, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x6a, 0x6f,
+	0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61,
 	0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a,
-	0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74,
-	0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x61,
-	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x5f,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76, 0x65,
-	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
-	0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x77, 0x0a, 0x11, 0x41,
-	0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x78,
-	0x6e, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0d, 0x74, 0x78, 0x6e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x54, 0x72, 0x61,
-	0x63, 0x69, 0x6e, 0x67, 0x22, 0x30, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x52, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6f,
-	0x70, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x6f, 0x72, 0x6d,
-	0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x3a, 0x4f, 0x0a, 0x04, 0x6f, 0x70,
-	0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x6f,
-	0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x3a, 0x4d, 0x0a, 0x05, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
-	0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x52, 0x0a, 0x06, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3a, 0x4d,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x36, 0x5a,
-	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x66, 0x6f,
-	0x62, 0x6c, 0x6f, 0x78, 0x6f, 0x70, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x3b, 0x67, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x6b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x20, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x6b, 0x65, 0x79, 0x12, 0x35,
+	0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75,
+	0x74, 0x6f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x1a,
+	0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x61, 0x76, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x61, 0x76,
+	0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x22, 0x77, 0x0a, 0x11,
+	0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x61, 0x75, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x78, 0x6e, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x74, 0x78, 0x6e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
+	0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x54, 0x72,
+	0x61, 0x63, 0x69, 0x6e, 0x67, 0x22, 0xba, 0x03, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x70,
+	0x75, 0x72, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x63, 0x74,
+	0x5f, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x63, 0x74, 0x4f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x61, 0x63, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x61, 0x63, 0x65,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x73, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x22,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06,
+	0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x41, 0x56, 0x45,
+	0x10, 0x01, 0x22, 0x55, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x3a, 0x52, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x3a, 0x4f, 0x0a,
+	0x04, 0x6f, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x3a, 0x4d,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x47, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x52, 0x0a,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x3a, 0x4d, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x97, 0x97, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x6f, 0x72, 0x6d, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
+	0x6e, 0x66, 0x6f, 0x62, 0x6c, 0x6f, 0x78, 0x6f, 0x70, 0x65, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x3b, 0x67, 0x6f, 0x72, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1494,52 +3033,78 @@ func file_options_gorm_proto_rawDescGZIP() []byte {
 	return file_options_gorm_proto_rawDescData
 }
 
-var file_options_gorm_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_options_gorm_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_options_gorm_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_options_gorm_proto_goTypes = []interface{}{
-	(*GormFileOptions)(nil),             // 0: gorm.GormFileOptions
-	(*GormMessageOptions)(nil),          // 1: gorm.GormMessageOptions
-	(*ExtraField)(nil),                  // 2: gorm.ExtraField
-	(*GormFieldOptions)(nil),            // 3: gorm.GormFieldOptions
-	(*GormTag)(nil),                     // 4: gorm.GormTag
-	(*HasOneOptions)(nil),               // 5: gorm.HasOneOptions
-	(*BelongsToOptions)(nil),            // 6: gorm.BelongsToOptions
-	(*HasManyOptions)(nil),              // 7: gorm.HasManyOptions
-	(*ManyToManyOptions)(nil),           // 8: gorm.ManyToManyOptions
-	(*AutoServerOptions)(nil),           // 9: gorm.AutoServerOptions
-	(*MethodOptions)(nil),               // 10: gorm.MethodOptions
-	(*descriptorpb.FileOptions)(nil),    // 11: google.protobuf.FileOptions
-	(*descriptorpb.MessageOptions)(nil), // 12: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 13: google.protobuf.FieldOptions
-	(*descriptorpb.ServiceOptions)(nil), // 14: google.protobuf.ServiceOptions
-	(*descriptorpb.MethodOptions)(nil),  // 15: google.protobuf.MethodOptions
+	(GormMessageOptions_TenantIsolation)(0), // 0: gorm.GormMessageOptions.TenantIsolation
+	(GormFieldOptions_EnumStorage)(0),       // 1: gorm.GormFieldOptions.EnumStorage
+	(GormFieldOptions_ForeignKeyAction)(0),  // 2: gorm.GormFieldOptions.ForeignKeyAction
+	(GormFieldOptions_DurationStorage)(0),   // 3: gorm.GormFieldOptions.DurationStorage
+	(GormFieldOptions_StoreAs)(0),           // 4: gorm.GormFieldOptions.StoreAs
+	(GormFieldOptions_ArrayElement)(0),      // 5: gorm.GormFieldOptions.ArrayElement
+	(MethodOptions_CreateMode)(0),           // 6: gorm.MethodOptions.CreateMode
+	(*GormFileOptions)(nil),                 // 7: gorm.GormFileOptions
+	(*GormMessageOptions)(nil),              // 8: gorm.GormMessageOptions
+	(*CursorListOptions)(nil),               // 9: gorm.CursorListOptions
+	(*SoftDeleteOptions)(nil),               // 10: gorm.SoftDeleteOptions
+	(*ExtraField)(nil),                      // 11: gorm.ExtraField
+	(*GormFieldOptions)(nil),                // 12: gorm.GormFieldOptions
+	(*JSONAccessor)(nil),                    // 13: gorm.JSONAccessor
+	(*MoneyOptions)(nil),                    // 14: gorm.MoneyOptions
+	(*GormTag)(nil),                         // 15: gorm.GormTag
+	(*HasOneOptions)(nil),                   // 16: gorm.HasOneOptions
+	(*BelongsToOptions)(nil),                // 17: gorm.BelongsToOptions
+	(*HasManyOptions)(nil),                  // 18: gorm.HasManyOptions
+	(*ManyToManyOptions)(nil),               // 19: gorm.ManyToManyOptions
+	(*AutoServerOptions)(nil),               // 20: gorm.AutoServerOptions
+	(*MethodOptions)(nil),                   // 21: gorm.MethodOptions
+	(*AggregateOptions)(nil),                // 22: gorm.AggregateOptions
+	(*descriptorpb.FileOptions)(nil),        // 23: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),     // 24: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),       // 25: google.protobuf.FieldOptions
+	(*descriptorpb.ServiceOptions)(nil),     // 26: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),      // 27: google.protobuf.MethodOptions
 }
 var file_options_gorm_proto_depIdxs = []int32{
-	2,  // 0: gorm.GormMessageOptions.include:type_name -> gorm.ExtraField
-	4,  // 1: gorm.ExtraField.tag:type_name -> gorm.GormTag
-	4,  // 2: gorm.GormFieldOptions.tag:type_name -> gorm.GormTag
-	5,  // 3: gorm.GormFieldOptions.has_one:type_name -> gorm.HasOneOptions
-	6,  // 4: gorm.GormFieldOptions.belongs_to:type_name -> gorm.BelongsToOptions
-	7,  // 5: gorm.GormFieldOptions.has_many:type_name -> gorm.HasManyOptions
-	8,  // 6: gorm.GormFieldOptions.many_to_many:type_name -> gorm.ManyToManyOptions
-	4,  // 7: gorm.HasOneOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 8: gorm.BelongsToOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 9: gorm.HasManyOptions.foreignkey_tag:type_name -> gorm.GormTag
-	4,  // 10: gorm.HasManyOptions.position_field_tag:type_name -> gorm.GormTag
-	11, // 11: gorm.file_opts:extendee -> google.protobuf.FileOptions
-	12, // 12: gorm.opts:extendee -> google.protobuf.MessageOptions
-	13, // 13: gorm.field:extendee -> google.protobuf.FieldOptions
-	14, // 14: gorm.server:extendee -> google.protobuf.ServiceOptions
-	15, // 15: gorm.method:extendee -> google.protobuf.MethodOptions
-	0,  // 16: gorm.file_opts:type_name -> gorm.GormFileOptions
-	1,  // 17: gorm.opts:type_name -> gorm.GormMessageOptions
-	3,  // 18: gorm.field:type_name -> gorm.GormFieldOptions
-	9,  // 19: gorm.server:type_name -> gorm.AutoServerOptions
-	10, // 20: gorm.method:type_name -> gorm.MethodOptions
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	16, // [16:21] is the sub-list for extension type_name
-	11, // [11:16] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	11, // 0: gorm.GormMessageOptions.include:type_name -> gorm.ExtraField
+	10, // 1: gorm.GormMessageOptions.soft_delete:type_name -> gorm.SoftDeleteOptions
+	9,  // 2: gorm.GormMessageOptions.cursor_list:type_name -> gorm.CursorListOptions
+	0,  // 3: gorm.GormMessageOptions.tenant_isolation:type_name -> gorm.GormMessageOptions.TenantIsolation
+	15, // 4: gorm.ExtraField.tag:type_name -> gorm.GormTag
+	15, // 5: gorm.GormFieldOptions.tag:type_name -> gorm.GormTag
+	16, // 6: gorm.GormFieldOptions.has_one:type_name -> gorm.HasOneOptions
+	17, // 7: gorm.GormFieldOptions.belongs_to:type_name -> gorm.BelongsToOptions
+	18, // 8: gorm.GormFieldOptions.has_many:type_name -> gorm.HasManyOptions
+	19, // 9: gorm.GormFieldOptions.many_to_many:type_name -> gorm.ManyToManyOptions
+	1,  // 10: gorm.GormFieldOptions.enum_storage:type_name -> gorm.GormFieldOptions.EnumStorage
+	2,  // 11: gorm.GormFieldOptions.on_delete:type_name -> gorm.GormFieldOptions.ForeignKeyAction
+	2,  // 12: gorm.GormFieldOptions.on_update:type_name -> gorm.GormFieldOptions.ForeignKeyAction
+	3,  // 13: gorm.GormFieldOptions.duration_as:type_name -> gorm.GormFieldOptions.DurationStorage
+	13, // 14: gorm.GormFieldOptions.json_accessor:type_name -> gorm.JSONAccessor
+	14, // 15: gorm.GormFieldOptions.money:type_name -> gorm.MoneyOptions
+	4,  // 16: gorm.GormFieldOptions.store_as:type_name -> gorm.GormFieldOptions.StoreAs
+	5,  // 17: gorm.GormFieldOptions.array_element:type_name -> gorm.GormFieldOptions.ArrayElement
+	15, // 18: gorm.HasOneOptions.foreignkey_tag:type_name -> gorm.GormTag
+	15, // 19: gorm.BelongsToOptions.foreignkey_tag:type_name -> gorm.GormTag
+	15, // 20: gorm.HasManyOptions.foreignkey_tag:type_name -> gorm.GormTag
+	15, // 21: gorm.HasManyOptions.position_field_tag:type_name -> gorm.GormTag
+	6,  // 22: gorm.MethodOptions.create_mode:type_name -> gorm.MethodOptions.CreateMode
+	22, // 23: gorm.MethodOptions.aggregate:type_name -> gorm.AggregateOptions
+	23, // 24: gorm.file_opts:extendee -> google.protobuf.FileOptions
+	24, // 25: gorm.opts:extendee -> google.protobuf.MessageOptions
+	25, // 26: gorm.field:extendee -> google.protobuf.FieldOptions
+	26, // 27: gorm.server:extendee -> google.protobuf.ServiceOptions
+	27, // 28: gorm.method:extendee -> google.protobuf.MethodOptions
+	7,  // 29: gorm.file_opts:type_name -> gorm.GormFileOptions
+	8,  // 30: gorm.opts:type_name -> gorm.GormMessageOptions
+	12, // 31: gorm.field:type_name -> gorm.GormFieldOptions
+	20, // 32: gorm.server:type_name -> gorm.AutoServerOptions
+	21, // 33: gorm.method:type_name -> gorm.MethodOptions
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	29, // [29:34] is the sub-list for extension type_name
+	24, // [24:29] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_options_gorm_proto_init() }
@@ -1573,7 +3138,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtraField); i {
+			switch v := v.(*CursorListOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1585,7 +3150,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormFieldOptions); i {
+			switch v := v.(*SoftDeleteOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1597,7 +3162,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GormTag); i {
+			switch v := v.(*ExtraField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1609,7 +3174,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasOneOptions); i {
+			switch v := v.(*GormFieldOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1621,7 +3186,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BelongsToOptions); i {
+			switch v := v.(*JSONAccessor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1633,7 +3198,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HasManyOptions); i {
+			switch v := v.(*MoneyOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1645,7 +3210,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ManyToManyOptions); i {
+			switch v := v.(*GormTag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1657,7 +3222,7 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutoServerOptions); i {
+			switch v := v.(*HasOneOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1669,6 +3234,54 @@ func file_options_gorm_proto_init() {
 			}
 		}
 		file_options_gorm_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BelongsToOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_options_gorm_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasManyOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_options_gorm_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManyToManyOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_options_gorm_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoServerOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_options_gorm_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MethodOptions); i {
 			case 0:
 				return &v.state
@@ -1680,25 +3293,39 @@ func file_options_gorm_proto_init() {
 				return nil
 			}
 		}
+		file_options_gorm_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_options_gorm_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_options_gorm_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*GormFieldOptions_HasOne)(nil),
 		(*GormFieldOptions_BelongsTo)(nil),
 		(*GormFieldOptions_HasMany)(nil),
 		(*GormFieldOptions_ManyToMany)(nil),
 	}
+	file_options_gorm_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_options_gorm_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      7,
+			NumMessages:   16,
 			NumExtensions: 5,
 			NumServices:   0,
 		},
 		GoTypes:           file_options_gorm_proto_goTypes,
 		DependencyIndexes: file_options_gorm_proto_depIdxs,
+		EnumInfos:         file_options_gorm_proto_enumTypes,
 		MessageInfos:      file_options_gorm_proto_msgTypes,
 		ExtensionInfos:    file_options_gorm_proto_extTypes,
 	}.Build()
@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	jgorm "github.com/jinzhu/gorm"
+	"github.com/jinzhu/inflection"
+)
+
+// NamingStrategy maps the ormable messages to their tables and the fields of
+// the ORM types to their columns. The table and column options take
+// precedence over it.
+type NamingStrategy interface {
+	// TableName returns the table of the message
+	TableName(message string) string
+	// JoinTableName returns the default many-to-many join table, given as
+	// the owner followed by the plural association, e.g. UserLanguages
+	JoinTableName(name string) string
+	// ColumnName returns the column of the ORM field in the table
+	ColumnName(table, field string) string
+}
+
+var namingStrategies = map[string]func(arg string) (NamingStrategy, error){
+	"snake": func(arg string) (NamingStrategy, error) {
+		return snakeNaming{}, nil
+	},
+	"prefixed": func(arg string) (NamingStrategy, error) {
+		if arg == "" {
+			return nil, fmt.Errorf("naming_strategy prefixed needs a prefix, e.g. prefixed:tbl_")
+		}
+		return prefixedNaming{prefix: arg}, nil
+	},
+	"camel": func(arg string) (NamingStrategy, error) {
+		return camelNaming{}, nil
+	},
+}
+
+// RegisterNamingStrategy makes a naming strategy selectable with the
+// naming_strategy={name}[:{arg}] parameter, the argument after the colon is
+// passed to newStrategy. A main wrapping the plugin registers its strategies
+// before calling New.
+func RegisterNamingStrategy(name string, newStrategy func(arg string) (NamingStrategy, error)) {
+	if _, ok := namingStrategies[name]; ok {
+		panic(fmt.Sprintf("naming strategy %q is registered twice", name))
+	}
+	namingStrategies[name] = newStrategy
+}
+
+// newNamingStrategy returns the strategy of the naming_strategy parameter,
+// the GORM snake case naming by default
+func newNamingStrategy(param string) (NamingStrategy, error) {
+	if param == "" {
+		return snakeNaming{}, nil
+	}
+	name, arg := param, ""
+	if i := strings.Index(param, ":"); i >= 0 {
+		name, arg = param[:i], param[i+1:]
+	}
+	newStrategy, ok := namingStrategies[name]
+	if !ok {
+		var names []string
+		for name := range namingStrategies {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown naming_strategy %q, the strategies are %s", name, strings.Join(names, ", "))
+	}
+	return newStrategy(arg)
+}
+
+// setPluralOverrides makes the pluralization of the tables and the default
+// join tables use the singular:plural pairs of the plural_overrides parameter,
+// separated by semicolons, ahead of the rules of inflection. A pair applies to
+// the words ending in the singular as well, e.g. person:people to salesperson.
+func setPluralOverrides(param string) error {
+	if param == "" {
+		return nil
+	}
+	rules := inflection.GetIrregular()
+	inflection.SetIrregular(nil)
+	words := make(map[string]bool)
+	for _, pair := range strings.Split(param, ";") {
+		i := strings.Index(pair, ":")
+		if i < 0 {
+			return fmt.Errorf("plural_overrides takes singular:plural pairs separated by semicolons, got %q", pair)
+		}
+		singular, plural := strings.ToLower(strings.TrimSpace(pair[:i])), strings.ToLower(strings.TrimSpace(pair[i+1:]))
+		if !isLowerWord(singular) || !isLowerWord(plural) {
+			return fmt.Errorf("plural_overrides pair %q is not two words of letters", pair)
+		}
+		inflection.AddIrregular(singular, plural)
+		// the plural stays as is, e.g. for the join table of a field named
+		// by it, keeping the case of its first letter
+		inflection.AddPlural("(["+plural[:1]+strings.ToUpper(plural[:1])+"])"+plural[1:]+"$", "${1}"+plural[1:])
+		words[singular], words[plural] = true, true
+	}
+	// an uncountable word would match ahead of the overrides
+	var uncountables []string
+	for _, word := range inflection.GetUncountable() {
+		if !words[strings.ToLower(word)] {
+			uncountables = append(uncountables, word)
+		}
+	}
+	inflection.SetUncountable(uncountables)
+	// the overrides come first, the first matching rule applies
+	inflection.SetIrregular(append(inflection.GetIrregular(), rules...))
+	return nil
+}
+
+// isLowerWord reports whether s is a non-empty run of lower case letters
+func isLowerWord(s string) bool {
+	for _, r := range s {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// snakeNaming is the naming of GORM, plural snake case tables and snake case
+// columns
+type snakeNaming struct{}
+
+func (snakeNaming) TableName(message string) string {
+	return inflection.Plural(jgorm.ToDBName(message))
+}
+
+func (snakeNaming) JoinTableName(name string) string {
+	return jgorm.ToDBName(name)
+}
+
+func (snakeNaming) ColumnName(table, field string) string {
+	return jgorm.ToDBName(field)
+}
+
+// prefixedNaming is the snake case naming with a prefix of the tables
+type prefixedNaming struct {
+	snakeNaming
+	prefix string
+}
+
+func (n prefixedNaming) TableName(message string) string {
+	return n.prefix + n.snakeNaming.TableName(message)
+}
+
+func (n prefixedNaming) JoinTableName(name string) string {
+	return n.prefix + n.snakeNaming.JoinTableName(name)
+}
+
+// camelNaming names the tables and the columns in lower camel case, e.g.
+// typeWithIds and createdAt
+type camelNaming struct{}
+
+func (camelNaming) TableName(message string) string {
+	return snakeToCamel(snakeNaming{}.TableName(message))
+}
+
+func (camelNaming) JoinTableName(name string) string {
+	return snakeToCamel(snakeNaming{}.JoinTableName(name))
+}
+
+func (camelNaming) ColumnName(table, field string) string {
+	return lowerFirst(field)
+}
+
+// snakeToCamel returns the lower camel case of a snake case name
+func snakeToCamel(s string) string {
+	words := strings.Split(s, "_")
+	for i := 1; i < len(words); i++ {
+		if words[i] != "" {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// lowerFirst lowers the leading upper case run of s, keeping the last upper
+// case letter of the run if a lower case letter follows, IDValue is idValue
+func lowerFirst(s string) string {
+	runes := []rune(s)
+	for i := 0; i < len(runes) && runes[i] >= 'A' && runes[i] <= 'Z'; i++ {
+		if i > 0 && i+1 < len(runes) && runes[i+1] >= 'a' && runes[i+1] <= 'z' {
+			break
+		}
+		runes[i] += 'a' - 'A'
+	}
+	return string(runes)
+}
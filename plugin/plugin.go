@@ -1,12 +1,19 @@
 package plugin
 
 import (
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"go/token"
+	"math"
 	"os"
+	"path"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	gorm "github.com/infobloxopen/protoc-gen-gorm/options"
 	jgorm "github.com/jinzhu/gorm"
@@ -20,18 +27,35 @@ import (
 
 const (
 	createService    = "Create"
+	createSetService = "CreateSet"
 	readService      = "Read"
 	updateService    = "Update"
 	updateSetService = "UpdateSet"
 	deleteService    = "Delete"
 	deleteSetService = "DeleteSet"
 	listService      = "List"
+	aggregateService = "Aggregate"
+	facetsService    = "Facets"
+	purgeService     = "Purge"
+	listTrashService = "ListTrash"
 )
 
 var (
 	ErrNotOrmable = errors.New("type is not ormable")
 )
 
+const (
+	// defaultBatchSize is the rows of an INSERT of DefaultCreate{Type}Set
+	// without a batch size
+	defaultBatchSize = 500
+	// postgresMaxParams is the most bind parameters postgres takes in a
+	// statement
+	postgresMaxParams = 65535
+	// idsBatchSize is the ids of an UPDATE of DefaultUpdate{Type}ByIds, which
+	// stays within the bind parameters of every engine
+	idsBatchSize = 500
+)
+
 var (
 	gormImport         = "github.com/jinzhu/gorm"
 	tkgormImport       = "github.com/infobloxopen/atlas-app-toolkit/gorm"
@@ -42,14 +66,34 @@ var (
 	resourceImport     = "github.com/infobloxopen/atlas-app-toolkit/gorm/resource"
 	queryImport        = "github.com/infobloxopen/atlas-app-toolkit/query"
 	ocTraceImport      = "go.opencensus.io/trace"
+	promImport         = "github.com/prometheus/client_golang/prometheus"
 	gatewayImport      = "github.com/infobloxopen/atlas-app-toolkit/gateway"
 	pqImport           = "github.com/lib/pq"
 	gerrorsImport      = "github.com/infobloxopen/protoc-gen-gorm/errors"
+	rlsImport          = "github.com/infobloxopen/protoc-gen-gorm/rls"
+	tenantImport       = "github.com/infobloxopen/protoc-gen-gorm/tenant"
+	auditImport        = "github.com/infobloxopen/protoc-gen-gorm/audit"
+	timeoutImport      = "github.com/infobloxopen/protoc-gen-gorm/timeout"
+	txnImport          = "github.com/infobloxopen/protoc-gen-gorm/txn"
+	metricsImport      = "github.com/infobloxopen/protoc-gen-gorm/metrics"
+	returningImport    = "github.com/infobloxopen/protoc-gen-gorm/returning"
+	timestampsImport   = "github.com/infobloxopen/protoc-gen-gorm/timestamps"
+	changesImport      = "github.com/infobloxopen/protoc-gen-gorm/changes"
+	explainImport      = "github.com/infobloxopen/protoc-gen-gorm/explain"
+	protoImport        = "google.golang.org/protobuf/proto"
 	timestampImport    = "google.golang.org/protobuf/types/known/timestamppb"
+	durationImport     = "google.golang.org/protobuf/types/known/durationpb"
 	wktImport          = "google.golang.org/protobuf/types/known/wrapperspb"
 	fmImport           = "google.golang.org/genproto/protobuf/field_mask"
+	grpcCodesImport    = "google.golang.org/grpc/codes"
+	grpcStatusImport   = "google.golang.org/grpc/status"
 	stdFmtImport       = "fmt"
 	stdCtxImport       = "context"
+	stdSQLImport       = "database/sql"
+	stdDriverImport    = "database/sql/driver"
+	stdBase64Import    = "encoding/base64"
+	stdReflectImport   = "reflect"
+	stdStrconvImport   = "strconv"
 	stdStringsImport   = "strings"
 	stdTimeImport      = "time"
 	encodingJsonImport = "encoding/json"
@@ -87,19 +131,24 @@ var wellKnownTypes = map[string]string{
 }
 
 const (
-	protoTypeTimestamp = "Timestamp" // last segment, first will be *google_protobufX
-	protoTypeJSON      = "JSONValue"
-	protoTypeUUID      = "UUID"
-	protoTypeUUIDValue = "UUIDValue"
-	protoTypeResource  = "Identifier"
-	protoTypeInet      = "InetValue"
-	protoTimeOnly      = "TimeOnly"
+	protoTypeTimestamp     = "Timestamp" // last segment, first will be *google_protobufX
+	protoTypeTimestampFull = "google.protobuf.Timestamp"
+	protoTypeDuration      = "google.protobuf.Duration"
+	protoTypeAny           = "google.protobuf.Any"
+	protoTypeJSON          = "JSONValue"
+	protoTypeUUID          = "UUID"
+	protoTypeUUIDValue     = "UUIDValue"
+	protoTypeResource      = "Identifier"
+	protoTypeInet          = "InetValue"
+	protoTimeOnly          = "TimeOnly"
+	protoTypeGeoPointFull  = "gorm.types.GeoPoint"
 )
 
 // DB Engine Enum
 const (
 	ENGINE_UNSET = iota
 	ENGINE_POSTGRES
+	ENGINE_SQLITE
 )
 
 type ORMBuilder struct {
@@ -113,6 +162,38 @@ type ORMBuilder struct {
 	stringEnums     bool
 	gateway         bool
 	suppressWarn    bool
+	describe        bool
+	deferrable      bool
+	rlsSessionVar   string
+	rlsExtractor    protogen.GoIdent
+	schemaExtractor protogen.GoIdent
+	actorExtractor  protogen.GoIdent
+	metrics         bool
+	joinTables      map[string]*joinTableUse
+	statusErrors    bool
+	validate        bool
+	postgresVersion int
+	naming          NamingStrategy
+	repositoryIface bool
+	lookupTables    map[string]*lookupTable
+	compositeTypes  map[string]*compositeType
+	defaultTimeout  time.Duration
+}
+
+// lookupTable is an enum_as_fk_table, generated in the file of the first
+// field stored in it
+type lookupTable struct {
+	Table string
+	Enum  *protogen.Enum
+	File  *protogen.File
+}
+
+// compositeType is the Go type of a message stored as a postgres composite
+// type, generated in the file of the first field stored as it
+type compositeType struct {
+	Name    string
+	Message *protogen.Message
+	File    *protogen.File
 }
 
 func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBuilder, error) {
@@ -120,17 +201,23 @@ func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBui
 	if err != nil {
 		return nil, err
 	}
+	plugin.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 
 	builder := &ORMBuilder{
-		plugin:       plugin,
-		ormableTypes: make(map[string]*OrmableType),
-		messages:     make(map[string]struct{}),
+		plugin:         plugin,
+		ormableTypes:   make(map[string]*OrmableType),
+		messages:       make(map[string]struct{}),
+		joinTables:     make(map[string]*joinTableUse),
+		lookupTables:   make(map[string]*lookupTable),
+		compositeTypes: make(map[string]*compositeType),
 	}
 
 	params := parseParameter(request.GetParameter())
 
 	if strings.EqualFold(params["engine"], "postgres") {
 		builder.dbEngine = ENGINE_POSTGRES
+	} else if strings.EqualFold(params["engine"], "sqlite") {
+		builder.dbEngine = ENGINE_SQLITE
 	} else {
 		builder.dbEngine = ENGINE_UNSET
 	}
@@ -139,6 +226,14 @@ func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBui
 		builder.stringEnums = true
 	}
 
+	if builder.naming, err = newNamingStrategy(params["naming_strategy"]); err != nil {
+		return nil, err
+	}
+
+	if err = setPluralOverrides(params["plural_overrides"]); err != nil {
+		return nil, err
+	}
+
 	if _, ok := params["gateway"]; ok {
 		builder.gateway = true
 	}
@@ -147,6 +242,83 @@ func New(opts protogen.Options, request *pluginpb.CodeGeneratorRequest) (*ORMBui
 		builder.suppressWarn = true
 	}
 
+	if _, ok := params["describe"]; ok {
+		builder.describe = true
+	}
+
+	if version, ok := params["postgres_version"]; ok {
+		if builder.postgresVersion, err = strconv.Atoi(version); err != nil || builder.postgresVersion <= 0 {
+			return nil, fmt.Errorf("postgres_version must be a major version such as 15, got %q", version)
+		}
+	}
+
+	if strings.EqualFold(params["deferrable_constraints"], "true") {
+		if builder.dbEngine != ENGINE_POSTGRES {
+			return nil, fmt.Errorf("deferrable_constraints needs engine=postgres, other engines cannot defer constraint checks")
+		}
+		builder.deferrable = true
+	}
+
+	if metrics, ok := params["metrics"]; ok {
+		if !strings.EqualFold(metrics, "prometheus") {
+			return nil, fmt.Errorf("unsupported metrics %q, only prometheus is supported", metrics)
+		}
+		builder.metrics = true
+	}
+
+	if strings.EqualFold(params["grpc_status_errors"], "true") {
+		builder.statusErrors = true
+	}
+
+	if strings.EqualFold(params["validate"], "true") {
+		builder.validate = true
+	}
+
+	if strings.EqualFold(params["generate_repository_iface"], "true") {
+		builder.repositoryIface = true
+	}
+
+	if sessionVar := params["rls_session_var"]; sessionVar != "" {
+		extractor := params["rls_extractor"]
+		i := strings.LastIndex(extractor, ".")
+		if i <= 0 || i == len(extractor)-1 {
+			return nil, fmt.Errorf("rls_session_var requires rls_extractor to be set to a function as {goImportPath}.{FuncName}, got %q", extractor)
+		}
+		builder.rlsSessionVar = sessionVar
+		builder.rlsExtractor = protogen.GoIdent{
+			GoName:       extractor[i+1:],
+			GoImportPath: protogen.GoImportPath(extractor[:i]),
+		}
+	}
+
+	if extractor := params["tenant_schema_extractor"]; extractor != "" {
+		i := strings.LastIndex(extractor, ".")
+		if i <= 0 || i == len(extractor)-1 {
+			return nil, fmt.Errorf("tenant_schema_extractor must be a function as {goImportPath}.{FuncName}, got %q", extractor)
+		}
+		builder.schemaExtractor = protogen.GoIdent{
+			GoName:       extractor[i+1:],
+			GoImportPath: protogen.GoImportPath(extractor[:i]),
+		}
+	}
+
+	if timeout, ok := params["default_timeout"]; ok {
+		if builder.defaultTimeout, err = time.ParseDuration(timeout); err != nil || builder.defaultTimeout < 0 {
+			return nil, fmt.Errorf("default_timeout must be a duration such as 5s, got %q", timeout)
+		}
+	}
+
+	if extractor := params["actor_extractor"]; extractor != "" {
+		i := strings.LastIndex(extractor, ".")
+		if i <= 0 || i == len(extractor)-1 {
+			return nil, fmt.Errorf("actor_extractor must be set to a function as {goImportPath}.{FuncName}, got %q", extractor)
+		}
+		builder.actorExtractor = protogen.GoIdent{
+			GoName:       extractor[i+1:],
+			GoImportPath: protogen.GoImportPath(extractor[:i]),
+		}
+	}
+
 	return builder, nil
 }
 
@@ -173,6 +345,36 @@ type OrmableType struct {
 	Name       string
 	OriginName string
 	Package    string
+	// SavedByCreate is set when a Create method uses the SAVE create mode
+	SavedByCreate bool
+	// Immutable is set by the immutable option, the rows are never updated
+	Immutable bool
+	// MultiAccount is set by the multi_account option, the rows are those of
+	// the account of the context
+	MultiAccount bool
+	// NoAutoTimestamps is set by the no_auto_timestamps option, GORM leaves
+	// the CreatedAt and UpdatedAt as they are set
+	NoAutoTimestamps bool
+	// Aggregates are the methods with the aggregate option on this type
+	Aggregates []*autogenMethod
+	// Facets are the methods with the facet option on this type
+	Facets []*autogenMethod
+	// Purged is set by a method with the purge option on this type
+	Purged bool
+	// ListsTrash is set by a method with the list_trash option on this type
+	ListsTrash bool
+	// IdempotencyFields are the sorted idempotency_field of the Create
+	// methods of this type
+	IdempotencyFields []string
+	// CasFields are the sorted cas_field of the Update methods of this type
+	CasFields []string
+	// DistinctOn are the columns of the distinct_on List methods of this
+	// type by the suffix of their handler
+	DistinctOn map[string][]string
+	// ShardBy is the Go name of the shard_by field, resolved to the handle
+	// of its shard by ShardResolver
+	ShardBy       string
+	ShardResolver protogen.GoIdent
 }
 
 func NewOrmableType(originalName string, pkg string, file *protogen.File) *OrmableType {
@@ -182,6 +384,7 @@ func NewOrmableType(originalName string, pkg string, file *protogen.File) *Ormab
 		File:       file,
 		Fields:     make(map[string]*Field),
 		Methods:    make(map[string]*autogenMethod),
+		DistinctOn: make(map[string][]string),
 	}
 }
 
@@ -191,6 +394,9 @@ type Field struct {
 	Type           string
 	Package        string
 	ParentOrigName string
+	// BackReference is set on the belongs-to field of a child that the
+	// set_back_reference of the parent association points to
+	BackReference bool
 }
 
 type autogenMethod struct {
@@ -250,6 +456,9 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 
 			if isOrmable(message) {
 				ormable := NewOrmableType(typeName, string(protoFile.GoPackageName), protoFile)
+				ormable.Immutable = getMessageOptions(message).GetImmutable()
+				ormable.MultiAccount = getMessageOptions(message).GetMultiAccount()
+				ormable.NoAutoTimestamps = getMessageOptions(message).GetNoAutoTimestamps()
 				b.ormableTypes[typeName] = ormable
 			}
 		}
@@ -258,6 +467,8 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 		for _, message := range protoFile.Messages {
 			if isOrmable(message) {
 				b.parseBasicFields(message, g)
+				b.parseShard(message)
+				b.parseTenantIsolation(message)
 			}
 		}
 
@@ -276,6 +487,9 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 
 	}
 
+	b.parseBackReferences()
+	b.applyNamingStrategy()
+
 	for _, protoFile := range b.plugin.Files {
 		b.parseServices(protoFile)
 	}
@@ -320,11 +534,41 @@ func (b *ORMBuilder) Generate() (*pluginpb.CodeGeneratorResponse, error) {
 			if isOrmable(message) {
 				b.generateOrmable(g, message)
 				b.generateTableNameFunctions(g, message)
+				b.generateHistory(g, message)
+				b.generateClearAssociations(g, message)
+				b.generateReload(g, message)
+				b.generateReloadComputed(g, message)
+				b.generateValidateReferences(g, message)
+				b.generateImmutableHook(g, message)
+				b.generateTouchHook(g, message)
+				b.generateJSONAccessors(g, message)
+				b.generateWithinScopes(g, message)
+				b.generateBackReferenceHook(g, message)
+				b.generateIndexDefinitions(g, message)
+				b.generateForeignKeyDefinitions(g, message)
+				b.generateDescribe(g, message)
+				b.generateThroughLoaders(g, message)
+				b.generateBatchLoaders(g, message)
+				b.generateConditionalPreloads(g, message)
+				b.generateConflictKeyResolvers(g, message)
 				b.generateConvertFunctions(g, message)
+				b.generateMergeFunction(g, message)
+				b.generateFieldMaskFunction(g, message)
+				b.generateDiffFunction(g, message)
+				b.generateTimezoneFunctions(g, message)
+				b.generateSliceConvertFunctions(g, message)
 				b.generateHookInterfaces(g, message)
+				b.generateChangeStream(g, message)
 			}
 		}
 
+		b.generateLookupTables(g, protoFile)
+		b.generateCompositeTypes(g, protoFile)
+		b.generateColumnRenames(protoFile, g)
+		b.generateColumnComments(protoFile, g)
+		b.generateChecks(protoFile, g)
+		b.generateSchemaHash(protoFile, g)
+		b.generateCallbacks(protoFile, g)
 		b.generateDefaultHandlers(protoFile, g)
 		b.generateDefaultServer(protoFile, g)
 	}
@@ -355,8 +599,14 @@ func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message
 			continue
 		}
 
+		// Read only and computed fields are never taken from the incoming object
+		if fieldOpts.GetReadOnly() || fieldOpts.GetComputed() {
+			continue
+		}
+
 		ofield := ormable.Fields[camelCase(field.GoName)]
 		b.generateFieldConversion(message, field, true, ofield, g)
+		b.generateTransform(ormable, field, ofield, g)
 	}
 	if getMessageOptions(message).GetMultiAccount() {
 		g.P("accountID, err := ", generateImport("GetAccountID", authImport, g), "(ctx, nil)")
@@ -385,6 +635,10 @@ func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message
 	g.P(`return to, err`)
 	g.P(`}`)
 	g.P(`}`)
+	if len(backReferences(ormable)) > 0 {
+		// the children pointing back at m leave it out of their messages
+		g.P(`ctx = `, generateImport("WithVisited", gtypesImport, g), `(ctx, m)`)
+	}
 	for _, field := range message.Fields {
 		// Checking if field is skipped
 		options := field.Desc.Options().(*descriptorpb.FieldOptions)
@@ -402,2042 +656,8612 @@ func (b *ORMBuilder) generateConvertFunctions(g *protogen.GeneratedFile, message
 	g.P(`}`)
 }
 
-func (b *ORMBuilder) generateTableNameFunctions(g *protogen.GeneratedFile, message *protogen.Message) {
-	typeName := string(message.Desc.Name())
-	msgName := string(message.Desc.Name())
-
-	g.P(`// TableName overrides the default tablename generated by GORM`)
-	g.P(`func (`, typeName, `ORM) TableName() string {`)
-
-	tableName := inflection.Plural(jgorm.ToDBName(msgName))
-	if opts := getMessageOptions(message); opts != nil && len(opts.Table) > 0 {
-		tableName = opts.GetTable()
+// scalarGoTypes are the Go types of the scalar kinds a transform applies to
+var scalarGoTypes = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "bool",
+	protoreflect.Int32Kind:    "int32",
+	protoreflect.Sint32Kind:   "int32",
+	protoreflect.Sfixed32Kind: "int32",
+	protoreflect.Uint32Kind:   "uint32",
+	protoreflect.Fixed32Kind:  "uint32",
+	protoreflect.Int64Kind:    "int64",
+	protoreflect.Sint64Kind:   "int64",
+	protoreflect.Sfixed64Kind: "int64",
+	protoreflect.Uint64Kind:   "uint64",
+	protoreflect.Fixed64Kind:  "uint64",
+	protoreflect.FloatKind:    "float32",
+	protoreflect.DoubleKind:   "float64",
+	protoreflect.StringKind:   "string",
+	protoreflect.BytesKind:    "[]byte",
+}
+
+// generateTransform applies the transform functions of the field to the
+// converted value, the field has to be stored as its own Go type so that the
+// func(T) T signature is checked by the compiler
+func (b *ORMBuilder) generateTransform(ormable *OrmableType, field *protogen.Field, ofield *Field, g *protogen.GeneratedFile) {
+	transform := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions)).GetTransform()
+	if transform == "" {
+		return
+	}
+	fieldName := camelCase(string(field.Desc.Name()))
+	goType, ok := scalarGoTypes[field.Desc.Kind()]
+	if !ok || field.Desc.IsList() || ofield == nil || strings.TrimPrefix(ofield.Type, "*") != goType {
+		panic(fmt.Sprintf("transform of %s in %s needs a scalar field stored as its Go type", fieldName, ormable.Name))
+	}
+	value := `to.` + fieldName
+	if strings.HasPrefix(ofield.Type, "*") {
+		value = `*to.` + fieldName
+	}
+	call := value
+	for _, name := range strings.Split(transform, ",") {
+		name = strings.TrimSpace(name)
+		i := strings.LastIndex(name, ".")
+		if i <= 0 || i == len(name)-1 {
+			panic(fmt.Sprintf("transform %q of %s in %s must be a function as {goImportPath}.{FuncName}", name, fieldName, ormable.Name))
+		}
+		call = g.QualifiedGoIdent(protogen.GoIdent{GoName: name[i+1:], GoImportPath: protogen.GoImportPath(name[:i])}) + `(` + call + `)`
+	}
+	if strings.HasPrefix(ofield.Type, "*") {
+		// the optional conversion copied the value already
+		g.P(`if to.`, fieldName, ` != nil {`)
+		g.P(value, ` = `, call)
+		g.P(`}`)
+	} else {
+		g.P(value, ` = `, call)
 	}
-	g.P(`return "`, tableName, `"`)
-	g.P(`}`)
 }
 
-func (b *ORMBuilder) generateOrmable(g *protogen.GeneratedFile, message *protogen.Message) {
-	ormable := b.getOrmable(message.GoIdent.GoName)
-	g.P(`type `, ormable.Name, ` struct {`)
+// generateFieldMaskFunction emits the ApplyFieldMaskToORM method copying the
+// fields in a field mask onto an ORM object and the applyFieldMaskPath method
+// of the ORM type following the dotted paths into its nested objects
+func (b *ORMBuilder) generateFieldMaskFunction(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
 
-	var names []string
-	for name := range ormable.Fields {
-		names = append(names, name)
-	}
-	sort.Strings(names)
+	g.P(`// ApplyFieldMaskToORM converts this object with ToORM and copies the fields in the`)
+	g.P(`// paths of mask onto dst, the paths are Go field names, dotted into an embedded`)
+	g.P(`// or nested object, e.g. Address.Street. A path of no field converted by ToORM`)
+	g.P(`// fails with errors.UnknownUpdateFieldError.`)
+	g.P(`func (m *`, typeName, `) ApplyFieldMaskToORM(ctx `, generateImport("Context", stdCtxImport, g), `, dst *`, ormable.Name, `, mask *`, generateImport("FieldMask", fmImport, g), `) error {`)
+	g.P(`if dst == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`from, err := m.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`for _, path := range mask.GetPaths() {`)
+	g.P(`if !dst.applyFieldMaskPath(&from, path) {`)
+	g.P(`return `, generateImport("Errorf", stdFmtImport, g), `("%w %q", `, generateImport("UnknownUpdateFieldError", gerrorsImport, g), `, path)`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
 
-	for _, name := range names {
-		field := ormable.Fields[name]
-		sp := strings.Split(field.Type, ".")
-		if len(sp) == 2 && sp[1] == "UUID" {
-			s := generateImport("UUID", uuidImport, g)
-			if field.Type[0] == '*' {
-				field.Type = "*" + s
-			} else {
-				field.Type = s
+	g.P(`// applyFieldMaskPath copies the field of the path from from onto m and reports`)
+	g.P(`// whether the path is a field`)
+	g.P(`func (m *`, ormable.Name, `) applyFieldMaskPath(from *`, ormable.Name, `, path string) bool {`)
+	g.P(`head, rest := path, ""`)
+	g.P(`if i := `, generateImport("Index", "strings", g), `(path, "."); i >= 0 {`)
+	g.P(`head, rest = path[:i], path[i+1:]`)
+	g.P(`}`)
+	g.P(`switch head {`)
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		if fieldOpts.GetDrop() || fieldOpts.GetReadOnly() || fieldOpts.GetComputed() {
+			continue
+		}
+		fieldName := camelCase(string(field.Desc.Name()))
+		ofield, ok := ormable.Fields[fieldName]
+		if !ok {
+			// not converted by ToORM either
+			continue
+		}
+		g.P(`case "`, fieldName, `":`)
+		nested := strings.TrimPrefix(ofield.Type, "*")
+		// the nested objects of the other packages have no applyFieldMaskPath
+		if !isEmbedded(ofield) && !(isAssociation(ofield) && strings.HasPrefix(ofield.Type, "*")) || strings.Contains(nested, ".") {
+			g.P(`if rest != "" {`)
+			g.P(`return false`)
+			g.P(`}`)
+			g.P(`m.`, fieldName, ` = from.`, fieldName)
+			if fieldOpts.GetPreserveNanos() {
+				g.P(`m.`, fieldName, `Nanos = from.`, fieldName, `Nanos`)
 			}
+			g.P(`return true`)
+			continue
 		}
-
-		g.P(name, ` `, field.Type, b.renderGormTag(field))
+		g.P(`if rest == "" {`)
+		g.P(`m.`, fieldName, ` = from.`, fieldName)
+		g.P(`return true`)
+		g.P(`}`)
+		if isEmbedded(ofield) {
+			g.P(`return m.`, fieldName, `.applyFieldMaskPath(&from.`, fieldName, `, rest)`)
+			continue
+		}
+		// a field of a missing object is copied as the zero value
+		g.P(`nested := from.`, fieldName)
+		g.P(`if nested == nil {`)
+		g.P(`nested = &`, nested, `{}`)
+		g.P(`}`)
+		g.P(`if m.`, fieldName, ` == nil {`)
+		g.P(`m.`, fieldName, ` = &`, nested, `{}`)
+		g.P(`}`)
+		g.P(`return m.`, fieldName, `.applyFieldMaskPath(nested, rest)`)
 	}
-
+	g.P(`default:`)
+	g.P(`return false`)
+	g.P(`}`)
 	g.P(`}`)
 	g.P()
 }
 
-func (b *ORMBuilder) parseAssociations(msg *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := camelCase(string(msg.Desc.Name())) // TODO: camelSnakeCase
+// generateMergeFunction writes the converted fields onto an already loaded
+// ORM object, fields with presence are only written when they are set
+func (b *ORMBuilder) generateMergeFunction(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
 	ormable := b.getOrmable(typeName)
 
-	for _, field := range msg.Fields {
-		options := field.Desc.Options().(*descriptorpb.FieldOptions)
-		fieldOpts := getFieldOptions(options)
-		if fieldOpts.GetDrop() {
+	g.P(`// MergeToORM converts this object with ToORM and writes its fields onto dst,`)
+	g.P(`// unset optional and message fields leave the dst values intact, as do the`)
+	g.P(`// read only fields and the ORM only ones. Associations are replaced only`)
+	g.P(`// when requested`)
+	g.P(`func (m *`, typeName, `) MergeToORM(ctx `, generateImport("Context", stdCtxImport, g), `, dst *`, typeName, `ORM, associations bool) error {`)
+	g.P(`if dst == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`to, err := m.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	var associations []string
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		if fieldOpts.GetDrop() || fieldOpts.GetReadOnly() || fieldOpts.GetImmutableAfterCreate() || fieldOpts.GetComputed() {
 			continue
 		}
-
 		fieldName := camelCase(string(field.Desc.Name()))
-		var fieldType string
-
-		if field.Desc.Message() == nil {
-			fieldType = field.Desc.Kind().String() // was GoType
-		} else {
-			fieldType = string(field.Desc.Message().Name())
+		if field.Message != nil && b.isOrmable(string(field.Message.Desc.Name())) && !isEmbedded(ormable.Fields[fieldName]) {
+			associations = append(associations, fieldName)
+			continue
 		}
-
-		fieldType = strings.Trim(fieldType, "[]*")
-		parts := strings.Split(fieldType, ".")
-		fieldTypeShort := parts[len(parts)-1]
-
-		if b.isOrmable(fieldType) {
-			if fieldOpts == nil {
-				fieldOpts = &gorm.GormFieldOptions{}
-			}
-			assocOrmable := b.getOrmable(fieldType)
-
-			if field.Message != nil {
-				fieldType = b.typeName(field.Message.GoIdent, g)
+		if _, ok := ormable.Fields[fieldName]; !ok {
+			// not converted by ToORM either
+			continue
+		}
+		if fieldOpts.GetDefaultNow() {
+			// a zero timestamp is left to the default on insert and keeps the
+			// value of dst here
+			g.P(`if to.`, fieldName, ` != nil {`)
+			g.P(`dst.`, fieldName, ` = to.`, fieldName)
+			if fieldOpts.GetPreserveNanos() {
+				g.P(`dst.`, fieldName, `Nanos = to.`, fieldName, `Nanos`)
 			}
-
-			if field.Desc.Cardinality() == protoreflect.Repeated {
-				if fieldOpts.GetManyToMany() != nil {
-					b.parseManyToMany(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
-				} else {
-					b.parseHasMany(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
-				}
-				fieldType = fmt.Sprintf("[]*%sORM", fieldType)
-			} else {
-				if fieldOpts.GetBelongsTo() != nil {
-					b.parseBelongsTo(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
-				} else {
-					b.parseHasOne(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
-				}
-				fieldType = fmt.Sprintf("*%sORM", fieldType)
+			g.P(`}`)
+		} else if field.Desc.HasPresence() {
+			g.P(`if m.`, fieldName, ` != nil {`)
+			g.P(`dst.`, fieldName, ` = to.`, fieldName)
+			if fieldOpts.GetPreserveNanos() {
+				g.P(`dst.`, fieldName, `Nanos = to.`, fieldName, `Nanos`)
 			}
-
-			// Register type used, in case it's an imported type from another package
-			// b.GetFileImports().typesToRegister = append(b.GetFileImports().typesToRegister, fieldType) // maybe we need other fields type
-			ormable.Fields[fieldName] = &Field{Type: fieldType, GormFieldOptions: fieldOpts}
-		}
-	}
-}
-
-func (b *ORMBuilder) hasPrimaryKey(ormable *OrmableType) bool {
-	for _, field := range ormable.Fields {
-		if field.GetTag().GetPrimaryKey() {
-			return true
+			g.P(`}`)
+		} else {
+			g.P(`dst.`, fieldName, ` = to.`, fieldName)
 		}
 	}
-	for fieldName := range ormable.Fields {
-		if strings.ToLower(fieldName) == "id" {
-			return true
+	if len(associations) > 0 {
+		g.P(`if associations {`)
+		for _, fieldName := range associations {
+			g.P(`dst.`, fieldName, ` = to.`, fieldName)
 		}
+		g.P(`}`)
 	}
-	return false
-}
-
-func (b *ORMBuilder) isOrmable(typeName string) bool {
-	_, ok := b.ormableTypes[typeName]
-	return ok
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
 }
 
-func (b *ORMBuilder) findPrimaryKey(ormable *OrmableType) (string, *Field) {
-	for fieldName, field := range ormable.Fields {
-		if field.GetTag().GetPrimaryKey() {
-			return fieldName, field
+// generateDiffFunction generates Diff{Type}, the field mask of the fields of
+// which two ORM objects differ
+func (b *ORMBuilder) generateDiffFunction(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	fieldMask := generateImport("FieldMask", fmImport, g)
+
+	g.P(`// Diff`, typeName, ` returns the paths of the fields of which the objects differ, as`)
+	g.P(`// taken by the field masks of the patch handlers, a nil object is the zero one.`)
+	g.P(`// An association differs as a whole, a belongs-to or many-to-many one by the`)
+	g.P(`// primary keys it refers to, and the times compare at the precision stored.`)
+	g.P(`func Diff`, typeName, `(old, new *`, ormable.Name, `) *`, fieldMask, ` {`)
+	g.P(`if old == nil {`)
+	g.P(`old = &`, ormable.Name, `{}`)
+	g.P(`}`)
+	g.P(`if new == nil {`)
+	g.P(`new = &`, ormable.Name, `{}`)
+	g.P(`}`)
+	g.P(`mask := &`, fieldMask, `{}`)
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		name := camelCase(string(field.Desc.Name()))
+		ofield, ok := ormable.Fields[name]
+		if fieldOpts.GetDrop() || !ok || ofield.GetTag().GetIgnore() {
+			continue
 		}
-	}
-	for fieldName, field := range ormable.Fields {
-		if strings.ToLower(fieldName) == "id" {
-			return fieldName, field
+		switch {
+		case isAssociation(ofield):
+			b.generateAssociationDiff(name, ofield, g)
+		case isEmbedded(ofield):
+			child := b.getOrmable(ofield.Type)
+			diff := b.typeName(protogen.GoIdent{GoName: "Diff" + child.OriginName, GoImportPath: child.File.GoImportPath}, g)
+			g.P(`if len(`, diff, `(&old.`, name, `, &new.`, name, `).Paths) > 0 {`)
+		default:
+			differs := b.valueDiffers(`old.`+name, `new.`+name, ofield.Type, g)
+			if fieldOpts.GetPreserveNanos() {
+				differs += ` || old.` + name + `Nanos != new.` + name + `Nanos`
+			}
+			g.P(`if `, differs, ` {`)
 		}
+		g.P(`mask.Paths = append(mask.Paths, "`, name, `")`)
+		g.P(`}`)
 	}
-
-	panic("no primary_key")
-}
-
-func (b *ORMBuilder) getOrmable(typeName string) *OrmableType {
-	r, err := GetOrmable(b.ormableTypes, typeName)
-	if err != nil {
-		panic(err)
-	}
-
-	return r
+	g.P(`return mask`)
+	g.P(`}`)
+	g.P()
 }
 
-func (b *ORMBuilder) parseManyToMany(msg *protogen.Message, ormable *OrmableType, fieldName string, fieldType string, assoc *OrmableType, opts *gorm.GormFieldOptions) {
-	typeName := camelCase(string(msg.Desc.Name()))
-	mtm := opts.GetManyToMany()
-	if mtm == nil {
-		mtm = &gorm.ManyToManyOptions{}
-		opts.Association = &gorm.GormFieldOptions_ManyToMany{mtm}
-	}
-
-	var foreignKeyName string
-	if foreignKeyName = camelCase(mtm.GetForeignkey()); foreignKeyName == "" {
-		foreignKeyName, _ = b.findPrimaryKey(ormable)
-	} else {
-		var ok bool
-		_, ok = ormable.Fields[foreignKeyName]
-		if !ok {
-			panic(fmt.Sprintf("Missing %s field in %s", foreignKeyName, ormable.Name))
+// generateAssociationDiff opens the if statement of the association field
+// differing, comparing has-one and has-many children with their Diff and the
+// belongs-to and many-to-many ones by their primary key
+func (b *ORMBuilder) generateAssociationDiff(name string, field *Field, g *protogen.GeneratedFile) {
+	child := b.getOrmable(field.Type)
+	diff := b.typeName(protogen.GoIdent{GoName: "Diff" + child.OriginName, GoImportPath: child.File.GoImportPath}, g)
+	differs := func(o, n string) string {
+		if (field.GetBelongsTo() != nil || field.GetManyToMany() != nil) && b.hasPrimaryKey(child) {
+			pkName, pk := b.findPrimaryKey(child)
+			return `(` + o + ` == nil) != (` + n + ` == nil) || ` + o + ` != nil && (` + b.valueDiffers(o+`.`+pkName, n+`.`+pkName, pk.Type, g) + `)`
 		}
+		return `(` + o + ` == nil) != (` + n + ` == nil) || len(` + diff + `(` + o + `, ` + n + `).Paths) > 0`
 	}
-	mtm.Foreignkey = foreignKeyName
-	var assocKeyName string
-	if assocKeyName = camelCase(mtm.GetAssociationForeignkey()); assocKeyName == "" {
-		assocKeyName, _ = b.findPrimaryKey(assoc)
-	} else {
-		var ok bool
-		_, ok = assoc.Fields[assocKeyName]
-		if !ok {
-			panic(fmt.Sprintf("Missing %s field in %s", assocKeyName, assoc.Name))
-		}
+	if !strings.HasPrefix(field.Type, "[]") {
+		g.P(`if `, differs(`old.`+name, `new.`+name), ` {`)
+		return
 	}
-	mtm.AssociationForeignkey = assocKeyName
-	var jt string
-	if jt = jgorm.ToDBName(mtm.GetJointable()); jt == "" {
-		if b.countManyToManyAssociationDimension(msg, fieldType) == 1 && typeName != fieldType {
-			jt = jgorm.ToDBName(typeName + inflection.Plural(fieldType))
+	g.P(`differs`, name, ` := len(old.`, name, `) != len(new.`, name, `)`)
+	g.P(`for i := 0; !differs`, name, ` && i < len(old.`, name, `); i++ {`)
+	g.P(`differs`, name, ` = `, differs(`old.`+name+`[i]`, `new.`+name+`[i]`))
+	g.P(`}`)
+	g.P(`if differs`, name, ` {`)
+}
+
+// valueDiffers returns the condition of the column values o and n of the Go
+// type differing, the times of postgres compare at its microseconds
+func (b *ORMBuilder) valueDiffers(o, n, fieldType string, g *protogen.GeneratedFile) string {
+	base := strings.TrimPrefix(fieldType, "*")
+	var differs string
+	switch {
+	case base == "time.Time":
+		if b.dbEngine == ENGINE_POSTGRES {
+			micro := generateImport("Microsecond", stdTimeImport, g)
+			differs = `!` + o + `.Truncate(` + micro + `).Equal(` + n + `.Truncate(` + micro + `))`
 		} else {
-			jt = jgorm.ToDBName(typeName + inflection.Plural(fieldName))
+			differs = `!` + o + `.Equal(` + n + `)`
 		}
+	case strings.HasPrefix(fieldType, "[]") || strings.Contains(fieldType, ".") || fieldType == "interface{}":
+		return `!` + generateImport("DeepEqual", stdReflectImport, g) + `(` + o + `, ` + n + `)`
+	case strings.HasPrefix(fieldType, "*"):
+		differs = `*` + o + ` != *` + n
+	default:
+		return o + ` != ` + n
 	}
-	mtm.Jointable = jt
-	var jtForeignKey string
-	if jtForeignKey = camelCase(mtm.GetJointableForeignkey()); jtForeignKey == "" {
-		jtForeignKey = camelCase(jgorm.ToDBName(typeName + foreignKeyName))
-	}
-	mtm.JointableForeignkey = jtForeignKey
-	var jtAssocForeignKey string
-	if jtAssocForeignKey = camelCase(mtm.GetAssociationJointableForeignkey()); jtAssocForeignKey == "" {
-		if typeName == fieldType {
-			jtAssocForeignKey = jgorm.ToDBName(inflection.Singular(fieldName) + assocKeyName)
-		} else {
-			jtAssocForeignKey = jgorm.ToDBName(fieldType + assocKeyName)
-		}
+	if strings.HasPrefix(fieldType, "*") {
+		return `(` + o + ` == nil) != (` + n + ` == nil) || ` + o + ` != nil && ` + differs
 	}
-	mtm.AssociationJointableForeignkey = camelCase(jtAssocForeignKey)
+	return differs
 }
 
-func (b *ORMBuilder) parseHasOne(msg *protogen.Message, parent *OrmableType, fieldName string, fieldType string, child *OrmableType, opts *gorm.GormFieldOptions) {
-	typeName := camelCase(string(msg.Desc.Name()))
-	hasOne := opts.GetHasOne()
-	if hasOne == nil {
-		hasOne = &gorm.HasOneOptions{}
-		opts.Association = &gorm.GormFieldOptions_HasOne{hasOne}
-	}
-
-	var assocKey *Field
-	var assocKeyName string
+func (b *ORMBuilder) generateSliceConvertFunctions(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	ctxType := generateImport("Context", stdCtxImport, g)
 
-	if assocKeyName = camelCase(hasOne.GetAssociationForeignkey()); assocKeyName == "" {
-		assocKeyName, assocKey = b.findPrimaryKey(parent)
-	} else {
-		var ok bool
-		assocKey, ok = parent.Fields[assocKeyName]
-		if !ok {
-			panic(fmt.Sprintf("Missing %s field in %s.", assocKeyName, parent.Name))
+	g.P(`// `, typeName, `SliceToORM converts a slice of PB objects to ORM format, the error`)
+	g.P(`// of the first object failing conversion is returned along with its index`)
+	g.P(`func `, typeName, `SliceToORM(ctx `, ctxType, `, in []*`, typeName, `) ([]*`, typeName, `ORM, error) {`)
+	g.P(`if in == nil {`)
+	g.P(`return nil, nil`)
+	g.P(`}`)
+	g.P(`out := make([]*`, typeName, `ORM, len(in))`)
+	g.P(`for i, m := range in {`)
+	g.P(`if m == nil {`)
+	g.P(`continue`)
+	g.P(`}`)
+	g.P(`to, err := m.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("%d: %w", i, err)`)
+	g.P(`}`)
+	g.P(`out[i] = &to`)
+	g.P(`}`)
+	g.P(`return out, nil`)
+	g.P(`}`)
+	g.P()
+	g.P(`// `, typeName, `ORMSliceToPB converts a slice of ORM objects to PB format, the error`)
+	g.P(`// of the first object failing conversion is returned along with its index`)
+	g.P(`func `, typeName, `ORMSliceToPB(ctx `, ctxType, `, in []*`, typeName, `ORM) ([]*`, typeName, `, error) {`)
+	g.P(`if in == nil {`)
+	g.P(`return nil, nil`)
+	g.P(`}`)
+	g.P(`out := make([]*`, typeName, `, len(in))`)
+	g.P(`for i, m := range in {`)
+	g.P(`if m == nil {`)
+	g.P(`continue`)
+	g.P(`}`)
+	g.P(`to, err := m.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("%d: %w", i, err)`)
+	g.P(`}`)
+	g.P(`out[i] = &to`)
+	g.P(`}`)
+	g.P(`return out, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateColumnRenames lists the renamed_from columns of the ormable types
+// of the file, in the order of the messages and fields, with the function
+// renaming them ahead of AutoMigrate
+func (b *ORMBuilder) generateColumnRenames(file *protogen.File, g *protogen.GeneratedFile) {
+	var renames []string
+	for _, message := range file.Messages {
+		if !isOrmable(message) {
+			continue
+		}
+		ormable := b.getOrmable(message.GoIdent.GoName)
+		var fieldNames []string
+		columns := make(map[string]string)
+		for name, field := range ormable.Fields {
+			fieldNames = append(fieldNames, name)
+			if !isAssociation(field) && !isEmbedded(field) {
+				columns[columnName(name, field)] = name
+			}
+		}
+		sort.Strings(fieldNames)
+		for _, name := range fieldNames {
+			field := ormable.Fields[name]
+			from := field.GetRenamedFrom()
+			if from == "" {
+				continue
+			}
+			if isAssociation(field) || isEmbedded(field) || field.GetTag().GetIgnore() {
+				panic(fmt.Sprintf("Field %s of %s has renamed_from but is not a column", name, ormable.Name))
+			}
+			if other, ok := columns[from]; ok {
+				panic(fmt.Sprintf("Field %s of %s is renamed_from %s, which is the column of %s", name, ormable.Name, from, other))
+			}
+			renames = append(renames, fmt.Sprintf(`{Table: "%s", From: "%s", To: "%s"},`, b.tableName(message), from, columnName(name, field)))
 		}
 	}
-
-	hasOne.AssociationForeignkey = assocKeyName
-	var foreignKeyType string
-	if hasOne.GetForeignkeyTag().GetNotNull() {
-		foreignKeyType = strings.TrimPrefix(assocKey.Type, "*")
-	} else if strings.HasPrefix(assocKey.Type, "*") {
-		foreignKeyType = assocKey.Type
-	} else if strings.Contains(assocKey.Type, "[]byte") {
-		foreignKeyType = assocKey.Type
-	} else {
-		foreignKeyType = "*" + assocKey.Type
+	if len(renames) == 0 {
+		return
 	}
 
-	foreignKey := &Field{Type: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gorm.GormFieldOptions{Tag: hasOne.GetForeignkeyTag()}}
-	var foreignKeyName string
-	if foreignKeyName = camelCase(hasOne.GetForeignkey()); foreignKeyName == "" {
-		if b.countHasAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
-		}
+	name := camelCase(strings.TrimSuffix(path.Base(file.Desc.Path()), ".proto"))
+	g.P(`// `, name, `ColumnRenames lists the renamed_from columns of the ORM types defined in`)
+	g.P(`// `, path.Base(file.Desc.Path()), `, in the order they are renamed`)
+	g.P(`var `, name, `ColumnRenames = []`, generateImport("ColumnRename", gtypesImport, g), `{`)
+	for _, rename := range renames {
+		g.P(rename)
 	}
+	g.P(`}`)
+	g.P()
+	g.P(`// Rename`, name, `Columns renames the columns of `, name, `ColumnRenames, it runs ahead`)
+	g.P(`// of AutoMigrate, which would add the new column and leave the data in the`)
+	g.P(`// old one. A rename is skipped unless the old column exists and the new one`)
+	g.P(`// does not, so it runs on every migration.`)
+	g.P(`func Rename`, name, `Columns(db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`if db == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`for _, rename := range `, name, `ColumnRenames {`)
+	g.P(`if !db.Dialect().HasColumn(rename.Table, rename.From) || db.Dialect().HasColumn(rename.Table, rename.To) {`)
+	g.P(`continue`)
+	g.P(`}`)
+	g.P(`if err := db.Exec(rename.SQL()).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
 
-	hasOne.Foreignkey = foreignKeyName
-	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
-		panic(fmt.Sprintf("Object %s from package %s cannot be user for has-one in %s since it does not have FK field %s defined. Manually define the key, or switch to belongs-to.",
-			child.Name, child.Package, parent.Name, foreignKeyName))
+// generateColumnComments lists the comments of the columns of the ormable
+// types of the file with the function setting them, postgres only takes them
+// by COMMENT ON COLUMN
+func (b *ORMBuilder) generateColumnComments(file *protogen.File, g *protogen.GeneratedFile) {
+	if b.dbEngine != ENGINE_POSTGRES {
+		return
 	}
-	if exField, ok := child.Fields[foreignKeyName]; !ok {
-		child.Fields[foreignKeyName] = foreignKey
-	} else {
-		if exField.Type == "interface{}" {
-			exField.Type = foreignKey.Type
-		} else if !b.sameType(exField, foreignKey) {
-			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
-				foreignKeyName, child.Name, exField.Type, foreignKey.Type))
+	var comments []string
+	for _, message := range file.Messages {
+		if !isOrmable(message) {
+			continue
+		}
+		ormable := b.getOrmable(message.GoIdent.GoName)
+		var fieldNames []string
+		for name := range ormable.Fields {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+		for _, name := range fieldNames {
+			field := ormable.Fields[name]
+			comment := field.GetTag().GetComment()
+			if comment == "" {
+				continue
+			}
+			if isAssociation(field) || isEmbedded(field) || field.GetTag().GetIgnore() {
+				panic(fmt.Sprintf("Field %s of %s has a comment but is not a column", name, ormable.Name))
+			}
+			comments = append(comments, fmt.Sprintf(`{Table: "%s", Column: "%s", Comment: %s},`, b.tableName(message), columnName(name, field), strconv.Quote(comment)))
 		}
 	}
+	if len(comments) == 0 {
+		return
+	}
 
-	child.Fields[foreignKeyName].ParentOrigName = parent.OriginName
+	name := camelCase(strings.TrimSuffix(path.Base(file.Desc.Path()), ".proto"))
+	g.P(`// `, name, `ColumnComments lists the comments of the columns of the ORM types`)
+	g.P(`// defined in `, path.Base(file.Desc.Path()))
+	g.P(`var `, name, `ColumnComments = []`, generateImport("ColumnComment", gtypesImport, g), `{`)
+	for _, comment := range comments {
+		g.P(comment)
+	}
+	g.P(`}`)
+	g.P()
+	g.P(`// Comment`, name, `Columns sets the comments of `, name, `ColumnComments, it runs`)
+	g.P(`// after AutoMigrate, which creates the columns without them. Setting a`)
+	g.P(`// comment again replaces it, so it runs on every migration.`)
+	g.P(`func Comment`, name, `Columns(db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`if db == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`for _, comment := range `, name, `ColumnComments {`)
+	g.P(`if err := db.Exec(comment.SQL()).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
 }
 
-func (b *ORMBuilder) parseHasMany(msg *protogen.Message, parent *OrmableType, fieldName string, fieldType string, child *OrmableType, opts *gorm.GormFieldOptions) {
-	typeName := camelCase(string(msg.Desc.Name()))
-	hasMany := opts.GetHasMany()
-	if hasMany == nil {
-		hasMany = &gorm.HasManyOptions{}
-		opts.Association = &gorm.GormFieldOptions_HasMany{hasMany}
-	}
-	var assocKey *Field
-	var assocKeyName string
-	if assocKeyName = camelCase(hasMany.GetAssociationForeignkey()); assocKeyName == "" {
-		assocKeyName, assocKey = b.findPrimaryKey(parent)
-	} else {
-		var ok bool
-		assocKey, ok = parent.Fields[assocKeyName]
-		if !ok {
-			panic(fmt.Sprintf("Missing %s field in %s", assocKeyName, parent.Name))
+// generateChecks lists the check constraints of the ormable types of the
+// file with the function adding them after AutoMigrate, the constraints are
+// named chk_{table}_{column} as GORM v2 would
+func (b *ORMBuilder) generateChecks(file *protogen.File, g *protogen.GeneratedFile) {
+	var checks []string
+	for _, message := range file.Messages {
+		if !isOrmable(message) {
+			continue
+		}
+		ormable := b.getOrmable(message.GoIdent.GoName)
+		var fieldNames []string
+		for name := range ormable.Fields {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+		for _, name := range fieldNames {
+			field := ormable.Fields[name]
+			check := field.GetTag().GetCheck()
+			if check == "" {
+				continue
+			}
+			if isAssociation(field) || isEmbedded(field) || field.GetTag().GetIgnore() {
+				panic(fmt.Sprintf("Field %s of %s has a check but is not a column", name, ormable.Name))
+			}
+			if b.dbEngine == ENGINE_SQLITE {
+				panic(fmt.Sprintf("check of %s in %s is not supported by sqlite, which cannot add a constraint to a table", name, ormable.Name))
+			}
+			checkExpression(ormable, name, "check", check)
+			checks = append(checks, fmt.Sprintf(`{Name: "chk_%s_%s", Table: "%s", Expression: %s},`,
+				b.ormableBaseTableName(ormable), columnName(name, field), b.tableName(message), strconv.Quote(check)))
 		}
 	}
+	if len(checks) == 0 {
+		return
+	}
 
-	hasMany.AssociationForeignkey = assocKeyName
-	var foreignKeyType string
-	if hasMany.GetForeignkeyTag().GetNotNull() {
-		foreignKeyType = strings.TrimPrefix(assocKey.Type, "*")
-	} else if strings.HasPrefix(assocKey.Type, "*") {
-		foreignKeyType = assocKey.Type
-	} else if strings.Contains(assocKey.Type, "[]byte") {
-		foreignKeyType = assocKey.Type
-	} else {
-		foreignKeyType = "*" + assocKey.Type
+	name := camelCase(strings.TrimSuffix(path.Base(file.Desc.Path()), ".proto"))
+	g.P(`// `, name, `Checks lists the check constraints of the ORM types defined in`)
+	g.P(`// `, path.Base(file.Desc.Path()))
+	g.P(`var `, name, `Checks = []`, generateImport("CheckDef", gtypesImport, g), `{`)
+	for _, check := range checks {
+		g.P(check)
 	}
-	foreignKey := &Field{Type: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gorm.GormFieldOptions{Tag: hasMany.GetForeignkeyTag()}}
-	var foreignKeyName string
-	if foreignKeyName = hasMany.GetForeignkey(); foreignKeyName == "" {
-		if b.countHasAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
-		}
+	g.P(`}`)
+	g.P()
+	g.P(`// Add`, name, `Checks adds the constraints of `, name, `Checks, it runs after`)
+	g.P(`// AutoMigrate, which creates the tables without them. A constraint is`)
+	g.P(`// skipped if its table has it already, so it runs on every migration.`)
+	g.P(`func Add`, name, `Checks(db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`if db == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`for _, check := range `, name, `Checks {`)
+	g.P(`var count int`)
+	if b.dbEngine == ENGINE_POSTGRES {
+		g.P(`if err := db.Raw("SELECT count(*) FROM pg_constraint WHERE conrelid = to_regclass(?) AND conname = ?", check.Table, check.Name).Row().Scan(&count); err != nil {`)
+	} else {
+		g.P(`if err := db.Raw("SELECT count(*) FROM information_schema.table_constraints WHERE table_schema = DATABASE() AND table_name = ? AND constraint_name = ?", check.Table, check.Name).Row().Scan(&count); err != nil {`)
 	}
-	hasMany.Foreignkey = foreignKeyName
-	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
-		panic(fmt.Sprintf("Object %s from package %s cannot be user for has-many in %s since it does not have FK field %s defined. Manually define the key, or switch to many-to-many.",
-			child.Name, child.Package, parent.Name, foreignKeyName))
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if count > 0 {`)
+	g.P(`continue`)
+	g.P(`}`)
+	g.P(`if err := db.Exec(check.SQL()).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
 
+// softDeleted returns the condition of the ORM object v of the message
+// typeName being soft deleted and left out of the ToPB of an association,
+// empty if the type is not soft deleted
+func (b *ORMBuilder) softDeleted(typeName, v string, g *protogen.GeneratedFile) string {
+	deletedAt, ok := b.getOrmable(typeName).Fields["DeletedAt"]
+	if !ok {
+		return ""
 	}
-	if exField, ok := child.Fields[foreignKeyName]; !ok {
-		child.Fields[foreignKeyName] = foreignKey
-	} else {
-		if exField.Type == "interface{}" {
-			exField.Type = foreignKey.Type
-		} else if !b.sameType(exField, foreignKey) {
-			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
-				foreignKeyName, child.Name, exField.Type, foreignKey.Type))
-		}
+	set := v + `.DeletedAt != nil`
+	if !strings.HasPrefix(deletedAt.Type, "*") {
+		set = `!` + v + `.DeletedAt.IsZero()`
 	}
-	child.Fields[foreignKeyName].ParentOrigName = parent.OriginName
+	return set + ` && !` + generateImport("IncludeDeleted", gtypesImport, g) + `(ctx)`
+}
 
-	var posField string
-	if posField = camelCase(hasMany.GetPositionField()); posField != "" {
-		if exField, ok := child.Fields[posField]; !ok {
-			child.Fields[posField] = &Field{Type: "int", GormFieldOptions: &gorm.GormFieldOptions{Tag: hasMany.GetPositionFieldTag()}}
-		} else {
-			if !strings.Contains(exField.Type, "int") {
-				panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type.",
-					posField, child.Name))
+// generateSchemaHash hashes the tables, columns, types and tags of all the
+// ormable types of the file, so that any schema change changes the constant
+func (b *ORMBuilder) generateSchemaHash(file *protogen.File, g *protogen.GeneratedFile) {
+	var tables []string
+	for _, message := range file.Messages {
+		if !isOrmable(message) {
+			continue
+		}
+		var columns []string
+		var addColumns func(ormable *OrmableType, prefix string)
+		addColumns = func(ormable *OrmableType, prefix string) {
+			for name, field := range ormable.Fields {
+				if isAssociation(field) || field.GetTag().GetIgnore() {
+					continue
+				}
+				// the columns of an embedded struct are part of the table
+				if isEmbedded(field) {
+					addColumns(b.getOrmable(field.Type), prefix+field.GetTag().GetEmbeddedPrefix())
+					continue
+				}
+				// package aliases depend on the file, only the type name is stable
+				fieldType := field.Type
+				if i := strings.LastIndex(fieldType, "."); i >= 0 {
+					base := strings.TrimLeft(fieldType, "*[]")
+					fieldType = fieldType[:len(fieldType)-len(base)] + fieldType[i+1:]
+				}
+				column := fmt.Sprintf("%s %s %s", prefix+columnName(name, field), fieldType, b.renderGormTag(ormable, name, field))
+				// the typed and partial indexes are not part of the rendered tag
+				where := field.GetTag().GetIndexWhere()
+				if index := field.GetTag().GetIndex(); index != "" && !b.gormCreatesIndex(ormable, name, field, false) {
+					column += " index:" + index
+				}
+				if index := field.GetTag().GetUniqueIndex(); index != "" && !b.gormCreatesIndex(ormable, name, field, true) {
+					column += " unique_index:" + index
+				}
+				if where != "" {
+					column += " index_where:" + where
+				}
+				columns = append(columns, column)
 			}
 		}
-		hasMany.PositionField = posField
+		addColumns(b.getOrmable(message.GoIdent.GoName), "")
+		sort.Strings(columns)
+		tables = append(tables, fmt.Sprintf("%s\n%s", b.tableName(message), strings.Join(columns, "\n")))
+	}
+	if len(tables) == 0 {
+		return
 	}
+	sort.Strings(tables)
+
+	name := camelCase(strings.TrimSuffix(path.Base(file.Desc.Path()), ".proto"))
+	g.P(`// `, name, `SchemaHash identifies the schema of the ORM types defined in `, path.Base(file.Desc.Path()))
+	g.P(`const `, name, `SchemaHash = "`, fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(tables, "\n\n")))), `"`)
+	g.P()
 }
 
-func (b *ORMBuilder) parseBelongsTo(msg *protogen.Message, child *OrmableType, fieldName string, fieldType string, parent *OrmableType, opts *gorm.GormFieldOptions) {
-	belongsTo := opts.GetBelongsTo()
-	if belongsTo == nil {
-		belongsTo = &gorm.BelongsToOptions{}
-		opts.Association = &gorm.GormFieldOptions_BelongsTo{belongsTo}
-	}
-	var assocKey *Field
-	var assocKeyName string
-	if assocKeyName = camelCase(belongsTo.GetAssociationForeignkey()); assocKeyName == "" {
-		assocKeyName, assocKey = b.findPrimaryKey(parent)
-	} else {
-		var ok bool
-		assocKey, ok = parent.Fields[assocKeyName]
-		if !ok {
-			panic(fmt.Sprintf("Missing %s field in %s", assocKeyName, parent.Name))
+// generateCallbacks generates a function registering the GORM callbacks of
+// all the ormable types of the file at once, so that the behaviors the default
+// handlers have inlined also apply to ad-hoc queries
+func (b *ORMBuilder) generateCallbacks(file *protogen.File, g *protogen.GeneratedFile) {
+	var readOnly, noAutoTimestamps, emitChanges []*protogen.Message
+	hasOrmable := false
+	for _, message := range file.Messages {
+		if !isOrmable(message) {
+			continue
+		}
+		hasOrmable = true
+		if len(b.readOnlyColumns(message)) > 0 {
+			readOnly = append(readOnly, message)
+		}
+		if len(b.autoTimestamps(b.getOrmable(message.GoIdent.GoName), true)) > 0 {
+			noAutoTimestamps = append(noAutoTimestamps, message)
+		}
+		if getMessageOptions(message).GetEmitChanges() {
+			emitChanges = append(emitChanges, message)
 		}
 	}
-	belongsTo.AssociationForeignkey = assocKeyName
-	var foreignKeyType string
-	if belongsTo.GetForeignkeyTag().GetNotNull() {
-		foreignKeyType = strings.TrimPrefix(assocKey.Type, "*")
-	} else if strings.HasPrefix(assocKey.Type, "*") {
-		foreignKeyType = assocKey.Type
-	} else if strings.Contains(assocKey.Type, "[]byte") {
-		foreignKeyType = assocKey.Type
-	} else {
-		foreignKeyType = "*" + assocKey.Type
+	if !hasOrmable {
+		return
 	}
-	foreignKey := &Field{Type: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gorm.GormFieldOptions{Tag: belongsTo.GetForeignkeyTag()}}
-	var foreignKeyName string
-	if foreignKeyName = camelCase(belongsTo.GetForeignkey()); foreignKeyName == "" {
-		if b.countBelongsToAssociationDimension(msg, fieldType) == 1 {
-			foreignKeyName = fmt.Sprintf(fieldType + assocKeyName)
-		} else {
-			foreignKeyName = fmt.Sprintf(fieldName + assocKeyName)
+
+	base := strings.TrimSuffix(path.Base(file.Desc.Path()), ".proto")
+	name := camelCase(base)
+	prefix := fmt.Sprintf("protoc-gen-gorm:%s:%s", file.Desc.Package(), base)
+	gormScope := generateImport("Scope", gormImport, g)
+
+	g.P(`// Register`, name, `Callbacks registers the GORM callbacks of the ORM types defined`)
+	g.P(`// in `, path.Base(file.Desc.Path()), `, registering them again replaces the previous ones`)
+	g.P(`func Register`, name, `Callbacks(db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`if db == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	if b.dbEngine == ENGINE_POSTGRES {
+		// the create and update handlers read the written row back
+		g.P(generateImport("Register", returningImport, g), `(db)`)
+	}
+	if len(readOnly) > 0 {
+		g.P(`db.Callback().Update().Before("gorm:assign_updating_attributes").Register("`, prefix, `:read_only", func(scope *`, gormScope, `) {`)
+		g.P(`switch scope.Value.(type) {`)
+		for _, message := range readOnly {
+			ormName := b.getOrmable(message.GoIdent.GoName).Name
+			g.P(`case *`, ormName, `, `, ormName, `:`)
+			g.P(`scope.Search.Omit("`, strings.Join(b.readOnlyColumns(message), `", "`), `")`)
 		}
+		g.P(`}`)
+		g.P(`})`)
 	}
-	belongsTo.Foreignkey = foreignKeyName
-	if exField, ok := child.Fields[foreignKeyName]; !ok {
-		child.Fields[foreignKeyName] = foreignKey
-	} else {
-		if exField.Type == "interface{}" {
-			exField.Type = foreignKeyType
-		} else if !b.sameType(exField, foreignKey) {
-			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
-				foreignKeyName, child.Name, exField.Type, foreignKey.Type))
+	if len(noAutoTimestamps) > 0 {
+		var cases []string
+		for _, message := range noAutoTimestamps {
+			ormName := b.getOrmable(message.GoIdent.GoName).Name
+			cases = append(cases, `*`+ormName, ormName)
 		}
-	}
-	child.Fields[foreignKeyName].ParentOrigName = parent.OriginName
+		g.P(generateImport("Skip", timestampsImport, g), `(db, "`, prefix, `:no_auto_timestamps", func(value interface{}) bool {`)
+		g.P(`switch value.(type) {`)
+		g.P(`case `, strings.Join(cases, `, `), `:`)
+		g.P(`return true`)
+		g.P(`}`)
+		g.P(`return false`)
+		g.P(`})`)
+	}
+	if len(emitChanges) > 0 {
+		changesOp := generateImport("Op", changesImport, g)
+		g.P(generateImport("Register", changesImport, g), `(db, "`, prefix, `:emit_changes", func(op `, changesOp, `, value interface{}) error {`)
+		g.P(`switch v := value.(type) {`)
+		for _, message := range emitChanges {
+			typeName := message.GoIdent.GoName
+			ormName := b.getOrmable(typeName).Name
+			g.P(`case *`, ormName, `:`)
+			g.P(`return publish`, typeName, `Change(op, v)`)
+			g.P(`case `, ormName, `:`)
+			g.P(`return publish`, typeName, `Change(op, &v)`)
+		}
+		g.P(`}`)
+		g.P(`return nil`)
+		g.P(`})`)
+	}
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
 }
 
-func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := string(msg.Desc.Name())
-	ormable, ok := b.ormableTypes[typeName]
-	if !ok {
-		panic("typeName should be found")
+// generateChangeStream emits the {Type}ChangeEvent of a type with
+// emit_changes, the broker of its subscribers and Subscribe{Type}
+func (b *ORMBuilder) generateChangeStream(g *protogen.GeneratedFile, message *protogen.Message) {
+	if !getMessageOptions(message).GetEmitChanges() {
+		return
 	}
-	ormable.Name = fmt.Sprintf("%sORM", typeName) // TODO: there are no reason to do it here
-
-	for _, field := range msg.Fields {
-		fd := field.Desc
-		options := fd.Options().(*descriptorpb.FieldOptions)
-		gormOptions := getFieldOptions(options)
-		if gormOptions == nil {
-			gormOptions = &gorm.GormFieldOptions{}
-		}
-		if gormOptions.GetDrop() {
-			continue
-		}
-
-		tag := gormOptions.Tag
-		fieldName := camelCase(string(fd.Name()))
-		fieldType := fd.Kind().String()
-
-		var typePackage string
-
-		if b.dbEngine == ENGINE_POSTGRES && b.IsAbleToMakePQArray(fieldType) && field.Desc.IsList() {
-			switch fieldType {
-			case "bool":
-				fieldType = generateImport("BoolArray", pqImport, g)
-				gormOptions.Tag = tagWithType(tag, "bool[]")
-			case "double":
-				fieldType = generateImport("Float64Array", pqImport, g)
-				gormOptions.Tag = tagWithType(tag, "float[]")
-			case "int64":
-				fieldType = generateImport("Int64Array", pqImport, g)
-				gormOptions.Tag = tagWithType(tag, "integer[]")
-			case "string":
-				fieldType = generateImport("StringArray", pqImport, g)
-				gormOptions.Tag = tagWithType(tag, "text[]")
-			default:
-				continue
-			}
-		} else if (field.Message == nil || !b.isOrmable(fieldType)) && field.Desc.IsList() {
-			// not implemented
-			continue
-		} else if field.Enum != nil {
-			fieldType = "int32"
-			if b.stringEnums {
-				fieldType = "string"
-			}
-		} else if field.Message != nil {
-			xs := strings.Split(string(field.Message.Desc.FullName()), ".")
-			rawType := xs[len(xs)-1]
-
-			if v, ok := wellKnownTypes[rawType]; ok {
-				fieldType = v
-			} else if rawType == protoTypeUUID {
-				typePackage = uuidImport
-				fieldType = generateImport("UUID", uuidImport, g)
-				if b.dbEngine == ENGINE_POSTGRES {
-					gormOptions.Tag = tagWithType(tag, "uuid")
-				}
-			} else if rawType == protoTypeUUIDValue {
-				typePackage = uuidImport
-				fieldType = "*" + generateImport("UUID", uuidImport, g)
-				if b.dbEngine == ENGINE_POSTGRES {
-					gormOptions.Tag = tagWithType(tag, "uuid")
-				}
-			} else if rawType == protoTypeTimestamp {
-				typePackage = stdTimeImport
-				fieldType = "*" + generateImport("Time", stdTimeImport, g)
-			} else if rawType == protoTypeJSON {
-				if b.dbEngine == ENGINE_POSTGRES {
-					typePackage = gormpqImport
-					fieldType = "*" + generateImport("Jsonb", gormpqImport, g)
-					gormOptions.Tag = tagWithType(tag, "jsonb")
-				} else {
-					// Potential TODO: add types we want to use in other/default DB engine
-					continue
-				}
-			} else if rawType == protoTypeResource {
-				ttype := strings.ToLower(tag.GetType())
-				if strings.Contains(ttype, "char") {
-					ttype = "char"
-				}
-				if field.Desc.IsList() {
-					ttype = "array"
-				}
-				switch ttype {
-				case "uuid", "text", "char", "array", "cidr", "inet", "macaddr":
-					fieldType = "*string"
-				case "smallint", "integer", "bigint", "numeric", "smallserial", "serial", "bigserial":
-					fieldType = "*int64"
-				case "jsonb", "bytea":
-					fieldType = "[]byte"
-				case "":
-					fieldType = "interface{}" // we do not know the type yet (if it association we will fix the type later)
-				default:
-					panic("unknown tag type of atlas.rpc.Identifier")
-				}
-				if tag.GetNotNull() || tag.GetPrimaryKey() {
-					fieldType = strings.TrimPrefix(fieldType, "*")
-				}
-			} else if rawType == protoTypeInet {
-				typePackage = gtypesImport
-				fieldType = "*" + generateImport("Inet", gtypesImport, g)
+	typeName := message.GoIdent.GoName
+	ormName := b.getOrmable(typeName).Name
+	broker := lowerFirst(typeName) + `Changes`
+	changesOp := generateImport("Op", changesImport, g)
+	ctxType := generateImport("Context", stdCtxImport, g)
+
+	g.P(`// `, typeName, `ChangeEvent is a create, update or delete of a `, typeName, ` through GORM, holding`)
+	g.P(`// the `, typeName, ` as it was written`)
+	g.P(`type `, typeName, `ChangeEvent struct {`)
+	g.P(`Op `, changesOp)
+	g.P(typeName, ` *`, typeName)
+	g.P(`}`)
+	g.P()
+	g.P(`var `, broker, ` `, generateImport("Broker", changesImport, g))
+	g.P()
+	g.P(`// Subscribe`, typeName, ` returns a channel of the changes of the `, typeName, ` objects written`)
+	g.P(`// through a db of the Register callbacks, until ctx is done and the channel`)
+	g.P(`// is closed. The policy of opts is what a write does when it is full.`)
+	g.P(`func Subscribe`, typeName, `(ctx `, ctxType, `, opts `, generateImport("Options", changesImport, g), `) <-chan `, typeName, `ChangeEvent {`)
+	g.P(`ch := make(chan `, typeName, `ChangeEvent, opts.Buffer)`)
+	g.P(broker, `.Subscribe(ctx, ch, opts.Policy)`)
+	g.P(`return ch`)
+	g.P(`}`)
+	g.P()
+	g.P(`func publish`, typeName, `Change(op `, changesOp, `, orm *`, ormName, `) error {`)
+	g.P(`if !`, broker, `.Subscribed() {`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P(`pbObj, err := orm.ToPB(`, generateImport("Background", stdCtxImport, g), `())`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(broker, `.Publish(`, typeName, `ChangeEvent{Op: op, `, typeName, `: &pbObj})`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
 
-				if b.dbEngine == ENGINE_POSTGRES {
-					gormOptions.Tag = tagWithType(tag, "inet")
-				} else {
-					gormOptions.Tag = tagWithType(tag, "varchar(48)")
-				}
-			} else if rawType == protoTimeOnly {
-				fieldType = "string"
-				gormOptions.Tag = tagWithType(tag, "time")
-			} else {
-				continue
-			}
+// autoTimestamps returns the CreatedAt and UpdatedAt time fields of the type
+// GORM sets by their names, kept if the type has no_auto_timestamps, or set
+// by GORM otherwise
+func (b *ORMBuilder) autoTimestamps(ormable *OrmableType, kept bool) []string {
+	if ormable.NoAutoTimestamps != kept {
+		return nil
+	}
+	var names []string
+	for _, name := range []string{"CreatedAt", "UpdatedAt"} {
+		if field, ok := ormable.Fields[name]; ok && strings.HasSuffix(field.Type, "time.Time") && !field.GetTouchOnSave() {
+			names = append(names, name)
 		}
+	}
+	return names
+}
 
-		switch fieldType {
-		case "float":
-			fieldType = "float32"
-		case "double":
-			fieldType = "float64"
-		}
+// tableName returns the table of the message, qualified by its schema
+func (b *ORMBuilder) tableName(message *protogen.Message) string {
+	if schema := b.tableSchema(message); schema != "" {
+		return schema + "." + b.baseTableName(message)
+	}
+	return b.baseTableName(message)
+}
 
-		f := &Field{
-			GormFieldOptions: gormOptions,
-			ParentGoType:     "",
-			Type:             fieldType,
-			Package:          typePackage,
-		}
+// baseTableName returns the table of the message without its schema, as
+// used in the names of the indexes and constraints
+func (b *ORMBuilder) baseTableName(message *protogen.Message) string {
+	if opts := getMessageOptions(message); opts != nil && len(opts.Table) > 0 {
+		return opts.GetTable()
+	}
+	return b.naming.TableName(string(message.Desc.Name()))
+}
 
-		if tName := gormOptions.GetReferenceOf(); tName != "" {
-			if _, ok := b.messages[tName]; !ok {
-				panic("unknow")
-			}
-			f.ParentOrigName = tName
-		}
+// plainIdentifier is a name that needs no quoting, e.g. a schema or a column
+var plainIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
 
-		ormable.Fields[fieldName] = f
+// checkTimezoneColumn checks that the with_timezone_column of the field names
+// a string field of the message stored in a column of its own
+func (b *ORMBuilder) checkTimezoneColumn(message *protogen.Message, field *protogen.Field, zone string) {
+	name := message.Desc.Name()
+	if field.Message == nil || field.Message.Desc.FullName() != protoTypeTimestampFull || field.Desc.IsList() {
+		panic(fmt.Sprintf("Field %s of %s has with_timezone_column but is not a google.protobuf.Timestamp", field.Desc.Name(), name))
 	}
-
-	gormMsgOptions := getMessageOptions(msg)
-	if gormMsgOptions.GetMultiAccount() {
-		if accID, ok := ormable.Fields["AccountID"]; !ok {
-			ormable.Fields["AccountID"] = &Field{Type: "string"}
-		} else if accID.Type != "string" {
-			panic("cannot include AccountID field")
+	for _, other := range message.Fields {
+		if string(other.Desc.Name()) != zone {
+			continue
 		}
-	}
-
-	// TODO: GetInclude
-	for _, field := range gormMsgOptions.GetInclude() {
-		fieldName := camelCase(field.GetName())
-		if _, ok := ormable.Fields[fieldName]; !ok {
-			b.addIncludedField(ormable, field, g)
-		} else {
-			panic("cound not include")
+		if other.Desc.Kind() != protoreflect.StringKind || other.Desc.IsList() || getFieldOptions(other.Desc.Options().(*descriptorpb.FieldOptions)).GetDrop() {
+			panic(fmt.Sprintf("with_timezone_column %s of %s.%s must be a singular string field stored by %s", zone, name, field.Desc.Name(), name))
 		}
+		return
 	}
+	panic(fmt.Sprintf("with_timezone_column %s of %s.%s is not a field of %s, add a string field for the zone", zone, name, field.Desc.Name(), name))
 }
 
-func (b *ORMBuilder) addIncludedField(ormable *OrmableType, field *gorm.ExtraField, g *protogen.GeneratedFile) {
-	fieldName := camelCase(field.GetName())
-	isPtr := strings.HasPrefix(field.GetType(), "*")
-	rawType := strings.TrimPrefix(field.GetType(), "*")
-	// cut off any package subpaths
-	rawType = rawType[strings.LastIndex(rawType, ".")+1:]
-	var typePackage string
-	// Handle types with a package defined
-	if field.GetPackage() != "" {
-		rawType = generateImport(rawType, field.GetPackage(), g)
-		typePackage = field.GetPackage()
-	} else {
-		// Handle types without a package defined
-		if _, ok := builtinTypes[rawType]; ok {
-			// basic type, 100% okay, no imports or changes needed
-		} else if rawType == "Time" {
-			// b.UsingGoImports(stdTimeImport) // TODO: missing UsingGoImports
-			rawType = generateImport("Time", stdTimeImport, g)
-		} else if rawType == "UUID" {
-			rawType = generateImport("UUID", uuidImport, g)
-		} else if field.GetType() == "Jsonb" && b.dbEngine == ENGINE_POSTGRES {
-			rawType = generateImport("Jsonb", gormpqImport, g)
-		} else if rawType == "Inet" {
-			rawType = generateImport("Inet", gtypesImport, g)
-		} else {
-			fmt.Fprintf(os.Stderr, "included field %q of type %q is not a recognized special type, and no package specified. This type is assumed to be in the same package as the generated code",
-				field.GetName(), field.GetType())
-		}
+// checkMoney checks that the money field is a singular string of a scale
+// fitting in an int64 and that its currency_field is a string field of the
+// message stored in a column of its own
+func (b *ORMBuilder) checkMoney(message *protogen.Message, field *protogen.Field, money *gorm.MoneyOptions) {
+	name := message.Desc.Name()
+	if field.Desc.Kind() != protoreflect.StringKind || field.Desc.IsList() {
+		panic(fmt.Sprintf("Field %s of %s has money but is not a singular string", field.Desc.Name(), name))
 	}
-	if isPtr {
-		rawType = fmt.Sprintf("*%s", rawType)
+	if scale := money.GetScale(); scale < 0 || scale > 18 {
+		panic(fmt.Sprintf("Field %s of %s has money scale %d, the scale is 0 to 18", field.Desc.Name(), name, scale))
 	}
-	ormable.Fields[fieldName] = &Field{Type: rawType, Package: typePackage, GormFieldOptions: &gorm.GormFieldOptions{Tag: field.GetTag()}}
+	currency := money.GetCurrencyField()
+	if currency == "" {
+		return
+	}
+	for _, other := range message.Fields {
+		if string(other.Desc.Name()) != currency {
+			continue
+		}
+		if other == field || other.Desc.Kind() != protoreflect.StringKind || other.Desc.IsList() || getFieldOptions(other.Desc.Options().(*descriptorpb.FieldOptions)).GetDrop() {
+			panic(fmt.Sprintf("money currency_field %s of %s.%s must be another singular string field stored by %s", currency, name, field.Desc.Name(), name))
+		}
+		return
+	}
+	panic(fmt.Sprintf("money currency_field %s of %s.%s is not a field of %s", currency, name, field.Desc.Name(), name))
 }
 
-func getFieldOptions(options *descriptorpb.FieldOptions) *gorm.GormFieldOptions {
-	if options == nil {
-		return nil
+// checkArrayStorage panics unless the field stored as an ARRAY is a repeated
+// enum of ENUM_STRING elements of a postgres table
+func (b *ORMBuilder) checkArrayStorage(message *protogen.Message, field *protogen.Field, opts *gorm.GormFieldOptions) {
+	name := message.Desc.Name()
+	if opts.GetStoreAs() != gorm.GormFieldOptions_ARRAY || opts.GetArrayElement() != gorm.GormFieldOptions_ENUM_STRING {
+		panic(fmt.Sprintf("Field %s of %s needs store_as ARRAY with array_element ENUM_STRING, the only element type", field.Desc.Name(), name))
 	}
-
-	v := proto.GetExtension(options, gorm.E_Field)
-	if v == nil {
-		return nil
+	if field.Enum == nil || !field.Desc.IsList() {
+		panic(fmt.Sprintf("Field %s of %s is stored as an array of enum names but is not a repeated enum", field.Desc.Name(), name))
 	}
-
-	opts, ok := v.(*gorm.GormFieldOptions)
-	if !ok {
-		return nil
+	if b.dbEngine != ENGINE_POSTGRES {
+		panic(fmt.Sprintf("Field %s of %s is stored as a text[] array, which needs engine=postgres", field.Desc.Name(), name))
 	}
-
-	return opts
 }
 
-// retrieves the GormMessageOptions from a message
-func getMessageOptions(message *protogen.Message) *gorm.GormMessageOptions {
-	options := message.Desc.Options()
-	if options == nil {
-		return nil
+// checkBase64Storage panics unless the field stored as BASE64_TEXT is a
+// singular bytes field
+func (b *ORMBuilder) checkBase64Storage(message *protogen.Message, field *protogen.Field, opts *gorm.GormFieldOptions) {
+	name := message.Desc.Name()
+	if field.Desc.Kind() != protoreflect.BytesKind || field.Desc.IsList() {
+		panic(fmt.Sprintf("Field %s of %s is stored as BASE64_TEXT but is not a singular bytes field", field.Desc.Name(), name))
 	}
-	v := proto.GetExtension(options, gorm.E_Opts)
-	if v == nil {
-		return nil
+	if opts.GetArrayElement() != gorm.GormFieldOptions_ARRAY_ELEMENT_UNSET {
+		panic(fmt.Sprintf("Field %s of %s is stored as BASE64_TEXT, which takes no array_element", field.Desc.Name(), name))
 	}
+}
 
-	opts, ok := v.(*gorm.GormMessageOptions)
-	if !ok {
-		return nil
+// generateBase64Conversion converts a bytes field to its standard base64 and
+// back, an empty column is no bytes
+func (b *ORMBuilder) generateBase64Conversion(field *protogen.Field, toORM bool, g *protogen.GeneratedFile) {
+	fieldName := camelCase(string(field.Desc.Name()))
+	encoding := generateImport("StdEncoding", stdBase64Import, g)
+	if toORM {
+		g.P(`to.`, fieldName, ` = `, encoding, `.EncodeToString(m.`, fieldName, `)`)
+		return
 	}
-
-	return opts
+	g.P(`if m.`, fieldName, ` != "" {`)
+	g.P(`if to.`, fieldName, `, err = `, encoding, `.DecodeString(m.`, fieldName, `); err != nil {`)
+	g.P(`return to, `, generateImport("Errorf", stdFmtImport, g), `("`, fieldName, `: %w", err)`)
+	g.P(`}`)
+	g.P(`}`)
 }
 
-func isOrmable(message *protogen.Message) bool {
-	desc := message.Desc
-	options := desc.Options()
-
-	m, ok := proto.GetExtension(options, gorm.E_Opts).(*gorm.GormMessageOptions)
-	if !ok || m == nil {
-		return false
+// generateEnumArrayConversion converts a repeated enum to the names of its
+// values and back
+func (b *ORMBuilder) generateEnumArrayConversion(field *protogen.Field, toORM bool, g *protogen.GeneratedFile) {
+	fieldName := camelCase(string(field.Desc.Name()))
+	enumType := b.typeName(field.Enum.GoIdent, g)
+	errorf := generateImport("Errorf", stdFmtImport, g)
+	if toORM {
+		g.P(`for _, v := range m.`, fieldName, ` {`)
+		g.P(`name, ok := `, enumType, `_name[int32(v)]`)
+		g.P(`if !ok {`)
+		g.P(`return to, `, errorf, `("`, fieldName, `: %d is not a value of `, field.Enum.Desc.Name(), `", v)`)
+		g.P(`}`)
+		g.P(`to.`, fieldName, ` = append(to.`, fieldName, `, name)`)
+		g.P(`}`)
+		return
 	}
-
-	return m.Ormable
+	g.P(`for _, name := range m.`, fieldName, ` {`)
+	g.P(`v, ok := `, enumType, `_value[name]`)
+	g.P(`if !ok {`)
+	g.P(`return to, `, errorf, `("`, fieldName, `: %q is not a value of `, field.Enum.Desc.Name(), `", name)`)
+	g.P(`}`)
+	g.P(`to.`, fieldName, ` = append(to.`, fieldName, `, `, enumType, `(v))`)
+	g.P(`}`)
 }
 
-func (b *ORMBuilder) IsAbleToMakePQArray(fieldType string) bool {
-	switch fieldType {
-	case "bool", "double", "int64", "string":
-		return true
-	default:
-		return false
+// generateMoneyConversion converts the decimal string of a money field to
+// the count of its minor units and back, the optional field as a pointer
+func (b *ORMBuilder) generateMoneyConversion(message *protogen.Message, field *protogen.Field, money *gorm.MoneyOptions, toORM bool, g *protogen.GeneratedFile) {
+	fieldName := camelCase(string(field.Desc.Name()))
+	scale := fmt.Sprint(money.GetScale())
+	optional := field.Desc.HasOptionalKeyword()
+	value := `m.` + fieldName
+	if optional {
+		g.P(`if m.`, fieldName, ` != nil {`)
+		value = `*m.` + fieldName
+	}
+	if !toORM {
+		if optional {
+			g.P(`v := `, generateImport("FormatMinorUnits", gtypesImport, g), `(`, value, `, `, scale, `)`)
+			g.P(`to.`, fieldName, ` = &v`)
+			g.P(`}`)
+		} else {
+			g.P(`to.`, fieldName, ` = `, generateImport("FormatMinorUnits", gtypesImport, g), `(`, value, `, `, scale, `)`)
+		}
+		return
+	}
+	target := `to.` + fieldName
+	if optional {
+		g.P(`to.`, fieldName, ` = new(int64)`)
+		target = `*to.` + fieldName
+	}
+	// an empty amount is zero
+	g.P(`if `, value, ` != "" {`)
+	g.P(`if `, target, `, err = `, generateImport("ParseMinorUnits", gtypesImport, g), `(`, value, `, `, scale, `); err != nil {`)
+	g.P(`return to, `, generateImport("Errorf", stdFmtImport, g), `("`, fieldName, `: %v", err)`)
+	g.P(`}`)
+	g.P(`}`)
+	if currency := money.GetCurrencyField(); currency != "" {
+		for _, other := range message.Fields {
+			if string(other.Desc.Name()) == currency {
+				g.P(`if `, target, ` != 0 && m.`, other.GoName, ` == "" {`)
+				g.P(`return to, `, generateImport("Errorf", stdFmtImport, g), `("`, fieldName, ` %s has no `, other.GoName, `", `, value, `)`)
+				g.P(`}`)
+			}
+		}
+	}
+	if optional {
+		g.P(`}`)
 	}
 }
 
-func tagWithType(tag *gorm.GormTag, typename string) *gorm.GormTag {
-	if tag == nil {
-		tag = &gorm.GormTag{}
+// timezoneField returns the Go name of the with_timezone_column field of the
+// timestamp field, or an empty string
+func timezoneField(message *protogen.Message, field *protogen.Field) string {
+	zone := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions)).GetWithTimezoneColumn()
+	for _, other := range message.Fields {
+		if zone != "" && string(other.Desc.Name()) == zone {
+			return other.GoName
+		}
 	}
+	return ""
+}
 
-	tag.Type = typename
-	return tag
+// generateTimezoneFunctions generates the {Field}InZone methods of the
+// timestamps stored with the zone in a with_timezone_column
+func (b *ORMBuilder) generateTimezoneFunctions(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	for _, field := range message.Fields {
+		zone := timezoneField(message, field)
+		if zone == "" {
+			continue
+		}
+		timeType := generateImport("Time", stdTimeImport, g)
+		g.P(`// `, field.GoName, `InZone returns the `, field.GoName, ` in the zone of `, zone, `, the zero time if it is`)
+		g.P(`// not set and UTC for an empty zone`)
+		g.P(`func (m *`, typeName, `) `, field.GoName, `InZone() (`, timeType, `, error) {`)
+		g.P(`if m.Get`, field.GoName, `() == nil {`)
+		g.P(`return `, timeType, `{}, nil`)
+		g.P(`}`)
+		g.P(`loc, err := `, generateImport("LoadLocation", stdTimeImport, g), `(m.Get`, zone, `())`)
+		g.P(`if err != nil {`)
+		g.P(`return `, timeType, `{}, err`)
+		g.P(`}`)
+		g.P(`return m.`, field.GoName, `.AsTime().In(loc), nil`)
+		g.P(`}`)
+		g.P()
+	}
 }
 
-func camelCase(s string) string {
-	if s == "" {
+// tableSchema returns the schema option of the message, only postgres has
+// schema-qualified table names
+func (b *ORMBuilder) tableSchema(message *protogen.Message) string {
+	schema := getMessageOptions(message).GetSchema()
+	if schema == "" {
 		return ""
 	}
-	t := make([]byte, 0, 32)
-	i := 0
-	if s[0] == '_' {
-		// Need a capital letter; drop the '_'.
-		t = append(t, 'X')
-		i++
+	if b.dbEngine != ENGINE_POSTGRES {
+		panic(fmt.Sprintf("schema of %s needs engine=postgres", message.Desc.Name()))
 	}
-	// Invariant: if the next letter is lower case, it must be converted
-	// to upper case.
-	// That is, we process a word at a time, where words are marked by _ or
-	// upper case letter. Digits are treated as words.
-	for ; i < len(s); i++ {
-		c := s[i]
-		if c == '_' && i+1 < len(s) && isASCIILower(s[i+1]) {
-			continue // Skip the underscore in s.
-		}
-		if isASCIIDigit(c) {
-			t = append(t, c)
-			continue
-		}
-		// Assume we have a letter now - if not, it's a bogus identifier.
-		// The next word is a sequence of characters that must start upper case.
-		if isASCIILower(c) {
-			c ^= ' ' // Make it a capital letter.
-		}
-		t = append(t, c) // Guaranteed not lower case.
-		// Accept lower case sequence that follows.
-		for i+1 < len(s) && isASCIILower(s[i+1]) {
-			i++
-			t = append(t, s[i])
-		}
+	if !plainIdentifier.MatchString(schema) {
+		panic(fmt.Sprintf("schema %q of %s is not a plain identifier", schema, message.Desc.Name()))
 	}
-	return string(t)
+	return schema
 }
 
-func isASCIILower(c byte) bool {
-	return 'a' <= c && c <= 'z'
-}
+func (b *ORMBuilder) generateTableNameFunctions(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
 
-func isASCIIDigit(c byte) bool {
-	return '0' <= c && c <= '9'
+	g.P(`// TableName overrides the default tablename generated by GORM`)
+	g.P(`func (`, typeName, `ORM) TableName() string {`)
+	g.P(`return "`, b.tableName(message), `"`)
+	g.P(`}`)
 }
 
-func (b *ORMBuilder) renderGormTag(field *Field) string {
-	var gormRes, atlasRes string
-	tag := field.GetTag()
-	if tag == nil {
-		tag = &gorm.GormTag{}
-	}
+func (b *ORMBuilder) generateClearAssociations(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
 
-	if len(tag.Column) > 0 {
-		gormRes += fmt.Sprintf("column:%s;", tag.GetColumn())
+	var names []string
+	for name, field := range ormable.Fields {
+		if isAssociation(field) {
+			names = append(names, name)
+		}
 	}
-	if len(tag.Type) > 0 {
-		gormRes += fmt.Sprintf("type:%s;", tag.GetType())
+	sort.Strings(names)
+
+	g.P(`// ClearAssociations nils out every association field of the ORM object, leaving`)
+	g.P(`// scalar fields intact, so that GORM will not cascade saves to the children`)
+	g.P(`func (m *`, ormable.Name, `) ClearAssociations() {`)
+	for _, name := range names {
+		g.P(`m.`, name, ` = nil`)
 	}
-	if tag.GetSize() > 0 {
-		gormRes += fmt.Sprintf("size:%d;", tag.GetSize())
+	g.P(`}`)
+	g.P()
+}
+
+// generateImmutableHook emits the gorm BeforeUpdate callback of an immutable
+// type, which rejects every update of its rows
+func (b *ORMBuilder) generateImmutableHook(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	if !ormable.Immutable {
+		return
 	}
-	if tag.Precision > 0 {
-		gormRes += fmt.Sprintf("precision:%d;", tag.GetPrecision())
+	g.P(`// BeforeUpdate is called by gorm before updating the row, `, ormable.OriginName, ` is immutable`)
+	g.P(`// and is never updated`)
+	g.P(`func (m *`, ormable.Name, `) BeforeUpdate() error {`)
+	g.P(`return `, generateImport("ImmutableError", gerrorsImport, g))
+	g.P(`}`)
+	g.P()
+}
+
+// parseShard checks the shard_by field and the shard_resolver of the message
+func (b *ORMBuilder) parseShard(message *protogen.Message) {
+	opts := getMessageOptions(message)
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	if opts.GetShardBy() == "" && opts.GetShardResolver() == "" {
+		return
 	}
-	if tag.GetPrimaryKey() {
-		gormRes += "primary_key;"
+	if opts.GetShardBy() == "" || opts.GetShardResolver() == "" {
+		panic(fmt.Sprintf("%s needs both shard_by and shard_resolver", ormable.Name))
 	}
-	if tag.GetUnique() {
-		gormRes += "unique;"
+	var shardField *protogen.Field
+	for _, field := range message.Fields {
+		if string(field.Desc.Name()) == opts.GetShardBy() {
+			shardField = field
+		}
 	}
-	if len(tag.Default) > 0 {
-		gormRes += fmt.Sprintf("default:%s;", tag.GetDefault())
+	if shardField == nil || getFieldOptions(shardField.Desc.Options().(*descriptorpb.FieldOptions)).GetDrop() {
+		panic(fmt.Sprintf("shard_by of %s is %s, which is not a field stored by %s", ormable.Name, opts.GetShardBy(), ormable.Name))
 	}
-	if tag.GetNotNull() {
-		gormRes += "not null;"
+	// the getter of the API object gives the value the ORM field stores
+	switch shardField.Desc.Kind() {
+	case protoreflect.StringKind, protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind, protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+	default:
+		panic(fmt.Sprintf("shard_by field %s of %s must be a string or an integer", opts.GetShardBy(), ormable.Name))
 	}
-	if tag.GetAutoIncrement() {
-		gormRes += "auto_increment;"
+	if shardField.Desc.IsList() || shardField.Desc.HasPresence() {
+		panic(fmt.Sprintf("shard_by field %s of %s must be a singular field without presence", opts.GetShardBy(), ormable.Name))
 	}
-	if len(tag.Index) > 0 {
-		if tag.GetIndex() == "" {
-			gormRes += "index;"
-		} else {
-			gormRes += fmt.Sprintf("index:%s;", tag.GetIndex())
-		}
+	resolver := opts.GetShardResolver()
+	i := strings.LastIndex(resolver, ".")
+	if i <= 0 || i == len(resolver)-1 {
+		panic(fmt.Sprintf("shard_resolver of %s must be a function as {goImportPath}.{FuncName}, got %q", ormable.Name, resolver))
 	}
-	if len(tag.UniqueIndex) > 0 {
-		if tag.GetUniqueIndex() == "" {
-			gormRes += "unique_index;"
-		} else {
-			gormRes += fmt.Sprintf("unique_index:%s;", tag.GetUniqueIndex())
-		}
+	ormable.ShardBy = shardField.GoName
+	ormable.ShardResolver = protogen.GoIdent{GoName: resolver[i+1:], GoImportPath: protogen.GoImportPath(resolver[:i])}
+}
+
+// parseTenantIsolation checks the tenant_isolation of the message, a schema
+// per tenant is set as the search_path of postgres
+func (b *ORMBuilder) parseTenantIsolation(message *protogen.Message) {
+	opts := getMessageOptions(message)
+	if opts.GetTenantIsolation() != gorm.GormMessageOptions_SCHEMA {
+		return
 	}
-	if tag.GetEmbedded() {
-		gormRes += "embedded;"
+	name := message.Desc.Name()
+	if b.dbEngine != ENGINE_POSTGRES {
+		panic(fmt.Sprintf("tenant_isolation SCHEMA of %s needs engine=postgres", name))
 	}
-	if len(tag.EmbeddedPrefix) > 0 {
-		gormRes += fmt.Sprintf("embedded_prefix:%s;", tag.GetEmbeddedPrefix())
+	if b.schemaExtractor.GoName == "" {
+		panic(fmt.Sprintf("tenant_isolation SCHEMA of %s requires tenant_schema_extractor to be set to a function as {goImportPath}.{FuncName}", name))
 	}
-	if tag.GetIgnore() {
-		gormRes += "-;"
+	if opts.GetSchema() != "" {
+		panic(fmt.Sprintf("%s has both a schema and tenant_isolation SCHEMA, its tables must stay unqualified", name))
 	}
+}
 
-	var foreignKey, associationForeignKey, joinTable, joinTableForeignKey, associationJoinTableForeignKey string
-	var associationAutoupdate, associationAutocreate, associationSaveReference, preload, replace, append, clear bool
-	if hasOne := field.GetHasOne(); hasOne != nil {
-		foreignKey = hasOne.Foreignkey
-		associationForeignKey = hasOne.AssociationForeignkey
-		associationAutoupdate = hasOne.AssociationAutoupdate
-		associationAutocreate = hasOne.AssociationAutocreate
-		associationSaveReference = hasOne.AssociationSaveReference
-		preload = hasOne.Preload
-		clear = hasOne.Clear
-		replace = hasOne.Replace
-		append = hasOne.Append
-	} else if belongsTo := field.GetBelongsTo(); belongsTo != nil {
-		foreignKey = belongsTo.Foreignkey
-		associationForeignKey = belongsTo.AssociationForeignkey
-		associationAutoupdate = belongsTo.AssociationAutoupdate
-		associationAutocreate = belongsTo.AssociationAutocreate
-		associationSaveReference = belongsTo.AssociationSaveReference
-		preload = belongsTo.Preload
-	} else if hasMany := field.GetHasMany(); hasMany != nil {
-		foreignKey = hasMany.Foreignkey
-		associationForeignKey = hasMany.AssociationForeignkey
-		associationAutoupdate = hasMany.AssociationAutoupdate
-		associationAutocreate = hasMany.AssociationAutocreate
-		associationSaveReference = hasMany.AssociationSaveReference
-		clear = hasMany.Clear
-		preload = hasMany.Preload
-		replace = hasMany.Replace
-		append = hasMany.Append
-		if len(hasMany.PositionField) > 0 {
-			atlasRes += fmt.Sprintf("position:%s;", hasMany.GetPositionField())
-		}
-	} else if mtm := field.GetManyToMany(); mtm != nil {
-		foreignKey = mtm.Foreignkey
-		associationForeignKey = mtm.AssociationForeignkey
-		joinTable = mtm.Jointable
-		joinTableForeignKey = mtm.JointableForeignkey
-		associationJoinTableForeignKey = mtm.AssociationJointableForeignkey
-		associationAutoupdate = mtm.AssociationAutoupdate
-		associationAutocreate = mtm.AssociationAutocreate
-		associationSaveReference = mtm.AssociationSaveReference
-		preload = mtm.Preload
-		clear = mtm.Clear
-		replace = mtm.Replace
-		append = mtm.Append
-	} else {
-		foreignKey = tag.Foreignkey
-		associationForeignKey = tag.AssociationForeignkey
-		joinTable = tag.ManyToMany
-		joinTableForeignKey = tag.JointableForeignkey
-		associationJoinTableForeignKey = tag.AssociationJointableForeignkey
-		associationAutoupdate = tag.AssociationAutoupdate
-		associationAutocreate = tag.AssociationAutocreate
-		associationSaveReference = tag.AssociationSaveReference
-		preload = tag.Preload
-	}
+// tenantSchema reports whether the handlers of the message set the search_path
+// to the schema of the tenant
+func tenantSchema(message *protogen.Message) bool {
+	return getMessageOptions(message).GetTenantIsolation() == gorm.GormMessageOptions_SCHEMA
+}
 
-	if len(foreignKey) > 0 {
-		gormRes += fmt.Sprintf("foreignkey:%s;", foreignKey)
-	}
+// sessionScoped reports whether the handlers of the message set a session
+// variable, the row-level security one or the search_path of the tenant
+func (b *ORMBuilder) sessionScoped(message *protogen.Message) bool {
+	return b.rlsSessionVar != "" || tenantSchema(message)
+}
 
-	if len(associationForeignKey) > 0 {
-		gormRes += fmt.Sprintf("association_foreignkey:%s;", associationForeignKey)
+// parseBackReferences checks the set_back_reference of the has-one and
+// has-many fields once the associations of all the types are known, the
+// back-reference must be the belongs-to field of the same foreign key
+func (b *ORMBuilder) parseBackReferences() {
+	for _, ormable := range b.ormableTypes {
+		for name, field := range ormable.Fields {
+			backRef := field.GetSetBackReference()
+			if backRef == "" {
+				continue
+			}
+			var foreignKey string
+			if hasMany := field.GetHasMany(); hasMany != nil {
+				foreignKey = hasMany.GetForeignkey()
+			} else if hasOne := field.GetHasOne(); hasOne != nil {
+				foreignKey = hasOne.GetForeignkey()
+			} else {
+				panic(fmt.Sprintf("set_back_reference of field %s of %s needs a has-one or has-many association", name, ormable.Name))
+			}
+			child := b.getOrmable(field.Type)
+			ref, ok := child.Fields[camelCase(backRef)]
+			if !ok || ref.GetBelongsTo() == nil || b.getOrmable(ref.Type) != ormable {
+				panic(fmt.Sprintf("set_back_reference of field %s of %s is %s, which is not a belongs-to %s field of %s", name, ormable.Name, backRef, ormable.OriginName, child.Name))
+			}
+			if ref.GetBelongsTo().GetForeignkey() != foreignKey {
+				panic(fmt.Sprintf("set_back_reference %s of field %s of %s has the foreign key %s, the association has %s", backRef, name, ormable.Name, ref.GetBelongsTo().GetForeignkey(), foreignKey))
+			}
+			field.SetBackReference = camelCase(backRef)
+			ref.BackReference = true
+		}
 	}
+}
 
-	if len(joinTable) > 0 {
-		gormRes += fmt.Sprintf("many2many:%s;", joinTable)
-	}
-	if len(joinTableForeignKey) > 0 {
-		gormRes += fmt.Sprintf("jointable_foreignkey:%s;", joinTableForeignKey)
-	}
-	if len(associationJoinTableForeignKey) > 0 {
-		gormRes += fmt.Sprintf("association_jointable_foreignkey:%s;", associationJoinTableForeignKey)
+// backReferences returns the sorted has-one and has-many fields of the type
+// setting a back-reference
+func backReferences(ormable *OrmableType) []string {
+	var names []string
+	for name, field := range ormable.Fields {
+		if field.GetSetBackReference() != "" {
+			names = append(names, name)
+		}
 	}
+	sort.Strings(names)
+	return names
+}
 
-	if associationAutoupdate {
-		gormRes += fmt.Sprintf("association_autoupdate:%s;", strconv.FormatBool(associationAutoupdate))
+// generateWithinScopes emits the {Type}{Field}Within scopes of the GeoPoint
+// fields of the type, selecting the rows within a distance of a point
+func (b *ORMBuilder) generateWithinScopes(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	typeName := string(message.Desc.Name())
+	for _, field := range message.Fields {
+		if field.Message == nil || field.Message.Desc.FullName() != protoTypeGeoPointFull {
+			continue
+		}
+		fieldName := camelCase(field.GoName)
+		ormField, ok := ormable.Fields[fieldName]
+		if !ok || ormField.GetDrop() {
+			continue
+		}
+		column := b.tableName(message) + "." + columnName(fieldName, ormField)
+		// a geometry column measures in degrees
+		if strings.HasPrefix(strings.ToLower(ormField.GetTag().GetType()), "geometry") {
+			column += "::geography"
+		}
+		g.P(`// `, typeName, fieldName, `Within returns a scope of the `, ormable.Name, ` rows with a `, fieldName)
+		g.P(`// within meters of the point, by ST_DWithin of PostGIS`)
+		g.P(`func `, typeName, fieldName, `Within(point `, generateImport("Point", gtypesImport, g), `, meters float64) func(*`, generateImport("DB", gormImport, g), `) *`, generateImport("DB", gormImport, g), ` {`)
+		g.P(`return func(db *`, generateImport("DB", gormImport, g), `) *`, generateImport("DB", gormImport, g), ` {`)
+		g.P(`return db.Where("ST_DWithin(`, column, `, ?::geography, ?)", point, meters)`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P()
 	}
+}
 
-	if associationAutocreate {
-		gormRes += fmt.Sprintf("association_autocreate:%s;", strconv.FormatBool(associationAutocreate))
+// generateBackReferenceHook emits the gorm AfterFind callback pointing the
+// back-references of the children at the row. GORM calls it after the
+// preload of the associations.
+func (b *ORMBuilder) generateBackReferenceHook(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	names := backReferences(ormable)
+	if len(names) == 0 {
+		return
 	}
-
-	if associationSaveReference {
-		gormRes += fmt.Sprintf("association_save_reference:%s;", strconv.FormatBool(associationSaveReference))
+	g.P(`// AfterFind is called by gorm after loading the row and its associations, it`)
+	g.P(`// sets the back-references of `, strings.Join(names, ", "), ` to m`)
+	g.P(`func (m *`, ormable.Name, `) AfterFind() error {`)
+	for _, name := range names {
+		backRef := ormable.Fields[name].GetSetBackReference()
+		if ormable.Fields[name].GetHasMany() != nil {
+			g.P(`for _, v := range m.`, name, ` {`)
+			g.P(`if v != nil {`)
+			g.P(`v.`, backRef, ` = m`)
+			g.P(`}`)
+			g.P(`}`)
+		} else {
+			g.P(`if m.`, name, ` != nil {`)
+			g.P(`m.`, name, `.`, backRef, ` = m`)
+			g.P(`}`)
+		}
 	}
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
 
-	if preload {
-		gormRes += fmt.Sprintf("preload:%s;", strconv.FormatBool(preload))
+// generateShardResolve replaces db by the handle of the shard of the
+// shard_by value of the object in, when db is not in a transaction already
+func (b *ORMBuilder) generateShardResolve(message *protogen.Message, errReturn string, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	if ormable.ShardBy == "" {
+		return
 	}
+	g.P(`if _, ok := db.CommonDB().(*`, generateImport("Tx", stdSQLImport, g), `); !ok {`)
+	g.P(`shard, err := `, b.typeName(ormable.ShardResolver, g), `(ctx, db, in.Get`, ormable.ShardBy, `())`)
+	g.P(`if err != nil {`)
+	g.P(`return `, errReturn)
+	g.P(`}`)
+	g.P(`db = shard`)
+	g.P(`}`)
+}
 
-	if clear {
-		gormRes += fmt.Sprintf("clear:%s;", strconv.FormatBool(clear))
-	} else if replace {
-		gormRes += fmt.Sprintf("replace:%s;", strconv.FormatBool(replace))
-	} else if append {
-		gormRes += fmt.Sprintf("append:%s;", strconv.FormatBool(append))
+// idKey returns the literal of the object of typeName keyed by the id of the
+// message in, with the shard_by value too when in has that field
+func (b *ORMBuilder) idKey(typeName string, in *protogen.Message) string {
+	shardBy := b.getOrmable(typeName).ShardBy
+	for _, field := range in.Fields {
+		if shardBy != "" && field.GoName == shardBy {
+			return `{Id: in.GetId(), ` + shardBy + `: in.Get` + shardBy + `()}`
+		}
 	}
+	return `{Id: in.GetId()}`
+}
 
-	var gormTag, atlasTag string
-	if gormRes != "" {
-		gormTag = fmt.Sprintf("gorm:\"%s\"", strings.TrimRight(gormRes, ";"))
-	}
-	if atlasRes != "" {
-		atlasTag = fmt.Sprintf("atlas:\"%s\"", strings.TrimRight(atlasRes, ";"))
+// generateDefaultUUIDs sets the empty default_uuid fields of the object to be
+// created to new UUIDs
+func (b *ORMBuilder) generateDefaultUUIDs(ormable *OrmableType, obj string, g *protogen.GeneratedFile) {
+	var names []string
+	for name, field := range ormable.Fields {
+		if field.GetDefaultUuid() {
+			names = append(names, name)
+		}
 	}
-	finalTag := strings.TrimSpace(strings.Join([]string{gormTag, atlasTag}, " "))
-	if finalTag == "" {
-		return ""
-	} else {
-		return fmt.Sprintf("`%s`", finalTag)
+	sort.Strings(names)
+	for _, name := range names {
+		g.P(`if `, obj, `.`, name, ` == "" {`)
+		g.P(obj, `.`, name, ` = `, generateImport("NewUUID", gtypesImport, g), `()`)
+		g.P(`}`)
 	}
 }
 
-func (b *ORMBuilder) setupOrderedHasMany(message *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
-	var fieldNames []string
-	for name := range ormable.Fields {
-		fieldNames = append(fieldNames, name)
-	}
-	sort.Strings(fieldNames)
-
-	for _, fieldName := range fieldNames {
-		b.setupOrderedHasManyByName(message, fieldName, g)
+// touchedFields returns the sorted touch_on_save fields of the type
+func touchedFields(ormable *OrmableType) []string {
+	var names []string
+	for name, field := range ormable.Fields {
+		if field.GetTouchOnSave() {
+			names = append(names, name)
+		}
 	}
+	sort.Strings(names)
+	return names
 }
 
-func (b *ORMBuilder) setupOrderedHasManyByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
-	field := ormable.Fields[fieldName]
-
-	if field == nil {
+// generateTouchHook emits the gorm BeforeSave callback setting the
+// touch_on_save fields to the current time. GORM calls it in the transaction
+// of the save, for the updates of the associations only as well.
+func (b *ORMBuilder) generateTouchHook(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	names := touchedFields(ormable)
+	if len(names) == 0 {
 		return
 	}
-
-	if field.GetHasMany().GetPositionField() != "" {
-		positionField := field.GetHasMany().GetPositionField()
-		positionFieldType := b.getOrmable(field.Type).Fields[positionField].Type
-		g.P(`for i, e := range `, `to.`, fieldName, `{`)
-		g.P(`e.`, positionField, ` = `, positionFieldType, `(i)`)
+	g.P(`// BeforeSave is called by gorm before creating or updating the row, it sets `, strings.Join(names, ", "))
+	g.P(`// to the current time so that `, ormable.OriginName, ` changes on every save`)
+	g.P(`func (m *`, ormable.Name, `) BeforeSave(scope *`, generateImport("Scope", gormImport, g), `) error {`)
+	g.P(`now := `, generateImport("NowFunc", gormImport, g), `()`)
+	for _, name := range names {
+		g.P(`if err := scope.SetColumn("`, name, `", &now); err != nil {`)
+		g.P(`return err`)
 		g.P(`}`)
 	}
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
 }
 
-// Output code that will convert a field to/from orm.
-func (b *ORMBuilder) generateFieldConversion(message *protogen.Message, field *protogen.Field,
-	toORM bool, ofield *Field, g *protogen.GeneratedFile) error {
+// jsonAccessorZero is the zero value of the types of a json_accessor
+var jsonAccessorZero = map[string]string{
+	"string":  `""`,
+	"bool":    "false",
+	"int64":   "0",
+	"float64": "0",
+}
 
-	fieldName := camelCase(string(field.Desc.Name()))
-	fieldType := field.Desc.Kind().String() // was GoType
-	if field.Desc.Message() != nil {
-		parts := strings.Split(string(field.Desc.Message().FullName()), ".")
-		fieldType = parts[len(parts)-1]
+// generateJSONAccessors emits the json_accessor getters of the JSONValue and
+// Any fields, returning the value at the path and whether it is present
+func (b *ORMBuilder) generateJSONAccessors(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var names []string
+	for name := range ormable.Fields {
+		names = append(names, name)
 	}
-	if field.Desc.Cardinality() == protoreflect.Repeated {
-		// Some repeated fields can be handled by github.com/lib/pq
-		if b.dbEngine == ENGINE_POSTGRES && b.IsAbleToMakePQArray(fieldType) && field.Desc.IsList() {
-			g.P(`if m.`, fieldName, ` != nil {`)
-			switch fieldType {
-			case "bool":
-				g.P(`to.`, fieldName, ` = make(`, generateImport("BoolArray", pqImport, g), `, len(m.`, fieldName, `))`)
-			case "double":
-				g.P(`to.`, fieldName, ` = make(`, generateImport("Float64Array", pqImport, g), `, len(m.`, fieldName, `))`)
-			case "int64":
-				g.P(`to.`, fieldName, ` = make(`, generateImport("Int64Array", pqImport, g), `, len(m.`, fieldName, `))`)
-			case "string":
-				g.P(`to.`, fieldName, ` = make(`, generateImport("StringArray", pqImport, g), `, len(m.`, fieldName, `))`)
-			}
-			g.P(`copy(to.`, fieldName, `, m.`, fieldName, `)`)
-			g.P(`}`)
-		} else if b.isOrmable(fieldType) { // Repeated ORMable type
-			//fieldType = strings.Trim(fieldType, "[]*")
-
-			g.P(`for _, v := range m.`, fieldName, ` {`)
-			g.P(`if v != nil {`)
-			if toORM {
-				g.P(`if temp`, fieldName, `, cErr := v.ToORM(ctx); cErr == nil {`)
-			} else {
-				g.P(`if temp`, fieldName, `, cErr := v.ToPB(ctx); cErr == nil {`)
-			}
-			g.P(`to.`, fieldName, ` = append(to.`, fieldName, `, &temp`, fieldName, `)`)
-			g.P(`} else {`)
-			g.P(`return to, cErr`)
-			g.P(`}`)
-			g.P(`} else {`)
-			g.P(`to.`, fieldName, ` = append(to.`, fieldName, `, nil)`)
-			g.P(`}`)
-			g.P(`}`) // end repeated for
-		} else {
-			g.P(`// Repeated type `, fieldType, ` is not an ORMable message type`)
+	sort.Strings(names)
+	methods := make(map[string]string)
+	for _, name := range names {
+		field := ormable.Fields[name]
+		accessors := field.GetJsonAccessor()
+		if len(accessors) == 0 {
+			continue
 		}
-	} else if field.Enum != nil { // Singular Enum, which is an int32 ---
-		fieldType = b.typeName(field.Enum.GoIdent, g)
-		if toORM {
-			if b.stringEnums {
-				g.P(`to.`, fieldName, ` = `, fieldType, `_name[int32(m.`, fieldName, `)]`)
-			} else {
-				g.P(`to.`, fieldName, ` = int32(m.`, fieldName, `)`)
-			}
-		} else {
-			if b.stringEnums {
-				g.P(`to.`, fieldName, ` = `, fieldType, `(`, fieldType, `_value[m.`, fieldName, `])`)
-			} else {
-				g.P(`to.`, fieldName, ` = `, fieldType, `(m.`, fieldName, `)`)
-			}
+		var data string
+		switch {
+		case strings.HasSuffix(field.Type, ".Jsonb"):
+			data = `m.` + name + `.RawMessage`
+		case strings.HasSuffix(field.Type, ".AnyJSON"):
+			data = `m.` + name
+		default:
+			panic(fmt.Sprintf("json_accessor of field %s of %s needs a JSONValue or Any field stored as jsonb", name, ormable.Name))
 		}
-	} else if field.Message != nil { // Singular Object -------------
-		//Check for WKTs
-		// Type is a WKT, convert to/from as ptr to base type
-		if _, exists := wellKnownTypes[fieldType]; exists { // Singular WKT -----
-			if toORM {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`v := m.`, fieldName, `.Value`)
-				g.P(`to.`, fieldName, ` = &v`)
-				g.P(`}`)
-			} else {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				// g.P(`to.`, fieldName, ` = &`, b.GetFileImports().wktPkgName, ".", fieldType,
-				// 	`{Value: *m.`, fieldName, `}`)
-				g.P(`to.`, fieldName, ` = &`, generateImport(fieldType, wktImport, g),
-					`{Value: *m.`, fieldName, `}`)
-				g.P(`}`)
+		for _, accessor := range accessors {
+			as, path := accessor.GetAs(), accessor.GetPath()
+			zero, ok := jsonAccessorZero[accessor.GetType()]
+			if !ok {
+				panic(fmt.Sprintf("json_accessor %s of field %s of %s has type %q, the types are bool, float64, int64 and string", as, name, ormable.Name, accessor.GetType()))
 			}
-		} else if fieldType == protoTypeUUIDValue { // Singular UUIDValue type ----
-			if toORM {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`tempUUID, uErr := `, generateImport("FromString", uuidImport, g), `(m.`, fieldName, `.Value)`)
-				g.P(`if uErr != nil {`)
-				g.P(`return to, uErr`)
-				g.P(`}`)
-				g.P(`to.`, fieldName, ` = &tempUUID`)
-				g.P(`}`)
-			} else {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`to.`, fieldName, ` = &`, generateImport("UUIDValue", gtypesImport, g), `{Value: m.`, fieldName, `.String()}`)
-				g.P(`}`)
+			if !token.IsIdentifier(as) || !token.IsExported(as) {
+				panic(fmt.Sprintf("json_accessor of field %s of %s needs an exported method name as, got %q", name, ormable.Name, as))
 			}
-		} else if fieldType == protoTypeUUID { // Singular UUID type --------------
-			if toORM {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`to.`, fieldName, `, err = `, generateImport("FromString", uuidImport, g), `(m.`, fieldName, `.Value)`)
-				g.P(`if err != nil {`)
-				g.P(`return to, err`)
-				g.P(`}`)
-				g.P(`} else {`)
-				g.P(`to.`, fieldName, ` = `, generateImport("Nil", uuidImport, g))
-				g.P(`}`)
-			} else {
-				g.P(`to.`, fieldName, ` = &`, generateImport("UUID", gtypesImport, g), `{Value: m.`, fieldName, `.String()}`)
+			if path == "" || strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") || strings.Contains(path, "..") {
+				panic(fmt.Sprintf("json_accessor %s of field %s of %s has an invalid path %q", as, name, ormable.Name, path))
 			}
-		} else if fieldType == protoTypeTimestamp { // Singular WKT Timestamp ---
-			if toORM {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`t := m.`, fieldName, `.AsTime()`)
-				g.P(`to.`, fieldName, ` = &t`)
-				g.P(`}`)
-			} else {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`to.`, fieldName, ` = `, generateImport("New", timestampImport, g), `(*m.`, fieldName, `)`)
-				g.P(`}`)
+			if _, ok := ormable.Fields[as]; ok {
+				panic(fmt.Sprintf("json_accessor %s of field %s of %s is named like a field", as, name, ormable.Name))
 			}
-		} else if fieldType == protoTypeJSON {
-			if b.dbEngine == ENGINE_POSTGRES {
-				if toORM {
-					g.P(`if m.`, fieldName, ` != nil {`)
-					g.P(`to.`, fieldName, ` = &`, generateImport("Jsonb", gormpqImport, g), `{[]byte(m.`, fieldName, `.Value)}`)
-					g.P(`}`)
-				} else {
-					g.P(`if m.`, fieldName, ` != nil {`)
-					g.P(`to.`, fieldName, ` = &`, generateImport("JSONValue", gtypesImport, g), `{Value: string(m.`, fieldName, `.RawMessage)}`)
-					g.P(`}`)
-				}
-			} // Potential TODO other DB engine handling if desired
-		} else if fieldType == protoTypeResource {
-			resource := "nil" // assuming we do not know the PB type, nil means call codec for any resource
-			if ofield != nil && ofield.ParentOrigName != "" {
-				resource = "&" + ofield.ParentOrigName + "{}"
+			if other, ok := methods[as]; ok {
+				panic(fmt.Sprintf("json_accessor %s of field %s of %s is declared on %s already", as, name, ormable.Name, other))
 			}
-			btype := strings.TrimPrefix(ofield.Type, "*")
-			nillable := strings.HasPrefix(ofield.Type, "*")
-			iface := ofield.Type == "interface{}"
-
-			if toORM {
-				if nillable {
-					g.P(`if m.`, fieldName, ` != nil {`)
-				}
-				switch btype {
-				case "int64":
-					g.P(`if v, err :=`, generateImport("DecodeInt64", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
-					g.P(`	return to, err`)
-					g.P(`} else {`)
-					if nillable {
-						g.P(`to.`, fieldName, ` = &v`)
-					} else {
-						g.P(`to.`, fieldName, ` = v`)
-					}
-					g.P(`}`)
-				case "[]byte":
-					g.P(`if v, err :=`, generateImport("DecodeBytes", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
-					g.P(`	return to, err`)
-					g.P(`} else {`)
-					g.P(`	to.`, fieldName, ` = v`)
-					g.P(`}`)
-				default:
-					g.P(`if v, err :=`, generateImport("Decode", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
-					g.P(`return to, err`)
-					g.P(`} else if v != nil {`)
-					if nillable {
-						g.P(`vv := v.(`, btype, `)`)
-						g.P(`to.`, fieldName, ` = &vv`)
-					} else if iface {
-						g.P(`to.`, fieldName, `= v`)
-					} else {
-						g.P(`to.`, fieldName, ` = v.(`, btype, `)`)
-					}
-					g.P(`}`)
-				}
-				if nillable {
-					g.P(`}`)
-				}
+			methods[as] = name
+			g.P(`// `, as, ` returns the `, accessor.GetType(), ` at `, path, ` in `, name, `, false if it is missing`)
+			g.P(`func (m *`, ormable.Name, `) `, as, `() (`, accessor.GetType(), `, bool) {`)
+			g.P(`var v `, accessor.GetType())
+			if strings.HasPrefix(field.Type, "*") {
+				g.P(`if m.`, name, ` == nil || !`, generateImport("JSONPath", gtypesImport, g), `(`, data, `, "`, path, `", &v) {`)
+			} else {
+				g.P(`if !`, generateImport("JSONPath", gtypesImport, g), `(`, data, `, "`, path, `", &v) {`)
 			}
+			g.P(`return `, zero, `, false`)
+			g.P(`}`)
+			g.P(`return v, true`)
+			g.P(`}`)
+			g.P()
+		}
+	}
+}
 
-			if !toORM {
-				if nillable {
-					g.P(`if m.`, fieldName, `!= nil {`)
-					g.P(`	if v, err := `, generateImport("Encode", resourceImport, g), `(`, resource, `, *m.`, fieldName, `); err != nil {`)
-					g.P(`		return to, err`)
-					g.P(`	} else {`)
-					g.P(`		to.`, fieldName, ` = v`)
-					g.P(`	}`)
-					g.P(`}`)
-
-				} else {
-					g.P(`if v, err := `, generateImport("Encode", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
-					g.P(`return to, err`)
-					g.P(`} else {`)
-					g.P(`to.`, fieldName, ` = v`)
-					g.P(`}`)
-				}
-			}
-		} else if fieldType == protoTypeInet { // Inet type for Postgres only, currently
-			if toORM {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`if to.`, fieldName, `, err = `, generateImport("ParseInet", gtypesImport, g), `(m.`, fieldName, `.Value); err != nil {`)
-				g.P(`return to, err`)
-				g.P(`}`)
-				g.P(`}`)
-			} else {
-				g.P(`if m.`, fieldName, ` != nil && m.`, fieldName, `.IPNet != nil {`)
-				g.P(`to.`, fieldName, ` = &`, generateImport("InetValue", gtypesImport, g), `{Value: m.`, fieldName, `.String()}`)
-				g.P(`}`)
-			}
-		} else if fieldType == protoTimeOnly { // Time only to support time via string
-			if toORM {
-				g.P(`if m.`, fieldName, ` != nil {`)
-				g.P(`if to.`, fieldName, `, err = `, generateImport("ParseTime", gtypesImport, g), `(m.`, fieldName, `.Value); err != nil {`)
-				g.P(`return to, err`)
-				g.P(`}`)
-				g.P(`}`)
-			} else {
-				g.P(`if m.`, fieldName, ` != "" {`)
-				g.P(`if to.`, fieldName, `, err = `, generateImport("TimeOnlyByString", gtypesImport, g), `( m.`, fieldName, `); err != nil {`)
-				g.P(`return to, err`)
-				g.P(`}`)
-				g.P(`}`)
-			}
-		} else if b.isOrmable(fieldType) {
-			// Not a WKT, but a type we're building converters for
-			g.P(`if m.`, fieldName, ` != nil {`)
-			if toORM {
-				g.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToORM (ctx)`)
-			} else {
-				g.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToPB (ctx)`)
-			}
-			g.P(`if err != nil {`)
-			g.P(`return to, err`)
-			g.P(`}`)
-			g.P(`to.`, fieldName, ` = &temp`, fieldName)
-			g.P(`}`)
+// generateReload emits the Reload method reading the row of the primary key
+// again with the associations marked for preload, for types with a key
+func (b *ORMBuilder) generateReload(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	if !b.hasPrimaryKey(ormable) {
+		return
+	}
+	var preloads []string
+	for name, field := range ormable.Fields {
+		if field.GetHasOne().GetPreload() || field.GetBelongsTo().GetPreload() || field.GetHasMany().GetPreload() ||
+			field.GetManyToMany().GetPreload() || (isAssociation(field) && field.GetTag().GetPreload()) {
+			preloads = append(preloads, name)
 		}
-	} else { // Singular raw ----------------------------------------------------
-		g.P(`to.`, fieldName, ` = m.`, fieldName)
 	}
-	return nil
-}
-
-func (b *ORMBuilder) generateDefaultHandlers(file *protogen.File, g *protogen.GeneratedFile) {
-	for _, message := range file.Messages {
-		if isOrmable(message) {
-			b.generateCreateHandler(message, g)
-			typeName := string(message.Desc.Name())
-			ormable := b.getOrmable(typeName)
+	sort.Strings(preloads)
 
-			if b.hasPrimaryKey(ormable) {
-				b.generateReadHandler(message, g)
-				b.generateDeleteHandler(message, g)
-				b.generateDeleteSetHandler(message, g)
-				b.generateStrictUpdateHandler(message, g)
-				b.generatePatchHandler(message, g)
-				b.generatePatchSetHandler(message, g)
-			}
+	g.P(`// Reload reads the row of the primary key of the object again, replacing the`)
+	g.P(`// object, so that the values set by the DB such as defaults are current`)
+	g.P(`func (m *`, ormable.Name, `) Reload(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(), ` {`)
+	b.generateStatusErrors(g)
+	where, args := b.generateRowKey(message, g)
+	for _, name := range preloads {
+		g.P(`db = db.Preload("`, name, `")`)
+	}
+	g.P(`reloaded := `, ormable.Name, `{}`)
+	g.P(`if err := db.Where("`, strings.Join(where, ` AND `), `", `, strings.Join(args, `, `), `).First(&reloaded).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`*m = reloaded`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
 
-			b.generateApplyFieldMask(message, g)
-			b.generateListHandler(message, g)
+// generateRowKey checks the key of the row of the object m and returns the
+// conditions and the arguments of the primary key and the account of it
+func (b *ORMBuilder) generateRowKey(message *protogen.Message, g *protogen.GeneratedFile) ([]string, []string) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var where, args []string
+	for _, name := range b.manyToManyKeys(ormable, "") {
+		field := ormable.Fields[name]
+		if strings.HasPrefix(field.Type, "*") {
+			g.P(`if m.`, name, ` == nil || *m.`, name, ` == `, b.guessZeroValue(field.Type, g), ` {`)
+		} else {
+			g.P(`if m.`, name, ` == `, b.guessZeroValue(field.Type, g), ` {`)
 		}
-
+		g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+		where = append(where, columnName(name, field)+` = ?`)
+		args = append(args, `m.`+name)
 	}
+	if getMessageOptions(message).GetMultiAccount() {
+		g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		where = append(where, columnName("AccountID", ormable.Fields["AccountID"])+` = ?`)
+		args = append(args, `accountID`)
+	}
+	return where, args
 }
 
-func (b *ORMBuilder) generateCreateHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	orm := b.getOrmable(typeName)
-	g.P(`// DefaultCreate`, typeName, ` executes a basic gorm create call`)
-	g.P(`func DefaultCreate`, typeName, `(ctx context.Context, in *`,
-		typeName, `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
-	g.P(`if in == nil {`)
-	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
-	g.P(`}`)
-	g.P(`ormObj, err := in.ToORM(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	create := "Create_"
-	b.generateBeforeHookCall(orm, create, g)
-	g.P(`if err = db.Create(&ormObj).Error; err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	b.generateAfterHookCall(orm, create, g)
-	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
-	g.P(`return &pbResponse, err`)
-	g.P(`}`)
-	b.generateBeforeHookDef(orm, create, g)
-	b.generateAfterHookDef(orm, create, g)
+// computedColumns returns the columns of the computed fields of the type,
+// which the DB sets on every write
+func (b *ORMBuilder) computedColumns(message *protogen.Message) []string {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var columns []string
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		if !fieldOpts.GetDrop() && fieldOpts.GetComputed() {
+			name := camelCase(field.GoName)
+			columns = append(columns, columnName(name, ormable.Fields[name]))
+		}
+	}
+	return columns
 }
 
-func (b *ORMBuilder) generateBeforeHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBefore`, method, `); ok {`)
-	g.P(`if db, err = hook.Before`, method, `(ctx, db); err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`}`)
+// omitsZero reports whether the zero value of the field can be left out of an
+// insert, a pointer is NULL for an absent value already
+func omitsZero(field *Field) bool {
+	switch field.Type {
+	case "string", "bool", "int32", "int64", "uint32", "uint64", "float32", "float64":
+		return !isAssociation(field)
+	}
+	return false
 }
 
-func (b *ORMBuilder) generateAfterHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfter`, method, `); ok {`)
-	g.P(`if err = hook.After`, method, `(ctx, db); err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`}`)
+// omitZeroFields returns the fields of the type left out of the insert of the
+// create handler when zero, with the omit_zero_on_create option of the field
+// or of the message
+func (b *ORMBuilder) omitZeroFields(message *protogen.Message) []string {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	all := getMessageOptions(message).GetOmitZeroOnCreate()
+	var names []string
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		name := camelCase(field.GoName)
+		ofield, ok := ormable.Fields[name]
+		if fieldOpts.GetDrop() || fieldOpts.GetComputed() || !ok || ofield.GetTag().GetPrimaryKey() || ofield.GetTag().GetIgnore() {
+			continue
+		}
+		if fieldOpts.GetOmitZeroOnCreate() || all && omitsZero(ofield) {
+			names = append(names, name)
+		}
+	}
+	return names
 }
 
-func (b *ORMBuilder) generateHookInterfaces(g *protogen.GeneratedFile, message *protogen.Message) {
-	typeName := string(message.Desc.Name())
-	g.P(`// The following are interfaces you can implement for special behavior during ORM/PB conversions`)
-	g.P(`// of type `, typeName, ` the arg will be the target, the caller the one being converted from`)
-	g.P()
-	for _, desc := range [][]string{
-		{"BeforeToORM", typeName + "ORM", " called before default ToORM code"},
-		{"AfterToORM", typeName + "ORM", " called after default ToORM code"},
-		{"BeforeToPB", typeName, " called before default ToPB code"},
-		{"AfterToPB", typeName, " called after default ToPB code"},
-	} {
-		g.P(`// `, typeName, desc[0], desc[2])
-		g.P(`type `, typeName, `With`, desc[0], ` interface {`)
-		g.P(desc[0], `(context.Context, *`, desc[1], `) error`)
-		g.P(`}`)
-		g.P()
+// validatedReferences returns the belongs-to fields of the type with the
+// validate_reference option
+func (b *ORMBuilder) validatedReferences(message *protogen.Message) []string {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var names []string
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		if fieldOpts.GetDrop() || !fieldOpts.GetValidateReference() {
+			continue
+		}
+		name := camelCase(field.GoName)
+		if ofield, ok := ormable.Fields[name]; !ok || ofield.GetBelongsTo() == nil {
+			panic(fmt.Sprintf("Field %s of %s has validate_reference but is not a belongs-to association", name, ormable.Name))
+		}
+		names = append(names, name)
 	}
+	return names
 }
 
-func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
+// generateValidateReferences emits the ValidateReferences method of the
+// types with belongs-to fields with the validate_reference option
+func (b *ORMBuilder) generateValidateReferences(g *protogen.GeneratedFile, message *protogen.Message) {
+	names := b.validatedReferences(message)
+	if len(names) == 0 {
+		return
+	}
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var accounted bool
+	for _, name := range names {
+		accounted = accounted || b.getOrmable(ormable.Fields[name].Type).MultiAccount
+	}
 
-	if b.readHasFieldSelection(ormable) {
-		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
-			typeName, `, db *`, generateImport("DB", gormImport, g), `, fs *`, generateImport("FieldSelection", queryImport, g), `) (*`, typeName, `, error) {`)
+	g.P(`// ValidateReferences checks that the rows the foreign keys of `, strings.Join(names, ", "), ` refer to`)
+	g.P(`// exist, unless the association is set and written along with the object, and fails`)
+	if b.dbEngine == ENGINE_POSTGRES {
+		g.P(`// with errors.MissingReferenceError otherwise. The rows are locked until the`)
+		g.P(`// transaction of db ends, so that they are not deleted before the write.`)
 	} else {
-		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
-			typeName, `, db *`, "gorm", `.DB) (*`, typeName, `, error) {`)
+		g.P(`// with errors.MissingReferenceError otherwise.`)
 	}
-	g.P(`if in == nil {`)
-	g.P(`return nil, `, "errors", `.NilArgumentError`)
+	g.P(`func (m *`, ormable.Name, `) ValidateReferences(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g), `) error {`)
+	if accounted {
+		g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+	}
+	query := `db`
+	if b.dbEngine == ENGINE_POSTGRES {
+		query = `db.Set("gorm:query_option", "FOR SHARE")`
+	}
+	for _, name := range names {
+		field := ormable.Fields[name]
+		parent := b.getOrmable(field.Type)
+		keyName := field.GetBelongsTo().GetForeignkey()
+		assocKeyName := field.GetBelongsTo().GetAssociationForeignkey()
+		key := ormable.Fields[keyName]
+		value := `m.` + keyName
+		if strings.HasPrefix(key.Type, "*") {
+			g.P(`if m.`, name, ` == nil && m.`, keyName, ` != nil {`)
+			value = `*` + value
+		} else if zero := b.guessZeroValue(key.Type, g); zero == `nil` {
+			g.P(`if m.`, name, ` == nil && len(m.`, keyName, `) > 0 {`)
+		} else {
+			g.P(`if m.`, name, ` == nil && m.`, keyName, ` != `, zero, ` {`)
+		}
+		where := columnName(assocKeyName, parent.Fields[assocKeyName]) + ` = ?`
+		args := value
+		if parent.MultiAccount {
+			where += ` AND ` + columnName("AccountID", parent.Fields["AccountID"]) + ` = ?`
+			args += `, accountID`
+		}
+		parentType := b.typeName(protogen.GoIdent{GoName: parent.Name, GoImportPath: parent.File.GoImportPath}, g)
+		g.P(`found := `, parentType, `{}`)
+		g.P(`if err := `, query, `.Select("`, columnName(assocKeyName, parent.Fields[assocKeyName]), `").Where("`, where, `", `, args, `).First(&found).Error; `, generateImport("IsRecordNotFoundError", gormImport, g), `(err) {`)
+		g.P(`return `, generateImport("Errorf", stdFmtImport, g), `("%w: no `, parent.OriginName, ` of `, columnName(keyName, key), ` %v", `, generateImport("MissingReferenceError", gerrorsImport, g), `, `, value, `)`)
+		g.P(`} else if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+	g.P(`return nil`)
 	g.P(`}`)
+	g.P()
+}
 
-	g.P(`ormObj, err := in.ToORM(ctx)`)
+// generateValidateReferencesCall checks the references of ormObj ahead of
+// the write of a handler, in a transaction opened for the check and the write
+// unless the handler already runs in one, which holds the locks of the check
+// until generateValidateReferencesCommit
+func (b *ORMBuilder) generateValidateReferencesCall(message *protogen.Message, g *protogen.GeneratedFile) {
+	if len(b.validatedReferences(message)) == 0 {
+		return
+	}
+	g.P(`db, referencesTxn, err := `, generateImport("Begin", txnImport, g), `(ctx, db, nil)`)
 	g.P(`if err != nil {`)
 	g.P(`return nil, err`)
 	g.P(`}`)
+	g.P(`defer referencesTxn.Rollback()`)
+	g.P(`if err = ormObj.ValidateReferences(ctx, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+}
 
-	k, f := b.findPrimaryKey(ormable)
-	if strings.Contains(f.Type, "*") {
-		g.P(`if ormObj.`, k, ` == nil || *ormObj.`, k, ` == `, b.guessZeroValue(f.Type, g), ` {`)
-	} else {
-		g.P(`if ormObj.`, k, ` == `, b.guessZeroValue(f.Type, g), ` {`)
+func (b *ORMBuilder) generateValidateReferencesCommit(message *protogen.Message, g *protogen.GeneratedFile) {
+	if len(b.validatedReferences(message)) == 0 {
+		return
 	}
-	g.P(`return nil, `, "errors", `.EmptyIdError`)
+	g.P(`if err = referencesTxn.Commit(); err != nil {`)
+	g.P(`return nil, err`)
 	g.P(`}`)
+}
 
-	var fs string
-	if b.readHasFieldSelection(ormable) {
-		fs = "fs"
-	} else {
-		fs = "nil"
+// dbSetFields returns the sorted fields of the type set by the DB, the
+// computed ones and those with a default, default_expr or default_now
+func dbSetFields(ormable *OrmableType) []string {
+	var names []string
+	for name, field := range ormable.Fields {
+		if field.GetComputed() || field.GetTag().GetDefault() != "" || field.GetTag().GetDefaultExpr() != "" {
+			names = append(names, name)
+		}
 	}
+	sort.Strings(names)
+	return names
+}
 
-	b.generateBeforeReadHookCall(ormable, "ApplyQuery", g)
-	g.P(`if db, err = `, generateImport("ApplyFieldSelection", tkgormImport, g), `(ctx, db, `, fs, `, &`, ormable.Name, `{}); err != nil {`)
-	g.P(`return nil, err`)
+// generateReloadComputed emits the ReloadComputed method of the types with
+// the readonly_fields_from_db option, reading only the columns set by the DB
+func (b *ORMBuilder) generateReloadComputed(g *protogen.GeneratedFile, message *protogen.Message) {
+	if !getMessageOptions(message).GetReadonlyFieldsFromDb() {
+		return
+	}
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	if !b.hasPrimaryKey(ormable) {
+		panic(fmt.Sprintf("%s has readonly_fields_from_db but no primary key to read the row by", ormable.Name))
+	}
+	names := dbSetFields(ormable)
+	if len(names) == 0 {
+		panic(fmt.Sprintf("%s has readonly_fields_from_db but no computed field or field with a default", ormable.Name))
+	}
+	var columns []string
+	for _, name := range names {
+		columns = append(columns, columnName(name, ormable.Fields[name]))
+	}
+	g.P(`// ReloadComputed reads the columns set by the DB, `, strings.Join(columns, ", "), `, of the row of the`)
+	g.P(`// primary key of the object again, leaving its other fields as they are`)
+	g.P(`func (m *`, ormable.Name, `) ReloadComputed(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(), ` {`)
+	b.generateStatusErrors(g)
+	where, args := b.generateRowKey(message, g)
+	g.P(`reloaded := `, ormable.Name, `{}`)
+	g.P(`if err := db.Select([]string{"`, strings.Join(columns, `", "`), `"}).Where("`, strings.Join(where, ` AND `), `", `, strings.Join(args, `, `), `).First(&reloaded).Error; err != nil {`)
+	g.P(`return err`)
 	g.P(`}`)
-
-	b.generateBeforeReadHookCall(ormable, "Find", g)
-	g.P(`ormResponse := `, ormable.Name, `{}`)
-	g.P(`if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {`)
-	g.P(`return nil, err`)
+	for _, name := range names {
+		g.P(`m.`, name, ` = reloaded.`, name)
+	}
+	g.P(`return nil`)
 	g.P(`}`)
+	g.P()
+}
 
-	b.generateAfterReadHookCall(ormable, g)
-	g.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
-	g.P(`return &pbResponse, err`)
+// generateReloadComputedCall reads the columns set by the DB back into the
+// object after the write resulting in written, unless it returned the row
+func (b *ORMBuilder) generateReloadComputedCall(message *protogen.Message, obj, written string, g *protogen.GeneratedFile) {
+	if !getMessageOptions(message).GetReadonlyFieldsFromDb() {
+		return
+	}
+	if b.dbEngine == ENGINE_POSTGRES {
+		g.P(`if !`, generateImport("Returned", returningImport, g), `(`, written, `) {`)
+	}
+	g.P(`if err = `, obj, `.ReloadComputed(ctx, db); err != nil {`)
+	g.P(`return nil, err`)
 	g.P(`}`)
-
-	b.generateBeforeReadHookDef(ormable, "ApplyQuery", g)
-	b.generateBeforeReadHookDef(ormable, "Find", g)
-	b.generateAfterReadHookDef(ormable, g)
-
+	if b.dbEngine == ENGINE_POSTGRES {
+		g.P(`}`)
+	}
 }
 
-func (b *ORMBuilder) readHasFieldSelection(ormable *OrmableType) bool {
-	if read, ok := ormable.Methods[readService]; ok {
-		if s := b.getFieldSelection(read.inType); s != "" {
-			return true
+// indexDefinition is an index of the gorm tags and options of a type
+type indexDefinition struct {
+	name             string
+	unique           bool
+	indexType        string
+	nullsNotDistinct bool
+	columns          []string
+	// fields are the ORM fields of the columns
+	fields []string
+	// include are the columns of the INCLUDE clause of a covering index
+	include []string
+	// where is the predicate of a partial index
+	where string
+}
+
+// gorm reports whether GORM creates the index from the gorm tags, it only
+// creates the btree indexes of all rows with the columns in the order of
+// the struct fields
+func (index *indexDefinition) gorm() bool {
+	return (index.indexType == "" || index.indexType == "btree") && !index.nullsNotDistinct && len(index.include) == 0 && index.where == "" && sort.StringsAreSorted(index.fields)
+}
+
+// declaredFieldNames returns the fields of the ORM type in the order of the
+// fields of its message, followed by the fields added by the generator
+func (b *ORMBuilder) declaredFieldNames(ormable *OrmableType) []string {
+	var names, added []string
+	declared := map[string]bool{}
+	if message := b.ormableMessage(ormable); message != nil {
+		for _, field := range message.Fields {
+			name := camelCase(string(field.Desc.Name()))
+			if _, ok := ormable.Fields[name]; ok && !declared[name] {
+				declared[name] = true
+				names = append(names, name)
+			}
 		}
 	}
-	return false
+	for name := range ormable.Fields {
+		if !declared[name] {
+			added = append(added, name)
+		}
+	}
+	sort.Strings(added)
+	return append(names, added...)
 }
 
-// guessZeroValue of the input type, so that we can check if a (key) value is set or not
-func (b *ORMBuilder) guessZeroValue(typeName string, g *protogen.GeneratedFile) string {
-	typeName = strings.ToLower(typeName)
-	if strings.Contains(typeName, "string") {
-		return `""`
-	}
-	if strings.Contains(typeName, "int") {
-		return `0`
+// fieldIndexName returns the name of the index or unique index of a field,
+// the unnamed ones are named like GORM names them
+func (b *ORMBuilder) fieldIndexName(ormable *OrmableType, fieldName string, field *Field, unique bool) string {
+	value := field.GetTag().GetIndex()
+	if unique {
+		value = field.GetTag().GetUniqueIndex()
 	}
-	if strings.Contains(typeName, "uuid") {
-		// return fmt.Sprintf(`%s.Nil`, p.Import(uuidImport))
-		return generateImport("Nil", uuidImport, g)
-	}
-	if strings.Contains(typeName, "[]byte") {
-		return `nil`
+	name, _, _, _ := parseIndexTag(value)
+	if name != "" {
+		return name
 	}
-	if strings.Contains(typeName, "bool") {
-		return `false`
+	if unique {
+		return gormKeyName.ReplaceAllString("uix_"+b.ormableTableName(ormable)+"_"+columnName(fieldName, field), "_")
 	}
-	return ``
+	return fmt.Sprintf("idx_%s_%s", b.ormableBaseTableName(ormable), columnName(fieldName, field))
 }
 
-func (b *ORMBuilder) generateBeforeReadHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeRead`, suffix, `); ok {`)
-	hookCall := fmt.Sprint(`if db, err = hook.BeforeRead`, suffix, `(ctx, db`)
-	if b.readHasFieldSelection(orm) {
-		hookCall += `, fs`
+// gormCreatesIndex reports whether GORM creates the index or unique index
+// of a field from its gorm tag, the others are left to the SQL of the
+// Indexes definitions
+func (b *ORMBuilder) gormCreatesIndex(ormable *OrmableType, fieldName string, field *Field, unique bool) bool {
+	name := b.fieldIndexName(ormable, fieldName, field, unique)
+	if message := b.ormableMessage(ormable); message != nil {
+		for _, index := range b.indexDefinitions(message) {
+			if index.name == name {
+				return index.gorm()
+			}
+		}
 	}
-	hookCall += `); err != nil{`
-	g.P(hookCall)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`}`)
-}
-
-func (b *ORMBuilder) generateAfterReadHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormResponse).(`, orm.Name, `WithAfterReadFind`, `); ok {`)
-	hookCall := `if err = hook.AfterReadFind(ctx, db`
-	if b.readHasFieldSelection(orm) {
-		hookCall += `, fs`
+	value := field.GetTag().GetIndex()
+	if unique {
+		value = field.GetTag().GetUniqueIndex()
 	}
-	hookCall += `); err != nil {`
-	g.P(hookCall)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`}`)
+	return isGormIndex(value) && field.GetTag().GetIndexWhere() == ""
 }
 
-func (b *ORMBuilder) generateBeforeReadHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	gormDB := generateImport("DB", gormImport, g)
-	g.P(`type `, orm.Name, `WithBeforeRead`, suffix, ` interface {`)
-	hookSign := fmt.Sprint(`BeforeRead`, suffix, `(context.Context, *`, gormDB)
-	if b.readHasFieldSelection(orm) {
-		hookSign += fmt.Sprint(`, *`, generateImport("FieldSelection", queryImport, g))
-	}
+// indexDefinitions returns the indexes of the type ordered by name, the
+// columns of an index are in the order the fields are declared in
+func (b *ORMBuilder) indexDefinitions(message *protogen.Message) []*indexDefinition {
+	ormable := b.getOrmable(message.GoIdent.GoName)
 
-	hookSign += fmt.Sprint(`) (*`, gormDB, `, error)`)
-	g.P(hookSign)
-	g.P(`}`)
-}
+	indexes := make(map[string]*indexDefinition)
+	var names []string
+	addColumn := func(def indexDefinition, fieldName, column string) {
+		index, ok := indexes[def.name]
+		if !ok {
+			index = &def
+			indexes[def.name] = index
+			names = append(names, def.name)
+		} else if index.unique != def.unique || index.indexType != def.indexType || index.nullsNotDistinct != def.nullsNotDistinct ||
+			strings.Join(index.include, ",") != strings.Join(def.include, ",") || index.where != def.where {
+			panic(fmt.Sprintf("index %s of %s is declared with differing options by %s and %s, all its fields must agree on unique, type, nulls_not_distinct, include and index_where", def.name, ormable.Name, index.fields[0], fieldName))
+		}
+		index.columns = append(index.columns, column)
+		index.fields = append(index.fields, fieldName)
+	}
+	for _, fieldName := range b.declaredFieldNames(ormable) {
+		field := ormable.Fields[fieldName]
+		tag := field.GetTag()
+		column := columnName(fieldName, field)
+		where := b.indexWhere(ormable, fieldName, tag)
+		if len(tag.GetIndex()) > 0 {
+			_, indexType, nullsNotDistinct, include := parseIndexTag(tag.GetIndex())
+			b.checkIndexType(ormable, fieldName, field, indexType, false)
+			if nullsNotDistinct {
+				b.checkNullsNotDistinct(ormable, fieldName, false)
+			}
+			included := b.indexIncludeColumns(ormable, fieldName, indexType, include)
+			addColumn(indexDefinition{name: b.fieldIndexName(ormable, fieldName, field, false), indexType: indexType, include: included, where: where}, fieldName, column)
+		}
+		if len(tag.GetUniqueIndex()) > 0 {
+			_, indexType, nullsNotDistinct, include := parseIndexTag(tag.GetUniqueIndex())
+			b.checkIndexType(ormable, fieldName, field, indexType, true)
+			if nullsNotDistinct {
+				b.checkNullsNotDistinct(ormable, fieldName, true)
+			}
+			included := b.indexIncludeColumns(ormable, fieldName, indexType, include)
+			addColumn(indexDefinition{name: b.fieldIndexName(ormable, fieldName, field, true), unique: true, indexType: indexType, nullsNotDistinct: nullsNotDistinct, include: included, where: where}, fieldName, column)
+		}
+	}
+	// GORM creates the unique_with indexes from the tags of the struct fields
+	for _, index := range b.uniqueWithIndexes(ormable) {
+		fields := append([]string(nil), index.fields...)
+		sort.Strings(fields)
+		for _, fieldName := range fields {
+			addColumn(indexDefinition{name: index.name, unique: true}, fieldName, columnName(fieldName, ormable.Fields[fieldName]))
+		}
+	}
+	sort.Strings(names)
 
-func (b *ORMBuilder) generateAfterReadHookDef(orm *OrmableType, g *protogen.GeneratedFile) {
-	g.P(`type `, orm.Name, `WithAfterReadFind interface {`)
-	hookSign := fmt.Sprint(`AfterReadFind`, `(context.Context, *`, generateImport("DB", gormImport, g))
-	if b.readHasFieldSelection(orm) {
-		hookSign += fmt.Sprint(`, *`, generateImport("FieldSelection", queryImport, g))
+	var defs []*indexDefinition
+	for _, name := range names {
+		defs = append(defs, indexes[name])
 	}
-	hookSign += `) error`
-	g.P(hookSign)
-	g.P(`}`)
+	return defs
 }
 
-func (b *ORMBuilder) generateDeleteHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-
-	g.P(`func DefaultDelete`, typeName, `(ctx context.Context, in *`,
-		typeName, `, db *`, generateImport("DB", gormImport, g), `) error {`)
-	g.P(`if in == nil {`)
-	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
-	g.P(`}`)
-	g.P(`ormObj, err := in.ToORM(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return err`)
-	g.P(`}`)
+func (b *ORMBuilder) generateIndexDefinitions(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
 
-	ormable := b.getOrmable(typeName)
-	pkName, pk := b.findPrimaryKey(ormable)
-	if strings.Contains(pk.Type, "*") {
-		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
-	} else {
-		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), `{`)
+	g.P(`// `, ormable.Name, `Indexes lists the indexes declared by the gorm tags of `, ormable.Name)
+	g.P(`var `, ormable.Name, `Indexes = []`, generateImport("IndexDef", gtypesImport, g), `{`)
+	for _, index := range b.indexDefinitions(message) {
+		var indexType string
+		if index.indexType != "" {
+			indexType = fmt.Sprintf(`, Type: "%s"`, index.indexType)
+		}
+		if index.nullsNotDistinct {
+			indexType += `, NullsNotDistinct: true`
+		}
+		if len(index.include) > 0 {
+			indexType += `, Include: []string{"` + strings.Join(index.include, `", "`) + `"}`
+		}
+		if index.where != "" {
+			indexType += `, Where: ` + strconv.Quote(index.where)
+		}
+		g.P(`{Name: "`, index.name, `", Columns: []string{"`, strings.Join(index.columns, `", "`), `"}, Unique: `, strconv.FormatBool(index.unique), indexType, `},`)
 	}
-	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
-	g.P(`}`)
-
-	b.generateBeforeDeleteHookCall(ormable, g)
-	g.P(`err = db.Where(&ormObj).Delete(&`, ormable.Name, `{}).Error`)
-	g.P(`if err != nil {`)
-	g.P(`return err`)
-	g.P(`}`)
-
-	b.generateAfterDeleteHookCall(ormable, g)
-	g.P(`return err`)
 	g.P(`}`)
-	delete := "Delete_"
-	b.generateBeforeHookDef(ormable, delete, g)
-	b.generateAfterHookDef(ormable, delete, g)
+	g.P()
 }
 
-func (b *ORMBuilder) generateBeforeDeleteHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeDelete_); ok {`)
-	g.P(`if db, err = hook.BeforeDelete_(ctx, db); err != nil {`)
-	g.P(`return err`)
-	g.P(`}`)
-	g.P(`}`)
-}
+// generateThroughLoaders generates the loaders of has-many-through
+// associations, which fetch the intermediate keys first and then all the
+// associated objects at once
+func (b *ORMBuilder) generateThroughLoaders(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
 
-func (b *ORMBuilder) generateAfterDeleteHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfterDelete_); ok {`)
-	g.P(`err = hook.AfterDelete_(ctx, db)`)
-	g.P(`}`)
-}
+	var names []string
+	for name, field := range ormable.Fields {
+		if field.GetThrough() != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
 
-func (b *ORMBuilder) generateBeforeHookDef(orm *OrmableType, method string, g *protogen.GeneratedFile) {
-	gormDB := generateImport("DB", gormImport, g)
-	g.P(`type `, orm.Name, `WithBefore`, method, ` interface {`)
-	g.P(`Before`, method, `(context.Context, *`, gormDB, `) (*`, gormDB, `, error)`)
-	g.P(`}`)
-}
+	for _, name := range names {
+		field := ormable.Fields[name]
+		throughName := camelCase(field.GetThrough())
+		through, ok := ormable.Fields[throughName]
+		if !ok || through.GetHasMany() == nil {
+			panic(fmt.Sprintf("Field %s of %s must be loaded through a has-many field, %s is not one", name, ormable.Name, throughName))
+		}
+		middle := b.getOrmable(through.Type)
+		target := b.getOrmable(field.Type)
 
-func (b *ORMBuilder) generateAfterHookDef(orm *OrmableType, method string, g *protogen.GeneratedFile) {
-	g.P(`type `, orm.Name, `WithAfter`, method, ` interface {`)
-	g.P(`After`, method, `(context.Context, *`, generateImport("DB", gormImport, g), `) error`)
-	g.P(`}`)
+		var assoc *Field
+		for _, middleField := range middle.Fields {
+			if middleField.GetHasMany() == nil {
+				continue
+			}
+			if assocOrmable, err := GetOrmable(b.ormableTypes, middleField.Type); err == nil && assocOrmable == target {
+				assoc = middleField
+				break
+			}
+		}
+		if assoc == nil {
+			panic(fmt.Sprintf("Cannot load %s of %s through %s since %s has no has-many association of %s", name, ormable.Name, throughName, middle.Name, target.Name))
+		}
+
+		parentKey := through.GetHasMany().GetAssociationForeignkey()
+		middleKey := assoc.GetHasMany().GetAssociationForeignkey()
+		gormDB := generateImport("DB", gormImport, g)
+		g.P(`// Load`, name, `Through`, throughName, ` loads the `, name, ` of all the `, throughName, ` of the object`)
+		g.P(`// with two queries: one for the `, throughName, ` keys and one for the `, name)
+		g.P(`func (m *`, ormable.Name, `) Load`, name, `Through`, throughName, `(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, gormDB, `) error {`)
+		g.P(`var keys []`, b.qualifiedFieldType(strings.TrimPrefix(middle.Fields[middleKey].Type, "*"), g))
+		g.P(`if err := db.Model(&`, b.typeName(protogen.GoIdent{GoName: middle.Name, GoImportPath: middle.File.GoImportPath}, g), `{}).Where("`,
+			columnName(through.GetHasMany().GetForeignkey(), middle.Fields[through.GetHasMany().GetForeignkey()]), ` = ?", m.`, parentKey, `).Pluck("`,
+			columnName(middleKey, middle.Fields[middleKey]), `", &keys).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`m.`, name, ` = nil`)
+		g.P(`if len(keys) == 0 {`)
+		g.P(`return nil`)
+		g.P(`}`)
+		g.P(`return db.Where("`, columnName(assoc.GetHasMany().GetForeignkey(), target.Fields[assoc.GetHasMany().GetForeignkey()]), ` in (?)", keys).Find(&m.`, name, `).Error`)
+		g.P(`}`)
+		g.P()
+	}
 }
 
-func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+// generateConditionalPreloads emits the With{Type}ConditionalPreload context
+// option and the function the Read and List handlers preload the
+// associations of the conditions in their context with
+func (b *ORMBuilder) generateConditionalPreloads(g *protogen.GeneratedFile, message *protogen.Message) {
 	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	var names []string
+	for name, field := range ormable.Fields {
+		if isAssociation(field) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
 	gormDB := generateImport("DB", gormImport, g)
 
-	g.P(`func DefaultDelete`, typeName, `Set(ctx context.Context, in []*`,
-		typeName, `, db *`, gormDB, `) error {`)
-	g.P(`if in == nil {`)
-	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
-	g.P(`}`)
-	g.P(`var err error`)
-	ormable := b.getOrmable(typeName)
-	pkName, pk := b.findPrimaryKey(ormable)
-	g.P(`keys := []`, pk.Type, `{}`)
-	g.P(`for _, obj := range in {`)
-	g.P(`ormObj, err := obj.ToORM(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return err`)
-	g.P(`}`)
-	if strings.Contains(pk.Type, "*") {
-		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
-	} else {
-		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), `{`)
+	if len(names) > 0 {
+		g.P(`// With`, typeName, `ConditionalPreload returns a context making the Read and List`)
+		g.P(`// handlers of `, typeName, ` preload the association name, e.g. `, names[0], `, when cond holds`)
+		g.P(`// for their context`)
+		g.P(`func With`, typeName, `ConditionalPreload(ctx `, generateImport("Context", stdCtxImport, g), `, name string, cond func(`, generateImport("Context", stdCtxImport, g), `) bool) `, generateImport("Context", stdCtxImport, g), ` {`)
+		g.P(`return `, generateImport("WithConditionalPreload", gtypesImport, g), `(ctx, "`, typeName, `", name, cond)`)
+		g.P(`}`)
+		g.P()
 	}
-	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
-	g.P(`}`)
-	g.P(`keys = append(keys, ormObj.`, pkName, `)`)
-	g.P(`}`)
-	b.generateBeforeDeleteSetHookCall(ormable, g)
-	if getMessageOptions(message).GetMultiAccount() {
-		g.P(`acctId, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
-		g.P(`if err != nil {`)
-		g.P(`return err`)
+	g.P(`// apply`, typeName, `ConditionalPreloads preloads the associations of the conditional`)
+	g.P(`// preloads of `, typeName, ` in ctx whose condition holds`)
+	g.P(`func apply`, typeName, `ConditionalPreloads(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, gormDB, `) (*`, gormDB, `, error) {`)
+	g.P(`for _, preload := range `, generateImport("ConditionalPreloads", gtypesImport, g), `(ctx, "`, typeName, `") {`)
+	if len(names) == 0 {
+		g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("%w %q", `, generateImport("UnknownPreloadError", gerrorsImport, g), `, preload.Name)`)
 		g.P(`}`)
-		g.P(`err = db.Where("account_id = ? AND `, jgorm.ToDBName(pkName), ` in (?)", acctId, keys).Delete(&`, ormable.Name, `{}).Error`)
-	} else {
-		g.P(`err = db.Where("`, jgorm.ToDBName(pkName), ` in (?)", keys).Delete(&`, ormable.Name, `{}).Error`)
+		g.P(`return db, nil`)
+		g.P(`}`)
+		g.P()
+		return
 	}
-	g.P(`if err != nil {`)
-	g.P(`return err`)
-	g.P(`}`)
-	b.generateAfterDeleteSetHookCall(ormable, g)
-	g.P(`return err`)
-	g.P(`}`)
-	g.P(`type `, ormable.Name, `WithBeforeDeleteSet interface {`)
-	g.P(`BeforeDeleteSet(context.Context, []*`, ormable.OriginName, `, *`, gormDB, `) (*`, gormDB, `, error)`)
+	g.P(`switch preload.Name {`)
+	g.P(`case "`, strings.Join(names, `", "`), `":`)
+	g.P(`default:`)
+	g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("%w %q", `, generateImport("UnknownPreloadError", gerrorsImport, g), `, preload.Name)`)
 	g.P(`}`)
-	g.P(`type `, ormable.Name, `WithAfterDeleteSet interface {`)
-	g.P(`AfterDeleteSet(context.Context, []*`, ormable.OriginName, `, *`, gormDB, `) error`)
+	g.P(`if preload.Cond(ctx) {`)
+	g.P(`db = db.Preload(preload.Name)`)
 	g.P(`}`)
-}
-
-func (b *ORMBuilder) generateBeforeDeleteSetHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := (interface{}(&`, orm.Name, `{})).(`, orm.Name, `WithBeforeDeleteSet); ok {`)
-	g.P(`if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {`)
-	g.P(`return err`)
 	g.P(`}`)
+	g.P(`return db, nil`)
 	g.P(`}`)
+	g.P()
 }
 
-func (b *ORMBuilder) generateAfterDeleteSetHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := (interface{}(&`, orm.Name, `{})).(`, orm.Name, `WithAfterDeleteSet); ok {`)
-	g.P(`err = hook.AfterDeleteSet(ctx, in, db)`)
+// generateConditionalPreloadsCall preloads the associations of the
+// conditional preloads in the context of a Read or List handler
+func (b *ORMBuilder) generateConditionalPreloadsCall(message *protogen.Message, errReturn string, g *protogen.GeneratedFile) {
+	g.P(`if db, err = apply`, message.Desc.Name(), `ConditionalPreloads(ctx, db); err != nil {`)
+	g.P(`return `, errReturn)
 	g.P(`}`)
 }
 
-func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	_ = generateImport("", "fmt", g)
-	typeName := string(message.Desc.Name())
-
-	g.P(`// DefaultStrictUpdate`, typeName, ` clears / replaces / appends first level 1:many children and then executes a gorm update call`)
-	g.P(`func DefaultStrictUpdate`, typeName, `(ctx context.Context, in *`,
-		typeName, `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
-	g.P(`if in == nil {`)
-	g.P(`return nil, fmt.Errorf("Nil argument to DefaultStrictUpdate`, typeName, `")`)
-	g.P(`}`)
-	g.P(`ormObj, err := in.ToORM(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-
-	if getMessageOptions(message).GetMultiAccount() {
-		b.generateAccountIdWhereClause(g)
-	}
+// generateBatchLoaders emits a Load{Type}{Field}ForAll function per has-one
+// and has-many association, loading the children of many parents with one
+// query instead of one per parent. The rows are matched to the parents by the
+// foreign key and, when both sides are multi-account, the account_id.
+func (b *ORMBuilder) generateBatchLoaders(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
 
-	ormable := b.getOrmable(typeName)
-	if b.gateway {
-		g.P(`var count int64`)
+	var names []string
+	for name, field := range ormable.Fields {
+		if field.GetHasMany() != nil || field.GetHasOne() != nil {
+			names = append(names, name)
+		}
 	}
+	sort.Strings(names)
 
-	if b.hasPrimaryKey(ormable) {
-		pkName, pk := b.findPrimaryKey(ormable)
-		column := pk.GetTag().GetColumn()
-		if len(column) == 0 {
-			column = jgorm.ToDBName(pkName)
+	for _, name := range names {
+		field := ormable.Fields[name]
+		child := b.getOrmable(field.Type)
+		foreignKey, parentKey, position := field.GetHasOne().GetForeignkey(), field.GetHasOne().GetAssociationForeignkey(), ""
+		if hasMany := field.GetHasMany(); hasMany != nil {
+			foreignKey, parentKey, position = hasMany.GetForeignkey(), hasMany.GetAssociationForeignkey(), hasMany.GetPositionField()
 		}
-		g.P(`lockedRow := &`, typeName, `ORM{}`)
-		var count string
-		var rowsAffected string
-		if b.gateway {
-			count = `count = `
-			rowsAffected = `.RowsAffected`
+		// the key pairs of the parent and the child fields, the foreign key
+		// first
+		keys := [][2]string{{parentKey, foreignKey}}
+		if _, ok := ormable.Fields["AccountID"]; ok {
+			if _, ok := child.Fields["AccountID"]; ok {
+				keys = append(keys, [2]string{"AccountID", "AccountID"})
+			}
+		}
+		comparable := true
+		for _, key := range keys {
+			if ormable.Fields[key[0]] == nil || child.Fields[key[1]] == nil || strings.Contains(ormable.Fields[key[0]].Type, "[]") {
+				comparable = false
+			}
+		}
+		if !comparable {
+			continue
+		}
+		var order string
+		if position != "" {
+			order = columnName(position, child.Fields[position])
+		} else if b.hasPrimaryKey(child) {
+			pkName, pk := b.findPrimaryKey(child)
+			order = columnName(pkName, pk)
+		}
+		childType := b.typeName(protogen.GoIdent{GoName: child.Name, GoImportPath: child.File.GoImportPath}, g)
+		var matched []string
+		for _, key := range keys {
+			matched = append(matched, key[1])
 		}
-		g.P(count+`db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("`, column, `=?", ormObj.`, pkName, `).First(lockedRow)`+rowsAffected)
-	}
-	b.generateBeforeHookCall(ormable, "StrictUpdateCleanup", g)
-	b.handleChildAssociations(message, g)
-	b.generateBeforeHookCall(ormable, "StrictUpdateSave", g)
-	g.P(`if err = db.Save(&ormObj).Error; err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	b.generateAfterHookCall(ormable, "StrictUpdateSave", g)
-	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
 
-	if b.gateway {
-		g.P(`if count == 0 {`)
-		g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
+		g.P(`// Load`, ormable.OriginName, name, `ForAll loads the `, name, ` of all the parents with a single query and`)
+		if order != "" {
+			g.P(`// sets them on each parent by `, strings.Join(matched, ` and `), `, in the order of `, order, `. The parents`)
+		} else {
+			g.P(`// sets them on each parent by `, strings.Join(matched, ` and `), `. The parents`)
+		}
+		g.P(`// lose the `, name, ` they held, a nil parent is skipped.`)
+		g.P(`func Load`, ormable.OriginName, name, `ForAll(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g), `, parents []*`, ormable.Name, `) error {`)
+		g.P(`type key struct {`)
+		for _, key := range keys {
+			g.P(key[0], ` `, b.qualifiedFieldType(strings.TrimPrefix(ormable.Fields[key[0]].Type, "*"), g))
+		}
+		g.P(`}`)
+		g.P(`byKey := make(map[key][]*`, ormable.Name, `, len(parents))`)
+		g.P(`loaded := make(map[*`, ormable.Name, `]bool, len(parents))`)
+		for _, key := range keys {
+			keyType := b.qualifiedFieldType(strings.TrimPrefix(ormable.Fields[key[0]].Type, "*"), g)
+			g.P(`var `, lowerFirst(key[0]), `s []`, keyType)
+			g.P(lowerFirst(key[0]), `Seen := make(map[`, keyType, `]bool)`)
+		}
+		g.P(`for _, parent := range parents {`)
+		g.P(`if parent == nil || loaded[parent] {`)
+		g.P(`continue`)
+		g.P(`}`)
+		g.P(`loaded[parent] = true`)
+		g.P(`parent.`, name, ` = nil`)
+		var values []string
+		for _, key := range keys {
+			if strings.HasPrefix(ormable.Fields[key[0]].Type, "*") {
+				g.P(`if parent.`, key[0], ` == nil {`)
+				g.P(`continue`)
+				g.P(`}`)
+				values = append(values, `*parent.`+key[0])
+			} else {
+				values = append(values, `parent.`+key[0])
+			}
+		}
+		g.P(`k := key{`, strings.Join(values, `, `), `}`)
+		for _, key := range keys {
+			v := lowerFirst(key[0])
+			g.P(`if !`, v, `Seen[k.`, key[0], `] {`)
+			g.P(v, `Seen[k.`, key[0], `] = true`)
+			g.P(v, `s = append(`, v, `s, k.`, key[0], `)`)
+			g.P(`}`)
+		}
+		g.P(`byKey[k] = append(byKey[k], parent)`)
+		g.P(`}`)
+		g.P(`if len(byKey) == 0 {`)
+		g.P(`return nil`)
+		g.P(`}`)
+		var where, args []string
+		for _, key := range keys {
+			where = append(where, columnName(key[1], child.Fields[key[1]])+` IN (?)`)
+			args = append(args, lowerFirst(key[0])+`s`)
+		}
+		g.P(`var children []*`, childType)
+		query := `db.Where("` + strings.Join(where, ` AND `) + `", ` + strings.Join(args, `, `) + `)`
+		if order != "" {
+			query += `.Order("` + order + `")`
+		}
+		g.P(`if err := `, query, `.Find(&children).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`for _, child := range children {`)
+		values = nil
+		for _, key := range keys {
+			if strings.HasPrefix(child.Fields[key[1]].Type, "*") {
+				g.P(`if child.`, key[1], ` == nil {`)
+				g.P(`continue`)
+				g.P(`}`)
+				values = append(values, `*child.`+key[1])
+			} else {
+				values = append(values, `child.`+key[1])
+			}
+		}
+		g.P(`for _, parent := range byKey[key{`, strings.Join(values, `, `), `}] {`)
+		if field.GetHasMany() != nil {
+			g.P(`parent.`, name, ` = append(parent.`, name, `, child)`)
+		} else {
+			g.P(`if parent.`, name, ` == nil {`)
+			g.P(`parent.`, name, ` = child`)
+			g.P(`}`)
+		}
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`return nil`)
 		g.P(`}`)
+		g.P()
 	}
+}
 
-	g.P(`return &pbResponse, err`)
-	g.P(`}`)
+// generateConflictKeyResolvers generates the methods setting the primary key
+// of the associated objects that already exist with the same conflict key, so
+// that GORM updates them in place when the object is saved. On postgres they
+// are upserted instead, which cannot race with a concurrent insert of the key.
+func (b *ORMBuilder) generateConflictKeyResolvers(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
 
-	b.generateBeforeHookDef(ormable, "StrictUpdateCleanup", g)
-	b.generateBeforeHookDef(ormable, "StrictUpdateSave", g)
-	b.generateAfterHookDef(ormable, "StrictUpdateSave", g)
-}
+	for _, name := range b.conflictKeyFields(ormable) {
+		field := ormable.Fields[name]
+		key := field.GetAssociationConflictKey()
+		child := b.getOrmable(field.Type)
+		keyField, ok := child.Fields[key]
+		if !ok {
+			panic(fmt.Sprintf("Field %s of %s has association conflict key %s which is not a field of %s", name, ormable.Name, key, child.Name))
+		}
+		if !b.hasPrimaryKey(child) {
+			panic(fmt.Sprintf("Field %s of %s has association conflict key but %s has no primary key", name, ormable.Name, child.Name))
+		}
+		if b.dbEngine == ENGINE_POSTGRES {
+			b.generateConflictKeyUpsert(g, ormable, name)
+			continue
+		}
+		pkName, pk := b.findPrimaryKey(child)
+		childType := b.typeName(protogen.GoIdent{GoName: child.Name, GoImportPath: child.File.GoImportPath}, g)
+
+		where := columnName(key, keyField) + ` = ?`
+		args := `child.` + key
+		if accountID, ok := child.Fields["AccountID"]; ok {
+			where += ` AND ` + columnName("AccountID", accountID) + ` = ?`
+			args += `, child.AccountID`
+		}
+		children := `m.` + name
+		if !strings.HasPrefix(field.Type, "[]") {
+			children = `[]*` + childType + `{m.` + name + `}`
+		}
 
-func (b *ORMBuilder) generateAccountIdWhereClause(g *protogen.GeneratedFile) {
-	g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`db = db.Where(map[string]interface{}{"account_id": accountID})`)
+		g.P(`// Resolve`, name, `By`, key, ` sets the primary key of the `, name, ` that already exist`)
+		g.P(`// with the same `, key, `, so that saving the object updates them in place`)
+		g.P(`func (m *`, ormable.Name, `) Resolve`, name, `By`, key, `(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g), `) error {`)
+		g.P(`for _, child := range `, children, ` {`)
+		if strings.HasPrefix(pk.Type, "*") {
+			g.P(`if child == nil || child.`, pkName, ` != nil {`)
+		} else {
+			g.P(`if child == nil || child.`, pkName, ` != `, b.guessZeroValue(pk.Type, g), ` {`)
+		}
+		g.P(`continue`)
+		g.P(`}`)
+		g.P(`var keys []`, b.qualifiedFieldType(strings.TrimPrefix(pk.Type, "*"), g))
+		g.P(`if err := db.Model(&`, childType, `{}).Where("`, where, `", `, args, `).Pluck("`, columnName(pkName, pk), `", &keys).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`if len(keys) > 0 {`)
+		if strings.HasPrefix(pk.Type, "*") {
+			g.P(`child.`, pkName, ` = &keys[0]`)
+		} else {
+			g.P(`child.`, pkName, ` = keys[0]`)
+		}
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`return nil`)
+		g.P(`}`)
+		g.P()
+	}
 }
 
-func (b *ORMBuilder) handleChildAssociations(message *protogen.Message, g *protogen.GeneratedFile) {
-	ormable := b.getOrmable(string(message.Desc.Name()))
+// generateConflictKeyUpsert generates the Upsert{Field}By{Key} method of a
+// postgres association with a conflict key, inserting each associated
+// object with an ON CONFLICT DO UPDATE of the key, and of the account of a
+// multi-account type, which takes the primary key of the row already stored.
+// The children of a has-one or has-many are upserted after the parent is
+// written, with their foreign key set, the others before it.
+func (b *ORMBuilder) generateConflictKeyUpsert(g *protogen.GeneratedFile, ormable *OrmableType, name string) {
+	field := ormable.Fields[name]
+	key := field.GetAssociationConflictKey()
+	child := b.getOrmable(field.Type)
+	pkName, _ := b.findPrimaryKey(child)
+	childType := b.typeName(protogen.GoIdent{GoName: child.Name, GoImportPath: child.File.GoImportPath}, g)
+
+	targets := []string{`"` + b.fieldColumn(child, key) + `"`}
+	kept := map[string]bool{key: true, pkName: true, "CreatedAt": true}
+	if _, ok := child.Fields["AccountID"]; ok {
+		targets = append(targets, `"`+b.fieldColumn(child, "AccountID")+`"`)
+		kept["AccountID"] = true
+	}
+	var sets []string
+	for _, column := range b.insertFields(child) {
+		f := child.Fields[column]
+		if kept[column] || (f != nil && (f.GetReadOnly() || f.GetImmutableAfterCreate() || f.GetDefaultUuid())) {
+			continue
+		}
+		quoted := `"` + b.fieldColumn(child, column) + `"`
+		sets = append(sets, quoted+` = EXCLUDED.`+quoted)
+	}
+	conflict := fmt.Sprintf(`ON CONFLICT (%s) DO NOTHING`, strings.Join(targets, `, `))
+	if len(sets) > 0 {
+		conflict = fmt.Sprintf(`ON CONFLICT (%s) DO UPDATE SET %s`, strings.Join(targets, `, `), strings.Join(sets, `, `))
+	}
 
-	var fieldNames []string
-	for name := range ormable.Fields {
-		fieldNames = append(fieldNames, name)
+	children := `m.` + name
+	if !strings.HasPrefix(field.Type, "[]") {
+		children = `[]*` + childType + `{m.` + name + `}`
+	}
+	var foreignKeyName, assocKeyName string
+	if hasMany := field.GetHasMany(); hasMany != nil {
+		foreignKeyName, assocKeyName = hasMany.GetForeignkey(), hasMany.GetAssociationForeignkey()
+	} else if hasOne := field.GetHasOne(); hasOne != nil {
+		foreignKeyName, assocKeyName = hasOne.GetForeignkey(), hasOne.GetAssociationForeignkey()
 	}
-	sort.Strings(fieldNames)
 
-	for _, fieldName := range fieldNames {
-		b.handleChildAssociationsByName(message, fieldName, g)
+	g.P(`// Upsert`, name, `By`, key, ` inserts the new `, name, ` or updates the rows already stored with`)
+	g.P(`// the same `, strings.Join(targets, `, `), ` in place, taking their primary key, in one statement per`)
+	g.P(`// object whether it has a primary key or not. Their associations are saved on their own. The table`)
+	g.P(`// needs a unique index of these columns.`)
+	g.P(`func (m *`, ormable.Name, `) Upsert`, name, `By`, key, `(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`for _, child := range `, children, ` {`)
+	g.P(`if child == nil {`)
+	g.P(`continue`)
+	g.P(`}`)
+	if foreignKeyName != "" {
+		b.generateAssignKey(`child`, child, foreignKeyName, `m`, ormable, assocKeyName, g)
 	}
+	// the insert option would also apply to the inserts of the associations
+	// of the child, they are saved on their own around the upsert instead
+	b.generateAssociationSaves(child, true, g)
+	g.P(`if err := db.Set("gorm:insert_option", `, "`"+conflict+"`", `).Set("gorm:save_associations", false).Create(child).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	b.generateAssociationSaves(child, false, g)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
 }
 
-func (b *ORMBuilder) handleChildAssociationsByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
-	field := ormable.Fields[fieldName]
+// hasAssociations reports whether the ORM type has an association field
+func (b *ORMBuilder) hasAssociations(ormable *OrmableType) bool {
+	for _, field := range ormable.Fields {
+		if isAssociation(field) {
+			return true
+		}
+	}
+	return false
+}
 
-	if field == nil {
-		return
+// generateAssignKey sets the key field of obj to the key field of other,
+// either of them may be a pointer
+func (b *ORMBuilder) generateAssignKey(obj string, ormable *OrmableType, keyName, other string, otherOrmable *OrmableType, otherKeyName string, g *protogen.GeneratedFile) {
+	key := obj + `.` + keyName
+	if keyType := ormable.Fields[keyName].Type; strings.HasPrefix(keyType, "*") {
+		g.P(key, ` = new(`, strings.TrimPrefix(keyType, "*"), `)`)
+		key = "*" + key
+	}
+	value := other + `.` + otherKeyName
+	if strings.HasPrefix(otherOrmable.Fields[otherKeyName].Type, "*") {
+		value = "*" + value
 	}
+	g.P(key, ` = `, value)
+}
 
-	if field.GetHasMany() != nil || field.GetHasOne() != nil || field.GetManyToMany() != nil {
-		var assocHandler string
-		switch {
-		case field.GetHasMany() != nil:
-			switch {
-			case field.GetHasMany().GetClear():
-				assocHandler = "Clear"
-			case field.GetHasMany().GetAppend():
-				assocHandler = "Append"
-			case field.GetHasMany().GetReplace():
-				assocHandler = "Replace"
-			default:
-				assocHandler = "Remove"
+// generateAssociationSaves saves the associations of a child written without
+// them as a GORM save would, with before the belongs-to objects ahead of the
+// write of the child, setting its foreign keys, otherwise the others after it
+func (b *ORMBuilder) generateAssociationSaves(child *OrmableType, before bool, g *protogen.GeneratedFile) {
+	var names []string
+	for name, field := range child.Fields {
+		if isAssociation(field) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		field := child.Fields[name]
+		assoc := b.getOrmable(field.Type)
+		if belongsTo := field.GetBelongsTo(); belongsTo != nil || before {
+			if belongsTo != nil && before {
+				g.P(`if child.`, name, ` != nil {`)
+				g.P(`if err := db.Save(child.`, name, `).Error; err != nil {`)
+				g.P(`return err`)
+				g.P(`}`)
+				b.generateAssignKey(`child`, child, belongsTo.GetForeignkey(), `child.`+name, assoc, belongsTo.GetAssociationForeignkey(), g)
+				g.P(`}`)
 			}
+			continue
+		}
+		switch {
 		case field.GetHasOne() != nil:
-			switch {
-			case field.GetHasOne().GetClear():
-				assocHandler = "Clear"
-			case field.GetHasOne().GetAppend():
-				assocHandler = "Append"
-			case field.GetHasOne().GetReplace():
-				assocHandler = "Replace"
-			default:
-				assocHandler = "Remove"
-			}
+			g.P(`if child.`, name, ` != nil {`)
+			b.generateAssignKey(`child.`+name, assoc, field.GetHasOne().GetForeignkey(), `child`, child, field.GetHasOne().GetAssociationForeignkey(), g)
+			g.P(`if err := db.Save(child.`, name, `).Error; err != nil {`)
+			g.P(`return err`)
+			g.P(`}`)
+			g.P(`}`)
+		case field.GetHasMany() != nil:
+			g.P(`for _, elem := range child.`, name, ` {`)
+			g.P(`if elem == nil {`)
+			g.P(`continue`)
+			g.P(`}`)
+			b.generateAssignKey(`elem`, assoc, field.GetHasMany().GetForeignkey(), `child`, child, field.GetHasMany().GetAssociationForeignkey(), g)
+			g.P(`if err := db.Save(elem).Error; err != nil {`)
+			g.P(`return err`)
+			g.P(`}`)
+			g.P(`}`)
 		case field.GetManyToMany() != nil:
-			switch {
-			case field.GetManyToMany().GetClear():
-				assocHandler = "Clear"
-			case field.GetManyToMany().GetAppend():
-				assocHandler = "Append"
-			case field.GetManyToMany().GetReplace():
-				assocHandler = "Replace"
-			default:
-				assocHandler = "Replace"
-			}
+			// Append saves the new objects, links them all and appends them
+			// to the field again
+			g.P(`if elems := child.`, name, `; len(elems) > 0 {`)
+			g.P(`child.`, name, ` = nil`)
+			g.P(`if err := db.Model(child).Association("`, name, `").Append(elems).Error; err != nil {`)
+			g.P(`return err`)
+			g.P(`}`)
+			g.P(`}`)
 		}
+	}
+}
 
-		if assocHandler == "Remove" {
-			b.removeChildAssociationsByName(message, fieldName, g)
-			return
+// conflictKeyFields returns the sorted association fields with a conflict key
+func (b *ORMBuilder) conflictKeyFields(ormable *OrmableType) []string {
+	var names []string
+	for name, field := range ormable.Fields {
+		if field.GetAssociationConflictKey() == "" {
+			continue
 		}
-
-		action := fmt.Sprintf("%s(ormObj.%s)", assocHandler, fieldName)
-		if assocHandler == "Clear" {
-			action = fmt.Sprintf("%s()", assocHandler)
+		if !isAssociation(field) {
+			panic(fmt.Sprintf("Field %s of %s has association conflict key but is not an association", name, ormable.Name))
 		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
 
-		g.P(`if err = db.Model(&ormObj).Association("`, fieldName, `").`, action, `.Error; err != nil {`)
+// generateConflictKeyResolution resolves the associations by their conflict
+// keys before the object is saved. On postgres the associations that are not
+// children are upserted, the children are held back from the write and
+// upserted by generateConflictKeyUpserts after it.
+func (b *ORMBuilder) generateConflictKeyResolution(ormable *OrmableType, g *protogen.GeneratedFile) {
+	for _, name := range b.conflictKeyFields(ormable) {
+		field := ormable.Fields[name]
+		if b.dbEngine != ENGINE_POSTGRES {
+			g.P(`if err = ormObj.Resolve`, name, `By`, field.GetAssociationConflictKey(), `(ctx, db); err != nil {`)
+		} else if isConflictKeyChild(field) {
+			g.P(`upsert`, name, ` := ormObj.`, name)
+			g.P(`ormObj.`, name, ` = nil`)
+			continue
+		} else {
+			g.P(`if err = ormObj.Upsert`, name, `By`, field.GetAssociationConflictKey(), `(ctx, db); err != nil {`)
+		}
 		g.P(`return nil, err`)
 		g.P(`}`)
-		g.P(`ormObj.`, fieldName, ` = nil`)
 	}
 }
 
-func (b *ORMBuilder) removeChildAssociationsByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
-	field := ormable.Fields[fieldName]
-
-	if field == nil {
+// generateConflictKeyUpserts upserts the has-one and has-many children with
+// a conflict key held back from the write of the object on postgres
+func (b *ORMBuilder) generateConflictKeyUpserts(ormable *OrmableType, g *protogen.GeneratedFile) {
+	if b.dbEngine != ENGINE_POSTGRES {
 		return
 	}
-
-	if field.GetHasMany() != nil || field.GetHasOne() != nil {
-		var assocKeyName, foreignKeyName string
-		switch {
-		case field.GetHasMany() != nil:
-			assocKeyName = field.GetHasMany().GetAssociationForeignkey()
-			foreignKeyName = field.GetHasMany().GetForeignkey()
-		case field.GetHasOne() != nil:
-			assocKeyName = field.GetHasOne().GetAssociationForeignkey()
-			foreignKeyName = field.GetHasOne().GetForeignkey()
-		}
-		assocKeyType := ormable.Fields[assocKeyName].Type
-		assocOrmable := b.getOrmable(field.Type)
-		foreignKeyType := assocOrmable.Fields[foreignKeyName].Type
-		g.P(`filter`, fieldName, ` := `, strings.Trim(field.Type, "[]*"), `{}`)
-		zeroValue := b.guessZeroValue(assocKeyType, g)
-		if strings.Contains(assocKeyType, "*") {
-			g.P(`if ormObj.`, assocKeyName, ` == nil || *ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
-		} else {
-			g.P(`if ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
-		}
-		g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
-		g.P(`}`)
-		filterDesc := "filter" + fieldName + "." + foreignKeyName
-		ormDesc := "ormObj." + assocKeyName
-		if strings.HasPrefix(foreignKeyType, "*") {
-			g.P(filterDesc, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
-			filterDesc = "*" + filterDesc
-		}
-		if strings.HasPrefix(assocKeyType, "*") {
-			ormDesc = "*" + ormDesc
+	for _, name := range b.conflictKeyFields(ormable) {
+		field := ormable.Fields[name]
+		if !isConflictKeyChild(field) {
+			continue
 		}
-		g.P(filterDesc, " = ", ormDesc)
-		g.P(`if err = db.Where(filter`, fieldName, `).Delete(`, strings.Trim(field.Type, "[]*"), `{}).Error; err != nil {`)
+		g.P(`ormObj.`, name, ` = upsert`, name)
+		g.P(`if err = ormObj.Upsert`, name, `By`, field.GetAssociationConflictKey(), `(ctx, db); err != nil {`)
 		g.P(`return nil, err`)
 		g.P(`}`)
 	}
 }
 
-func (b *ORMBuilder) generatePatchHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	var isMultiAccount bool
-
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
+// isConflictKeyChild reports whether the association with a conflict key is
+// a has-one or has-many, whose rows refer to the object
+func isConflictKeyChild(field *Field) bool {
+	return field.GetHasMany() != nil || field.GetHasOne() != nil
+}
 
-	if getMessageOptions(message).GetMultiAccount() {
-		isMultiAccount = true
+// qualifiedFieldType qualifies the package of a special ORM field type for
+// the file being generated
+func (b *ORMBuilder) qualifiedFieldType(fieldType string, g *protogen.GeneratedFile) string {
+	if sp := strings.Split(fieldType, "."); len(sp) == 2 && strings.TrimPrefix(sp[1], "*") == "UUID" {
+		return strings.TrimSuffix(fieldType, strings.TrimLeft(fieldType, "*")) + generateImport("UUID", uuidImport, g)
 	}
+	return fieldType
+}
 
-	if isMultiAccount && !b.hasIDField(message) {
-		g.P(fmt.Sprintf("// Cannot autogen DefaultPatch%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
+// generateDescribe emits the Describe method of the ORM type with the table
+// metadata known when generating, with the describe parameter only
+func (b *ORMBuilder) generateDescribe(g *protogen.GeneratedFile, message *protogen.Message) {
+	if !b.describe {
 		return
 	}
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var names []string
+	for name := range ormable.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var hasPrimaryKeyTag bool
+	for _, name := range names {
+		hasPrimaryKeyTag = hasPrimaryKeyTag || ormable.Fields[name].GetTag().GetPrimaryKey()
+	}
 
-	g.P(`// DefaultPatch`, typeName, ` executes a basic gorm update call with patch behavior`)
-	g.P(`func DefaultPatch`, typeName, `(ctx context.Context, in *`,
-		typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
-
-	g.P(`if in == nil {`)
-	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
-	g.P(`}`)
-	g.P(`var pbObj `, typeName)
-	g.P(`var err error`)
-	b.generateBeforePatchHookCall(ormable, "Read", g)
-
-	// TODO: not in original code, but it don't make a lot of sense to generate code with id if message doesn't have it
-	if b.hasIDField(message) {
-		if b.readHasFieldSelection(ormable) {
-			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, nil)`)
-		} else {
-			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+	g.P(`// Describe returns the table, columns and associations of `, ormable.Name)
+	g.P(`func (`, ormable.Name, `) Describe() `, generateImport("TableInfo", gtypesImport, g), ` {`)
+	g.P(`return `, generateImport("TableInfo", gtypesImport, g), `{`)
+	g.P(`Name: "`, b.tableName(message), `",`)
+	g.P(`Columns: []`, generateImport("ColumnInfo", gtypesImport, g), `{`)
+	for _, name := range names {
+		field := ormable.Fields[name]
+		if isAssociation(field) || field.GetTag().GetIgnore() {
+			continue
 		}
-
-		g.P(`if err != nil {`)
-		g.P(`return nil, err`)
-		g.P(`}`)
-		g.P(`pbObj = *pbReadRes`)
+		primaryKey := field.GetTag().GetPrimaryKey() || (!hasPrimaryKeyTag && strings.ToLower(name) == "id")
+		nullable := strings.HasPrefix(field.Type, "*") && !field.GetTag().GetNotNull() && !primaryKey
+		goType := field.Type
+		if i := strings.LastIndex(goType, "."); i >= 0 {
+			base := strings.TrimLeft(goType, "*[]")
+			goType = goType[:len(goType)-len(base)] + goType[i+1:]
+		}
+		column := fmt.Sprintf(`Name: "%s", `, columnName(name, field))
+		if columnType := field.GetTag().GetType(); columnType != "" {
+			column += fmt.Sprintf(`Type: "%s", `, columnType)
+		}
+		g.P(`{`, column, `GoType: "`, goType, `", Nullable: `, nullable, `, PrimaryKey: `, primaryKey, `},`)
+	}
+	g.P(`},`)
+	var associations []string
+	for _, name := range names {
+		field := ormable.Fields[name]
+		var kind, foreignKey, joinTable string
+		switch {
+		case field.GetHasOne() != nil:
+			kind, foreignKey = "has_one", field.GetHasOne().GetForeignkey()
+		case field.GetHasMany() != nil:
+			kind, foreignKey = "has_many", field.GetHasMany().GetForeignkey()
+		case field.GetBelongsTo() != nil:
+			kind, foreignKey = "belongs_to", field.GetBelongsTo().GetForeignkey()
+		case field.GetManyToMany() != nil:
+			kind, foreignKey, joinTable = "many_to_many", field.GetManyToMany().GetForeignkey(), field.GetManyToMany().GetJointable()
+		default:
+			continue
+		}
+		assocType := strings.TrimLeft(field.Type, "*[]")
+		if i := strings.LastIndex(assocType, "."); i >= 0 {
+			assocType = assocType[i+1:]
+		}
+		association := fmt.Sprintf(`{Field: "%s", Kind: "%s", Type: "%s", ForeignKey: "%s"`, name, kind, assocType, foreignKey)
+		if joinTable != "" {
+			association += fmt.Sprintf(`, JoinTable: "%s"`, joinTable)
+		}
+		associations = append(associations, association+`},`)
+	}
+	if len(associations) > 0 {
+		g.P(`Associations: []`, generateImport("AssociationInfo", gtypesImport, g), `{`)
+		for _, association := range associations {
+			g.P(association)
+		}
+		g.P(`},`)
 	}
-
-	b.generateBeforePatchHookCall(ormable, "ApplyFieldMask", g)
-	g.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
-	g.P(`return nil, err`)
 	g.P(`}`)
-
-	b.generateBeforePatchHookCall(ormable, "Save", g)
-	g.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, &pbObj, db)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
 	g.P(`}`)
-	b.generateAfterPatchHookCall(ormable, "Save", g)
+	g.P()
+}
 
-	g.P(`return pbResponse, nil`)
+// generateForeignKeyDefinitions lists the foreign keys of the has-one,
+// has-many and belongs-to associations of the type, many-to-many join tables
+// are left out
+func (b *ORMBuilder) generateForeignKeyDefinitions(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var fieldNames []string
+	for name := range ormable.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+
+	var defs []string
+	for _, fieldName := range fieldNames {
+		field := ormable.Fields[fieldName]
+		var child, parent *OrmableType
+		var foreignKey, assocKey string
+		if hasOne := field.GetHasOne(); hasOne != nil {
+			child, parent, foreignKey, assocKey = b.getOrmable(field.Type), ormable, hasOne.GetForeignkey(), hasOne.GetAssociationForeignkey()
+		} else if hasMany := field.GetHasMany(); hasMany != nil {
+			child, parent, foreignKey, assocKey = b.getOrmable(field.Type), ormable, hasMany.GetForeignkey(), hasMany.GetAssociationForeignkey()
+		} else if belongsTo := field.GetBelongsTo(); belongsTo != nil {
+			child, parent, foreignKey, assocKey = ormable, b.getOrmable(field.Type), belongsTo.GetForeignkey(), belongsTo.GetAssociationForeignkey()
+		} else if field.GetEnumAsFkTable() == "" {
+			continue
+		}
+		var def string
+		if child == nil {
+			// an enum refers to the id of its lookup table
+			column := columnName(fieldName, field)
+			def = fmt.Sprintf(`{Name: "fk_%s_%s", Table: "%s", Columns: []string{"%s"}, References: "%s", ReferencedColumns: []string{"id"}`,
+				b.ormableBaseTableName(ormable), column, b.ormableTableName(ormable), column, field.GetEnumAsFkTable())
+		} else {
+			column := columnName(foreignKey, child.Fields[foreignKey])
+			def = fmt.Sprintf(`{Name: "fk_%s_%s", Table: "%s", Columns: []string{"%s"}, References: "%s", ReferencedColumns: []string{"%s"}`,
+				b.ormableBaseTableName(child), column, b.ormableTableName(child), column, b.ormableTableName(parent), columnName(assocKey, parent.Fields[assocKey]))
+		}
+		if action := foreignKeyAction(field.GetOnDelete()); action != "" {
+			def += fmt.Sprintf(`, OnDelete: "%s"`, action)
+		}
+		if action := foreignKeyAction(field.GetOnUpdate()); action != "" {
+			def += fmt.Sprintf(`, OnUpdate: "%s"`, action)
+		}
+		if b.deferrable {
+			def += `, Deferred: true`
+		}
+		defs = append(defs, def+`},`)
+	}
+	if len(defs) == 0 {
+		return
+	}
+
+	g.P(`// `, ormable.Name, `ForeignKeys lists the foreign keys of the associations of `, ormable.Name)
+	g.P(`var `, ormable.Name, `ForeignKeys = []`, generateImport("ForeignKeyDef", gtypesImport, g), `{`)
+	for _, def := range defs {
+		g.P(def)
+	}
 	g.P(`}`)
+	g.P()
+}
 
-	b.generateBeforePatchHookDef(ormable, "Read", g)
-	b.generateBeforePatchHookDef(ormable, "ApplyFieldMask", g)
-	b.generateBeforePatchHookDef(ormable, "Save", g)
-	b.generateAfterPatchHookDef(ormable, "Save", g)
+// addLookupTable records the enum_as_fk_table of an enum field, each table
+// of a package holds the values of a single enum
+func (b *ORMBuilder) addLookupTable(ormable *OrmableType, fieldName string, field *protogen.Field, table string) {
+	enum := field.Enum.Desc.FullName()
+	if lookup, ok := b.lookupTables[string(ormable.File.GoImportPath)+" "+table]; ok {
+		if lookup.Enum.Desc.FullName() != enum {
+			panic(fmt.Sprintf("Field %s of %s stores %s in the lookup table %s of %s", fieldName, ormable.Name, enum, table, lookup.Enum.Desc.FullName()))
+		}
+		return
+	}
+	for _, lookup := range b.lookupTables {
+		// the ORM type of the rows is named by the enum
+		if lookup.File.GoImportPath == ormable.File.GoImportPath && lookup.Enum.Desc.FullName() == enum {
+			panic(fmt.Sprintf("Field %s of %s stores %s in the lookup table %s, it is stored in %s already", fieldName, ormable.Name, enum, table, lookup.Table))
+		}
+	}
+	b.lookupTables[string(ormable.File.GoImportPath)+" "+table] = &lookupTable{Table: table, Enum: field.Enum, File: ormable.File}
+}
+
+// generateLookupTables emits the ORM types of the enum_as_fk_table lookup
+// tables of the file with the rows of the enum values and their seed function
+func (b *ORMBuilder) generateLookupTables(g *protogen.GeneratedFile, file *protogen.File) {
+	var keys []string
+	for key, lookup := range b.lookupTables {
+		if lookup.File == file {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		lookup := b.lookupTables[key]
+		name := lookup.Enum.GoIdent.GoName + "ORM"
+		g.P(`// `, name, ` is a row of the `, lookup.Table, ` lookup table of the values of`)
+		g.P(`// `, lookup.Enum.GoIdent.GoName)
+		g.P(`type `, name, ` struct {`)
+		g.P("Id int32 `gorm:\"primary_key;auto_increment:false\"`")
+		g.P("Name string `gorm:\"not null\"`")
+		g.P(`}`)
+		g.P()
+		g.P(`// TableName overrides the default tablename generated by GORM`)
+		g.P(`func (`, name, `) TableName() string {`)
+		g.P(`return "`, lookup.Table, `"`)
+		g.P(`}`)
+		g.P()
+		g.P(`// `, name, `Rows are the values of `, lookup.Enum.GoIdent.GoName, `, an alias keeps the first name`)
+		g.P(`var `, name, `Rows = []`, name, `{`)
+		seen := make(map[protoreflect.EnumNumber]bool)
+		for _, value := range lookup.Enum.Values {
+			if !seen[value.Desc.Number()] {
+				seen[value.Desc.Number()] = true
+				g.P(`{Id: `, value.Desc.Number(), `, Name: "`, value.Desc.Name(), `"},`)
+			}
+		}
+		g.P(`}`)
+		g.P()
+		g.P(`// Seed`, name, ` writes the rows of `, name, `Rows to `, lookup.Table, `,`)
+		g.P(`// after the migration of the table, it inserts the missing values and`)
+		g.P(`// renames the others`)
+		g.P(`func Seed`, name, `(db *`, generateImport("DB", gormImport, g), `) error {`)
+		g.P(`for _, row := range `, name, `Rows {`)
+		g.P(`var count int`)
+		g.P(`if err := db.Model(&`, name, `{}).Where("id = ?", row.Id).Count(&count).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`var err error`)
+		g.P(`if count == 0 {`)
+		g.P(`// a create would leave out the zero id`)
+		g.P(`err = db.Exec("INSERT INTO `, lookup.Table, ` (id, name) VALUES (?, ?)", row.Id, row.Name).Error`)
+		g.P(`} else {`)
+		g.P(`err = db.Model(&`, name, `{}).Where("id = ?", row.Id).Update("name", row.Name).Error`)
+		g.P(`}`)
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`return nil`)
+		g.P(`}`)
+		g.P()
+	}
+}
 
+// compositeAttributeTypes are the Go and postgres types of the scalar fields
+// of a message stored as a composite type
+var compositeAttributeTypes = map[protoreflect.Kind][2]string{
+	protoreflect.StringKind:   {"string", "text"},
+	protoreflect.BoolKind:     {"bool", "boolean"},
+	protoreflect.Int32Kind:    {"int32", "integer"},
+	protoreflect.Sint32Kind:   {"int32", "integer"},
+	protoreflect.Sfixed32Kind: {"int32", "integer"},
+	protoreflect.Int64Kind:    {"int64", "bigint"},
+	protoreflect.Sint64Kind:   {"int64", "bigint"},
+	protoreflect.Sfixed64Kind: {"int64", "bigint"},
+	protoreflect.Uint32Kind:   {"uint32", "bigint"},
+	protoreflect.Fixed32Kind:  {"uint32", "bigint"},
+	protoreflect.Uint64Kind:   {"uint64", "numeric(20)"},
+	protoreflect.Fixed64Kind:  {"uint64", "numeric(20)"},
+	protoreflect.FloatKind:    {"float32", "real"},
+	protoreflect.DoubleKind:   {"float64", "double precision"},
+}
+
+// addCompositeType records the composite_type of a message field and returns
+// the Go type of its values, each message of a package is stored as a single
+// composite type
+func (b *ORMBuilder) addCompositeType(ormable *OrmableType, fieldName string, field *protogen.Field, name string) string {
+	if !plainIdentifier.MatchString(name) {
+		panic(fmt.Sprintf("Field %s of %s has composite_type %q, which is not a plain identifier", fieldName, ormable.Name, name))
+	}
+	message := field.Message
+	for _, attr := range message.Fields {
+		if _, ok := compositeAttributeTypes[attr.Desc.Kind()]; !ok || attr.Desc.IsList() || attr.Desc.HasOptionalKeyword() {
+			panic(fmt.Sprintf("Field %s of %s is stored as the composite type %s, field %s of %s is not a plain scalar", fieldName, ormable.Name, name, attr.Desc.Name(), message.Desc.Name()))
+		}
+	}
+	goName := message.GoIdent.GoName + "Composite"
+	key := string(ormable.File.GoImportPath) + " " + goName
+	if composite, ok := b.compositeTypes[key]; ok {
+		if composite.Message.Desc.FullName() != message.Desc.FullName() || composite.Name != name {
+			panic(fmt.Sprintf("Field %s of %s stores %s as the composite type %s, %s is %s stored as %s already", fieldName, ormable.Name, message.Desc.FullName(), name, goName, composite.Message.Desc.FullName(), composite.Name))
+		}
+		return goName
+	}
+	for _, composite := range b.compositeTypes {
+		if composite.File.GoImportPath == ormable.File.GoImportPath && composite.Name == name {
+			panic(fmt.Sprintf("Field %s of %s stores %s as the composite type %s, which stores %s already", fieldName, ormable.Name, message.Desc.FullName(), name, composite.Message.Desc.FullName()))
+		}
+	}
+	b.compositeTypes[key] = &compositeType{Name: name, Message: message, File: ormable.File}
+	return goName
 }
 
-func (b *ORMBuilder) hasIDField(message *protogen.Message) bool {
-	for _, field := range message.Fields {
-		if strings.ToLower(field.GoName) == "id" { // TODO: not sure
-			return true
+// generateCompositeTypes emits the Go types of the messages of the file stored
+// as composite types, with their row literal encoding and the function
+// creating the postgres type
+func (b *ORMBuilder) generateCompositeTypes(g *protogen.GeneratedFile, file *protogen.File) {
+	var keys []string
+	for key, composite := range b.compositeTypes {
+		if composite.File == file {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		composite := b.compositeTypes[key]
+		message := composite.Message
+		name := message.GoIdent.GoName + "Composite"
+		var attrs, values, dsts []string
+		g.P(`// `, name, ` is a `, message.GoIdent.GoName, ` stored as a value of`)
+		g.P(`// the postgres composite type `, composite.Name)
+		g.P(`type `, name, ` struct {`)
+		for _, attr := range message.Fields {
+			attrType := compositeAttributeTypes[attr.Desc.Kind()]
+			g.P(attr.GoName, ` `, attrType[0])
+			attrs = append(attrs, string(attr.Desc.Name())+" "+attrType[1])
+			values = append(values, "c."+attr.GoName)
+			dsts = append(dsts, "&c."+attr.GoName)
+		}
+		g.P(`}`)
+		g.P()
+		g.P(`// `, name, `TypeSQL creates `, composite.Name, `, the attributes`)
+		g.P(`// are the fields of `, message.GoIdent.GoName, ` in order`)
+		g.P(`const `, name, `TypeSQL = "CREATE TYPE `, composite.Name, ` AS (`, strings.Join(attrs, ", "), `)"`)
+		g.P()
+		g.P(`// Value implements the Value part of the sql scannable interface, the`)
+		g.P(`// value is written as a row literal`)
+		g.P(`func (c `, name, `) Value() (`, generateImport("Value", stdDriverImport, g), `, error) {`)
+		g.P(`return `, generateImport("CompositeValue", gtypesImport, g), `(`, strings.Join(values, ", "), `)`)
+		g.P(`}`)
+		g.P()
+		g.P(`// Scan implements the scan part of the sql scannable interface, a NULL`)
+		g.P(`// attribute is read as the zero value`)
+		g.P(`func (c *`, name, `) Scan(value interface{}) error {`)
+		g.P(`*c = `, name, `{}`)
+		g.P(`return `, generateImport("ScanComposite", gtypesImport, g), `(value, `, strings.Join(dsts, ", "), `)`)
+		g.P(`}`)
+		g.P()
+		g.P(`// Create`, name, `Type creates `, composite.Name, ` unless it exists,`)
+		g.P(`// it runs ahead of the AutoMigrate of the tables storing `, name)
+		g.P(`func Create`, name, `Type(db *`, generateImport("DB", gormImport, g), `) error {`)
+		g.P(`if db == nil {`)
+		g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+		g.P(`}`)
+		g.P(`var exists bool`)
+		g.P(`if err := db.Raw("SELECT to_regtype(?) IS NOT NULL", "`, composite.Name, `").Row().Scan(&exists); err != nil || exists {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`return db.Exec(`, name, `TypeSQL).Error`)
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// ormableMessage returns the message of an ormable type of any file
+func (b *ORMBuilder) ormableMessage(ormable *OrmableType) *protogen.Message {
+	for _, message := range ormable.File.Messages {
+		if string(message.Desc.Name()) == ormable.OriginName {
+			return message
 		}
 	}
+	return nil
+}
 
-	return false
+// ormableTableName returns the table of an ormable type of any file
+func (b *ORMBuilder) ormableTableName(ormable *OrmableType) string {
+	if message := b.ormableMessage(ormable); message != nil {
+		return b.tableName(message)
+	}
+	return b.naming.TableName(ormable.OriginName)
 }
 
-func (b *ORMBuilder) generateBeforePatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(&pbObj).(`, orm.OriginName, `WithBeforePatch`, suffix, `); ok {`)
-	g.P(`if db, err = hook.BeforePatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
-	g.P(`return nil, err`)
+// ormableBaseTableName returns the table of an ormable type of any file
+// without its schema
+func (b *ORMBuilder) ormableBaseTableName(ormable *OrmableType) string {
+	if message := b.ormableMessage(ormable); message != nil {
+		return b.baseTableName(message)
+	}
+	return b.naming.TableName(ormable.OriginName)
+}
+
+// generateDeferConstraints defers the deferrable constraints of the
+// transaction the handler runs in, outside of one every statement commits
+// on its own and there is nothing to defer
+func (b *ORMBuilder) generateDeferConstraints(errReturn string, g *protogen.GeneratedFile) {
+	if !b.deferrable {
+		return
+	}
+	g.P(`if _, ok := db.CommonDB().(*`, generateImport("Tx", stdSQLImport, g), `); ok {`)
+	g.P(`if err := db.Exec("SET CONSTRAINTS ALL DEFERRED").Error; err != nil {`)
+	g.P(`return `, errReturn)
 	g.P(`}`)
 	g.P(`}`)
 }
 
-func (b *ORMBuilder) generateAfterPatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`if hook, ok := interface{}(pbResponse).(`, orm.OriginName, `WithAfterPatch`, suffix, `); ok {`)
-	g.P(`if err = hook.AfterPatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
+// generateAuditBegin opens the transaction the audit records and the history
+// are written in unless the handler already runs in one, and extracts the
+// actor of the audit
+func (b *ORMBuilder) generateAuditBegin(message *protogen.Message, errReturn string, g *protogen.GeneratedFile) {
+	if !tracksWrites(message) {
+		return
+	}
+	scope, _, _, _ := trackedNames(message)
+	if getMessageOptions(message).GetAudit() {
+		g.P(`db, `, scope, `, err := `, generateImport("Begin", auditImport, g), `(ctx, db)`)
+	} else {
+		g.P(`db, `, scope, `, err := `, generateImport("Begin", txnImport, g), `(ctx, db, nil)`)
+	}
+	g.P(`if err != nil {`)
+	g.P(`return `, errReturn)
 	g.P(`}`)
+	g.P(`defer `, scope, `.Rollback()`)
+	if getMessageOptions(message).GetAudit() {
+		b.generateActor(`auditActor`, errReturn, g)
+	}
 }
 
-func (b *ORMBuilder) generateBeforePatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`type `, orm.OriginName, `WithBeforePatch`, suffix, ` interface {`)
-	g.P(`BeforePatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
-		`) (*`, generateImport("DB", gormImport, g), `, error)`)
+// trackedNames returns the names of the transaction scope, the prior row, its
+// presence and the prior rows of the handlers of a tracked message, those of
+// the audit for an audited one and of its history otherwise
+func trackedNames(message *protogen.Message) (scope, row, found, rows string) {
+	if getMessageOptions(message).GetAudit() {
+		return "auditTxn", "auditRow", "auditFound", "auditRows"
+	}
+	return "historyTxn", "prior", "priorFound", "priors"
+}
+
+// tracksWrites reports whether the writes of the message are audited or kept
+// in its history
+func tracksWrites(message *protogen.Message) bool {
+	return getMessageOptions(message).GetAudit() || getMessageOptions(message).GetHistory()
+}
+
+func (b *ORMBuilder) generateAuditCommit(message *protogen.Message, errReturn string, g *protogen.GeneratedFile) {
+	if !tracksWrites(message) {
+		return
+	}
+	scope, _, _, _ := trackedNames(message)
+	g.P(`if err = `, scope, `.Commit(); err != nil {`)
+	g.P(`return `, errReturn)
 	g.P(`}`)
 }
 
-func (b *ORMBuilder) generateAfterPatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
-	g.P(`type `, orm.OriginName, `WithAfterPatch`, suffix, ` interface {`)
-	g.P(`AfterPatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
-		`) error`)
+// generateAuditWrite writes the audit record keyed by the primary key of the
+// row, before and after are ORM objects and either is left empty for a missing
+// row, before is also missing unless the condition beforeIf holds
+func (b *ORMBuilder) generateAuditWrite(message *protogen.Message, operation, row, before, beforeIf, after, errReturn string, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	var keys []string
+	for _, name := range b.manyToManyKeys(ormable, "") {
+		key := row + "." + name
+		if strings.HasPrefix(ormable.Fields[name].Type, "*") {
+			key = "*" + key
+		}
+		keys = append(keys, key)
+	}
+	key := strings.Join(keys, `, "/", `)
+	g.P(`var auditBefore, auditAfter `, generateImport("Message", protoImport, g))
+	for _, state := range [][]string{{`auditBefore`, before, beforeIf}, {`auditAfter`, after, ""}} {
+		if state[1] == "" {
+			continue
+		}
+		if state[2] != "" {
+			g.P(`if `, state[2], ` {`)
+		}
+		g.P(`if pb, err := `, state[1], `.ToPB(ctx); err != nil {`)
+		g.P(`return `, errReturn)
+		g.P(`} else {`)
+		g.P(state[0], ` = &pb`)
+		g.P(`}`)
+		if state[2] != "" {
+			g.P(`}`)
+		}
+	}
+	g.P(`auditRecord := `, generateImport("Record", auditImport, g), `{Resource: "`, b.tableName(message), `", Key: `,
+		generateImport("Sprint", stdFmtImport, g), `(`, key, `), Operation: "`, operation, `", Actor: auditActor}`)
+	g.P(`if err = `, generateImport("Write", auditImport, g), `(db, auditRecord, auditBefore, auditAfter); err != nil {`)
+	g.P(`return `, errReturn)
 	g.P(`}`)
 }
 
-func (b *ORMBuilder) generatePatchSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	var isMultiAccount bool
+// historyColumn is a column of the history of a type, the fields of an
+// embedded struct are flattened, e.g. AddressStreet of Address.Street
+type historyColumn struct {
+	name, path, column string
+	field              *Field
+}
 
-	typeName := string(message.Desc.Name())
-	if getMessageOptions(message).GetMultiAccount() {
-		isMultiAccount = true
+// historyColumns returns the columns of the history type of the ormable, the
+// columns of the ORM type in the order of columnFields
+func (b *ORMBuilder) historyColumns(ormable *OrmableType) []historyColumn {
+	var columns []historyColumn
+	for _, path := range b.columnFields(ormable) {
+		field, parts := (*Field)(nil), strings.Split(path, ".")
+		for owner, i := ormable, 0; i < len(parts); i++ {
+			field = owner.Fields[parts[i]]
+			if i+1 < len(parts) {
+				owner = b.getOrmable(field.Type)
+			}
+		}
+		columns = append(columns, historyColumn{name: strings.Join(parts, ""), path: path, column: b.fieldColumn(ormable, path), field: field})
 	}
+	return columns
+}
 
-	if isMultiAccount && !b.hasIDField(message) {
-		g.P(fmt.Sprintf("// Cannot autogen DefaultPatchSet%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
+// generateHistory emits the {Type}HistoryORM of the prior versions of the
+// rows of a type with the history option and the function writing them
+func (b *ORMBuilder) generateHistory(g *protogen.GeneratedFile, message *protogen.Message) {
+	if !getMessageOptions(message).GetHistory() {
 		return
 	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !b.hasPrimaryKey(ormable) || len(b.manyToManyKeys(ormable, "")) != 1 {
+		panic(fmt.Sprintf("history of %s needs a single primary key", typeName))
+	}
+	if ormable.SavedByCreate {
+		panic(fmt.Sprintf("history of %s cannot have the create mode SAVE, which updates rows without writing their history", typeName))
+	}
+	pkName, pk := b.findPrimaryKey(ormable)
+	name := typeName + "HistoryORM"
+	table := b.baseTableName(message) + "_history"
+	columns := b.historyColumns(ormable)
+	for _, column := range columns {
+		switch column.name {
+		case "HistoryID", "ValidFrom", "ValidTo", "Operation":
+			panic(fmt.Sprintf("history of %s has a %s of its own, the field %s of %s clashes with it", typeName, column.name, column.path, ormable.Name))
+		}
+	}
 
-	_ = generateImport("", "fmt", g)
-	g.P(`// DefaultPatchSet`, typeName, ` executes a bulk gorm update call with patch behavior`)
-	g.P(`func DefaultPatchSet`, typeName, `(ctx context.Context, objects []*`,
-		typeName, `, updateMasks []*`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) ([]*`, typeName, `, error) {`)
-	g.P(`if len(objects) != len(updateMasks) {`)
-	g.P(`return nil, fmt.Errorf(`, generateImport("BadRepeatedFieldMaskTpl", gerrorsImport, g), `, len(updateMasks), len(objects))`)
+	g.P(`// `, name, ` is a prior version of a `, ormable.Name, ` row, valid from ValidFrom, the`)
+	g.P(`// end of the version before it or nil for the first one, until ValidTo, when`)
+	g.P(`// Operation, "update" or "delete", replaced it`)
+	g.P(`type `, name, ` struct {`)
+	g.P(`HistoryID uint64 ` + "`" + `gorm:"primary_key"` + "`")
+	g.P(`ValidFrom *`, generateImport("Time", stdTimeImport, g))
+	g.P(`ValidTo `, generateImport("Time", stdTimeImport, g))
+	g.P(`Operation string`)
+	for _, column := range columns {
+		tag := column.field.GetTag()
+		res := fmt.Sprintf("column:%s;", column.column)
+		if tag.GetType() != "" {
+			res += fmt.Sprintf("type:%s;", tag.GetType())
+		}
+		if tag.GetSize() > 0 {
+			res += fmt.Sprintf("size:%d;", tag.GetSize())
+		}
+		if tag.GetPrecision() > 0 {
+			res += fmt.Sprintf("precision:%d;", tag.GetPrecision())
+		}
+		// the versions are looked up by the key of their row
+		if column.path == pkName {
+			res += fmt.Sprintf("index:idx_%s_%s;", table, column.column)
+		}
+		g.P(column.name, ` `, column.field.Type, " `", `gorm:"`, strings.TrimRight(res, ";"), `"`, "`")
+	}
 	g.P(`}`)
-	g.P(``)
-	g.P(`results := make([]*`, typeName, `, 0, len(objects))`)
-	g.P(`for i, patcher := range objects {`)
-	g.P(`pbResponse, err := DefaultPatch`, typeName, `(ctx, patcher, updateMasks[i], db)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
+	g.P()
+	g.P(`// TableName overrides the default tablename generated by GORM`)
+	g.P(`func (`, name, `) TableName() string {`)
+	g.P(`return "`, b.tableName(message), `_history"`)
 	g.P(`}`)
-	g.P(``)
-	g.P(`results = append(results, pbResponse)`)
+	g.P()
+	g.P(`// ToPB converts the version to the `, typeName, ` it was`)
+	g.P(`func (m *`, name, `) ToPB(ctx `, generateImport("Context", stdCtxImport, g), `) (`, typeName, `, error) {`)
+	g.P(`to := `, ormable.Name, `{}`)
+	for _, column := range columns {
+		g.P(`to.`, column.path, ` = m.`, column.name)
+	}
+	g.P(`return to.ToPB(ctx)`)
 	g.P(`}`)
-	g.P(``)
-	g.P(`return results, nil`)
+	g.P()
+	g.P(`// write`, typeName, `History inserts the prior version of the row, valid since the end of`)
+	g.P(`// its last version, the handlers call it in the transaction of the write`)
+	g.P(`func write`, typeName, `History(db *`, generateImport("DB", gormImport, g), `, prior *`, ormable.Name, `, operation string) error {`)
+	g.P(`db = db.New().Unscoped()`)
+	g.P(`last := `, name, `{}`)
+	g.P(`err := db.Where("`, columnName(pkName, pk), ` = ?", prior.`, pkName, `).Order("valid_to DESC, history_id DESC").First(&last).Error`)
+	g.P(`if err != nil && !`, generateImport("IsRecordNotFoundError", gormImport, g), `(err) {`)
+	g.P(`return err`)
 	g.P(`}`)
-
+	g.P(`version := `, name, `{ValidTo: `, generateImport("Now", stdTimeImport, g), `().UTC(), Operation: operation}`)
+	g.P(`if err == nil {`)
+	g.P(`version.ValidFrom = &last.ValidTo`)
+	g.P(`}`)
+	for _, column := range columns {
+		g.P(`version.`, column.name, ` = prior.`, column.path)
+	}
+	g.P(`return db.Create(&version).Error`)
+	g.P(`}`)
+	g.P()
 }
 
-func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protogen.GeneratedFile) {
+// generateHistoryHandler emits DefaultHistoryOf{Type} listing the prior
+// versions of a row of a type with the history option
+func (b *ORMBuilder) generateHistoryHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	if !getMessageOptions(message).GetHistory() {
+		return
+	}
 	typeName := string(message.Desc.Name())
-	g.P(`// DefaultApplyFieldMask`, typeName, ` patches an pbObject with patcher according to a field mask.`)
-	g.P(`func DefaultApplyFieldMask`, typeName, `(ctx context.Context, patchee *`,
-		typeName, `, patcher *`, typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g),
-		`, prefix string, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
-
-	g.P(`if patcher == nil {`)
-	g.P(`return nil, nil`)
-	g.P(`} else if patchee == nil {`)
-	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	ormable := b.getOrmable(typeName)
+	pkName, pk := b.findPrimaryKey(ormable)
+	g.P(`// DefaultHistoryOf`, typeName, ` returns the prior versions of the `, typeName, ` of the id from`)
+	g.P(`// the oldest, the current version is the row itself. For a sharded type db`)
+	g.P(`// is the handle of the shard of the row.`)
+	g.P(`func DefaultHistoryOf`, typeName, `(ctx `, generateImport("Context", stdCtxImport, g), `, db *`, generateImport("DB", gormImport, g), `, id `, strings.TrimPrefix(pk.Type, "*"), `) `, b.handlerResults(`[]*`+typeName+`HistoryORM`), ` {`)
+	b.generateMetricsObserve(message, "history", g)
+	b.generateStatusErrors(g)
+	b.generateSessionBegin(message, `nil, err`, g)
+	g.P(`db = db.Unscoped().Where("`, columnName(pkName, pk), ` = ?", id)`)
+	if getMessageOptions(message).GetMultiAccount() {
+		g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`db = db.Where("`, columnName("AccountID", ormable.Fields["AccountID"]), ` = ?", accountID)`)
+	}
+	g.P(`versions := []*`, typeName, `HistoryORM{}`)
+	g.P(`if err := db.Order("valid_to, history_id").Find(&versions).Error; err != nil {`)
+	g.P(`return nil, err`)
 	g.P(`}`)
-	g.P(`var err error`)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`return versions, nil`)
+	g.P(`}`)
+	g.P()
+}
 
-	hasNested := false
-	for _, field := range message.Fields {
-		fieldType := getFieldType(field)
+// generateHistoryWrite writes the prior version row of a type with the
+// history option
+func (b *ORMBuilder) generateHistoryWrite(message *protogen.Message, operation, row, errReturn string, g *protogen.GeneratedFile) {
+	if !getMessageOptions(message).GetHistory() {
+		return
+	}
+	g.P(`if err = write`, message.Desc.Name(), `History(db, `, row, `, "`, operation, `"); err != nil {`)
+	g.P(`return `, errReturn)
+	g.P(`}`)
+}
 
-		if field.Message != nil && !isSpecialType(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
-			g.P(`var updated`, camelCase(field.GoName), ` bool`)
-			hasNested = true
-		} else if strings.HasSuffix(fieldType, protoTypeJSON) {
-			g.P(`var updated`, camelCase(field.GoName), ` bool`)
+// parseIndexTag splits an index tag value into the index names, the index
+// method of the type option, the nulls_not_distinct option and the columns of
+// the include option, e.g. "idx_meta,type:gin". The include option takes the
+// parts after it that are not options, e.g. "idx_x,include:email,name".
+func parseIndexTag(value string) (name, indexType string, nullsNotDistinct bool, include []string) {
+	var names []string
+	including := false
+	for _, part := range strings.Split(value, ",") {
+		if option := strings.ToLower(strings.TrimSpace(part)); strings.HasPrefix(option, "type:") {
+			indexType = strings.TrimSpace(strings.TrimPrefix(option, "type:"))
+		} else if option == "nulls_not_distinct" {
+			nullsNotDistinct = true
+		} else if strings.HasPrefix(option, "include:") {
+			including = true
+			include = append(include, strings.TrimSpace(strings.TrimSpace(part)[len("include:"):]))
+		} else if including {
+			include = append(include, strings.TrimSpace(part))
+		} else {
+			names = append(names, part)
 		}
 	}
+	return strings.Join(names, ","), indexType, nullsNotDistinct, include
+}
 
-	// Patch pbObj with input according to a field mask.
-	if hasNested {
-		g.P(`for i, f := range updateMask.Paths {`)
-	} else {
-		g.P(`for _, f := range updateMask.Paths {`)
+// indexWhere validates the index_where predicate of the indexes of a field,
+// MySQL has no partial indexes
+func (b *ORMBuilder) indexWhere(ormable *OrmableType, fieldName string, tag *gorm.GormTag) string {
+	where := tag.GetIndexWhere()
+	if where == "" {
+		return ""
 	}
-	for _, field := range message.Fields {
-		ccName := camelCase(field.GoName)
+	if tag.GetIndex() == "" && tag.GetUniqueIndex() == "" {
+		panic(fmt.Sprintf("index_where of %s in %s needs an index or unique_index", fieldName, ormable.Name))
+	}
+	if b.dbEngine == ENGINE_UNSET {
+		panic(fmt.Sprintf("index_where of %s in %s needs engine=postgres or engine=sqlite", fieldName, ormable.Name))
+	}
+	checkExpression(ormable, fieldName, "index_where", where)
+	return where
+}
 
-		fieldType := getFieldType(field)
-		//  for ormable message, do recursive patching
+// indexIncludeColumns validates the include option of the index of a field
+// and returns its columns, given by proto field or column name. A covering
+// index needs a btree or gist index of postgres 11 or later.
+func (b *ORMBuilder) indexIncludeColumns(ormable *OrmableType, fieldName, indexType string, include []string) []string {
+	if len(include) == 0 {
+		return nil
+	}
+	if b.dbEngine != ENGINE_POSTGRES {
+		panic(fmt.Sprintf("index of %s in %s includes columns, which needs engine=postgres", fieldName, ormable.Name))
+	}
+	if b.postgresVersion != 0 && b.postgresVersion < 11 {
+		panic(fmt.Sprintf("index of %s in %s includes columns, which needs postgres 11 or later, postgres_version is %d", fieldName, ormable.Name, b.postgresVersion))
+	}
+	if indexType != "" && indexType != "btree" && indexType != "gist" {
+		panic(fmt.Sprintf("index of %s in %s includes columns, which a %s index cannot, only btree and gist ones can", fieldName, ormable.Name, indexType))
+	}
+	var columns []string
+	for _, ref := range include {
+		column := ""
+		for name, field := range ormable.Fields {
+			if isAssociation(field) || isEmbedded(field) || field.GetTag().GetIgnore() {
+				continue
+			}
+			if name == camelCase(ref) || columnName(name, field) == ref {
+				column = columnName(name, field)
+			}
+		}
+		if column == "" {
+			panic(fmt.Sprintf("index of %s in %s includes %q, which is not a column of %s", fieldName, ormable.Name, ref, ormable.Name))
+		}
+		if column == columnName(fieldName, ormable.Fields[fieldName]) {
+			panic(fmt.Sprintf("index of %s in %s includes its own column", fieldName, ormable.Name))
+		}
+		for _, other := range columns {
+			if other == column {
+				panic(fmt.Sprintf("index of %s in %s includes the column %s twice", fieldName, ormable.Name, column))
+			}
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// uniqueWithIndex is a composite unique index of the unique_with option of
+// a field, the first of its fields
+type uniqueWithIndex struct {
+	name    string
+	fields  []string
+	columns []string
+}
+
+// gormKeyName is the name BuildKeyName of GORM gives an unnamed index
+var gormKeyName = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// uniqueWithIndexes returns the unique indexes of the unique_with options of
+// the type, named after their sorted columns. An index of the same columns
+// as another one or as a unique_index tag is left out.
+func (b *ORMBuilder) uniqueWithIndexes(ormable *OrmableType) []uniqueWithIndex {
+	var fieldNames []string
+	for name := range ormable.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+
+	tagged := map[string][]string{}
+	for _, name := range fieldNames {
+		field := ormable.Fields[name]
+		if index := field.GetTag().GetUniqueIndex(); index != "" && isGormIndex(index) && field.GetTag().GetIndexWhere() == "" {
+			if indexName, _, _, _ := parseIndexTag(index); indexName != "" {
+				tagged[indexName] = append(tagged[indexName], columnName(name, field))
+			}
+		}
+	}
+	seen := map[string]bool{}
+	for _, columns := range tagged {
+		sort.Strings(columns)
+		seen[strings.Join(columns, ",")] = true
+	}
+
+	var indexes []uniqueWithIndex
+	for _, name := range fieldNames {
+		field := ormable.Fields[name]
+		if len(field.GetUniqueWith()) == 0 {
+			continue
+		}
+		if isAssociation(field) || field.GetTag().GetIgnore() {
+			panic(fmt.Sprintf("Field %s of %s has unique_with but is not a column", name, ormable.Name))
+		}
+		index := uniqueWithIndex{fields: []string{name}}
+		for _, ref := range field.GetUniqueWith() {
+			refName := ""
+			for other, otherField := range ormable.Fields {
+				if isAssociation(otherField) || otherField.GetTag().GetIgnore() {
+					continue
+				}
+				// the GORM column still refers to the field under a naming strategy
+				if other == camelCase(ref) || columnName(other, otherField) == ref || jgorm.ToDBName(other) == ref {
+					refName = other
+				}
+			}
+			if refName == "" {
+				panic(fmt.Sprintf("unique_with of %s in %s refers to %q, which is not a column of %s", name, ormable.Name, ref, ormable.Name))
+			}
+			for _, f := range index.fields {
+				if f == refName {
+					panic(fmt.Sprintf("unique_with of %s in %s repeats the column of %s", name, ormable.Name, refName))
+				}
+			}
+			index.fields = append(index.fields, refName)
+		}
+		for _, f := range index.fields {
+			index.columns = append(index.columns, columnName(f, ormable.Fields[f]))
+		}
+		sort.Strings(index.columns)
+		key := strings.Join(index.columns, ",")
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		index.name = fmt.Sprintf("uix_%s_%s", b.ormableBaseTableName(ormable), strings.Join(index.columns, "_"))
+		if _, ok := tagged[index.name]; ok {
+			panic(fmt.Sprintf("unique_with of %s in %s is named %s, which is the unique_index of other columns", name, ormable.Name, index.name))
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// isGormIndex reports whether GORM can create the index of the tag value, it
+// knows neither index methods other than btree, NULLS NOT DISTINCT nor INCLUDE
+func isGormIndex(value string) bool {
+	_, indexType, nullsNotDistinct, include := parseIndexTag(value)
+	return (indexType == "" || indexType == "btree") && !nullsNotDistinct && len(include) == 0
+}
+
+// checkNullsNotDistinct validates a nulls_not_distinct index, it needs a
+// unique index of postgres 15 or later
+func (b *ORMBuilder) checkNullsNotDistinct(ormable *OrmableType, fieldName string, unique bool) {
+	if !unique {
+		panic(fmt.Sprintf("index of %s in %s cannot be nulls_not_distinct, only unique indexes are", fieldName, ormable.Name))
+	}
+	if b.dbEngine != ENGINE_POSTGRES {
+		panic(fmt.Sprintf("nulls_not_distinct unique index of %s in %s needs engine=postgres", fieldName, ormable.Name))
+	}
+	if b.postgresVersion != 0 && b.postgresVersion < 15 {
+		panic(fmt.Sprintf("nulls_not_distinct unique index of %s in %s needs postgres 15 or later, postgres_version is %d", fieldName, ormable.Name, b.postgresVersion))
+	}
+}
+
+// checkIndexType validates the index method against the column, GIN needs
+// a jsonb, array or tsvector column, GIST a tsvector, network, geometric or
+// range one and BRIN a naturally ordered scalar
+func (b *ORMBuilder) checkIndexType(ormable *OrmableType, fieldName string, field *Field, indexType string, unique bool) {
+	if indexType == "" {
+		return
+	}
+	if b.dbEngine != ENGINE_POSTGRES {
+		panic(fmt.Sprintf("index type %s of %s in %s needs engine=postgres", indexType, fieldName, ormable.Name))
+	}
+	if unique && indexType != "btree" {
+		panic(fmt.Sprintf("unique index of %s in %s cannot be of type %s, only btree supports unique indexes", fieldName, ormable.Name, indexType))
+	}
+	sqlType := strings.ToLower(field.GetTag().GetType())
+	goType := strings.TrimPrefix(field.Type, "*")
+	isArray := strings.HasSuffix(sqlType, "[]") || strings.HasSuffix(goType, "Array") ||
+		(strings.HasPrefix(goType, "[]") && goType != "[]byte")
+	isJSON := sqlType == "jsonb" || strings.HasSuffix(goType, ".Jsonb")
+	var fits bool
+	switch indexType {
+	case "btree":
+		fits = true
+	case "hash":
+		fits = !isArray && !isJSON
+	case "gin":
+		fits = isArray || isJSON || sqlType == "tsvector"
+	case "gist":
+		switch sqlType {
+		case "tsvector", "inet", "cidr", "point", "box", "polygon", "circle", "geometry", "geography",
+			"geometry(point,4326)", "geography(point,4326)":
+			fits = true
+		default:
+			fits = strings.HasSuffix(sqlType, "range") || strings.HasSuffix(goType, ".Inet")
+		}
+	case "brin":
+		switch sqlType {
+		case "date", "timestamp", "timestamptz", "smallint", "integer", "bigint", "numeric", "real", "double precision":
+			fits = true
+		case "":
+			fits = strings.HasSuffix(goType, "time.Time") || strings.Contains(goType, "int") || strings.HasPrefix(goType, "float")
+		}
+	default:
+		panic(fmt.Sprintf("unknown index type %s of %s in %s, one of btree, hash, gin, gist or brin is expected", indexType, fieldName, ormable.Name))
+	}
+	if !fits {
+		column := sqlType
+		if column == "" {
+			column = goType
+		}
+		panic(fmt.Sprintf("index type %s of %s in %s does not fit its %s column", indexType, fieldName, ormable.Name, column))
+	}
+}
+
+// columnName returns the DB column of the ORM field
+func columnName(fieldName string, field *Field) string {
+	if column := field.GetTag().GetColumn(); len(column) > 0 {
+		return column
+	}
+	return jgorm.ToDBName(fieldName)
+}
+
+// applyNamingStrategy tags the columns of every ormable type that the naming
+// strategy names unlike GORM, so that the tags are the only source of the
+// column names
+func (b *ORMBuilder) applyNamingStrategy() {
+	for _, ormable := range b.ormableTypes {
+		table := b.ormableBaseTableName(ormable)
+		b.checkStrategyName(table, "table of "+ormable.OriginName)
+		for name, field := range ormable.Fields {
+			if isAssociation(field) || field.GetThrough() != "" || field.GetTag().GetIgnore() || field.GetTag().GetColumn() != "" ||
+				strings.HasPrefix(field.Type, "[]") || strings.HasSuffix(field.Type, "ORM") {
+				continue
+			}
+			column := b.naming.ColumnName(table, name)
+			if column == jgorm.ToDBName(name) {
+				continue
+			}
+			b.checkStrategyName(column, "column of "+ormable.Name+"."+name)
+			if field.GormFieldOptions == nil {
+				field.GormFieldOptions = &gorm.GormFieldOptions{}
+			}
+			if field.Tag == nil {
+				field.Tag = &gorm.GormTag{}
+			}
+			field.Tag.Column = column
+		}
+	}
+}
+
+// checkStrategyName panics if a name given by the naming strategy would need
+// quoting, postgres folds unquoted identifiers to lower case
+func (b *ORMBuilder) checkStrategyName(name, what string) {
+	if !plainIdentifier.MatchString(name) {
+		panic(fmt.Sprintf("naming strategy gives the %s as %q, which is not a plain identifier", what, name))
+	}
+	if b.dbEngine == ENGINE_POSTGRES && strings.ToLower(name) != name {
+		panic(fmt.Sprintf("naming strategy gives the %s as %q, postgres folds unquoted identifiers to lower case", what, name))
+	}
+}
+
+func isAssociation(field *Field) bool {
+	return field.GetHasOne() != nil || field.GetBelongsTo() != nil || field.GetHasMany() != nil || field.GetManyToMany() != nil
+}
+
+// isEmbedded reports whether the field is the embedded struct of the ORM type
+// of a message, see the embedded tag
+func isEmbedded(field *Field) bool {
+	return field != nil && field.GetTag().GetEmbedded() && strings.HasSuffix(field.Type, "ORM") && !strings.HasPrefix(field.Type, "*")
+}
+
+func (b *ORMBuilder) generateOrmable(g *protogen.GeneratedFile, message *protogen.Message) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	g.P(`type `, ormable.Name, ` struct {`)
+
+	var names []string
+	for name := range ormable.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := ormable.Fields[name]
+		sp := strings.Split(field.Type, ".")
+		if len(sp) == 2 && sp[1] == "UUID" {
+			s := generateImport("UUID", uuidImport, g)
+			if field.Type[0] == '*' {
+				field.Type = "*" + s
+			} else {
+				field.Type = s
+			}
+		}
+
+		g.P(name, ` `, field.Type, b.renderGormTag(ormable, name, field))
+	}
+
+	g.P(`}`)
+	g.P()
+}
+
+func (b *ORMBuilder) parseAssociations(msg *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := camelCase(string(msg.Desc.Name())) // TODO: camelSnakeCase
+	ormable := b.getOrmable(typeName)
+
+	for _, field := range msg.Fields {
+		options := field.Desc.Options().(*descriptorpb.FieldOptions)
+		fieldOpts := getFieldOptions(options)
+		if fieldOpts.GetDrop() {
+			continue
+		}
+
+		fieldName := camelCase(string(field.Desc.Name()))
+		var fieldType string
+
+		if field.Desc.Message() == nil {
+			fieldType = field.Desc.Kind().String() // was GoType
+		} else {
+			fieldType = string(field.Desc.Message().Name())
+		}
+
+		fieldType = strings.Trim(fieldType, "[]*")
+		parts := strings.Split(fieldType, ".")
+		fieldTypeShort := parts[len(parts)-1]
+
+		if b.isOrmable(fieldType) {
+			if fieldOpts == nil {
+				fieldOpts = &gorm.GormFieldOptions{}
+			}
+			assocOrmable := b.getOrmable(fieldType)
+
+			if field.Message != nil {
+				fieldType = b.typeName(field.Message.GoIdent, g)
+			}
+			if fieldOpts.GetTag().GetEmbedded() {
+				if field.Desc.IsList() || isAssociation(&Field{GormFieldOptions: fieldOpts}) || fieldOpts.GetAssociationTag() != "" {
+					panic(fmt.Sprintf("Field %s of %s is embedded, it cannot be repeated or an association", fieldName, ormable.Name))
+				}
+				// the columns of the ORM type of the message are embedded,
+				// converted by its own ToORM and ToPB
+				ormable.Fields[fieldName] = &Field{Type: fieldType + "ORM", GormFieldOptions: fieldOpts}
+				continue
+			}
+			if fieldOpts.GetAssociationTag() != "" {
+				applyAssociationTag(ormable, fieldName, field.Desc.IsList(), fieldOpts)
+			}
+
+			if field.Desc.Cardinality() == protoreflect.Repeated {
+				if fieldOpts.GetThrough() != "" {
+					// loaded by the generated LoadXThroughY method, not by GORM
+					if fieldOpts.Tag == nil {
+						fieldOpts.Tag = &gorm.GormTag{}
+					}
+					fieldOpts.Tag.Ignore = true
+				} else if fieldOpts.GetManyToMany() != nil {
+					b.parseManyToMany(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
+				} else {
+					b.parseHasMany(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
+				}
+				fieldType = fmt.Sprintf("[]*%sORM", fieldType)
+			} else {
+				if fieldOpts.GetBelongsTo() != nil {
+					b.parseBelongsTo(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
+				} else {
+					b.parseHasOne(msg, ormable, fieldName, fieldTypeShort, assocOrmable, fieldOpts)
+				}
+				fieldType = fmt.Sprintf("*%sORM", fieldType)
+			}
+
+			b.checkForeignKeyActions(ormable, assocOrmable, fieldName, fieldOpts)
+
+			// Register type used, in case it's an imported type from another package
+			// b.GetFileImports().typesToRegister = append(b.GetFileImports().typesToRegister, fieldType) // maybe we need other fields type
+			ormable.Fields[fieldName] = &Field{Type: fieldType, GormFieldOptions: fieldOpts}
+		} else if fieldOpts.GetAssociationTag() != "" {
+			panic(fmt.Sprintf("Field %s of %s has an association_tag but is not an association", fieldName, ormable.Name))
+		}
+	}
+}
+
+// parseAssociationTag splits an association_tag into its key and value
+// pairs, a key without a value has an empty one
+func parseAssociationTag(ormable *OrmableType, fieldName, value string) [][2]string {
+	if strings.ContainsAny(value, "\"`") {
+		panic(fmt.Sprintf("association_tag of %s in %s cannot contain quotes", fieldName, ormable.Name))
+	}
+	var pairs [][2]string
+	for _, setting := range strings.Split(strings.TrimSuffix(value, ";"), ";") {
+		kv := strings.SplitN(setting, ":", 2)
+		key := strings.TrimSpace(kv[0])
+		if !associationTagKey.MatchString(key) {
+			panic(fmt.Sprintf("association_tag of %s in %s has a malformed setting %q, key:value or key is expected", fieldName, ormable.Name, setting))
+		}
+		pair := [2]string{key, ""}
+		if len(kv) == 2 {
+			pair[1] = strings.TrimSpace(kv[1])
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+var associationTagKey = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// applyAssociationTag copies the keys of the association_tag into the
+// association options, so that the generated key fields and handlers match
+// the ones GORM uses
+func applyAssociationTag(ormable *OrmableType, fieldName string, repeated bool, opts *gorm.GormFieldOptions) {
+	pairs := parseAssociationTag(ormable, fieldName, opts.GetAssociationTag())
+	if opts.Association == nil {
+		hasMany2Many := false
+		for _, pair := range pairs {
+			hasMany2Many = hasMany2Many || strings.EqualFold(pair[0], "many2many")
+		}
+		switch {
+		case repeated && hasMany2Many:
+			opts.Association = &gorm.GormFieldOptions_ManyToMany{ManyToMany: &gorm.ManyToManyOptions{}}
+		case repeated:
+			opts.Association = &gorm.GormFieldOptions_HasMany{HasMany: &gorm.HasManyOptions{}}
+		default:
+			opts.Association = &gorm.GormFieldOptions_HasOne{HasOne: &gorm.HasOneOptions{}}
+		}
+	}
+	for _, pair := range pairs {
+		key, value := strings.ToLower(pair[0]), pair[1]
+		switch assoc := opts.Association.(type) {
+		case *gorm.GormFieldOptions_HasOne:
+			switch key {
+			case "foreignkey":
+				assoc.HasOne.Foreignkey = value
+			case "association_foreignkey":
+				assoc.HasOne.AssociationForeignkey = value
+			}
+		case *gorm.GormFieldOptions_BelongsTo:
+			switch key {
+			case "foreignkey":
+				assoc.BelongsTo.Foreignkey = value
+			case "association_foreignkey":
+				assoc.BelongsTo.AssociationForeignkey = value
+			}
+		case *gorm.GormFieldOptions_HasMany:
+			switch key {
+			case "foreignkey":
+				assoc.HasMany.Foreignkey = value
+			case "association_foreignkey":
+				assoc.HasMany.AssociationForeignkey = value
+			case "many2many":
+				panic(fmt.Sprintf("association_tag of %s in %s has many2many, but the field is a has-many", fieldName, ormable.Name))
+			}
+		case *gorm.GormFieldOptions_ManyToMany:
+			switch key {
+			case "many2many":
+				assoc.ManyToMany.Jointable = value
+			case "foreignkey":
+				assoc.ManyToMany.Foreignkey = value
+			case "association_foreignkey":
+				assoc.ManyToMany.AssociationForeignkey = value
+			case "jointable_foreignkey":
+				assoc.ManyToMany.JointableForeignkey = value
+			case "association_jointable_foreignkey":
+				assoc.ManyToMany.AssociationJointableForeignkey = value
+			}
+		}
+	}
+}
+
+func (b *ORMBuilder) hasPrimaryKey(ormable *OrmableType) bool {
+	for _, field := range ormable.Fields {
+		if field.GetTag().GetPrimaryKey() {
+			return true
+		}
+	}
+	for fieldName := range ormable.Fields {
+		if strings.ToLower(fieldName) == "id" {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *ORMBuilder) isOrmable(typeName string) bool {
+	_, ok := b.ormableTypes[typeName]
+	return ok
+}
+
+func (b *ORMBuilder) findPrimaryKey(ormable *OrmableType) (string, *Field) {
+	// sorted, so that the first field of a composite key is picked every time
+	var fieldNames []string
+	for fieldName := range ormable.Fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		if field := ormable.Fields[fieldName]; field.GetTag().GetPrimaryKey() {
+			return fieldName, field
+		}
+	}
+	for _, fieldName := range fieldNames {
+		if strings.ToLower(fieldName) == "id" {
+			return fieldName, ormable.Fields[fieldName]
+		}
+	}
+
+	panic("no primary_key")
+}
+
+func (b *ORMBuilder) getOrmable(typeName string) *OrmableType {
+	r, err := GetOrmable(b.ormableTypes, typeName)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// checkForeignKeyActions validates the on_delete and on_update actions of an
+// association, they apply to the foreign key column so many-to-many and
+// through associations cannot have them
+func (b *ORMBuilder) checkForeignKeyActions(ormable, assoc *OrmableType, fieldName string, opts *gorm.GormFieldOptions) {
+	actions := []gorm.GormFieldOptions_ForeignKeyAction{opts.GetOnDelete(), opts.GetOnUpdate()}
+	if actions[0] == gorm.GormFieldOptions_ACTION_UNSET && actions[1] == gorm.GormFieldOptions_ACTION_UNSET {
+		return
+	}
+	var fk *Field
+	if hasOne := opts.GetHasOne(); hasOne != nil {
+		fk = assoc.Fields[hasOne.GetForeignkey()]
+	} else if hasMany := opts.GetHasMany(); hasMany != nil {
+		fk = assoc.Fields[hasMany.GetForeignkey()]
+	} else if belongsTo := opts.GetBelongsTo(); belongsTo != nil {
+		fk = ormable.Fields[belongsTo.GetForeignkey()]
+	} else {
+		panic(fmt.Sprintf("on_delete and on_update of %s in %s need a has-one, has-many or belongs-to association", fieldName, ormable.Name))
+	}
+	for _, action := range actions {
+		switch action {
+		case gorm.GormFieldOptions_SET_NULL:
+			if !strings.HasPrefix(fk.Type, "*") || fk.GetTag().GetNotNull() {
+				panic(fmt.Sprintf("SET_NULL action of %s in %s needs a nullable foreign key", fieldName, ormable.Name))
+			}
+		case gorm.GormFieldOptions_SET_DEFAULT:
+			if b.dbEngine == ENGINE_UNSET {
+				fmt.Fprintf(os.Stderr, "SET_DEFAULT action of %s in %s is not supported by every engine, e.g. MySQL rejects it.\n", fieldName, ormable.Name)
+			}
+		}
+	}
+}
+
+// foreignKeyAction returns the SQL of a referential action, empty when unset
+func foreignKeyAction(action gorm.GormFieldOptions_ForeignKeyAction) string {
+	if action == gorm.GormFieldOptions_ACTION_UNSET {
+		return ""
+	}
+	return strings.ReplaceAll(action.String(), "_", " ")
+}
+
+// foreignKeyConstraint renders the constraint tag segment of the on_delete
+// and on_update actions, empty when neither is set
+func foreignKeyConstraint(opts *gorm.GormFieldOptions) string {
+	var actions []string
+	if action := foreignKeyAction(opts.GetOnUpdate()); action != "" {
+		actions = append(actions, "OnUpdate:"+action)
+	}
+	if action := foreignKeyAction(opts.GetOnDelete()); action != "" {
+		actions = append(actions, "OnDelete:"+action)
+	}
+	if len(actions) == 0 {
+		return ""
+	}
+	return "constraint:" + strings.Join(actions, ",") + ";"
+}
+
+func (b *ORMBuilder) parseManyToMany(msg *protogen.Message, ormable *OrmableType, fieldName string, fieldType string, assoc *OrmableType, opts *gorm.GormFieldOptions) {
+	typeName := camelCase(string(msg.Desc.Name()))
+	mtm := opts.GetManyToMany()
+	if mtm == nil {
+		mtm = &gorm.ManyToManyOptions{}
+		opts.Association = &gorm.GormFieldOptions_ManyToMany{mtm}
+	}
+
+	foreignKeys := b.manyToManyKeys(ormable, mtm.GetForeignkey())
+	mtm.Foreignkey = strings.Join(foreignKeys, ",")
+	assocKeys := b.manyToManyKeys(assoc, mtm.GetAssociationForeignkey())
+	mtm.AssociationForeignkey = strings.Join(assocKeys, ",")
+	var jt string
+	if jt = jgorm.ToDBName(mtm.GetJointable()); jt == "" {
+		if b.countManyToManyAssociationDimension(msg, fieldType) == 1 && typeName != fieldType {
+			jt = b.naming.JoinTableName(typeName + inflection.Plural(fieldType))
+		} else {
+			jt = b.naming.JoinTableName(typeName + inflection.Plural(fieldName))
+		}
+		// the join table is created next to the table of the owner
+		if schema := b.tableSchema(msg); schema != "" {
+			jt = schema + "." + jt
+		}
+	}
+	mtm.Jointable = jt
+	// explicit join table columns are the names of an existing table and
+	// are kept as they are
+	joinColumns := func(option string) []string {
+		var columns []string
+		for _, column := range strings.Split(option, ",") {
+			column = strings.TrimSpace(column)
+			if !plainIdentifier.MatchString(column) {
+				panic(fmt.Sprintf("Many-to-many %s of %s has the join table column %q, which is not a plain identifier", fieldName, ormable.Name, column))
+			}
+			columns = append(columns, column)
+		}
+		return columns
+	}
+	var jtForeignKeys []string
+	if mtm.GetJointableForeignkey() != "" {
+		jtForeignKeys = joinColumns(mtm.GetJointableForeignkey())
+	} else {
+		for _, key := range foreignKeys {
+			jtForeignKeys = append(jtForeignKeys, camelCase(jgorm.ToDBName(typeName+key)))
+		}
+	}
+	mtm.JointableForeignkey = strings.Join(jtForeignKeys, ",")
+	var jtAssocForeignKeys []string
+	if mtm.GetAssociationJointableForeignkey() != "" {
+		jtAssocForeignKeys = joinColumns(mtm.GetAssociationJointableForeignkey())
+	} else {
+		for _, key := range assocKeys {
+			if typeName == fieldType {
+				jtAssocForeignKeys = append(jtAssocForeignKeys, camelCase(jgorm.ToDBName(inflection.Singular(fieldName)+key)))
+			} else {
+				jtAssocForeignKeys = append(jtAssocForeignKeys, camelCase(jgorm.ToDBName(fieldType+key)))
+			}
+		}
+	}
+	mtm.AssociationJointableForeignkey = strings.Join(jtAssocForeignKeys, ",")
+	if len(jtForeignKeys) != len(foreignKeys) || len(jtAssocForeignKeys) != len(assocKeys) {
+		panic(fmt.Sprintf("Many-to-many %s of %s needs a join table column for each of the keys %s and %s",
+			fieldName, ormable.Name, mtm.Foreignkey, mtm.AssociationForeignkey))
+	}
+	seen := map[string]bool{}
+	for _, column := range append(append([]string(nil), jtForeignKeys...), jtAssocForeignKeys...) {
+		if seen[strings.ToLower(column)] {
+			panic(fmt.Sprintf("Many-to-many %s of %s has the join table column %s twice", fieldName, ormable.Name, column))
+		}
+		seen[strings.ToLower(column)] = true
+	}
+	b.checkJoinTable(jt, &joinTableUse{
+		field:        ormable.Name + "." + fieldName,
+		owner:        ormable.Name,
+		assoc:        assoc.Name,
+		ownerColumns: mtm.JointableForeignkey,
+		assocColumns: mtm.AssociationJointableForeignkey,
+	})
+}
+
+// joinTableUse is a many-to-many association through a join table
+type joinTableUse struct {
+	field        string
+	owner, assoc string
+	ownerColumns string
+	assocColumns string
+}
+
+// checkJoinTable validates a many-to-many against the other ones of the same
+// join table, which must join the same types with the same columns, or the
+// inverse association with the columns swapped
+func (b *ORMBuilder) checkJoinTable(table string, use *joinTableUse) {
+	first, ok := b.joinTables[table]
+	if !ok {
+		b.joinTables[table] = use
+		return
+	}
+	same := first.owner == use.owner && first.assoc == use.assoc &&
+		first.ownerColumns == use.ownerColumns && first.assocColumns == use.assocColumns
+	inverse := first.owner == use.assoc && first.assoc == use.owner &&
+		first.ownerColumns == use.assocColumns && first.assocColumns == use.ownerColumns
+	if !same && !inverse {
+		panic(fmt.Sprintf("Many-to-many %s uses the join table %s of %s with other types or columns, %s joins %s (%s) and %s (%s)",
+			use.field, table, first.field, first.field, first.owner, first.ownerColumns, first.assoc, first.assocColumns))
+	}
+}
+
+// manyToManyKeys returns the key fields of one side of a many-to-many, the
+// comma separated keys of the option or else all the primary key fields, so
+// that composite keys get a join table column each
+func (b *ORMBuilder) manyToManyKeys(ormable *OrmableType, option string) []string {
+	var keys []string
+	if option != "" {
+		for _, key := range strings.Split(option, ",") {
+			key = camelCase(strings.TrimSpace(key))
+			if _, ok := ormable.Fields[key]; !ok {
+				panic(fmt.Sprintf("Missing %s field in %s", key, ormable.Name))
+			}
+			keys = append(keys, key)
+		}
+		return keys
+	}
+	for fieldName, field := range ormable.Fields {
+		if field.GetTag().GetPrimaryKey() {
+			keys = append(keys, fieldName)
+		}
+	}
+	if len(keys) == 0 {
+		key, _ := b.findPrimaryKey(ormable)
+		return []string{key}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (b *ORMBuilder) parseHasOne(msg *protogen.Message, parent *OrmableType, fieldName string, fieldType string, child *OrmableType, opts *gorm.GormFieldOptions) {
+	typeName := camelCase(string(msg.Desc.Name()))
+	hasOne := opts.GetHasOne()
+	if hasOne == nil {
+		hasOne = &gorm.HasOneOptions{}
+		opts.Association = &gorm.GormFieldOptions_HasOne{hasOne}
+	}
+
+	var assocKey *Field
+	var assocKeyName string
+
+	if assocKeyName = camelCase(hasOne.GetAssociationForeignkey()); assocKeyName == "" {
+		assocKeyName, assocKey = b.findPrimaryKey(parent)
+	} else {
+		var ok bool
+		assocKey, ok = parent.Fields[assocKeyName]
+		if !ok {
+			panic(fmt.Sprintf("Missing %s field in %s.", assocKeyName, parent.Name))
+		}
+	}
+
+	hasOne.AssociationForeignkey = assocKeyName
+	var foreignKeyType string
+	if hasOne.GetForeignkeyTag().GetNotNull() {
+		foreignKeyType = strings.TrimPrefix(assocKey.Type, "*")
+	} else if strings.HasPrefix(assocKey.Type, "*") {
+		foreignKeyType = assocKey.Type
+	} else if strings.Contains(assocKey.Type, "[]byte") {
+		foreignKeyType = assocKey.Type
+	} else {
+		foreignKeyType = "*" + assocKey.Type
+	}
+
+	foreignKey := &Field{Type: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gorm.GormFieldOptions{Tag: hasOne.GetForeignkeyTag()}}
+	var foreignKeyName string
+	if foreignKeyName = camelCase(hasOne.GetForeignkey()); foreignKeyName == "" {
+		if b.countHasAssociationDimension(msg, fieldType) == 1 {
+			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
+		} else {
+			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
+		}
+	}
+
+	hasOne.Foreignkey = foreignKeyName
+	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
+		panic(fmt.Sprintf("Object %s from package %s cannot be user for has-one in %s since it does not have FK field %s defined. Manually define the key, or switch to belongs-to.",
+			child.Name, child.Package, parent.Name, foreignKeyName))
+	}
+	if exField, ok := child.Fields[foreignKeyName]; !ok {
+		child.Fields[foreignKeyName] = foreignKey
+	} else {
+		if exField.Type == "interface{}" {
+			exField.Type = foreignKey.Type
+		} else if !b.sameType(exField, foreignKey) {
+			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
+				foreignKeyName, child.Name, exField.Type, foreignKey.Type))
+		}
+	}
+
+	child.Fields[foreignKeyName].ParentOrigName = parent.OriginName
+}
+
+func (b *ORMBuilder) parseHasMany(msg *protogen.Message, parent *OrmableType, fieldName string, fieldType string, child *OrmableType, opts *gorm.GormFieldOptions) {
+	typeName := camelCase(string(msg.Desc.Name()))
+	hasMany := opts.GetHasMany()
+	if hasMany == nil {
+		hasMany = &gorm.HasManyOptions{}
+		opts.Association = &gorm.GormFieldOptions_HasMany{hasMany}
+	}
+	var assocKey *Field
+	var assocKeyName string
+	if assocKeyName = camelCase(hasMany.GetAssociationForeignkey()); assocKeyName == "" {
+		assocKeyName, assocKey = b.findPrimaryKey(parent)
+	} else {
+		var ok bool
+		assocKey, ok = parent.Fields[assocKeyName]
+		if !ok {
+			panic(fmt.Sprintf("Missing %s field in %s", assocKeyName, parent.Name))
+		}
+	}
+
+	hasMany.AssociationForeignkey = assocKeyName
+	var foreignKeyType string
+	if hasMany.GetForeignkeyTag().GetNotNull() {
+		foreignKeyType = strings.TrimPrefix(assocKey.Type, "*")
+	} else if strings.HasPrefix(assocKey.Type, "*") {
+		foreignKeyType = assocKey.Type
+	} else if strings.Contains(assocKey.Type, "[]byte") {
+		foreignKeyType = assocKey.Type
+	} else {
+		foreignKeyType = "*" + assocKey.Type
+	}
+	foreignKey := &Field{Type: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gorm.GormFieldOptions{Tag: hasMany.GetForeignkeyTag()}}
+	var foreignKeyName string
+	if foreignKeyName = hasMany.GetForeignkey(); foreignKeyName == "" {
+		if b.countHasAssociationDimension(msg, fieldType) == 1 {
+			foreignKeyName = fmt.Sprintf(typeName + assocKeyName)
+		} else {
+			foreignKeyName = fmt.Sprintf(fieldName + typeName + assocKeyName)
+		}
+	}
+	hasMany.Foreignkey = foreignKeyName
+	if _, ok := child.Fields[foreignKeyName]; child.Package != parent.Package && !ok {
+		panic(fmt.Sprintf("Object %s from package %s cannot be user for has-many in %s since it does not have FK field %s defined. Manually define the key, or switch to many-to-many.",
+			child.Name, child.Package, parent.Name, foreignKeyName))
+
+	}
+	if exField, ok := child.Fields[foreignKeyName]; !ok {
+		child.Fields[foreignKeyName] = foreignKey
+	} else {
+		if exField.Type == "interface{}" {
+			exField.Type = foreignKey.Type
+		} else if !b.sameType(exField, foreignKey) {
+			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
+				foreignKeyName, child.Name, exField.Type, foreignKey.Type))
+		}
+	}
+	child.Fields[foreignKeyName].ParentOrigName = parent.OriginName
+
+	var posField string
+	if posField = camelCase(hasMany.GetPositionField()); posField != "" {
+		if exField, ok := child.Fields[posField]; !ok {
+			child.Fields[posField] = &Field{Type: "int", GormFieldOptions: &gorm.GormFieldOptions{Tag: hasMany.GetPositionFieldTag()}}
+		} else {
+			if !strings.Contains(exField.Type, "int") {
+				panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type.",
+					posField, child.Name))
+			}
+		}
+		hasMany.PositionField = posField
+	}
+}
+
+func (b *ORMBuilder) parseBelongsTo(msg *protogen.Message, child *OrmableType, fieldName string, fieldType string, parent *OrmableType, opts *gorm.GormFieldOptions) {
+	belongsTo := opts.GetBelongsTo()
+	if belongsTo == nil {
+		belongsTo = &gorm.BelongsToOptions{}
+		opts.Association = &gorm.GormFieldOptions_BelongsTo{belongsTo}
+	}
+	var assocKey *Field
+	var assocKeyName string
+	if assocKeyName = camelCase(belongsTo.GetAssociationForeignkey()); assocKeyName == "" {
+		assocKeyName, assocKey = b.findPrimaryKey(parent)
+	} else {
+		var ok bool
+		assocKey, ok = parent.Fields[assocKeyName]
+		if !ok {
+			panic(fmt.Sprintf("Missing %s field in %s", assocKeyName, parent.Name))
+		}
+	}
+	belongsTo.AssociationForeignkey = assocKeyName
+	var foreignKeyType string
+	if belongsTo.GetForeignkeyTag().GetNotNull() {
+		foreignKeyType = strings.TrimPrefix(assocKey.Type, "*")
+	} else if strings.HasPrefix(assocKey.Type, "*") {
+		foreignKeyType = assocKey.Type
+	} else if strings.Contains(assocKey.Type, "[]byte") {
+		foreignKeyType = assocKey.Type
+	} else {
+		foreignKeyType = "*" + assocKey.Type
+	}
+	foreignKey := &Field{Type: foreignKeyType, Package: assocKey.Package, GormFieldOptions: &gorm.GormFieldOptions{Tag: belongsTo.GetForeignkeyTag()}}
+	var foreignKeyName string
+	if foreignKeyName = camelCase(belongsTo.GetForeignkey()); foreignKeyName == "" {
+		if b.countBelongsToAssociationDimension(msg, fieldType) == 1 {
+			foreignKeyName = fmt.Sprintf(fieldType + assocKeyName)
+		} else {
+			foreignKeyName = fmt.Sprintf(fieldName + assocKeyName)
+		}
+	}
+	belongsTo.Foreignkey = foreignKeyName
+	if exField, ok := child.Fields[foreignKeyName]; !ok {
+		child.Fields[foreignKeyName] = foreignKey
+	} else {
+		if exField.Type == "interface{}" {
+			exField.Type = foreignKeyType
+		} else if !b.sameType(exField, foreignKey) {
+			panic(fmt.Sprintf("Cannot include %s field into %s as it already exists there with a different type: %s, %s",
+				foreignKeyName, child.Name, exField.Type, foreignKey.Type))
+		}
+	}
+	child.Fields[foreignKeyName].ParentOrigName = parent.OriginName
+}
+
+func (b *ORMBuilder) parseBasicFields(msg *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(msg.Desc.Name())
+	ormable, ok := b.ormableTypes[typeName]
+	if !ok {
+		panic("typeName should be found")
+	}
+	ormable.Name = fmt.Sprintf("%sORM", typeName) // TODO: there are no reason to do it here
+
+	for _, field := range msg.Fields {
+		fd := field.Desc
+		options := fd.Options().(*descriptorpb.FieldOptions)
+		gormOptions := getFieldOptions(options)
+		if gormOptions == nil {
+			gormOptions = &gorm.GormFieldOptions{}
+		}
+		if gormOptions.GetDrop() {
+			continue
+		}
+
+		tag := gormOptions.Tag
+		fieldName := camelCase(string(fd.Name()))
+		fieldType := fd.Kind().String()
+
+		var typePackage string
+
+		if name := gormOptions.GetCompositeType(); name != "" {
+			if b.dbEngine != ENGINE_POSTGRES {
+				panic(fmt.Sprintf("Field %s of %s is stored as the composite type %s, which needs engine=postgres", fieldName, ormable.Name, name))
+			}
+			if field.Message == nil || fd.IsList() || b.isOrmable(string(field.Message.Desc.Name())) {
+				panic(fmt.Sprintf("Field %s of %s has composite_type but is not a singular message that is not ormable", fieldName, ormable.Name))
+			}
+			fieldType = "*" + b.addCompositeType(ormable, fieldName, field, name)
+			gormOptions.Tag = tagWithType(tag, name)
+		} else if money := gormOptions.GetMoney(); money != nil {
+			b.checkMoney(msg, field, money)
+			fieldType = "int64"
+			gormOptions.Tag = tagWithType(tag, "bigint")
+		} else if gormOptions.GetStoreAs() == gorm.GormFieldOptions_BASE64_TEXT {
+			b.checkBase64Storage(msg, field, gormOptions)
+			fieldType = "string"
+			gormOptions.Tag = tagWithType(tag, "text")
+		} else if gormOptions.GetStoreAs() != gorm.GormFieldOptions_STORE_AS_UNSET || gormOptions.GetArrayElement() != gorm.GormFieldOptions_ARRAY_ELEMENT_UNSET {
+			b.checkArrayStorage(msg, field, gormOptions)
+			fieldType = generateImport("EnumNames", gtypesImport, g)
+			gormOptions.Tag = tagWithType(tag, "text[]")
+		} else if b.dbEngine == ENGINE_POSTGRES && b.IsAbleToMakePQArray(fieldType) && field.Desc.IsList() {
+			switch fieldType {
+			case "bool":
+				fieldType = generateImport("BoolArray", pqImport, g)
+				gormOptions.Tag = tagWithType(tag, "bool[]")
+			case "double":
+				fieldType = generateImport("Float64Array", pqImport, g)
+				gormOptions.Tag = tagWithType(tag, "float[]")
+			case "int64":
+				fieldType = generateImport("Int64Array", pqImport, g)
+				gormOptions.Tag = tagWithType(tag, "integer[]")
+			case "string":
+				fieldType = generateImport("StringArray", pqImport, g)
+				gormOptions.Tag = tagWithType(tag, "text[]")
+			default:
+				continue
+			}
+		} else if (field.Message == nil || !b.isOrmable(fieldType)) && field.Desc.IsList() {
+			// not implemented
+			continue
+		} else if isLookupEnum(field) {
+			if gormOptions.GetEnumStorage() != gorm.GormFieldOptions_DEFAULT {
+				panic(fmt.Sprintf("Field %s of %s cannot have enum_storage and enum_as_fk_table", fieldName, ormable.Name))
+			}
+			b.addLookupTable(ormable, fieldName, field, gormOptions.GetEnumAsFkTable())
+			fieldType = "int32"
+		} else if isSmallintEnum(field) {
+			for _, value := range field.Enum.Values {
+				if n := value.Desc.Number(); n < math.MinInt16 || n > math.MaxInt16 {
+					panic(fmt.Sprintf("Field %s of %s cannot be stored as smallint, value %s = %d of %s exceeds int16", fieldName, ormable.Name, value.Desc.Name(), n, field.Enum.Desc.Name()))
+				}
+			}
+			fieldType = "int16"
+			if b.dbEngine == ENGINE_POSTGRES {
+				gormOptions.Tag = tagWithType(tag, "smallint")
+			} else {
+				fmt.Fprintf(os.Stderr, "field %s of %s is stored as int16, the smallint column type is only set for postgres, other engines use the type of the dialect.\n", fieldName, ormable.Name)
+			}
+		} else if field.Enum != nil {
+			fieldType = "int32"
+			if b.stringEnums {
+				fieldType = "string"
+			}
+		} else if field.Message != nil {
+			xs := strings.Split(string(field.Message.Desc.FullName()), ".")
+			rawType := xs[len(xs)-1]
+
+			if v, ok := wellKnownTypes[rawType]; ok {
+				fieldType = v
+			} else if rawType == protoTypeUUID {
+				typePackage = uuidImport
+				fieldType = generateImport("UUID", uuidImport, g)
+				if b.dbEngine == ENGINE_POSTGRES {
+					gormOptions.Tag = tagWithType(tag, "uuid")
+				}
+			} else if rawType == protoTypeUUIDValue {
+				typePackage = uuidImport
+				fieldType = "*" + generateImport("UUID", uuidImport, g)
+				if b.dbEngine == ENGINE_POSTGRES {
+					gormOptions.Tag = tagWithType(tag, "uuid")
+				}
+			} else if rawType == protoTypeTimestamp {
+				typePackage = stdTimeImport
+				fieldType = "*" + generateImport("Time", stdTimeImport, g)
+			} else if field.Message.Desc.FullName() == protoTypeDuration {
+				storage := gormOptions.GetDurationAs()
+				if storage == gorm.GormFieldOptions_DURATION_UNSET {
+					storage = gorm.GormFieldOptions_NANOS
+					if b.dbEngine == ENGINE_POSTGRES {
+						storage = gorm.GormFieldOptions_INTERVAL
+					}
+				}
+				if storage == gorm.GormFieldOptions_INTERVAL {
+					if b.dbEngine != ENGINE_POSTGRES {
+						panic(fmt.Sprintf("Field %s of %s is stored as an interval, which needs engine=postgres", fieldName, ormable.Name))
+					}
+					typePackage = gtypesImport
+					fieldType = "*" + generateImport("Interval", gtypesImport, g)
+					gormOptions.Tag = tagWithType(tag, "interval")
+				} else {
+					fieldType = "*int64"
+					gormOptions.Tag = tagWithType(tag, "bigint")
+				}
+			} else if field.Message.Desc.FullName() == protoTypeAny {
+				typePackage = gtypesImport
+				fieldType = generateImport("AnyJSON", gtypesImport, g)
+				if b.dbEngine == ENGINE_POSTGRES {
+					gormOptions.Tag = tagWithType(tag, "jsonb")
+				}
+			} else if rawType == protoTypeJSON {
+				if b.dbEngine == ENGINE_POSTGRES {
+					typePackage = gormpqImport
+					fieldType = "*" + generateImport("Jsonb", gormpqImport, g)
+					gormOptions.Tag = tagWithType(tag, "jsonb")
+				} else {
+					// Potential TODO: add types we want to use in other/default DB engine
+					continue
+				}
+			} else if rawType == protoTypeResource {
+				ttype := strings.ToLower(tag.GetType())
+				if strings.Contains(ttype, "char") {
+					ttype = "char"
+				}
+				if field.Desc.IsList() {
+					ttype = "array"
+				}
+				switch ttype {
+				case "uuid", "text", "char", "array", "cidr", "inet", "macaddr":
+					fieldType = "*string"
+				case "smallint", "integer", "bigint", "numeric", "smallserial", "serial", "bigserial":
+					fieldType = "*int64"
+				case "jsonb", "bytea":
+					fieldType = "[]byte"
+				case "":
+					fieldType = "interface{}" // we do not know the type yet (if it association we will fix the type later)
+				default:
+					panic("unknown tag type of atlas.rpc.Identifier")
+				}
+				if tag.GetNotNull() || tag.GetPrimaryKey() {
+					fieldType = strings.TrimPrefix(fieldType, "*")
+				}
+			} else if rawType == protoTypeInet {
+				typePackage = gtypesImport
+				fieldType = "*" + generateImport("Inet", gtypesImport, g)
+
+				if b.dbEngine == ENGINE_POSTGRES {
+					gormOptions.Tag = tagWithType(tag, "inet")
+				} else {
+					gormOptions.Tag = tagWithType(tag, "varchar(48)")
+				}
+			} else if rawType == protoTimeOnly {
+				fieldType = "string"
+				gormOptions.Tag = tagWithType(tag, "time")
+			} else if field.Message.Desc.FullName() == protoTypeGeoPointFull {
+				if b.dbEngine != ENGINE_POSTGRES {
+					panic(fmt.Sprintf("Field %s of %s is a GeoPoint, it needs engine=postgres with PostGIS", fieldName, ormable.Name))
+				}
+				typePackage = gtypesImport
+				fieldType = "*" + generateImport("Point", gtypesImport, g)
+				switch sqlType := strings.ToLower(strings.ReplaceAll(tag.GetType(), " ", "")); sqlType {
+				case "":
+					gormOptions.Tag = tagWithType(tag, "geography(Point,4326)")
+				case "geography(point,4326)", "geometry(point,4326)":
+				default:
+					panic(fmt.Sprintf("Field %s of %s is a GeoPoint, its type must be geography(Point,4326) or geometry(Point,4326), not %s", fieldName, ormable.Name, tag.GetType()))
+				}
+			} else {
+				continue
+			}
+		}
+
+		switch fieldType {
+		case "float":
+			fieldType = "float32"
+		case "double":
+			fieldType = "float64"
+		}
+
+		if gormOptions.GetDurationAs() != gorm.GormFieldOptions_DURATION_UNSET && (field.Message == nil || field.Message.Desc.FullName() != protoTypeDuration || fd.IsList()) {
+			panic(fmt.Sprintf("Field %s of %s has duration_as but is not a google.protobuf.Duration", fieldName, ormable.Name))
+		}
+
+		if boolAs := gormOptions.GetBoolAs(); boolAs != "" {
+			if fd.Kind() != protoreflect.BoolKind || fd.IsList() {
+				panic(fmt.Sprintf("Field %s of %s has bool_as but is not a bool", fieldName, ormable.Name))
+			}
+			if boolAs != "char" {
+				panic(fmt.Sprintf("Field %s of %s has unsupported bool_as %q, only char is supported", fieldName, ormable.Name, boolAs))
+			}
+			typePackage = gtypesImport
+			fieldType = generateImport("YesNo", gtypesImport, g)
+			gormOptions.Tag = tagWithType(tag, "char(1)")
+		} else if b.dbEngine == ENGINE_SQLITE && fd.Kind() == protoreflect.BoolKind && !fd.IsList() && tag.GetType() == "" {
+			// SQLite has no boolean type, bools are stored as 0 and 1
+			gormOptions.Tag = tagWithType(tag, "integer")
+		}
+
+		if isOptionalScalar(field) {
+			// absent optional values are stored as NULL
+			fieldType = "*" + fieldType
+		}
+
+		if gormOptions.GetCaseInsensitive() {
+			gormOptions.Tag = b.caseInsensitiveTag(ormable, fieldName, fieldType, gormOptions.Tag)
+		}
+		if collation := gormOptions.GetTag().GetCollation(); collation != "" {
+			b.checkCollation(ormable, fieldName, fieldType, gormOptions.Tag)
+		}
+
+		f := &Field{
+			GormFieldOptions: gormOptions,
+			ParentGoType:     "",
+			Type:             fieldType,
+			Package:          typePackage,
+		}
+
+		if gormOptions.GetDefaultNow() {
+			if field.Message == nil || field.Message.Desc.FullName() != protoTypeTimestampFull || fd.IsList() {
+				panic(fmt.Sprintf("Field %s of %s has default_now but is not a google.protobuf.Timestamp", fieldName, ormable.Name))
+			}
+			if tag := gormOptions.GetTag(); len(tag.GetDefault()) > 0 || len(tag.GetDefaultExpr()) > 0 {
+				panic(fmt.Sprintf("Field %s of %s cannot have default_now and a default or default_expr", fieldName, ormable.Name))
+			}
+			if gormOptions.Tag == nil {
+				gormOptions.Tag = &gorm.GormTag{}
+			}
+			gormOptions.Tag.DefaultExpr = "CURRENT_TIMESTAMP"
+			if b.dbEngine == ENGINE_POSTGRES {
+				gormOptions.Tag.DefaultExpr = "now()"
+			}
+		}
+		if gormOptions.GetDefaultUuid() {
+			if fd.Kind() != protoreflect.StringKind || fd.IsList() || isOptionalScalar(field) {
+				panic(fmt.Sprintf("Field %s of %s has default_uuid but is not a string field that is not optional", fieldName, ormable.Name))
+			}
+			if tag := gormOptions.GetTag(); len(tag.GetDefault()) > 0 || len(tag.GetDefaultExpr()) > 0 {
+				panic(fmt.Sprintf("Field %s of %s cannot have default_uuid and a default or default_expr", fieldName, ormable.Name))
+			}
+		}
+		if jsonDefault := gormOptions.GetJsonDefault(); jsonDefault != "" {
+			if field.Message == nil || string(field.Message.Desc.Name()) != protoTypeJSON || fd.IsList() {
+				panic(fmt.Sprintf("Field %s of %s has json_default but is not a gorm.types.JSONValue", fieldName, ormable.Name))
+			}
+			if !json.Valid([]byte(jsonDefault)) {
+				panic(fmt.Sprintf("Field %s of %s has json_default %q, which is not valid JSON", fieldName, ormable.Name, jsonDefault))
+			}
+			// GORM splits its tag at the semicolons
+			if strings.ContainsAny(jsonDefault, ";`") {
+				panic(fmt.Sprintf("Field %s of %s has json_default %q, it cannot contain a semicolon or a backquote", fieldName, ormable.Name, jsonDefault))
+			}
+			if tag := gormOptions.GetTag(); len(tag.GetDefault()) > 0 || len(tag.GetDefaultExpr()) > 0 {
+				panic(fmt.Sprintf("Field %s of %s cannot have json_default and a default or default_expr", fieldName, ormable.Name))
+			}
+			if gormOptions.Tag == nil {
+				gormOptions.Tag = &gorm.GormTag{}
+			}
+			gormOptions.Tag.DefaultExpr = tagQuoter.Replace(quoteDefault(jsonDefault, "string")) + "::jsonb"
+		}
+		if gormOptions.GetImmutableAfterCreate() && gormOptions.GetReadOnly() {
+			panic(fmt.Sprintf("Field %s of %s is read_only, it cannot be immutable_after_create as it is never written", fieldName, ormable.Name))
+		}
+		if gormOptions.GetComputed() && (gormOptions.GetReadOnly() || gormOptions.GetImmutableAfterCreate() || gormOptions.GetTouchOnSave() || gormOptions.GetDefaultNow()) {
+			panic(fmt.Sprintf("Field %s of %s is computed by the DB, it cannot be read_only, immutable_after_create, touch_on_save or default_now", fieldName, ormable.Name))
+		}
+		if gormOptions.GetRejectChange() && !gormOptions.GetImmutableAfterCreate() {
+			panic(fmt.Sprintf("Field %s of %s has reject_change but is not immutable_after_create", fieldName, ormable.Name))
+		}
+		if gormOptions.GetTouchOnSave() && (field.Message == nil || field.Message.Desc.FullName() != protoTypeTimestampFull || fd.IsList()) {
+			panic(fmt.Sprintf("Field %s of %s has touch_on_save but is not a google.protobuf.Timestamp", fieldName, ormable.Name))
+		}
+		if tag := gormOptions.GetTag(); len(tag.GetDefault()) > 0 && len(tag.GetDefaultExpr()) > 0 {
+			panic(fmt.Sprintf("Field %s of %s cannot have both a default and a default_expr", fieldName, ormable.Name))
+		}
+		if tag := gormOptions.GetTag(); isAppAssignedKey(tag) && (len(tag.GetDefault()) > 0 || len(tag.GetDefaultExpr()) > 0) {
+			panic(fmt.Sprintf("Field %s of %s cannot disable auto_increment and set a DB default at the same time", fieldName, ormable.Name))
+		}
+
+		if tName := gormOptions.GetReferenceOf(); tName != "" {
+			if _, ok := b.messages[tName]; !ok {
+				panic("unknow")
+			}
+			f.ParentOrigName = tName
+		}
+
+		ormable.Fields[fieldName] = f
+
+		if gormOptions.GetOmitZeroOnCreate() && !omitsZero(f) {
+			panic(fmt.Sprintf("Field %s of %s has omit_zero_on_create but is stored as %s, only a string, bool or number is left out when zero", fieldName, ormable.Name, fieldType))
+		}
+		if zone := gormOptions.GetWithTimezoneColumn(); zone != "" {
+			b.checkTimezoneColumn(msg, field, zone)
+		}
+		if gormOptions.GetPreserveNanos() {
+			if field.Message == nil || field.Message.Desc.FullName() != protoTypeTimestampFull || fd.IsList() {
+				panic(fmt.Sprintf("Field %s of %s has preserve_nanos but is not a google.protobuf.Timestamp", fieldName, ormable.Name))
+			}
+			if _, ok := ormable.Fields[fieldName+"Nanos"]; ok {
+				panic(fmt.Sprintf("Field %s of %s has preserve_nanos but %sNanos exists already", fieldName, ormable.Name, fieldName))
+			}
+			nanos := &Field{Type: "int32"}
+			if column := tag.GetColumn(); column != "" {
+				nanos.GormFieldOptions = &gorm.GormFieldOptions{Tag: &gorm.GormTag{Column: column + "_nanos"}}
+			}
+			ormable.Fields[fieldName+"Nanos"] = nanos
+		}
+	}
+
+	gormMsgOptions := getMessageOptions(msg)
+	if gormMsgOptions.GetMultiAccount() {
+		if accID, ok := ormable.Fields["AccountID"]; !ok {
+			ormable.Fields["AccountID"] = &Field{Type: "string"}
+		} else if accID.Type != "string" {
+			panic("cannot include AccountID field")
+		}
+	}
+
+	for _, byField := range []string{gormMsgOptions.GetCreatedByField(), gormMsgOptions.GetUpdatedByField()} {
+		if byField == "" {
+			continue
+		}
+		if f, ok := ormable.Fields[camelCase(byField)]; !ok {
+			ormable.Fields[camelCase(byField)] = &Field{Type: "string"}
+		} else if f.Type != "string" {
+			panic(fmt.Sprintf("Field %s of %s must be a string to be stamped with the actor", byField, ormable.Name))
+		}
+	}
+	if byField := gormMsgOptions.GetSoftDelete().GetByField(); byField != "" {
+		if _, ok := ormable.Fields["DeletedAt"]; !ok {
+			panic(fmt.Sprintf("%s needs a deleted_at field to be soft deleted by %s", ormable.Name, byField))
+		}
+		if f, ok := ormable.Fields[camelCase(byField)]; !ok {
+			ormable.Fields[camelCase(byField)] = &Field{Type: "*string"}
+		} else if strings.TrimPrefix(f.Type, "*") != "string" {
+			panic(fmt.Sprintf("Field %s of %s must be a string to be stamped with the actor", byField, ormable.Name))
+		}
+	}
+
+	// TODO: GetInclude
+	for _, field := range gormMsgOptions.GetInclude() {
+		fieldName := camelCase(field.GetName())
+		if _, ok := ormable.Fields[fieldName]; !ok {
+			b.addIncludedField(ormable, field, g)
+		} else {
+			panic("cound not include")
+		}
+	}
+}
+
+func (b *ORMBuilder) addIncludedField(ormable *OrmableType, field *gorm.ExtraField, g *protogen.GeneratedFile) {
+	fieldName := camelCase(field.GetName())
+	isPtr := strings.HasPrefix(field.GetType(), "*")
+	rawType := strings.TrimPrefix(field.GetType(), "*")
+	// cut off any package subpaths
+	rawType = rawType[strings.LastIndex(rawType, ".")+1:]
+	var typePackage string
+	// Handle types with a package defined
+	if field.GetPackage() != "" {
+		rawType = generateImport(rawType, field.GetPackage(), g)
+		typePackage = field.GetPackage()
+	} else {
+		// Handle types without a package defined
+		if _, ok := builtinTypes[rawType]; ok {
+			// basic type, 100% okay, no imports or changes needed
+		} else if rawType == "Time" {
+			// b.UsingGoImports(stdTimeImport) // TODO: missing UsingGoImports
+			rawType = generateImport("Time", stdTimeImport, g)
+		} else if rawType == "UUID" {
+			rawType = generateImport("UUID", uuidImport, g)
+		} else if field.GetType() == "Jsonb" && b.dbEngine == ENGINE_POSTGRES {
+			rawType = generateImport("Jsonb", gormpqImport, g)
+		} else if rawType == "Inet" {
+			rawType = generateImport("Inet", gtypesImport, g)
+		} else {
+			fmt.Fprintf(os.Stderr, "included field %q of type %q is not a recognized special type, and no package specified. This type is assumed to be in the same package as the generated code",
+				field.GetName(), field.GetType())
+		}
+	}
+	if isPtr {
+		rawType = fmt.Sprintf("*%s", rawType)
+	}
+	ormable.Fields[fieldName] = &Field{Type: rawType, Package: typePackage, GormFieldOptions: &gorm.GormFieldOptions{Tag: field.GetTag()}}
+}
+
+func getFieldOptions(options *descriptorpb.FieldOptions) *gorm.GormFieldOptions {
+	if options == nil {
+		return nil
+	}
+
+	v := proto.GetExtension(options, gorm.E_Field)
+	if v == nil {
+		return nil
+	}
+
+	opts, ok := v.(*gorm.GormFieldOptions)
+	if !ok {
+		return nil
+	}
+
+	return opts
+}
+
+// retrieves the GormMessageOptions from a message
+func getMessageOptions(message *protogen.Message) *gorm.GormMessageOptions {
+	options := message.Desc.Options()
+	if options == nil {
+		return nil
+	}
+	v := proto.GetExtension(options, gorm.E_Opts)
+	if v == nil {
+		return nil
+	}
+
+	opts, ok := v.(*gorm.GormMessageOptions)
+	if !ok {
+		return nil
+	}
+
+	return opts
+}
+
+func isOrmable(message *protogen.Message) bool {
+	desc := message.Desc
+	options := desc.Options()
+
+	m, ok := proto.GetExtension(options, gorm.E_Opts).(*gorm.GormMessageOptions)
+	if !ok || m == nil {
+		return false
+	}
+
+	return m.Ormable
+}
+
+func (b *ORMBuilder) IsAbleToMakePQArray(fieldType string) bool {
+	switch fieldType {
+	case "bool", "double", "int64", "string":
+		return true
+	default:
+		return false
+	}
+}
+
+// isAppAssignedKey reports whether the value of the column is supplied by
+// the application, since auto_increment is explicitly disabled
+func isAppAssignedKey(tag *gorm.GormTag) bool {
+	return tag != nil && tag.AutoIncrement != nil && !tag.GetAutoIncrement()
+}
+
+func tagWithType(tag *gorm.GormTag, typename string) *gorm.GormTag {
+	if tag == nil {
+		tag = &gorm.GormTag{}
+	}
+
+	tag.Type = typename
+	return tag
+}
+
+func camelCase(s string) string {
+	if s == "" {
+		return ""
+	}
+	t := make([]byte, 0, 32)
+	i := 0
+	if s[0] == '_' {
+		// Need a capital letter; drop the '_'.
+		t = append(t, 'X')
+		i++
+	}
+	// Invariant: if the next letter is lower case, it must be converted
+	// to upper case.
+	// That is, we process a word at a time, where words are marked by _ or
+	// upper case letter. Digits are treated as words.
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '_' && i+1 < len(s) && isASCIILower(s[i+1]) {
+			continue // Skip the underscore in s.
+		}
+		if isASCIIDigit(c) {
+			t = append(t, c)
+			continue
+		}
+		// Assume we have a letter now - if not, it's a bogus identifier.
+		// The next word is a sequence of characters that must start upper case.
+		if isASCIILower(c) {
+			c ^= ' ' // Make it a capital letter.
+		}
+		t = append(t, c) // Guaranteed not lower case.
+		// Accept lower case sequence that follows.
+		for i+1 < len(s) && isASCIILower(s[i+1]) {
+			i++
+			t = append(t, s[i])
+		}
+	}
+	return string(t)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func (b *ORMBuilder) renderGormTag(ormable *OrmableType, name string, field *Field) string {
+	var gormRes, atlasRes string
+	tag := field.GetTag()
+	if tag == nil {
+		tag = &gorm.GormTag{}
+	}
+
+	if len(tag.Column) > 0 {
+		gormRes += fmt.Sprintf("column:%s;", tag.GetColumn())
+	}
+	if len(tag.Collation) > 0 {
+		gormRes += fmt.Sprintf("type:%s;", b.collatedType(tag))
+	} else if len(tag.Type) > 0 {
+		gormRes += fmt.Sprintf("type:%s;", tag.GetType())
+	}
+	if tag.GetSize() > 0 {
+		gormRes += fmt.Sprintf("size:%d;", tag.GetSize())
+	}
+	if tag.Precision > 0 {
+		gormRes += fmt.Sprintf("precision:%d;", tag.GetPrecision())
+	}
+	if tag.GetPrimaryKey() {
+		gormRes += "primary_key;"
+	}
+	if tag.GetUnique() {
+		gormRes += "unique;"
+	}
+	if len(tag.Default) > 0 {
+		gormRes += fmt.Sprintf("default:%s;", quoteDefault(tag.GetDefault(), field.Type))
+	} else if len(tag.DefaultExpr) > 0 {
+		gormRes += fmt.Sprintf("default:%s;", tag.GetDefaultExpr())
+	}
+	if tag.GetNotNull() {
+		gormRes += "not null;"
+	}
+	if tag.GetAutoIncrement() {
+		gormRes += "auto_increment;"
+	} else if tag.AutoIncrement != nil {
+		gormRes += "auto_increment:false;"
+	}
+	// GORM creates the btree indexes of all rows with the columns in the
+	// order of the struct fields only, the others are left to the SQL of the
+	// Indexes definitions
+	if indexName, _, _, _ := parseIndexTag(tag.GetIndex()); len(tag.Index) > 0 && b.gormCreatesIndex(ormable, name, field, false) {
+		if indexName == "" {
+			gormRes += "index;"
+		} else {
+			gormRes += fmt.Sprintf("index:%s;", indexName)
+		}
+	}
+	var uniqueIndexes []string
+	if indexName, _, _, _ := parseIndexTag(tag.GetUniqueIndex()); len(tag.UniqueIndex) > 0 && b.gormCreatesIndex(ormable, name, field, true) {
+		uniqueIndexes = append(uniqueIndexes, indexName)
+	}
+	for _, index := range b.uniqueWithIndexes(ormable) {
+		for _, f := range index.fields {
+			if f == name {
+				uniqueIndexes = append(uniqueIndexes, index.name)
+			}
+		}
+	}
+	if len(uniqueIndexes) == 1 && uniqueIndexes[0] == "" {
+		gormRes += "unique_index;"
+	} else if len(uniqueIndexes) > 0 {
+		// GORM names an unnamed index itself only when it is the only one
+		if uniqueIndexes[0] == "" {
+			uniqueIndexes[0] = gormKeyName.ReplaceAllString("uix_"+b.ormableTableName(ormable)+"_"+columnName(name, field), "_")
+		}
+		gormRes += fmt.Sprintf("unique_index:%s;", strings.Join(uniqueIndexes, ","))
+	}
+	if tag.GetEmbedded() {
+		gormRes += "embedded;"
+	}
+	if len(tag.EmbeddedPrefix) > 0 {
+		gormRes += fmt.Sprintf("embedded_prefix:%s;", tag.GetEmbeddedPrefix())
+	}
+	if tag.GetIgnore() {
+		gormRes += "-;"
+	}
+	// postgres takes no COMMENT clause in a column definition, the comments
+	// are set by the ColumnComments of the file instead
+	if len(tag.Comment) > 0 && b.dbEngine != ENGINE_POSTGRES {
+		gormRes += fmt.Sprintf("comment:%s;", b.quoteComment(ormable, name, tag.GetComment()))
+	}
+
+	var foreignKey, associationForeignKey, joinTable, joinTableForeignKey, associationJoinTableForeignKey string
+	var associationAutoupdate, associationAutocreate, associationSaveReference, preload, replace, append, clear bool
+	if hasOne := field.GetHasOne(); hasOne != nil {
+		foreignKey = hasOne.Foreignkey
+		associationForeignKey = hasOne.AssociationForeignkey
+		associationAutoupdate = hasOne.AssociationAutoupdate
+		associationAutocreate = hasOne.AssociationAutocreate
+		associationSaveReference = hasOne.AssociationSaveReference
+		preload = hasOne.Preload
+		clear = hasOne.Clear
+		replace = hasOne.Replace
+		append = hasOne.Append
+	} else if belongsTo := field.GetBelongsTo(); belongsTo != nil {
+		foreignKey = belongsTo.Foreignkey
+		associationForeignKey = belongsTo.AssociationForeignkey
+		associationAutoupdate = belongsTo.AssociationAutoupdate
+		associationAutocreate = belongsTo.AssociationAutocreate
+		associationSaveReference = belongsTo.AssociationSaveReference
+		preload = belongsTo.Preload
+	} else if hasMany := field.GetHasMany(); hasMany != nil {
+		foreignKey = hasMany.Foreignkey
+		associationForeignKey = hasMany.AssociationForeignkey
+		associationAutoupdate = hasMany.AssociationAutoupdate
+		associationAutocreate = hasMany.AssociationAutocreate
+		associationSaveReference = hasMany.AssociationSaveReference
+		clear = hasMany.Clear
+		preload = hasMany.Preload
+		replace = hasMany.Replace
+		append = hasMany.Append
+		if len(hasMany.PositionField) > 0 {
+			atlasRes += fmt.Sprintf("position:%s;", hasMany.GetPositionField())
+		}
+	} else if mtm := field.GetManyToMany(); mtm != nil {
+		foreignKey = mtm.Foreignkey
+		associationForeignKey = mtm.AssociationForeignkey
+		joinTable = mtm.Jointable
+		joinTableForeignKey = mtm.JointableForeignkey
+		associationJoinTableForeignKey = mtm.AssociationJointableForeignkey
+		associationAutoupdate = mtm.AssociationAutoupdate
+		associationAutocreate = mtm.AssociationAutocreate
+		associationSaveReference = mtm.AssociationSaveReference
+		preload = mtm.Preload
+		clear = mtm.Clear
+		replace = mtm.Replace
+		append = mtm.Append
+	} else {
+		foreignKey = tag.Foreignkey
+		associationForeignKey = tag.AssociationForeignkey
+		joinTable = tag.ManyToMany
+		joinTableForeignKey = tag.JointableForeignkey
+		associationJoinTableForeignKey = tag.AssociationJointableForeignkey
+		associationAutoupdate = tag.AssociationAutoupdate
+		associationAutocreate = tag.AssociationAutocreate
+		associationSaveReference = tag.AssociationSaveReference
+		preload = tag.Preload
+	}
+
+	if len(foreignKey) > 0 {
+		gormRes += fmt.Sprintf("foreignkey:%s;", foreignKey)
+	}
+
+	if len(associationForeignKey) > 0 {
+		gormRes += fmt.Sprintf("association_foreignkey:%s;", associationForeignKey)
+	}
+
+	if len(joinTable) > 0 {
+		gormRes += fmt.Sprintf("many2many:%s;", joinTable)
+	}
+	if len(joinTableForeignKey) > 0 {
+		gormRes += fmt.Sprintf("jointable_foreignkey:%s;", joinTableForeignKey)
+	}
+	if len(associationJoinTableForeignKey) > 0 {
+		gormRes += fmt.Sprintf("association_jointable_foreignkey:%s;", associationJoinTableForeignKey)
+	}
+
+	if associationAutoupdate {
+		gormRes += fmt.Sprintf("association_autoupdate:%s;", strconv.FormatBool(associationAutoupdate))
+	}
+
+	if associationAutocreate {
+		gormRes += fmt.Sprintf("association_autocreate:%s;", strconv.FormatBool(associationAutocreate))
+	}
+
+	if associationSaveReference {
+		gormRes += fmt.Sprintf("association_save_reference:%s;", strconv.FormatBool(associationSaveReference))
+	}
+
+	if preload {
+		gormRes += fmt.Sprintf("preload:%s;", strconv.FormatBool(preload))
+	}
+	// a save of the child must not save the parent again, which saves the
+	// child of the back-reference in turn
+	if field.BackReference {
+		gormRes += "save_associations:false;"
+	}
+	// keeps the field selection of the atlas toolkit from preloading the
+	// embedded struct as an association
+	if isEmbedded(field) {
+		gormRes += "preload:false;"
+	}
+
+	gormRes += foreignKeyConstraint(field.GormFieldOptions)
+
+	if clear {
+		gormRes += fmt.Sprintf("clear:%s;", strconv.FormatBool(clear))
+	} else if replace {
+		gormRes += fmt.Sprintf("replace:%s;", strconv.FormatBool(replace))
+	} else if append {
+		gormRes += fmt.Sprintf("append:%s;", strconv.FormatBool(append))
+	}
+	// GORM keeps the last value of a key, so the verbatim settings win
+	if associationTag := field.GetAssociationTag(); associationTag != "" {
+		gormRes += strings.TrimSuffix(associationTag, ";") + ";"
+	}
+
+	var gormTag, atlasTag string
+	if gormRes != "" {
+		gormTag = fmt.Sprintf("gorm:\"%s\"", strings.TrimRight(gormRes, ";"))
+	}
+	if atlasRes != "" {
+		atlasTag = fmt.Sprintf("atlas:\"%s\"", strings.TrimRight(atlasRes, ";"))
+	}
+	finalTag := strings.TrimSpace(strings.Join([]string{gormTag, atlasTag}, " "))
+	if finalTag == "" {
+		return ""
+	} else {
+		return fmt.Sprintf("`%s`", finalTag)
+	}
+}
+
+// tagQuoter escapes a value for the double quotes of a struct tag
+var tagQuoter = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// checkCollation checks the collation of the field and sets the type of the
+// column it applies to when there is none, the one GORM would pick for a
+// string
+func (b *ORMBuilder) checkCollation(ormable *OrmableType, fieldName, fieldType string, tag *gorm.GormTag) {
+	collation := tag.GetCollation()
+	sqlType := strings.ToLower(tag.GetType())
+	if strings.TrimPrefix(fieldType, "*") != "string" || (sqlType != "" && !strings.Contains(sqlType, "char") && !strings.Contains(sqlType, "text")) {
+		panic(fmt.Sprintf("Field %s of %s has collation %s but is not a text column", fieldName, ormable.Name, collation))
+	}
+	if b.dbEngine == ENGINE_POSTGRES {
+		// the name is a quoted identifier
+		if strings.ContainsAny(collation, "\";`") {
+			panic(fmt.Sprintf("Field %s of %s has collation %q, it cannot contain a double quote, a semicolon or a backquote", fieldName, ormable.Name, collation))
+		}
+		if lower := strings.ToLower(collation); strings.HasSuffix(lower, "_ci") || strings.HasSuffix(lower, "_cs") || strings.HasSuffix(lower, "_bin") {
+			fmt.Fprintf(os.Stderr, "collation %s of field %s of %s looks like a MySQL collation, postgres names them e.g. und-x-icu or en_US.utf8.\n", collation, fieldName, ormable.Name)
+		}
+	} else if !plainIdentifier.MatchString(collation) {
+		panic(fmt.Sprintf("Field %s of %s has collation %q, which is not a plain identifier", fieldName, ormable.Name, collation))
+	} else if b.dbEngine == ENGINE_SQLITE {
+		switch strings.ToUpper(collation) {
+		case "BINARY", "NOCASE", "RTRIM":
+		default:
+			fmt.Fprintf(os.Stderr, "collation %s of field %s of %s is not built into SQLite, which has BINARY, NOCASE and RTRIM, it has to be registered by the application.\n", collation, fieldName, ormable.Name)
+		}
+	}
+	if sqlType != "" {
+		return
+	}
+	switch {
+	case tag.GetSize() > 0:
+		tag.Type = fmt.Sprintf("varchar(%d)", tag.GetSize())
+	case b.dbEngine == ENGINE_UNSET:
+		tag.Type = "varchar(255)"
+	default:
+		tag.Type = "text"
+	}
+}
+
+// caseInsensitiveTag returns the tag of a case_insensitive string column, a
+// citext one on postgres and one of a case-insensitive collation otherwise,
+// so that the comparisons and the unique indexes of the column ignore case
+func (b *ORMBuilder) caseInsensitiveTag(ormable *OrmableType, fieldName, fieldType string, tag *gorm.GormTag) *gorm.GormTag {
+	if strings.TrimPrefix(fieldType, "*") != "string" {
+		panic(fmt.Sprintf("Field %s of %s is case_insensitive but is not a string", fieldName, ormable.Name))
+	}
+	if tag.GetCollation() != "" {
+		panic(fmt.Sprintf("Field %s of %s is case_insensitive, it cannot have the collation %s", fieldName, ormable.Name, tag.GetCollation()))
+	}
+	if b.dbEngine == ENGINE_POSTGRES {
+		if sqlType := strings.ToLower(tag.GetType()); sqlType != "" && sqlType != "citext" {
+			panic(fmt.Sprintf("Field %s of %s is case_insensitive, stored as citext, it cannot have the type %s", fieldName, ormable.Name, tag.GetType()))
+		}
+		return tagWithType(tag, "citext")
+	}
+	if tag == nil {
+		tag = &gorm.GormTag{}
+	}
+	// the column type is completed by checkCollation
+	if b.dbEngine == ENGINE_SQLITE {
+		tag.Collation = "NOCASE"
+	} else {
+		tag.Collation = "utf8mb4_unicode_ci"
+	}
+	return tag
+}
+
+// collatedType returns the column type of the tag followed by its collation
+func (b *ORMBuilder) collatedType(tag *gorm.GormTag) string {
+	if b.dbEngine == ENGINE_POSTGRES {
+		return tagQuoter.Replace(tag.GetType() + ` COLLATE "` + tag.GetCollation() + `"`)
+	}
+	return tag.GetType() + " COLLATE " + tag.GetCollation()
+}
+
+// quoteDefault quotes a literal default as a SQL string unless the column is
+// numeric or boolean, a value that is quoted already is kept as is
+func quoteDefault(value, fieldType string) string {
+	switch strings.TrimPrefix(fieldType, "*") {
+	case "bool", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return value
+	}
+	if len(value) >= 2 && strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// checkExpression panics on a check or index_where expression with
+// unbalanced parentheses or quotes, which would otherwise only fail on
+// migration, and on a semicolon, which would end the statement using it
+func checkExpression(ormable *OrmableType, name, kind, expression string) {
+	if strings.Contains(expression, ";") {
+		panic(fmt.Sprintf("%s of %s in %s cannot contain a semicolon", kind, name, ormable.Name))
+	}
+	depth, quoted := 0, false
+	for _, r := range expression {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')':
+			if depth--; depth < 0 {
+				panic(fmt.Sprintf("%s of %s in %s has an unbalanced ')'", kind, name, ormable.Name))
+			}
+		}
+	}
+	if quoted || depth != 0 {
+		panic(fmt.Sprintf("%s of %s in %s has an unterminated quote or parenthesis", kind, name, ormable.Name))
+	}
+}
+
+// quoteComment returns the comment of a column as the string literal GORM
+// appends to the column definition of MySQL, made safe to be placed into a
+// double-quoted struct tag. GORM splits its tags at every semicolon and
+// sqlite has no column comments, both are rejected.
+func (b *ORMBuilder) quoteComment(ormable *OrmableType, name, comment string) string {
+	if b.dbEngine == ENGINE_SQLITE {
+		panic(fmt.Sprintf("comment of %s in %s is not supported by sqlite", name, ormable.Name))
+	}
+	if strings.Contains(comment, ";") {
+		panic(fmt.Sprintf("comment of %s in %s cannot contain a semicolon, GORM splits its tags at it", name, ormable.Name))
+	}
+	if strings.Contains(comment, "`") {
+		panic(fmt.Sprintf("comment of %s in %s cannot contain a backquote", name, ormable.Name))
+	}
+	comment = "'" + strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(comment) + "'"
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(comment)
+}
+
+func (b *ORMBuilder) setupOrderedHasMany(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	var fieldNames []string
+	for name := range ormable.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		b.setupOrderedHasManyByName(message, fieldName, g)
+	}
+}
+
+func (b *ORMBuilder) setupOrderedHasManyByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	field := ormable.Fields[fieldName]
+
+	if field == nil {
+		return
+	}
+
+	if field.GetHasMany().GetPositionField() != "" {
+		positionField := field.GetHasMany().GetPositionField()
+		positionFieldType := b.getOrmable(field.Type).Fields[positionField].Type
+		g.P(`for i, e := range `, `to.`, fieldName, `{`)
+		g.P(`e.`, positionField, ` = `, positionFieldType, `(i)`)
+		g.P(`}`)
+	}
+}
+
+// Output code that will convert a field to/from orm.
+func (b *ORMBuilder) generateFieldConversion(message *protogen.Message, field *protogen.Field,
+	toORM bool, ofield *Field, g *protogen.GeneratedFile) error {
+
+	fieldName := camelCase(string(field.Desc.Name()))
+	fieldType := field.Desc.Kind().String() // was GoType
+	if field.Desc.Message() != nil {
+		parts := strings.Split(string(field.Desc.Message().FullName()), ".")
+		fieldType = parts[len(parts)-1]
+	}
+	if field.Desc.Cardinality() == protoreflect.Repeated {
+		// Some repeated fields can be handled by github.com/lib/pq
+		if ofield != nil && ofield.GetStoreAs() == gorm.GormFieldOptions_ARRAY {
+			b.generateEnumArrayConversion(field, toORM, g)
+		} else if b.dbEngine == ENGINE_POSTGRES && b.IsAbleToMakePQArray(fieldType) && field.Desc.IsList() {
+			g.P(`if m.`, fieldName, ` != nil {`)
+			switch fieldType {
+			case "bool":
+				g.P(`to.`, fieldName, ` = make(`, generateImport("BoolArray", pqImport, g), `, len(m.`, fieldName, `))`)
+			case "double":
+				g.P(`to.`, fieldName, ` = make(`, generateImport("Float64Array", pqImport, g), `, len(m.`, fieldName, `))`)
+			case "int64":
+				g.P(`to.`, fieldName, ` = make(`, generateImport("Int64Array", pqImport, g), `, len(m.`, fieldName, `))`)
+			case "string":
+				g.P(`to.`, fieldName, ` = make(`, generateImport("StringArray", pqImport, g), `, len(m.`, fieldName, `))`)
+			}
+			g.P(`copy(to.`, fieldName, `, m.`, fieldName, `)`)
+			g.P(`}`)
+		} else if b.isOrmable(fieldType) { // Repeated ORMable type
+			//fieldType = strings.Trim(fieldType, "[]*")
+
+			g.P(`for _, v := range m.`, fieldName, ` {`)
+			if deleted := b.softDeleted(fieldType, "v", g); !toORM && deleted != "" {
+				g.P(`if v != nil && `, deleted, ` {`)
+				g.P(`continue`)
+				g.P(`}`)
+			}
+			g.P(`if v != nil {`)
+			if toORM {
+				g.P(`if temp`, fieldName, `, cErr := v.ToORM(ctx); cErr == nil {`)
+			} else {
+				g.P(`if temp`, fieldName, `, cErr := v.ToPB(ctx); cErr == nil {`)
+			}
+			g.P(`to.`, fieldName, ` = append(to.`, fieldName, `, &temp`, fieldName, `)`)
+			g.P(`} else {`)
+			g.P(`return to, cErr`)
+			g.P(`}`)
+			g.P(`} else {`)
+			g.P(`to.`, fieldName, ` = append(to.`, fieldName, `, nil)`)
+			g.P(`}`)
+			g.P(`}`) // end repeated for
+		} else {
+			g.P(`// Repeated type `, fieldType, ` is not an ORMable message type`)
+		}
+	} else if ofield != nil && ofield.GetStoreAs() == gorm.GormFieldOptions_BASE64_TEXT { // Singular bytes in base64
+		b.generateBase64Conversion(field, toORM, g)
+	} else if ofield != nil && ofield.GetMoney() != nil { // Singular money in minor units
+		b.generateMoneyConversion(message, field, ofield.GetMoney(), toORM, g)
+	} else if isOptionalScalar(field) { // Singular optional, kept as a pointer
+		b.generateOptionalConversion(field, toORM, g)
+	} else if isYesNoBool(field) { // Singular bool stored as Y or N
+		if toORM {
+			g.P(`to.`, fieldName, ` = `, generateImport("YesNo", gtypesImport, g), `(m.`, fieldName, `)`)
+		} else {
+			g.P(`to.`, fieldName, ` = bool(m.`, fieldName, `)`)
+		}
+	} else if isSmallintEnum(field) { // Singular Enum stored as an int16
+		if toORM {
+			g.P(`to.`, fieldName, ` = int16(m.`, fieldName, `)`)
+		} else {
+			g.P(`to.`, fieldName, ` = `, b.typeName(field.Enum.GoIdent, g), `(m.`, fieldName, `)`)
+		}
+	} else if field.Enum != nil { // Singular Enum, which is an int32 ---
+		fieldType = b.typeName(field.Enum.GoIdent, g)
+		if toORM {
+			if b.enumAsString(field) {
+				g.P(`to.`, fieldName, ` = `, fieldType, `_name[int32(m.`, fieldName, `)]`)
+			} else {
+				g.P(`to.`, fieldName, ` = int32(m.`, fieldName, `)`)
+			}
+		} else {
+			if b.enumAsString(field) {
+				g.P(`to.`, fieldName, ` = `, fieldType, `(`, fieldType, `_value[m.`, fieldName, `])`)
+			} else {
+				g.P(`to.`, fieldName, ` = `, fieldType, `(m.`, fieldName, `)`)
+			}
+		}
+	} else if field.Message != nil { // Singular Object -------------
+		//Check for WKTs
+		// Type is a WKT, convert to/from as ptr to base type
+		if ofield != nil && ofield.GetCompositeType() != "" { // Singular composite type ---
+			var attrs []string
+			for _, attr := range field.Message.Fields {
+				attrs = append(attrs, attr.GoName+`: m.`+fieldName+`.`+attr.GoName)
+			}
+			name := strings.TrimPrefix(ofield.Type, "*")
+			if !toORM {
+				name = b.typeName(field.Message.GoIdent, g)
+			}
+			g.P(`if m.`, fieldName, ` != nil {`)
+			g.P(`to.`, fieldName, ` = &`, name, `{`, strings.Join(attrs, ", "), `}`)
+			g.P(`}`)
+		} else if _, exists := wellKnownTypes[fieldType]; exists { // Singular WKT -----
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`v := m.`, fieldName, `.Value`)
+				g.P(`to.`, fieldName, ` = &v`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				// g.P(`to.`, fieldName, ` = &`, b.GetFileImports().wktPkgName, ".", fieldType,
+				// 	`{Value: *m.`, fieldName, `}`)
+				g.P(`to.`, fieldName, ` = &`, generateImport(fieldType, wktImport, g),
+					`{Value: *m.`, fieldName, `}`)
+				g.P(`}`)
+			}
+		} else if fieldType == protoTypeUUIDValue { // Singular UUIDValue type ----
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`tempUUID, uErr := `, generateImport("FromString", uuidImport, g), `(m.`, fieldName, `.Value)`)
+				g.P(`if uErr != nil {`)
+				g.P(`return to, uErr`)
+				g.P(`}`)
+				g.P(`to.`, fieldName, ` = &tempUUID`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`to.`, fieldName, ` = &`, generateImport("UUIDValue", gtypesImport, g), `{Value: m.`, fieldName, `.String()}`)
+				g.P(`}`)
+			}
+		} else if fieldType == protoTypeUUID { // Singular UUID type --------------
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`to.`, fieldName, `, err = `, generateImport("FromString", uuidImport, g), `(m.`, fieldName, `.Value)`)
+				g.P(`if err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`} else {`)
+				g.P(`to.`, fieldName, ` = `, generateImport("Nil", uuidImport, g))
+				g.P(`}`)
+			} else {
+				g.P(`to.`, fieldName, ` = &`, generateImport("UUID", gtypesImport, g), `{Value: m.`, fieldName, `.String()}`)
+			}
+		} else if fieldType == protoTypeTimestamp { // Singular WKT Timestamp ---
+			if toORM {
+				if ofield.GetDefaultNow() {
+					// GORM leaves the nil field to the default of the column
+					g.P(`if m.`, fieldName, ` != nil && (m.`, fieldName, `.Seconds != 0 || m.`, fieldName, `.Nanos != 0) {`)
+				} else {
+					g.P(`if m.`, fieldName, ` != nil {`)
+				}
+				g.P(`t := m.`, fieldName, `.AsTime()`)
+				if zone := timezoneField(message, field); zone != "" {
+					// the time is kept in UTC, the zone it is read in must exist
+					g.P(`if _, err := `, generateImport("LoadLocation", stdTimeImport, g), `(m.`, zone, `); err != nil {`)
+					g.P(`return to, err`)
+					g.P(`}`)
+				}
+				if ofield.GetPreserveNanos() {
+					// the column is written in microseconds, so that it is not rounded
+					g.P(`to.`, fieldName, `Nanos = int32(t.Nanosecond() % 1000)`)
+					g.P(`t = t.Truncate(`, generateImport("Microsecond", stdTimeImport, g), `)`)
+				}
+				g.P(`to.`, fieldName, ` = &t`)
+				g.P(`}`)
+			} else if ofield.GetPreserveNanos() {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`to.`, fieldName, ` = `, generateImport("New", timestampImport, g), `(m.`, fieldName, `.Truncate(`, generateImport("Microsecond", stdTimeImport, g), `).Add(`, generateImport("Duration", stdTimeImport, g), `(m.`, fieldName, `Nanos)))`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`to.`, fieldName, ` = `, generateImport("New", timestampImport, g), `(*m.`, fieldName, `)`)
+				g.P(`}`)
+			}
+		} else if field.Message.Desc.FullName() == protoTypeDuration { // Singular WKT Duration ---
+			if toORM {
+				convert := generateImport("DurationNanos", gtypesImport, g)
+				if strings.HasSuffix(ofield.Type, "Interval") {
+					convert = generateImport("IntervalFromPB", gtypesImport, g)
+				}
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`v, err := `, convert, `(m.`, fieldName, `)`)
+				g.P(`if err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`to.`, fieldName, ` = &v`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`to.`, fieldName, ` = `, generateImport("New", durationImport, g), `(`, generateImport("Duration", stdTimeImport, g), `(*m.`, fieldName, `))`)
+				g.P(`}`)
+			}
+		} else if field.Message.Desc.FullName() == protoTypeAny { // Singular WKT Any ---
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`v, err := `, generateImport("AnyJSONFromPB", gtypesImport, g), `(m.`, fieldName, `)`)
+				g.P(`if err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`to.`, fieldName, ` = v`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`v, err := m.`, fieldName, `.ToPB()`)
+				g.P(`if err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`to.`, fieldName, ` = v`)
+				g.P(`}`)
+			}
+		} else if fieldType == protoTypeJSON {
+			if b.dbEngine == ENGINE_POSTGRES {
+				var jsonDefault string
+				if ofield != nil {
+					jsonDefault = ofield.GetJsonDefault()
+				}
+				if toORM && jsonDefault != "" {
+					g.P(`if m.`, fieldName, ` != nil && m.`, fieldName, `.Value != "" {`)
+					g.P(`to.`, fieldName, ` = &`, generateImport("Jsonb", gormpqImport, g), `{[]byte(m.`, fieldName, `.Value)}`)
+					g.P(`} else {`)
+					g.P(`to.`, fieldName, ` = &`, generateImport("Jsonb", gormpqImport, g), `{[]byte(`, strconv.Quote(jsonDefault), `)}`)
+					g.P(`}`)
+				} else if toORM {
+					g.P(`if m.`, fieldName, ` != nil {`)
+					g.P(`to.`, fieldName, ` = &`, generateImport("Jsonb", gormpqImport, g), `{[]byte(m.`, fieldName, `.Value)}`)
+					g.P(`}`)
+				} else if jsonDefault != "" {
+					// a NULL column reads as the default
+					g.P(`if m.`, fieldName, ` != nil && m.`, fieldName, `.RawMessage != nil {`)
+					g.P(`to.`, fieldName, ` = &`, generateImport("JSONValue", gtypesImport, g), `{Value: string(m.`, fieldName, `.RawMessage)}`)
+					g.P(`} else {`)
+					g.P(`to.`, fieldName, ` = &`, generateImport("JSONValue", gtypesImport, g), `{Value: `, strconv.Quote(jsonDefault), `}`)
+					g.P(`}`)
+				} else {
+					g.P(`if m.`, fieldName, ` != nil {`)
+					g.P(`to.`, fieldName, ` = &`, generateImport("JSONValue", gtypesImport, g), `{Value: string(m.`, fieldName, `.RawMessage)}`)
+					g.P(`}`)
+				}
+			} // Potential TODO other DB engine handling if desired
+		} else if fieldType == protoTypeResource {
+			resource := "nil" // assuming we do not know the PB type, nil means call codec for any resource
+			if ofield != nil && ofield.ParentOrigName != "" {
+				resource = "&" + ofield.ParentOrigName + "{}"
+			}
+			btype := strings.TrimPrefix(ofield.Type, "*")
+			nillable := strings.HasPrefix(ofield.Type, "*")
+			iface := ofield.Type == "interface{}"
+
+			if toORM {
+				if nillable {
+					g.P(`if m.`, fieldName, ` != nil {`)
+				}
+				switch btype {
+				case "int64":
+					g.P(`if v, err :=`, generateImport("DecodeInt64", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					g.P(`	return to, err`)
+					g.P(`} else {`)
+					if nillable {
+						g.P(`to.`, fieldName, ` = &v`)
+					} else {
+						g.P(`to.`, fieldName, ` = v`)
+					}
+					g.P(`}`)
+				case "[]byte":
+					g.P(`if v, err :=`, generateImport("DecodeBytes", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					g.P(`	return to, err`)
+					g.P(`} else {`)
+					g.P(`	to.`, fieldName, ` = v`)
+					g.P(`}`)
+				default:
+					g.P(`if v, err :=`, generateImport("Decode", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					g.P(`return to, err`)
+					g.P(`} else if v != nil {`)
+					if nillable {
+						g.P(`vv := v.(`, btype, `)`)
+						g.P(`to.`, fieldName, ` = &vv`)
+					} else if iface {
+						g.P(`to.`, fieldName, `= v`)
+					} else {
+						g.P(`to.`, fieldName, ` = v.(`, btype, `)`)
+					}
+					g.P(`}`)
+				}
+				if nillable {
+					g.P(`}`)
+				}
+			}
+
+			if !toORM {
+				if nillable {
+					g.P(`if m.`, fieldName, `!= nil {`)
+					g.P(`	if v, err := `, generateImport("Encode", resourceImport, g), `(`, resource, `, *m.`, fieldName, `); err != nil {`)
+					g.P(`		return to, err`)
+					g.P(`	} else {`)
+					g.P(`		to.`, fieldName, ` = v`)
+					g.P(`	}`)
+					g.P(`}`)
+
+				} else {
+					g.P(`if v, err := `, generateImport("Encode", resourceImport, g), `(`, resource, `, m.`, fieldName, `); err != nil {`)
+					g.P(`return to, err`)
+					g.P(`} else {`)
+					g.P(`to.`, fieldName, ` = v`)
+					g.P(`}`)
+				}
+			}
+		} else if field.Message.Desc.FullName() == protoTypeGeoPointFull { // PostGIS point
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`if to.`, fieldName, `, err = `, generateImport("NewPoint", gtypesImport, g), `(m.`, fieldName, `.Lat, m.`, fieldName, `.Lng); err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`to.`, fieldName, ` = &`, generateImport("GeoPoint", gtypesImport, g), `{Lat: m.`, fieldName, `.Lat, Lng: m.`, fieldName, `.Lng}`)
+				g.P(`}`)
+			}
+		} else if fieldType == protoTypeInet { // Inet type for Postgres only, currently
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`if to.`, fieldName, `, err = `, generateImport("ParseInet", gtypesImport, g), `(m.`, fieldName, `.Value); err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil && m.`, fieldName, `.IPNet != nil {`)
+				g.P(`to.`, fieldName, ` = &`, generateImport("InetValue", gtypesImport, g), `{Value: m.`, fieldName, `.String()}`)
+				g.P(`}`)
+			}
+		} else if fieldType == protoTimeOnly { // Time only to support time via string
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`if to.`, fieldName, `, err = `, generateImport("ParseTime", gtypesImport, g), `(m.`, fieldName, `.Value); err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`}`)
+			} else {
+				g.P(`if m.`, fieldName, ` != "" {`)
+				g.P(`if to.`, fieldName, `, err = `, generateImport("TimeOnlyByString", gtypesImport, g), `( m.`, fieldName, `); err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`}`)
+			}
+		} else if b.isOrmable(fieldType) && isEmbedded(ofield) {
+			// the embedded struct is converted by the message's own converters
+			if toORM {
+				g.P(`if m.`, fieldName, ` != nil {`)
+				g.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToORM(ctx)`)
+				g.P(`if err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`to.`, fieldName, ` = temp`, fieldName)
+				g.P(`}`)
+			} else {
+				g.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToPB(ctx)`)
+				g.P(`if err != nil {`)
+				g.P(`return to, err`)
+				g.P(`}`)
+				g.P(`to.`, fieldName, ` = &temp`, fieldName)
+			}
+		} else if b.isOrmable(fieldType) {
+			// Not a WKT, but a type we're building converters for
+			if !toORM && ofield.BackReference {
+				// the parent being converted is not converted again, the
+				// messages would be cyclic
+				g.P(`if m.`, fieldName, ` != nil && !`, generateImport("Visited", gtypesImport, g), `(ctx, m.`, fieldName, `) {`)
+			} else if deleted := b.softDeleted(fieldType, "m."+fieldName, g); !toORM && deleted != "" {
+				g.P(`if m.`, fieldName, ` != nil && !(`, deleted, `) {`)
+			} else {
+				g.P(`if m.`, fieldName, ` != nil {`)
+			}
+			if toORM {
+				g.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToORM (ctx)`)
+			} else {
+				g.P(`temp`, fieldName, `, err := m.`, fieldName, `.ToPB (ctx)`)
+			}
+			g.P(`if err != nil {`)
+			g.P(`return to, err`)
+			g.P(`}`)
+			g.P(`to.`, fieldName, ` = &temp`, fieldName)
+			g.P(`}`)
+		}
+	} else { // Singular raw ----------------------------------------------------
+		g.P(`to.`, fieldName, ` = m.`, fieldName)
+	}
+	return nil
+}
+
+// isOptionalScalar reports whether the field is a singular scalar or enum
+// with explicit presence, generated as a pointer on both sides
+func isOptionalScalar(field *protogen.Field) bool {
+	return field.Desc.HasOptionalKeyword() && field.Message == nil &&
+		field.Desc.Kind() != protoreflect.BytesKind && !field.Desc.IsList()
+}
+
+// isYesNoBool reports whether the bool field is stored as Y or N
+func isYesNoBool(field *protogen.Field) bool {
+	options := field.Desc.Options().(*descriptorpb.FieldOptions)
+	return field.Desc.Kind() == protoreflect.BoolKind && getFieldOptions(options).GetBoolAs() == "char"
+}
+
+// isLookupEnum reports whether the enum field refers to an enum_as_fk_table
+func isLookupEnum(field *protogen.Field) bool {
+	options := field.Desc.Options().(*descriptorpb.FieldOptions)
+	return field.Enum != nil && getFieldOptions(options).GetEnumAsFkTable() != ""
+}
+
+// enumAsString reports whether the enum field is stored as the name of its
+// value, as all the enums with enums=string except those of a lookup table
+func (b *ORMBuilder) enumAsString(field *protogen.Field) bool {
+	return b.stringEnums && !isLookupEnum(field)
+}
+
+// isSmallintEnum reports whether the enum field is stored as an int16
+func isSmallintEnum(field *protogen.Field) bool {
+	options := field.Desc.Options().(*descriptorpb.FieldOptions)
+	return field.Enum != nil && getFieldOptions(options).GetEnumStorage() == gorm.GormFieldOptions_SMALLINT
+}
+
+func (b *ORMBuilder) generateOptionalConversion(field *protogen.Field, toORM bool, g *protogen.GeneratedFile) {
+	fieldName := camelCase(string(field.Desc.Name()))
+	g.P(`if m.`, fieldName, ` != nil {`)
+	if field.Enum != nil {
+		enumType := b.typeName(field.Enum.GoIdent, g)
+		if isSmallintEnum(field) {
+			if toORM {
+				g.P(`v := int16(*m.`, fieldName, `)`)
+			} else {
+				g.P(`v := `, enumType, `(*m.`, fieldName, `)`)
+			}
+		} else if toORM {
+			if b.enumAsString(field) {
+				g.P(`v := `, enumType, `_name[int32(*m.`, fieldName, `)]`)
+			} else {
+				g.P(`v := int32(*m.`, fieldName, `)`)
+			}
+		} else {
+			if b.enumAsString(field) {
+				g.P(`v := `, enumType, `(`, enumType, `_value[*m.`, fieldName, `])`)
+			} else {
+				g.P(`v := `, enumType, `(*m.`, fieldName, `)`)
+			}
+		}
+	} else if isYesNoBool(field) {
+		if toORM {
+			g.P(`v := `, generateImport("YesNo", gtypesImport, g), `(*m.`, fieldName, `)`)
+		} else {
+			g.P(`v := bool(*m.`, fieldName, `)`)
+		}
+	} else {
+		g.P(`v := *m.`, fieldName)
+	}
+	g.P(`to.`, fieldName, ` = &v`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateDefaultHandlers(file *protogen.File, g *protogen.GeneratedFile) {
+	for _, message := range file.Messages {
+		if isOrmable(message) {
+			b.generateCreateHandler(message, false, g)
+			b.generateCopyFromHandler(message, g)
+			b.generateCreateSetHandler(message, g)
+			b.generateUpsertSetHandler(message, g)
+			typeName := string(message.Desc.Name())
+			ormable := b.getOrmable(typeName)
+
+			if !b.hasPrimaryKey(ormable) && getMessageOptions(message).GetResourceNamePattern() != "" {
+				panic(fmt.Sprintf("resource_name_pattern of %s needs a primary key", typeName))
+			}
+			if !b.hasPrimaryKey(ormable) && getMessageOptions(message).GetAudit() {
+				panic(fmt.Sprintf("audit of %s needs a primary key", typeName))
+			}
+			if ormable.SavedByCreate && !b.hasPrimaryKey(ormable) {
+				panic(fmt.Sprintf("create mode SAVE of %s needs a primary key", typeName))
+			}
+			if ormable.Immutable && getMessageOptions(message).GetUpdatedByField() != "" {
+				panic(fmt.Sprintf("updated_by_field of %s is never set, the type is immutable", typeName))
+			}
+			if b.hasPrimaryKey(ormable) {
+				if ormable.SavedByCreate {
+					b.generateCreateHandler(message, true, g)
+				}
+				b.generateResourceNameParser(message, g)
+				b.generateReadHandler(message, g)
+				b.generateReadForUpdateHandler(message, g)
+				b.generateDeleteHandler(message, g)
+				b.generateDeleteSetHandler(message, g)
+				b.generateRestoreHandler(message, g)
+				b.generatePurgeHandler(message, g)
+				if !ormable.Immutable {
+					b.generateStrictUpdateHandler(message, g)
+					b.generatePatchHandler(message, g)
+					b.generatePatchSetHandler(message, g)
+					// the rows of a bulk update would change outside of the history
+					if !getMessageOptions(message).GetHistory() {
+						b.generateUpdateByIdsHandler(message, g)
+					}
+					for _, name := range ormable.CasFields {
+						b.generateUpdateIfHandler(message, name, g)
+					}
+				}
+				b.generateHistoryHandler(message, g)
+			}
+
+			b.generateGetOrCreateHandler(message, getMessageOptions(message).GetGetOrCreateKey(), "", g)
+			for _, name := range ormable.IdempotencyFields {
+				b.generateGetOrCreateHandler(message, []string{name}, "By"+name, g)
+			}
+			b.generateApplyFieldMask(message, g)
+			b.generateListHandler(message, g)
+			b.generateExplainListHandler(message, g)
+			b.generateSelectHandler(message, g)
+			b.generateCursorListHandler(message, g)
+			b.generateAggregateHandlers(message, g)
+			b.generateFacetHandlers(message, g)
+			b.generateReparentHandlers(message, g)
+			b.generateReorderHandlers(message, g)
+			b.generateRepository(message, g)
+		}
+
+	}
+}
+
+// insertFields returns the sorted fields of the columns a multi-row insert of
+// the type writes, every column but a single integer primary key and the
+// computed ones
+func (b *ORMBuilder) insertFields(ormable *OrmableType) []string {
+	var names []string
+	for _, name := range b.columnFields(ormable) {
+		if field, ok := ormable.Fields[name]; !ok || !field.GetComputed() {
+			names = append(names, name)
+		}
+	}
+	// an integer primary key is left to the sequence of the column, unlike the
+	// columns of a composite key
+	if b.hasPrimaryKey(ormable) && len(b.manyToManyKeys(ormable, "")) == 1 {
+		pkName, pk := b.findPrimaryKey(ormable)
+		if strings.Contains(pk.Type, "int") && (pk.GetTag() == nil || pk.GetTag().AutoIncrement == nil || pk.GetTag().GetAutoIncrement()) {
+			for i, name := range names {
+				if name == pkName {
+					names = append(names[:i], names[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+	return names
+}
+
+// insertDefaults returns the fields written by the multi-row inserts of the
+// type that are left to the default of their column when blank, as a GORM
+// create leaves them: the primary key, the fields with a default or
+// default_expr and those with omit_zero_on_create
+func (b *ORMBuilder) insertDefaults(message *protogen.Message) []string {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	defaulted := map[string]bool{}
+	if b.hasPrimaryKey(ormable) && len(b.manyToManyKeys(ormable, "")) == 1 {
+		pkName, _ := b.findPrimaryKey(ormable)
+		defaulted[pkName] = true
+	}
+	for name, field := range ormable.Fields {
+		if field.GetTag().GetDefault() != "" || field.GetTag().GetDefaultExpr() != "" {
+			defaulted[name] = true
+		}
+	}
+	for _, name := range b.omitZeroFields(message) {
+		defaulted[name] = true
+	}
+	var names []string
+	for _, name := range b.insertFields(ormable) {
+		if defaulted[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// blankCheck returns the condition of the field of obj being blank, as GORM
+// tells a blank field
+func blankCheck(obj, name string, field *Field, g *protogen.GeneratedFile) string {
+	value := obj + "." + name
+	switch fieldType := field.Type; {
+	case fieldType == "string":
+		return value + ` == ""`
+	case fieldType == "bool":
+		return `!` + value
+	case strings.HasPrefix(fieldType, "int") || strings.HasPrefix(fieldType, "uint") || strings.HasPrefix(fieldType, "float"):
+		return value + ` == 0`
+	case fieldType == "time.Time":
+		return value + `.IsZero()`
+	case strings.HasPrefix(fieldType, "*") || strings.HasPrefix(fieldType, "[]") || strings.HasPrefix(fieldType, "map["):
+		return value + ` == nil`
+	}
+	return generateImport("ValueOf", stdReflectImport, g) + `(` + value + `).IsZero()`
+}
+
+// generateInsertTuple emits the placeholders and arguments of the row of a
+// multi-row insert, the blank defaulted columns are written as DEFAULT
+func (b *ORMBuilder) generateInsertTuple(message *protogen.Message, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	names := b.insertFields(ormable)
+	var placeholders, values []string
+	for _, name := range names {
+		placeholders = append(placeholders, `?`)
+		values = append(values, `row.`+name)
+	}
+	defaults := b.insertDefaults(message)
+	if len(defaults) == 0 {
+		g.P(`tuples = append(tuples, "(`, strings.Join(placeholders, `, `), `)")`)
+		g.P(`args = append(args, `, strings.Join(values, `, `), `)`)
+		return
+	}
+	g.P(`values := []interface{}{`, strings.Join(values, `, `), `}`)
+	for _, name := range defaults {
+		for i, other := range names {
+			if other == name {
+				g.P(`if `, blankCheck(`row`, name, ormable.Fields[name], g), ` {`)
+				g.P(`values[`, i, `] = `, generateImport("Default", gtypesImport, g))
+				g.P(`}`)
+			}
+		}
+	}
+	g.P(`tuple, bound := `, generateImport("InsertTuple", gtypesImport, g), `(values)`)
+	g.P(`tuples = append(tuples, tuple)`)
+	g.P(`args = append(args, bound...)`)
+}
+
+// columnFields returns the sorted fields of the ORM type stored in columns of
+// its table, a field of an embedded struct is named {Embedded}.{Field}
+func (b *ORMBuilder) columnFields(ormable *OrmableType) []string {
+	var names []string
+	for name, field := range ormable.Fields {
+		if isEmbedded(field) {
+			for _, sub := range b.columnFields(b.getOrmable(field.Type)) {
+				names = append(names, name+"."+sub)
+			}
+			continue
+		}
+		if isAssociation(field) || field.GetThrough() != "" || field.GetTag().GetIgnore() || strings.HasPrefix(field.Type, "[]*") || strings.HasSuffix(field.Type, "ORM") {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fieldColumn returns the column of a field of columnFields, the column of an
+// embedded struct field is prefixed by the embedded_prefix as GORM does
+func (b *ORMBuilder) fieldColumn(ormable *OrmableType, name string) string {
+	if i := strings.Index(name, "."); i >= 0 {
+		field := ormable.Fields[name[:i]]
+		return field.GetTag().GetEmbeddedPrefix() + b.fieldColumn(b.getOrmable(field.Type), name[i+1:])
+	}
+	return columnName(name, ormable.Fields[name])
+}
+
+// generateInsertRows converts the objects of in to the ORM rows of a
+// multi-row insert, setting the timestamps GORM would set on create
+func (b *ORMBuilder) generateInsertRows(ormable *OrmableType, zero string, g *protogen.GeneratedFile) {
+	timestamps := b.autoTimestamps(ormable, false)
+	// the default_now columns are written too, they take the time of the
+	// insert like the ones GORM sets
+	var defaultNow []string
+	set := map[string]bool{}
+	for _, name := range timestamps {
+		set[name] = true
+	}
+	for name, field := range ormable.Fields {
+		if field.GetDefaultNow() && !field.GetTouchOnSave() && !set[name] {
+			defaultNow = append(defaultNow, name)
+		}
+	}
+	sort.Strings(defaultNow)
+	timestamps = append(timestamps, defaultNow...)
+	// the multi-row insert runs no BeforeSave, the touch_on_save fields are
+	// set to the time of the insert here
+	touched := touchedFields(ormable)
+	if len(timestamps) > 0 || len(touched) > 0 {
+		g.P(`now := `, generateImport("Now", stdTimeImport, g), `()`)
+	}
+	g.P(`rows := make([]`, ormable.Name, `, 0, len(in))`)
+	g.P(`for _, obj := range in {`)
+	g.P(`if obj == nil {`)
+	g.P(`return `, zero, `, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`row, err := obj.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return `, zero, `, err`)
+	g.P(`}`)
+	for _, name := range timestamps {
+		if strings.HasPrefix(ormable.Fields[name].Type, "*") {
+			g.P(`if row.`, name, ` == nil {`)
+			g.P(`row.`, name, ` = &now`)
+		} else {
+			g.P(`if row.`, name, `.IsZero() {`)
+			g.P(`row.`, name, ` = now`)
+		}
+		g.P(`}`)
+	}
+	for _, name := range touched {
+		g.P(`row.`, name, ` = &now`)
+	}
+	b.generateDefaultUUIDs(ormable, `row`, g)
+	g.P(`rows = append(rows, row)`)
+	g.P(`}`)
+}
+
+// maxBatchSize returns the most rows of a multi-row insert of the type that
+// stay within the bind parameters postgres takes
+func (b *ORMBuilder) maxBatchSize(ormable *OrmableType) int {
+	return postgresMaxParams / len(b.insertFields(ormable))
+}
+
+// generateCreateSetHandler emits DefaultCreate{Type}Set, inserting the objects
+// with multi-row INSERTs returning the stored rows
+func (b *ORMBuilder) generateCreateSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	if b.dbEngine != ENGINE_POSTGRES {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	names := b.insertFields(ormable)
+	if len(names) == 0 {
+		return
+	}
+
+	var columns []string
+	for _, name := range names {
+		columns = append(columns, `"`+b.fieldColumn(ormable, name)+`"`)
+	}
+	table := `"` + b.baseTableName(message) + `"`
+	if schema := b.tableSchema(message); schema != "" {
+		table = `"` + schema + `".` + table
+	}
+	insert := fmt.Sprintf(`INSERT INTO %s (%s) VALUES `, table, strings.Join(columns, `, `))
+
+	g.P(`// `, ormable.Name, `MaxBatchSize is the most rows of an INSERT of DefaultCreate`, typeName, `Set,`)
+	g.P(`// postgres takes `, postgresMaxParams, ` bind parameters in a statement and a row has `, len(names))
+	g.P(`const `, ormable.Name, `MaxBatchSize = `, b.maxBatchSize(ormable))
+	g.P()
+	g.P(`// DefaultCreate`, typeName, `Set inserts the objects with INSERTs of batchSize rows, `, defaultBatchSize, ` if`)
+	g.P(`// batchSize is not positive and at most `, ormable.Name, `MaxBatchSize, in one transaction and`)
+	g.P(`// returns them as stored. The GORM callbacks and hooks do not run and the`)
+	g.P(`// associations are not written.`)
+	g.P(`func DefaultCreate`, typeName, `Set(ctx context.Context, in []*`, typeName, `, db *`, generateImport("DB", gormImport, g), `, batchSize int) `, b.handlerResults(`[]*`+typeName), ` {`)
+	b.generateMetricsObserve(message, "create_set", g)
+	b.generateStatusErrors(g)
+	g.P(`if len(in) == 0 {`)
+	g.P(`return nil, nil`)
+	g.P(`}`)
+	g.P(`if batchSize <= 0 {`)
+	g.P(`batchSize = `, defaultBatchSize)
+	g.P(`}`)
+	g.P(`if batchSize > `, ormable.Name, `MaxBatchSize {`)
+	g.P(`batchSize = `, ormable.Name, `MaxBatchSize`)
+	g.P(`}`)
+	b.generateInsertRows(ormable, `nil`, g)
+	b.generateSessionBegin(message, `nil, err`, g)
+	g.P(`created := make([]*`, ormable.Name, `, 0, len(rows))`)
+	g.P(`if err := db.Transaction(func(tx *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`for start := 0; start < len(rows); start += batchSize {`)
+	g.P(`batch := rows[start:]`)
+	g.P(`if len(batch) > batchSize {`)
+	g.P(`batch = batch[:batchSize]`)
+	g.P(`}`)
+	g.P(`tuples := make([]string, 0, len(batch))`)
+	g.P(`args := make([]interface{}, 0, len(batch)*`, len(names), `)`)
+	g.P(`for _, row := range batch {`)
+	b.generateInsertTuple(message, g)
+	g.P(`}`)
+	g.P(`var stored []*`, ormable.Name)
+	g.P("if err := tx.Raw(`"+insert+"`+", generateImport("Join", stdStringsImport, g), `(tuples, ", ")+" RETURNING *", args...).Scan(&stored).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`created = append(created, stored...)`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`return `, ormable.Name, `SliceToPB(ctx, created)`)
+	g.P(`}`)
+	g.P()
+}
+
+// upsertKey returns the fields of the conflict target of the upserts of the
+// type, those of upsert_key or else the primary key, nil if the key is left
+// to a sequence and never conflicts
+func (b *ORMBuilder) upsertKey(message *protogen.Message) []string {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	inserted := map[string]bool{}
+	for _, name := range b.insertFields(ormable) {
+		inserted[name] = true
+	}
+	refs := getMessageOptions(message).GetUpsertKey()
+	if len(refs) == 0 {
+		if !b.hasPrimaryKey(ormable) {
+			return nil
+		}
+		keys := b.manyToManyKeys(ormable, "")
+		for _, key := range keys {
+			if !inserted[key] {
+				return nil
+			}
+		}
+		return keys
+	}
+	var keys []string
+	for _, ref := range refs {
+		key := ""
+		for name, field := range ormable.Fields {
+			if inserted[name] && (name == camelCase(ref) || columnName(name, field) == ref) {
+				key = name
+			}
+		}
+		if key == "" {
+			panic(fmt.Sprintf("upsert_key of %s refers to %q, which is not a column written by its inserts", ormable.Name, ref))
+		}
+		for _, other := range keys {
+			if other == key {
+				panic(fmt.Sprintf("upsert_key of %s repeats the column of %s", ormable.Name, key))
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// generateUpsertSetHandler emits DefaultUpsert{Type}Set, inserting the objects
+// with multi-row INSERTs updating the rows of the same key instead
+func (b *ORMBuilder) generateUpsertSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	opts := getMessageOptions(message)
+	if b.dbEngine != ENGINE_POSTGRES {
+		if len(opts.GetUpsertKey()) > 0 {
+			panic(fmt.Sprintf("upsert_key of %s needs engine=postgres", typeName))
+		}
+		return
+	}
+	names := b.insertFields(ormable)
+	keys := b.upsertKey(message)
+	if len(names) == 0 || len(keys) == 0 {
+		return
+	}
+	if ormable.Immutable || tracksWrites(message) {
+		if len(opts.GetUpsertKey()) > 0 {
+			panic(fmt.Sprintf("upsert_key of %s is never used, the rows of an immutable, audited or history type are not upserted", typeName))
+		}
+		return
+	}
+
+	// the columns keep their value of the create
+	kept := map[string]bool{}
+	for _, key := range keys {
+		kept[key] = true
+	}
+	for _, name := range b.autoTimestamps(ormable, false) {
+		if name == "CreatedAt" {
+			kept[name] = true
+		}
+	}
+	if byField := opts.GetCreatedByField(); byField != "" {
+		kept[camelCase(byField)] = true
+	}
+	var columns, targets, sets []string
+	for _, name := range names {
+		column := `"` + b.fieldColumn(ormable, name) + `"`
+		columns = append(columns, column)
+		field := ormable.Fields[name]
+		if kept[name] || field.GetReadOnly() || field.GetImmutableAfterCreate() || field.GetDefaultUuid() {
+			continue
+		}
+		sets = append(sets, column+` = EXCLUDED.`+column)
+	}
+	for _, key := range keys {
+		targets = append(targets, `"`+b.fieldColumn(ormable, key)+`"`)
+	}
+	table := `"` + b.baseTableName(message) + `"`
+	if schema := b.tableSchema(message); schema != "" {
+		table = `"` + schema + `".` + table
+	}
+	insert := fmt.Sprintf(`INSERT INTO %s (%s) VALUES `, table, strings.Join(columns, `, `))
+	conflict := fmt.Sprintf(` ON CONFLICT (%s) DO NOTHING`, strings.Join(targets, `, `))
+	if len(sets) > 0 {
+		conflict = fmt.Sprintf(` ON CONFLICT (%s) DO UPDATE SET %s`, strings.Join(targets, `, `), strings.Join(sets, `, `))
+		if ormable.MultiAccount {
+			// a row of another account is never overwritten
+			account := `"` + columnName("AccountID", ormable.Fields["AccountID"]) + `"`
+			conflict += fmt.Sprintf(` WHERE "%s".%s = EXCLUDED.%s`, b.baseTableName(message), account, account)
+		}
+	}
+
+	g.P(`// DefaultUpsert`, typeName, `Set inserts the objects with INSERTs of batchSize rows, `, defaultBatchSize, ` if`)
+	g.P(`// batchSize is not positive and at most `, ormable.Name, `MaxBatchSize, updating the row of the same`)
+	g.P(`// `, strings.Join(targets, `, `), ` instead, in one transaction, e.g. to reconcile a table with external data.`)
+	g.P(`// It returns the count of rows inserted or updated. The objects of a batch need distinct keys,`)
+	g.P(`// the GORM callbacks and hooks do not run and the associations are not written.`)
+	g.P(`func DefaultUpsert`, typeName, `Set(ctx context.Context, in []*`, typeName, `, db *`, generateImport("DB", gormImport, g), `, batchSize int) `, b.handlerResults(`int64`), ` {`)
+	b.generateMetricsObserve(message, "upsert_set", g)
+	b.generateStatusErrors(g)
+	g.P(`if len(in) == 0 {`)
+	g.P(`return 0, nil`)
+	g.P(`}`)
+	g.P(`if batchSize <= 0 {`)
+	g.P(`batchSize = `, defaultBatchSize)
+	g.P(`}`)
+	g.P(`if batchSize > `, ormable.Name, `MaxBatchSize {`)
+	g.P(`batchSize = `, ormable.Name, `MaxBatchSize`)
+	g.P(`}`)
+	b.generateInsertRows(ormable, `0`, g)
+	b.generateSessionBegin(message, `0, err`, g)
+	g.P(`var upserted int64`)
+	g.P(`if err := db.Transaction(func(tx *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`for start := 0; start < len(rows); start += batchSize {`)
+	g.P(`batch := rows[start:]`)
+	g.P(`if len(batch) > batchSize {`)
+	g.P(`batch = batch[:batchSize]`)
+	g.P(`}`)
+	g.P(`tuples := make([]string, 0, len(batch))`)
+	g.P(`args := make([]interface{}, 0, len(batch)*`, len(names), `)`)
+	g.P(`for _, row := range batch {`)
+	b.generateInsertTuple(message, g)
+	g.P(`}`)
+	g.P("written := tx.Exec(`"+insert+"`+", generateImport("Join", stdStringsImport, g), "(tuples, \", \")+`"+conflict+"`, args...)")
+	g.P(`if err := written.Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`upserted += written.RowsAffected`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}); err != nil {`)
+	g.P(`return 0, err`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `0, err`, g)
+	g.P(`return upserted, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateCopyFromHandler emits DefaultCopyFrom, inserting the objects with a
+// postgres COPY through lib/pq on the transaction of the GORM handle
+func (b *ORMBuilder) generateCopyFromHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	if b.dbEngine != ENGINE_POSTGRES {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	var columns, values []string
+	for _, name := range b.insertFields(ormable) {
+		columns = append(columns, `"`+b.fieldColumn(ormable, name)+`"`)
+		values = append(values, `row.`+name)
+	}
+	copyIn := fmt.Sprint(generateImport("CopyIn", pqImport, g), `("`, b.baseTableName(message), `", `, strings.Join(columns, `, `), `)`)
+	if schema := b.tableSchema(message); schema != "" {
+		copyIn = fmt.Sprint(generateImport("CopyInSchema", pqImport, g), `("`, schema, `", "`, b.baseTableName(message), `", `, strings.Join(columns, `, `), `)`)
+	}
+
+	g.P(`// DefaultCopyFrom`, typeName, ` inserts the objects with a COPY into the `, b.tableName(message), ` table, which`)
+	g.P(`// is faster than INSERTs for many rows. The GORM callbacks and hooks do not run, the`)
+	if len(b.insertDefaults(message)) == 0 {
+		g.P(`// associations are not written and every column takes the value of the object.`)
+	} else {
+		g.P(`// associations are not written and every column takes the value of the object. COPY has no`)
+		g.P(`// DEFAULT, an object with a blank primary key or defaulted column fails, DefaultCreate`, typeName, `Set`)
+		g.P(`// leaves them to the DB.`)
+	}
+	g.P(`func DefaultCopyFrom`, typeName, `(ctx context.Context, in []*`, typeName, `, db *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`int64`), ` {`)
+	b.generateMetricsObserve(message, "copy_from", g)
+	b.generateStatusErrors(g)
+	g.P(`if len(in) == 0 {`)
+	g.P(`return 0, nil`)
+	g.P(`}`)
+	b.generateInsertRows(ormable, `0`, g)
+	if defaults := b.insertDefaults(message); len(defaults) > 0 {
+		g.P(`for i, row := range rows {`)
+		for _, name := range defaults {
+			column := b.fieldColumn(ormable, name)
+			g.P(`if `, blankCheck(`row`, name, ormable.Fields[name], g), ` {`)
+			g.P(`return 0, `, generateImport("Errorf", stdFmtImport, g), `("COPY into `, b.tableName(message), ` takes no default of `, column, `, which is blank in object %d", i)`)
+			g.P(`}`)
+		}
+		g.P(`}`)
+	}
+	b.generateSessionBegin(message, `0, err`, g)
+	g.P(`if err := db.Transaction(func(tx *`, generateImport("DB", gormImport, g), `) error {`)
+	g.P(`sqlTx, ok := tx.CommonDB().(*`, generateImport("Tx", stdSQLImport, g), `)`)
+	g.P(`if !ok {`)
+	g.P(`return `, generateImport("Errorf", stdFmtImport, g), `("COPY into `, b.tableName(message), ` needs a *sql.Tx, got %T", tx.CommonDB())`)
+	g.P(`}`)
+	g.P(`stmt, err := sqlTx.PrepareContext(ctx, `, copyIn, `)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`for _, row := range rows {`)
+	g.P(`if _, err := stmt.ExecContext(ctx, `, strings.Join(values, `, `), `); err != nil {`)
+	g.P(`stmt.Close()`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`if _, err := stmt.ExecContext(ctx); err != nil {`)
+	g.P(`stmt.Close()`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`return stmt.Close()`)
+	g.P(`}); err != nil {`)
+	g.P(`return 0, err`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `0, err`, g)
+	g.P(`return int64(len(rows)), nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateCreateHandler emits DefaultCreate, or DefaultSave with save for the
+// SAVE create mode of the Create methods
+func (b *ORMBuilder) generateCreateHandler(message *protogen.Message, save bool, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	orm := b.getOrmable(typeName)
+	verb, op := "Create", "create"
+	if save {
+		verb, op = "Save", "save"
+		g.P(`// DefaultSave`, typeName, ` executes a basic gorm save call, inserting the object or updating the`)
+		g.P(`// row of its primary key, as used by the Create methods with the SAVE create mode`)
+	} else {
+		g.P(`// DefaultCreate`, typeName, ` executes a basic gorm create call`)
+	}
+	g.P(`func Default`, verb, typeName, `(ctx context.Context, in *`,
+		typeName, `, db *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`*`+typeName), ` {`)
+	b.generateMetricsObserve(message, op, g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	b.generateValidateCall(g)
+	b.generateShardResolve(message, `nil, err`, g)
+	b.generateSessionBegin(message, `nil, err`, g)
+	b.generateDeferConstraints(`nil, err`, g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if b.hasPrimaryKey(orm) {
+		// GORM skips a blank primary key on insert, so it has to be set by now
+		if pkName, pk := b.findPrimaryKey(orm); isAppAssignedKey(pk.GetTag()) {
+			if strings.Contains(pk.Type, "*") {
+				g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+			} else {
+				g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+			}
+			g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+			g.P(`}`)
+		}
+	}
+	b.generateStampActor(`nil, err`, g, getMessageOptions(message).GetCreatedByField(), getMessageOptions(message).GetUpdatedByField())
+	b.generateDefaultUUIDs(orm, `ormObj`, g)
+	create := verb + "_"
+	b.generateBeforeHookCall(orm, create, g)
+	b.generateConflictKeyResolution(orm, g)
+	b.generateValidateReferencesCall(message, g)
+	var write string
+	if zeroFields := b.omitZeroFields(message); !save && len(zeroFields) > 0 {
+		// the zero fields are left to the defaults of their columns
+		omitted := `[]string{}`
+		if columns := b.computedColumns(message); len(columns) > 0 {
+			omitted = `[]string{"` + strings.Join(columns, `", "`) + `"}`
+		}
+		g.P(`omitted := `, omitted)
+		for _, name := range zeroFields {
+			field := orm.Fields[name]
+			zero := `0`
+			switch field.Type {
+			case "string":
+				zero = `""`
+			case "bool":
+				zero = `false`
+			}
+			g.P(`if ormObj.`, name, ` == `, zero, ` {`)
+			g.P(`omitted = append(omitted, "`, columnName(name, field), `")`)
+			g.P(`}`)
+		}
+		write = `Omit(omitted...).Create(&ormObj)`
+	} else if columns := b.computedColumns(message); !save && len(columns) > 0 {
+		write = `Omit("` + strings.Join(columns, `", "`) + `").Create(&ormObj)`
+	} else if !save {
+		write = `Create(&ormObj)`
+	} else if columns := b.readOnlyColumns(message); len(columns) > 0 {
+		// the insert of a missing row runs on a fresh scope and keeps them
+		write = `Omit("` + strings.Join(columns, `", "`) + `").Save(&ormObj)`
+	} else {
+		write = `Save(&ormObj)`
+	}
+	if b.dbEngine == ENGINE_POSTGRES {
+		g.P(`written := `, generateImport("Set", returningImport, g), `(db).`, write)
+		g.P(`if err = written.Error; err != nil {`)
+	} else {
+		g.P(`if err = db.`, write, `.Error; err != nil {`)
+	}
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateConflictKeyUpserts(orm, g)
+	b.generateReloadComputedCall(message, `ormObj`, `written`, g)
+	b.generateAfterHookCall(orm, create, g)
+	b.generateValidateReferencesCommit(message, g)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
+	g.P(`return &pbResponse, err`)
+	g.P(`}`)
+	b.generateBeforeHookDef(orm, create, g)
+	b.generateAfterHookDef(orm, create, g)
+}
+
+// generateGetOrCreateHandler generates a handler reading the object by the
+// fields of the get_or_create_key, or of an idempotency_field by the suffix of
+// the field, or creating it, a concurrent create of the same key is detected
+// by the unique violation and resolved by reading it again
+func (b *ORMBuilder) generateGetOrCreateHandler(message *protogen.Message, keys []string, suffix string, g *protogen.GeneratedFile) {
+	if len(keys) == 0 {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	var where, args []string
+	for _, key := range keys {
+		fieldName := camelCase(key)
+		field, ok := ormable.Fields[fieldName]
+		if !ok || isAssociation(field) {
+			panic(fmt.Sprintf("get or create key %s of %s is not a column of %s", key, typeName, ormable.Name))
+		}
+		where = append(where, columnName(fieldName, field)+` = ?`)
+		args = append(args, `ormObj.`+fieldName)
+	}
+	if getMessageOptions(message).GetMultiAccount() {
+		where = append(where, columnName("AccountID", ormable.Fields["AccountID"])+` = ?`)
+		args = append(args, `ormObj.AccountID`)
+	}
+	savepoint := "get_or_create_" + jgorm.ToDBName(typeName+suffix)
+
+	g.P(`// DefaultGetOrCreate`, typeName, suffix, ` reads the `, typeName, ` with the same `, strings.Join(keys, ", "), ` or creates it`)
+	g.P(`// if it does not exist yet, created reports which of the two happened`)
+	g.P(`func DefaultGetOrCreate`, typeName, suffix, `(ctx context.Context, in *`, typeName, `, db *`, generateImport("DB", gormImport, g), `) (_ *`, typeName, `, created bool, err error) {`)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, false, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	b.generateShardResolve(message, `nil, false, err`, g)
+	b.generateSessionBegin(message, `nil, false, err`, g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, false, err`)
+	g.P(`}`)
+	g.P(`// inside a transaction a failed insert has to be rolled back to a savepoint`)
+	g.P(`_, inTxn := db.CommonDB().(*`, generateImport("Tx", stdSQLImport, g), `)`)
+	g.P(`var pbResponse *`, typeName)
+	g.P(`for retried := false; ; retried = true {`)
+	g.P(`found := `, ormable.Name, `{}`)
+	g.P(`if err = db.Where("`, strings.Join(where, ` AND `), `", `, strings.Join(args, `, `), `).First(&found).Error; err == nil {`)
+	g.P(`pbObj, err := found.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, false, err`)
+	g.P(`}`)
+	g.P(`pbResponse = &pbObj`)
+	g.P(`break`)
+	g.P(`} else if !`, generateImport("IsRecordNotFoundError", gormImport, g), `(err) {`)
+	g.P(`return nil, false, err`)
+	g.P(`}`)
+	g.P(`if inTxn {`)
+	g.P(`if err = db.Exec("SAVEPOINT `, savepoint, `").Error; err != nil {`)
+	g.P(`return nil, false, err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`if pbResponse, err = DefaultCreate`, typeName, `(ctx, in, db); err == nil {`)
+	g.P(`if inTxn {`)
+	g.P(`if err = db.Exec("RELEASE SAVEPOINT `, savepoint, `").Error; err != nil {`)
+	g.P(`return nil, false, err`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`created = true`)
+	g.P(`break`)
+	g.P(`}`)
+	g.P(`if inTxn {`)
+	g.P(`if txnErr := db.Exec("ROLLBACK TO SAVEPOINT `, savepoint, `").Error; txnErr != nil {`)
+	g.P(`return nil, false, txnErr`)
+	g.P(`}`)
+	g.P(`}`)
+	g.P(`// only a concurrent create of the same key is worth reading again`)
+	g.P(`if retried || !`, generateImport("IsUniqueViolation", gerrorsImport, g), `(err) {`)
+	g.P(`return nil, false, err`)
+	g.P(`}`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `nil, false, err`, g)
+	g.P(`return pbResponse, created, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// handlerResults renders the result list of a default handler, the error is
+// named when the metrics are observed on return
+func (b *ORMBuilder) handlerResults(results ...string) string {
+	if !b.namedHandlerErr() {
+		if len(results) == 0 {
+			return `error`
+		}
+		return `(` + strings.Join(results, `, `) + `, error)`
+	}
+	var named []string
+	for _, result := range results {
+		named = append(named, `_ `+result)
+	}
+	return `(` + strings.Join(append(named, `err error`), `, `) + `)`
+}
+
+// namedHandlerErr reports whether the handlers name their error result, so
+// that deferred functions can observe or replace it
+func (b *ORMBuilder) namedHandlerErr() bool {
+	return b.metrics || b.statusErrors
+}
+
+// generateStatusErrors converts the error returned by the handler to a gRPC
+// status error, with the grpc_status_errors parameter only
+func (b *ORMBuilder) generateStatusErrors(g *protogen.GeneratedFile) {
+	if !b.statusErrors {
+		return
+	}
+	g.P(`defer func() {`)
+	g.P(`err = `, generateImport("Status", gerrorsImport, g), `(err)`)
+	g.P(`}()`)
+}
+
+// generateValidateCall validates the object in with its protoc-gen-validate
+// methods, with the validate parameter only
+func (b *ORMBuilder) generateValidateCall(g *protogen.GeneratedFile) {
+	if !b.validate {
+		return
+	}
+	g.P(`if err := `, generateImport("Validate", gerrorsImport, g), `(in); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+}
+
+// generateMetricsObserve counts and times the handler of the message with
+// the shared metrics, labelled by the full proto name of the message
+func (b *ORMBuilder) generateMetricsObserve(message *protogen.Message, op string, g *protogen.GeneratedFile) {
+	if !b.metrics {
+		return
+	}
+	g.P(`defer func(start `, generateImport("Time", stdTimeImport, g), `) {`)
+	g.P(generateImport("Observe", metricsImport, g), `("`, message.Desc.FullName(), `", "`, op, `", start, err)`)
+	g.P(`}(`, generateImport("Now", stdTimeImport, g), `())`)
+}
+
+// generateSessionBegin sets the row-level security session variable and the
+// search_path of the tenant of the message, opening a transaction for them
+// when the handler is not already running in one
+func (b *ORMBuilder) generateSessionBegin(message *protogen.Message, errReturn string, g *protogen.GeneratedFile) {
+	if b.rlsSessionVar != "" {
+		g.P(`db, rlsSession, err := `, generateImport("Begin", rlsImport, g), `(ctx, db, "`, b.rlsSessionVar, `", `, g.QualifiedGoIdent(b.rlsExtractor), `)`)
+		g.P(`if err != nil {`)
+		g.P(`return `, errReturn)
+		g.P(`}`)
+		g.P(`defer rlsSession.Rollback()`)
+	}
+	if tenantSchema(message) {
+		// the transaction of the row-level security session is reused
+		g.P(`db, tenantSession, err := `, generateImport("Begin", tenantImport, g), `(ctx, db, `, g.QualifiedGoIdent(b.schemaExtractor), `)`)
+		g.P(`if err != nil {`)
+		g.P(`return `, errReturn)
+		g.P(`}`)
+		g.P(`defer tenantSession.Rollback()`)
+	}
+}
+
+func (b *ORMBuilder) generateSessionCommit(message *protogen.Message, errReturn string, g *protogen.GeneratedFile) {
+	if tenantSchema(message) {
+		g.P(`if err = tenantSession.Commit(); err != nil {`)
+		g.P(`return `, errReturn)
+		g.P(`}`)
+	}
+	if b.rlsSessionVar != "" {
+		g.P(`if err = rlsSession.Commit(); err != nil {`)
+		g.P(`return `, errReturn)
+		g.P(`}`)
+	}
+}
+
+func (b *ORMBuilder) generateBeforeHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBefore`, method, `); ok {`)
+	g.P(`if db, err = hook.Before`, method, `(ctx, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterHookCall(orm *OrmableType, method string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfter`, method, `); ok {`)
+	g.P(`if err = hook.After`, method, `(ctx, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateHookInterfaces(g *protogen.GeneratedFile, message *protogen.Message) {
+	typeName := string(message.Desc.Name())
+	g.P(`// The following are interfaces you can implement for special behavior during ORM/PB conversions`)
+	g.P(`// of type `, typeName, ` the arg will be the target, the caller the one being converted from`)
+	g.P()
+	for _, desc := range [][]string{
+		{"BeforeToORM", typeName + "ORM", " called before default ToORM code"},
+		{"AfterToORM", typeName + "ORM", " called after default ToORM code"},
+		{"BeforeToPB", typeName, " called before default ToPB code"},
+		{"AfterToPB", typeName, " called after default ToPB code"},
+	} {
+		g.P(`// `, typeName, desc[0], desc[2])
+		g.P(`type `, typeName, `With`, desc[0], ` interface {`)
+		g.P(desc[0], `(context.Context, *`, desc[1], `) error`)
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// resourceNameSegment is a literal collection id of a resource name pattern,
+// or a variable with the ORM field it is stored in
+type resourceNameSegment struct {
+	literal string
+	field   string
+}
+
+// resourceNameSegments splits the resource_name_pattern of the message, each
+// variable names a field with an _id suffix, a field, or the primary key
+// for the last one
+func (b *ORMBuilder) resourceNameSegments(message *protogen.Message) []resourceNameSegment {
+	pattern := getMessageOptions(message).GetResourceNamePattern()
+	if pattern == "" {
+		return nil
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	var nameField *protogen.Field
+	for _, field := range message.Fields {
+		if field.Desc.Name() == "name" {
+			nameField = field
+		}
+	}
+	if nameField == nil || nameField.Desc.Kind() != protoreflect.StringKind || nameField.Desc.IsList() {
+		panic(fmt.Sprintf("resource_name_pattern of %s needs a string name field", typeName))
+	}
+
+	parts := strings.Split(pattern, "/")
+	last := -1
+	for i, part := range parts {
+		if strings.HasPrefix(part, "{") {
+			last = i
+		}
+	}
+	if last < 0 {
+		panic(fmt.Sprintf("resource_name_pattern %q of %s has no variable", pattern, typeName))
+	}
+	var segments []resourceNameSegment
+	seen := map[string]bool{}
+	for i, part := range parts {
+		if part == "" || strings.ContainsAny(strings.Trim(part, "{}"), "{}") {
+			panic(fmt.Sprintf("malformed resource_name_pattern %q of %s", pattern, typeName))
+		}
+		if !strings.HasPrefix(part, "{") && !strings.HasSuffix(part, "}") {
+			segments = append(segments, resourceNameSegment{literal: part})
+			continue
+		}
+		variable := strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
+		if variable == "" || len(variable)+2 != len(part) {
+			panic(fmt.Sprintf("malformed resource_name_pattern %q of %s", pattern, typeName))
+		}
+		var fieldName string
+		candidates := []string{camelCase(variable + "_id"), camelCase(variable)}
+		if getMessageOptions(message).GetMultiAccount() && variable == "account" {
+			candidates = append(candidates, "AccountID")
+		}
+		for _, candidate := range candidates {
+			if field, ok := ormable.Fields[candidate]; ok && !isAssociation(field) {
+				fieldName = candidate
+				break
+			}
+		}
+		if fieldName == "" && i == last {
+			fieldName, _ = b.findPrimaryKey(ormable)
+		}
+		if fieldName == "" {
+			panic(fmt.Sprintf("resource_name_pattern variable %s of %s does not name a field", variable, typeName))
+		}
+		if seen[fieldName] {
+			panic(fmt.Sprintf("resource_name_pattern of %s has %s twice", typeName, fieldName))
+		}
+		seen[fieldName] = true
+		segments = append(segments, resourceNameSegment{field: fieldName})
+	}
+	return segments
+}
+
+// generateResourceNameParser emits Parse{Type}Name, returning the ORM object
+// with the fields of the resource name variables set
+func (b *ORMBuilder) generateResourceNameParser(message *protogen.Message, g *protogen.GeneratedFile) {
+	segments := b.resourceNameSegments(message)
+	if segments == nil {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	pattern := getMessageOptions(message).GetResourceNamePattern()
+
+	malformed := fmt.Sprint(generateImport("Errorf", grpcStatusImport, g), `(`,
+		generateImport("InvalidArgument", grpcCodesImport, g), `, "malformed `, typeName, ` name %q", name)`)
+	g.P(`// Parse`, typeName, `Name returns the ids of a resource name of the form `, pattern)
+	g.P(`func Parse`, typeName, `Name(name string) (`, ormable.Name, `, error) {`)
+	g.P(`to := `, ormable.Name, `{}`)
+	g.P(`segments := `, generateImport("Split", stdStringsImport, g), `(name, "/")`)
+	check := fmt.Sprint(`len(segments) != `, len(segments))
+	for i, segment := range segments {
+		if segment.literal != "" {
+			check += fmt.Sprintf(` || segments[%d] != "%s"`, i, segment.literal)
+		} else {
+			check += fmt.Sprintf(` || segments[%d] == ""`, i)
+		}
+	}
+	g.P(`if `, check, ` {`)
+	g.P(`return to, `, malformed)
+	g.P(`}`)
+	for i, segment := range segments {
+		if segment.field == "" {
+			continue
+		}
+		value := fmt.Sprintf(`segments[%d]`, i)
+		fieldType := ormable.Fields[segment.field].Type
+		switch {
+		case fieldType == "string":
+			g.P(`to.`, segment.field, ` = `, value)
+		case strings.HasPrefix(fieldType, "int") || strings.HasPrefix(fieldType, "uint"):
+			parse, bits := "ParseInt", strings.TrimPrefix(fieldType, "int")
+			if strings.HasPrefix(fieldType, "uint") {
+				parse, bits = "ParseUint", strings.TrimPrefix(fieldType, "uint")
+			}
+			if bits == "" {
+				bits = "0"
+			}
+			g.P(`if v, err := `, generateImport(parse, stdStrconvImport, g), `(`, value, `, 10, `, bits, `); err != nil {`)
+			g.P(`return to, `, malformed)
+			g.P(`} else {`)
+			g.P(`to.`, segment.field, ` = `, fieldType, `(v)`)
+			g.P(`}`)
+		case strings.HasSuffix(fieldType, ".UUID") && !strings.HasPrefix(fieldType, "*"):
+			g.P(`if v, err := `, generateImport("FromString", uuidImport, g), `(`, value, `); err != nil {`)
+			g.P(`return to, `, malformed)
+			g.P(`} else {`)
+			g.P(`to.`, segment.field, ` = v`)
+			g.P(`}`)
+		default:
+			panic(fmt.Sprintf("resource_name_pattern of %s cannot set %s of type %s", typeName, segment.field, fieldType))
+		}
+	}
+	g.P(`return to, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateResourceNameLookup replaces the ORM object of a Read or Delete
+// handler with the ids parsed from a non-empty name, for a multi account type
+// the account of the name has to be the one of the caller
+func (b *ORMBuilder) generateResourceNameLookup(message *protogen.Message, errReturn string, g *protogen.GeneratedFile) {
+	segments := b.resourceNameSegments(message)
+	if segments == nil {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	g.P(`if in.Name != "" {`)
+	g.P(`key, err := Parse`, typeName, `Name(in.Name)`)
+	g.P(`if err != nil {`)
+	g.P(`return `, errReturn)
+	g.P(`}`)
+	if getMessageOptions(message).GetMultiAccount() {
+		var hasAccount bool
+		for _, segment := range segments {
+			hasAccount = hasAccount || segment.field == "AccountID"
+		}
+		if hasAccount {
+			g.P(`if key.AccountID != ormObj.AccountID {`)
+			g.P(`err = `, generateImport("Errorf", grpcStatusImport, g), `(`,
+				generateImport("InvalidArgument", grpcCodesImport, g), `, "`, typeName, ` name %q is not in the account of the caller", in.Name)`)
+			g.P(`return `, errReturn)
+			g.P(`}`)
+		} else {
+			g.P(`key.AccountID = ormObj.AccountID`)
+		}
+	}
+	g.P(`ormObj = key`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateReadHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	if b.readHasFieldSelection(ormable) {
+		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
+			typeName, `, db *`, generateImport("DB", gormImport, g), `, fs *`, generateImport("FieldSelection", queryImport, g), `) `, b.handlerResults(`*`+typeName), ` {`)
+	} else {
+		g.P(`func DefaultRead`, typeName, `(ctx context.Context, in *`,
+			typeName, `, db *`, "gorm", `.DB) `, b.handlerResults(`*`+typeName), ` {`)
+	}
+	b.generateMetricsObserve(message, "read", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, "errors", `.NilArgumentError`)
+	g.P(`}`)
+	b.generateShardResolve(message, `nil, err`, g)
+	b.generateSessionBegin(message, `nil, err`, g)
+
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateResourceNameLookup(message, `nil, err`, g)
+
+	k, f := b.findPrimaryKey(ormable)
+	if strings.Contains(f.Type, "*") {
+		g.P(`if ormObj.`, k, ` == nil || *ormObj.`, k, ` == `, b.guessZeroValue(f.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, k, ` == `, b.guessZeroValue(f.Type, g), ` {`)
+	}
+	g.P(`return nil, `, "errors", `.EmptyIdError`)
+	g.P(`}`)
+
+	var fs string
+	if b.readHasFieldSelection(ormable) {
+		fs = "fs"
+	} else {
+		fs = "nil"
+	}
+
+	b.generateBeforeReadHookCall(ormable, "ApplyQuery", g)
+	g.P(`if db, err = `, generateImport("ApplyFieldSelection", tkgormImport, g), `(ctx, db, `, fs, `, &`, ormable.Name, `{}); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateConditionalPreloadsCall(message, `nil, err`, g)
+
+	b.generateBeforeReadHookCall(ormable, "Find", g)
+	g.P(`ormResponse := `, ormable.Name, `{}`)
+	g.P(`if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+
+	b.generateAfterReadHookCall(ormable, g)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
+	g.P(`return &pbResponse, err`)
+	g.P(`}`)
+
+	b.generateBeforeReadHookDef(ormable, "ApplyQuery", g)
+	b.generateBeforeReadHookDef(ormable, "Find", g)
+	b.generateAfterReadHookDef(ormable, g)
+
+}
+
+// generateReadForUpdateHandler emits DefaultRead{Type}ForUpdate, reading the
+// row with SELECT ... FOR UPDATE, the lock is held until the transaction of
+// db ends. SQLite has no row locks, nothing is generated for it.
+func (b *ORMBuilder) generateReadForUpdateHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	if b.dbEngine == ENGINE_SQLITE {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	g.P(`// DefaultRead`, typeName, `ForUpdate reads the row of the primary key of in and locks it until the`)
+	g.P(`// transaction of db ends, it fails with NoTransactionError outside of one. With`)
+	g.P(`// LockWaitSkipLocked a row locked by another transaction is not found.`)
+	g.P(`func DefaultRead`, typeName, `ForUpdate(ctx context.Context, in *`, typeName, `, db *`, generateImport("DB", gormImport, g), `, wait `, generateImport("LockWait", gtypesImport, g), `) `, b.handlerResults(`*`+typeName), ` {`)
+	b.generateMetricsObserve(message, "read_for_update", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`if _, ok := db.CommonDB().(*`, generateImport("Tx", stdSQLImport, g), `); !ok {`)
+	g.P(`return nil, `, generateImport("NoTransactionError", gerrorsImport, g))
+	g.P(`}`)
+	b.generateSessionBegin(message, `nil, err`, g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateResourceNameLookup(message, `nil, err`, g)
+
+	k, f := b.findPrimaryKey(ormable)
+	if strings.Contains(f.Type, "*") {
+		g.P(`if ormObj.`, k, ` == nil || *ormObj.`, k, ` == `, b.guessZeroValue(f.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, k, ` == `, b.guessZeroValue(f.Type, g), ` {`)
+	}
+	g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`ormResponse := `, ormable.Name, `{}`)
+	g.P(`if err = db.Set("gorm:query_option", wait.ForUpdate()).Where(&ormObj).First(&ormResponse).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`pbResponse, err := ormResponse.ToPB(ctx)`)
+	g.P(`return &pbResponse, err`)
+	g.P(`}`)
+	g.P()
+}
+
+func (b *ORMBuilder) readHasFieldSelection(ormable *OrmableType) bool {
+	if read, ok := ormable.Methods[readService]; ok {
+		if s := b.getFieldSelection(read.inType); s != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// guessZeroValue of the input type, so that we can check if a (key) value is set or not
+func (b *ORMBuilder) guessZeroValue(typeName string, g *protogen.GeneratedFile) string {
+	typeName = strings.ToLower(typeName)
+	if strings.Contains(typeName, "string") {
+		return `""`
+	}
+	if strings.Contains(typeName, "int") {
+		return `0`
+	}
+	if strings.Contains(typeName, "uuid") {
+		// return fmt.Sprintf(`%s.Nil`, p.Import(uuidImport))
+		return generateImport("Nil", uuidImport, g)
+	}
+	if strings.Contains(typeName, "[]byte") {
+		return `nil`
+	}
+	if strings.Contains(typeName, "bool") {
+		return `false`
+	}
+	return ``
+}
+
+func (b *ORMBuilder) generateBeforeReadHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeRead`, suffix, `); ok {`)
+	hookCall := fmt.Sprint(`if db, err = hook.BeforeRead`, suffix, `(ctx, db`)
+	if b.readHasFieldSelection(orm) {
+		hookCall += `, fs`
+	}
+	hookCall += `); err != nil{`
+	g.P(hookCall)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterReadHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormResponse).(`, orm.Name, `WithAfterReadFind`, `); ok {`)
+	hookCall := `if err = hook.AfterReadFind(ctx, db`
+	if b.readHasFieldSelection(orm) {
+		hookCall += `, fs`
+	}
+	hookCall += `); err != nil {`
+	g.P(hookCall)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateBeforeReadHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	gormDB := generateImport("DB", gormImport, g)
+	g.P(`type `, orm.Name, `WithBeforeRead`, suffix, ` interface {`)
+	hookSign := fmt.Sprint(`BeforeRead`, suffix, `(context.Context, *`, gormDB)
+	if b.readHasFieldSelection(orm) {
+		hookSign += fmt.Sprint(`, *`, generateImport("FieldSelection", queryImport, g))
+	}
+
+	hookSign += fmt.Sprint(`) (*`, gormDB, `, error)`)
+	g.P(hookSign)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterReadHookDef(orm *OrmableType, g *protogen.GeneratedFile) {
+	g.P(`type `, orm.Name, `WithAfterReadFind interface {`)
+	hookSign := fmt.Sprint(`AfterReadFind`, `(context.Context, *`, generateImport("DB", gormImport, g))
+	if b.readHasFieldSelection(orm) {
+		hookSign += fmt.Sprint(`, *`, generateImport("FieldSelection", queryImport, g))
+	}
+	hookSign += `) error`
+	g.P(hookSign)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateDeleteHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+
+	writeResult := generateImport("WriteResult", gtypesImport, g)
+	gormDB := generateImport("DB", gormImport, g)
+	g.P(`func DefaultDelete`, typeName, `(ctx context.Context, in *`, typeName, `, db *`, gormDB, `) error {`)
+	g.P(`return defaultDelete`, typeName, `(ctx, in, db, nil)`)
+	g.P(`}`)
+	g.P()
+	g.P(`// DefaultDelete`, typeName, `WithResult is DefaultDelete`, typeName, ` reporting the affected rows,`)
+	g.P(`// no affected rows means no `, typeName, ` matched`)
+	g.P(`func DefaultDelete`, typeName, `WithResult(ctx context.Context, in *`, typeName, `, db *`, gormDB, `) (`, writeResult, `, error) {`)
+	g.P(`var result `, writeResult)
+	g.P(`err := defaultDelete`, typeName, `(ctx, in, db, &result)`)
+	g.P(`return result, err`)
+	g.P(`}`)
+	g.P()
+	g.P(`func defaultDelete`, typeName, `(ctx context.Context, in *`,
+		typeName, `, db *`, gormDB, `, result *`, writeResult, `) `, b.handlerResults(), ` {`)
+	b.generateMetricsObserve(message, "delete", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	b.generateShardResolve(message, `err`, g)
+	b.generateSessionBegin(message, `err`, g)
+	b.generateDeferConstraints(`err`, g)
+	b.generateAuditBegin(message, `err`, g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	b.generateResourceNameLookup(message, `err`, g)
+
+	ormable := b.getOrmable(typeName)
+	pkName, pk := b.findPrimaryKey(ormable)
+	if strings.Contains(pk.Type, "*") {
+		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), `{`)
+	}
+	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
+	audit := getMessageOptions(message).GetAudit()
+	_, prior, priorFound, _ := trackedNames(message)
+	if tracksWrites(message) {
+		g.P(prior, ` := `, ormable.Name, `{}`)
+		g.P(`if err = db.Where(&ormObj).First(&`, prior, `).Error; err != nil && !`, generateImport("IsRecordNotFoundError", gormImport, g), `(err) {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(priorFound, ` := err == nil`)
+	}
+
+	b.generateBeforeDeleteHookCall(ormable, g)
+	if getMessageOptions(message).GetSoftDelete().GetByField() != "" {
+		b.generateSoftDelete(message, `&ormObj`, g)
+	} else if getMessageOptions(message).GetEmitChanges() {
+		// the callbacks publish the deleted object, the model of the delete
+		g.P(`deleted := db.Where(&ormObj).Delete(&ormObj)`)
+	} else {
+		g.P(`deleted := db.Where(&ormObj).Delete(&`, ormable.Name, `{})`)
+	}
+	g.P(`if err = deleted.Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if result != nil {`)
+	g.P(`result.RowsAffected = deleted.RowsAffected`)
+	g.P(`result.Found = deleted.RowsAffected > 0`)
+	g.P(`}`)
+	if tracksWrites(message) {
+		g.P(`if `, priorFound, ` {`)
+		if audit {
+			b.generateAuditWrite(message, "delete", prior, prior, "", "", `err`, g)
+		}
+		b.generateHistoryWrite(message, "delete", `&`+prior, `err`, g)
+		g.P(`}`)
+	}
+
+	b.generateAfterDeleteHookCall(ormable, g)
+	if b.sessionScoped(message) || tracksWrites(message) {
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		b.generateAuditCommit(message, `err`, g)
+		b.generateSessionCommit(message, `err`, g)
+	}
+	g.P(`return err`)
+	g.P(`}`)
+	delete := "Delete_"
+	b.generateBeforeHookDef(ormable, delete, g)
+	b.generateAfterHookDef(ormable, delete, g)
+}
+
+func (b *ORMBuilder) generateBeforeDeleteHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeDelete_); ok {`)
+	g.P(`if db, err = hook.BeforeDelete_(ctx, db); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterDeleteHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithAfterDelete_); ok {`)
+	g.P(`err = hook.AfterDelete_(ctx, db)`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateBeforeHookDef(orm *OrmableType, method string, g *protogen.GeneratedFile) {
+	gormDB := generateImport("DB", gormImport, g)
+	g.P(`type `, orm.Name, `WithBefore`, method, ` interface {`)
+	g.P(`Before`, method, `(context.Context, *`, gormDB, `) (*`, gormDB, `, error)`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterHookDef(orm *OrmableType, method string, g *protogen.GeneratedFile) {
+	g.P(`type `, orm.Name, `WithAfter`, method, ` interface {`)
+	g.P(`After`, method, `(context.Context, *`, generateImport("DB", gormImport, g), `) error`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateDeleteSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	gormDB := generateImport("DB", gormImport, g)
+
+	g.P(`func DefaultDelete`, typeName, `Set(ctx context.Context, in []*`,
+		typeName, `, db *`, gormDB, `) `, b.handlerResults(), ` {`)
+	b.generateMetricsObserve(message, "delete_set", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	if !b.namedHandlerErr() {
+		g.P(`var err error`)
+	}
+	b.generateSessionBegin(message, `err`, g)
+	b.generateDeferConstraints(`err`, g)
+	b.generateAuditBegin(message, `err`, g)
+	ormable := b.getOrmable(typeName)
+	pkName, pk := b.findPrimaryKey(ormable)
+	g.P(`keys := []`, pk.Type, `{}`)
+	g.P(`for _, obj := range in {`)
+	g.P(`ormObj, err := obj.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	if strings.Contains(pk.Type, "*") {
+		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), `{`)
+	}
+	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`keys = append(keys, ormObj.`, pkName, `)`)
+	g.P(`}`)
+	b.generateBeforeDeleteSetHookCall(ormable, g)
+	audit := tracksWrites(message)
+	if getMessageOptions(message).GetMultiAccount() {
+		g.P(`acctId, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		where := `"` + columnName("AccountID", ormable.Fields["AccountID"]) + ` = ? AND ` + columnName(pkName, ormable.Fields[pkName]) + ` in (?)", acctId, keys`
+		if audit {
+			b.generateAuditRows(message, where, g)
+		}
+		if getMessageOptions(message).GetSoftDelete().GetByField() != "" {
+			b.generateSoftDelete(message, where, g)
+			g.P(`err = deleted.Error`)
+		} else {
+			g.P(`err = db.Where(`, where, `).Delete(&`, ormable.Name, `{}).Error`)
+		}
+	} else {
+		where := `"` + columnName(pkName, ormable.Fields[pkName]) + ` in (?)", keys`
+		if audit {
+			b.generateAuditRows(message, where, g)
+		}
+		if getMessageOptions(message).GetSoftDelete().GetByField() != "" {
+			b.generateSoftDelete(message, where, g)
+			g.P(`err = deleted.Error`)
+		} else {
+			g.P(`err = db.Where(`, where, `).Delete(&`, ormable.Name, `{}).Error`)
+		}
+	}
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	if audit {
+		_, prior, _, priors := trackedNames(message)
+		if getMessageOptions(message).GetHistory() {
+			g.P(`for i := range `, priors, ` {`)
+			g.P(prior, ` := &`, priors, `[i]`)
+		} else {
+			g.P(`for _, `, prior, ` := range `, priors, ` {`)
+		}
+		if getMessageOptions(message).GetAudit() {
+			b.generateAuditWrite(message, "delete", prior, prior, "", "", `err`, g)
+		}
+		b.generateHistoryWrite(message, "delete", prior, `err`, g)
+		g.P(`}`)
+	}
+	b.generateAfterDeleteSetHookCall(ormable, g)
+	if b.sessionScoped(message) || audit {
+		g.P(`if err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		b.generateAuditCommit(message, `err`, g)
+		b.generateSessionCommit(message, `err`, g)
+	}
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`type `, ormable.Name, `WithBeforeDeleteSet interface {`)
+	g.P(`BeforeDeleteSet(context.Context, []*`, ormable.OriginName, `, *`, gormDB, `) (*`, gormDB, `, error)`)
+	g.P(`}`)
+	g.P(`type `, ormable.Name, `WithAfterDeleteSet interface {`)
+	g.P(`AfterDeleteSet(context.Context, []*`, ormable.OriginName, `, *`, gormDB, `) error`)
+	g.P(`}`)
+}
+
+// generateAuditRows loads the rows a DeleteSet handler is about to delete
+func (b *ORMBuilder) generateAuditRows(message *protogen.Message, where string, g *protogen.GeneratedFile) {
+	_, _, _, priors := trackedNames(message)
+	g.P(priors, ` := []`, b.getOrmable(message.GoIdent.GoName).Name, `{}`)
+	g.P(`if err = db.Where(`, where, `).Find(&`, priors, `).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateBeforeDeleteSetHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := (interface{}(&`, orm.Name, `{})).(`, orm.Name, `WithBeforeDeleteSet); ok {`)
+	g.P(`if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterDeleteSetHookCall(orm *OrmableType, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := (interface{}(&`, orm.Name, `{})).(`, orm.Name, `WithAfterDeleteSet); ok {`)
+	g.P(`err = hook.AfterDeleteSet(ctx, in, db)`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateStrictUpdateHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	_ = generateImport("", "fmt", g)
+	typeName := string(message.Desc.Name())
+
+	writeResult := generateImport("WriteResult", gtypesImport, g)
+	gormDB := generateImport("DB", gormImport, g)
+	g.P(`// DefaultStrictUpdate`, typeName, ` clears / replaces / appends first level 1:many children and then executes a gorm update call`)
+	g.P(`func DefaultStrictUpdate`, typeName, `(ctx context.Context, in *`, typeName, `, db *`, gormDB, `) (*`, typeName, `, error) {`)
+	g.P(`return defaultStrictUpdate`, typeName, `(ctx, in, db, nil)`)
+	g.P(`}`)
+	g.P()
+	g.P(`// DefaultStrictUpdate`, typeName, `WithResult is DefaultStrictUpdate`, typeName, ` reporting the affected rows`)
+	g.P(`// and whether the `, typeName, ` existed before the update`)
+	g.P(`func DefaultStrictUpdate`, typeName, `WithResult(ctx context.Context, in *`, typeName, `, db *`, gormDB, `) (*`, typeName, `, `, writeResult, `, error) {`)
+	g.P(`var result `, writeResult)
+	g.P(`out, err := defaultStrictUpdate`, typeName, `(ctx, in, db, &result)`)
+	g.P(`return out, result, err`)
+	g.P(`}`)
+	g.P()
+	g.P(`func defaultStrictUpdate`, typeName, `(ctx context.Context, in *`,
+		typeName, `, db *`, gormDB, `, result *`, writeResult, `) `, b.handlerResults(`*`+typeName), ` {`)
+	b.generateMetricsObserve(message, "update", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, fmt.Errorf("Nil argument to DefaultStrictUpdate`, typeName, `")`)
+	g.P(`}`)
+	b.generateValidateCall(g)
+	b.generateShardResolve(message, `nil, err`, g)
+	b.generateSessionBegin(message, `nil, err`, g)
+	b.generateDeferConstraints(`nil, err`, g)
+	b.generateAuditBegin(message, `nil, err`, g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+
+	if getMessageOptions(message).GetMultiAccount() {
+		b.generateAccountIdWhereClause(b.getOrmable(typeName), g)
+	}
+
+	ormable := b.getOrmable(typeName)
+	g.P(`var count int64`)
+
+	if b.hasPrimaryKey(ormable) {
+		pkName, pk := b.findPrimaryKey(ormable)
+		column := columnName(pkName, pk)
+		g.P(`lockedRow := &`, typeName, `ORM{}`)
+		g.P(`count = db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("`, column, `=?", ormObj.`, pkName, `).First(lockedRow).RowsAffected`)
+		b.generateRejectChanges(message, g)
+	}
+	b.generateBeforeHookCall(ormable, "StrictUpdateCleanup", g)
+	// the cleanup must keep the resolved children
+	b.generateConflictKeyResolution(ormable, g)
+	b.handleChildAssociations(message, g)
+	b.generateStampActor(`nil, err`, g, getMessageOptions(message).GetUpdatedByField())
+	b.generateBeforeHookCall(ormable, "StrictUpdateSave", g)
+	b.generateValidateReferencesCall(message, g)
+	saveDB := `db`
+	if b.dbEngine == ENGINE_POSTGRES {
+		saveDB = generateImport("Set", returningImport, g) + `(db)`
+	}
+	if columns := b.readOnlyColumns(message); len(columns) > 0 {
+		g.P(`saved := `, saveDB, `.Omit("`, strings.Join(columns, `", "`), `").Save(&ormObj)`)
+	} else {
+		g.P(`saved := `, saveDB, `.Save(&ormObj)`)
+	}
+	g.P(`if err = saved.Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateConflictKeyUpserts(ormable, g)
+	b.generateReloadComputedCall(message, `ormObj`, `saved`, g)
+	g.P(`if result != nil {`)
+	g.P(`result.RowsAffected = saved.RowsAffected`)
+	g.P(`result.Found = count > 0`)
+	g.P(`}`)
+	if getMessageOptions(message).GetAudit() {
+		b.generateAuditWrite(message, "update", `ormObj`, `lockedRow`, `count > 0`, `ormObj`, `nil, err`, g)
+	}
+	if getMessageOptions(message).GetHistory() {
+		g.P(`if count > 0 {`)
+		b.generateHistoryWrite(message, "update", `lockedRow`, `nil, err`, g)
+		g.P(`}`)
+	}
+	b.generateAfterHookCall(ormable, "StrictUpdateSave", g)
+	b.generateValidateReferencesCommit(message, g)
+	b.generateAuditCommit(message, `nil, err`, g)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`pbResponse, err := ormObj.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+
+	if b.gateway {
+		g.P(`if count == 0 {`)
+		g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
+		g.P(`}`)
+	}
+
+	g.P(`return &pbResponse, err`)
+	g.P(`}`)
+
+	b.generateBeforeHookDef(ormable, "StrictUpdateCleanup", g)
+	b.generateBeforeHookDef(ormable, "StrictUpdateSave", g)
+	b.generateAfterHookDef(ormable, "StrictUpdateSave", g)
+}
+
+// generateRejectChanges fails the strict update of an existing row changing
+// a reject_change field, comparing the locked row with the incoming one
+func (b *ORMBuilder) generateRejectChanges(message *protogen.Message, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		if fieldOpts.GetDrop() || !fieldOpts.GetRejectChange() {
+			continue
+		}
+		name := camelCase(field.GoName)
+		ofield := ormable.Fields[name]
+		var changed string
+		switch fieldType := ofield.Type; {
+		case fieldType == "time.Time":
+			changed = `!lockedRow.` + name + `.Equal(ormObj.` + name + `)`
+		case fieldType == "*time.Time":
+			changed = `(lockedRow.` + name + ` == nil) != (ormObj.` + name + ` == nil) || lockedRow.` + name + ` != nil && !lockedRow.` + name + `.Equal(*ormObj.` + name + `)`
+		case strings.HasPrefix(fieldType, "[]") || strings.Contains(fieldType, "Jsonb") || strings.Contains(fieldType, ".") && !strings.HasSuffix(fieldType, "UUID"):
+			panic(fmt.Sprintf("Field %s of %s has reject_change but its type %s cannot be compared", name, ormable.Name, fieldType))
+		case strings.HasPrefix(fieldType, "*"):
+			changed = `(lockedRow.` + name + ` == nil) != (ormObj.` + name + ` == nil) || lockedRow.` + name + ` != nil && *lockedRow.` + name + ` != *ormObj.` + name
+		default:
+			changed = `lockedRow.` + name + ` != ormObj.` + name
+		}
+		g.P(`if count > 0 && (`, changed, `) {`)
+		g.P(`return nil, `, generateImport("Errorf", "fmt", g), `("%w: `, columnName(name, ofield), ` cannot change after create", `, generateImport("ImmutableError", gerrorsImport, g), `)`)
+		g.P(`}`)
+	}
+}
+
+// readOnlyColumns returns the DB columns updates leave intact, of the fields
+// marked as read_only or immutable_after_create and of the creator
+func (b *ORMBuilder) readOnlyColumns(message *protogen.Message) []string {
+	var columns []string
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		if fieldOpts.GetDrop() || !fieldOpts.GetReadOnly() && !fieldOpts.GetImmutableAfterCreate() && !fieldOpts.GetComputed() {
+			continue
+		}
+		name := camelCase(field.GoName)
+		columns = append(columns, columnName(name, b.getOrmable(message.GoIdent.GoName).Fields[name]))
+	}
+	// the creator is only stamped by the create handler
+	if byField := getMessageOptions(message).GetCreatedByField(); byField != "" {
+		ormable := b.getOrmable(message.GoIdent.GoName)
+		column := columnName(camelCase(byField), ormable.Fields[camelCase(byField)])
+		for _, c := range columns {
+			if c == column {
+				return columns
+			}
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// generateActor assigns the actor extracted from the context to the variable,
+// the JWT subject unless the actor_extractor parameter is set
+func (b *ORMBuilder) generateActor(variable, errReturn string, g *protogen.GeneratedFile) {
+	if b.actorExtractor.GoName != "" {
+		g.P(variable, `, err := `, g.QualifiedGoIdent(b.actorExtractor), `(ctx)`)
+	} else {
+		g.P(variable, `, err := `, generateImport("GetJWTField", authImport, g), `(ctx, "sub", nil)`)
+	}
+	g.P(`if err != nil {`)
+	g.P(`return `, errReturn)
+	g.P(`}`)
+}
+
+// generateStampActor stamps the by-fields of the message options with the actor
+func (b *ORMBuilder) generateStampActor(errReturn string, g *protogen.GeneratedFile, byFields ...string) {
+	var names []string
+	for _, byField := range byFields {
+		if byField != "" {
+			names = append(names, camelCase(byField))
+		}
+	}
+	if len(names) == 0 {
+		return
+	}
+	b.generateActor(`actor`, errReturn, g)
+	for _, name := range names {
+		g.P(`ormObj.`, name, ` = actor`)
+	}
+}
+
+// generateSoftDelete soft deletes the rows matched by the where arguments,
+// stamping the deleted_at and the by-field columns in one statement, the
+// outcome is left in deleted
+func (b *ORMBuilder) generateSoftDelete(message *protogen.Message, where string, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	byField := camelCase(getMessageOptions(message).GetSoftDelete().GetByField())
+	b.generateActor(`deletedBy`, `err`, g)
+	g.P(`deleted := db.Model(&`, ormable.Name, `{}).Where(`, where, `).UpdateColumns(map[string]interface{}{`)
+	g.P(`"`, columnName("DeletedAt", ormable.Fields["DeletedAt"]), `": `, generateImport("NowFunc", gormImport, g), `(),`)
+	g.P(`"`, columnName(byField, ormable.Fields[byField]), `": deletedBy,`)
+	g.P(`})`)
+}
+
+func (b *ORMBuilder) generateRestoreHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	byField := camelCase(getMessageOptions(message).GetSoftDelete().GetByField())
+	if byField == "" {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	pkName, pk := b.findPrimaryKey(ormable)
+
+	g.P(`// DefaultRestore`, typeName, ` undoes the soft delete of the `, typeName, `, clearing the deleted_at`)
+	g.P(`// and `, columnName(byField, ormable.Fields[byField]), ` columns, a missing row is gorm.ErrRecordNotFound`)
+	g.P(`func DefaultRestore`, typeName, `(ctx context.Context, in *`, typeName, `, db *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(), ` {`)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	b.generateShardResolve(message, `err`, g)
+	b.generateSessionBegin(message, `err`, g)
+	b.generateDeferConstraints(`err`, g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	if strings.Contains(pk.Type, "*") {
+		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	}
+	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
+	// the account of a multi_account type scopes the row as for a purge
+	where := pkName + `: ormObj.` + pkName
+	if getMessageOptions(message).GetMultiAccount() {
+		where += `, AccountID: ormObj.AccountID`
+	}
+	g.P(`restored := db.Unscoped().Model(&`, ormable.Name, `{}).Where(&`, ormable.Name, `{`, where, `}).UpdateColumns(map[string]interface{}{`)
+	g.P(`"`, columnName("DeletedAt", ormable.Fields["DeletedAt"]), `": nil,`)
+	g.P(`"`, columnName(byField, ormable.Fields[byField]), `": nil,`)
+	g.P(`})`)
+	g.P(`if err = restored.Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	g.P(`if restored.RowsAffected == 0 {`)
+	g.P(`return `, generateImport("ErrRecordNotFound", gormImport, g))
+	g.P(`}`)
+	b.generateSessionCommit(message, `err`, g)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generatePurgeHandler emits the DefaultPurge{Type} handler of a type with a
+// purge method, deleting the row and its has-one and has-many children for
+// good and clearing its many-to-many join rows
+func (b *ORMBuilder) generatePurgeHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if !ormable.Purged {
+		return
+	}
+	pkName, pk := b.findPrimaryKey(ormable)
+	gormDB := generateImport("DB", gormImport, g)
+
+	g.P(`// DefaultPurge`, typeName, ` permanently deletes the `, typeName, `, soft deleted or not, together`)
+	g.P(`// with the rows of its has-one and has-many children, it is not served by the default server`)
+	g.P(`func DefaultPurge`, typeName, `(ctx context.Context, in *`, typeName, `, db *`, gormDB, `) `, b.handlerResults(), ` {`)
+	b.generateMetricsObserve(message, "purge", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	b.generateShardResolve(message, `err`, g)
+	b.generateSessionBegin(message, `err`, g)
+	b.generateAuditBegin(message, `err`, g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	if strings.Contains(pk.Type, "*") {
+		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	}
+	g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`if err = db.Transaction(func(tx *`, gormDB, `) error {`)
+	where := pkName + `: ormObj.` + pkName
+	if getMessageOptions(message).GetMultiAccount() {
+		where += `, AccountID: ormObj.AccountID`
+	}
+	g.P(`row := `, ormable.Name, `{}`)
+	g.P(`if err := tx.Unscoped().Where(&`, ormable.Name, `{`, where, `}).First(&row).Error; err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	if getMessageOptions(message).GetAudit() {
+		b.generateAuditWrite(message, "purge", `row`, `row`, "", "", `err`, g)
+	}
+
+	var fieldNames []string
+	for name := range ormable.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		field := ormable.Fields[fieldName]
+		var assocKeyName, foreignKeyName string
+		switch {
+		case field.GetHasMany() != nil:
+			assocKeyName = field.GetHasMany().GetAssociationForeignkey()
+			foreignKeyName = field.GetHasMany().GetForeignkey()
+		case field.GetHasOne() != nil:
+			assocKeyName = field.GetHasOne().GetAssociationForeignkey()
+			foreignKeyName = field.GetHasOne().GetForeignkey()
+		case field.GetManyToMany() != nil:
+			g.P(`if err := tx.Model(&row).Association("`, fieldName, `").Clear().Error; err != nil {`)
+			g.P(`return err`)
+			g.P(`}`)
+			continue
+		default:
+			continue
+		}
+		childType := strings.Trim(field.Type, "[]*")
+		foreignKeyType := b.getOrmable(field.Type).Fields[foreignKeyName].Type
+		filterDesc := "filter" + fieldName + "." + foreignKeyName
+		rowDesc := "row." + assocKeyName
+		g.P(`filter`, fieldName, ` := `, childType, `{}`)
+		if strings.HasPrefix(foreignKeyType, "*") {
+			g.P(filterDesc, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
+			filterDesc = "*" + filterDesc
+		}
+		if strings.HasPrefix(ormable.Fields[assocKeyName].Type, "*") {
+			rowDesc = "*" + rowDesc
+		}
+		g.P(filterDesc, ` = `, rowDesc)
+		g.P(`if err := tx.Unscoped().Where(filter`, fieldName, `).Delete(`, childType, `{}).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+	}
+	g.P(`return tx.Unscoped().Delete(&row).Error`)
+	g.P(`}); err != nil {`)
+	g.P(`return err`)
+	g.P(`}`)
+	b.generateAuditCommit(message, `err`, g)
+	b.generateSessionCommit(message, `err`, g)
+	g.P(`return nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateRepository emits the {Type}ORMRepository interface of the CRUD
+// handlers generated for the type and {Type}ORMDefaultRepository calling
+// them, so that the code using the handlers can be tested with a mock
+func (b *ORMBuilder) generateRepository(message *protogen.Message, g *protogen.GeneratedFile) {
+	if !b.repositoryIface {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	gormDB := generateImport("DB", gormImport, g)
+	result := `(*` + typeName + `, error)`
+
+	type repositoryMethod struct {
+		name, params, args, results string
+	}
+	methods := []repositoryMethod{{`Create`, `in *` + typeName + `, db *` + gormDB, `in, db`, result}}
+	if b.hasPrimaryKey(ormable) {
+		if b.readHasFieldSelection(ormable) {
+			methods = append(methods, repositoryMethod{`Read`, `in *` + typeName + `, db *` + gormDB + `, fs *` + generateImport("FieldSelection", queryImport, g), `in, db, fs`, result})
+		} else {
+			methods = append(methods, repositoryMethod{`Read`, `in *` + typeName + `, db *` + gormDB, `in, db`, result})
+		}
+		if !ormable.Immutable {
+			methods = append(methods, repositoryMethod{`StrictUpdate`, `in *` + typeName + `, db *` + gormDB, `in, db`, result})
+			if !getMessageOptions(message).GetMultiAccount() || b.hasIDField(message) {
+				methods = append(methods, repositoryMethod{`Patch`, `in *` + typeName + `, updateMask *` + generateImport("FieldMask", fmImport, g) + `, db *` + gormDB, `in, updateMask, db`, result})
+			}
+		}
+		methods = append(methods, repositoryMethod{`Delete`, `in *` + typeName + `, db *` + gormDB, `in, db`, `error`})
+	}
+	listArgs := []string{`db`}
+	for _, arg := range strings.Split(b.listArgs(ormable), ",") {
+		if arg != "nil" {
+			listArgs = append(listArgs, arg)
+		}
+	}
+	methods = append(methods, repositoryMethod{`List`, `db *` + gormDB + b.listParams(ormable, g) + `, scopes ...func(*` + gormDB + `) *` + gormDB,
+		strings.Join(append(listArgs, `scopes...`), `, `), `([]*` + typeName + `, error)`})
+
+	g.P(`// `, ormable.Name, `Repository is the interface of the default CRUD handlers of `, typeName, `,`)
+	g.P(`// implemented by `, ormable.Name, `DefaultRepository and to be mocked in tests`)
+	g.P(`type `, ormable.Name, `Repository interface {`)
+	for _, method := range methods {
+		g.P(method.name, `(ctx context.Context, `, method.params, `) `, method.results)
+	}
+	g.P(`}`)
+	g.P()
+	g.P(`// `, ormable.Name, `DefaultRepository calls the default handlers of `, typeName)
+	g.P(`type `, ormable.Name, `DefaultRepository struct{}`)
+	g.P()
+	g.P(`var _ `, ormable.Name, `Repository = `, ormable.Name, `DefaultRepository{}`)
+	g.P()
+	for _, method := range methods {
+		g.P(`func (`, ormable.Name, `DefaultRepository) `, method.name, `(ctx context.Context, `, method.params, `) `, method.results, ` {`)
+		g.P(`return Default`, method.name, typeName, `(ctx, `, method.args, `)`)
+		g.P(`}`)
+		g.P()
+	}
+}
+
+func (b *ORMBuilder) generateAccountIdWhereClause(ormable *OrmableType, g *protogen.GeneratedFile) {
+	g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`db = db.Where(map[string]interface{}{"`, columnName("AccountID", ormable.Fields["AccountID"]), `": accountID})`)
+}
+
+func (b *ORMBuilder) handleChildAssociations(message *protogen.Message, g *protogen.GeneratedFile) {
+	ormable := b.getOrmable(string(message.Desc.Name()))
+
+	var fieldNames []string
+	for name := range ormable.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		b.handleChildAssociationsByName(message, fieldName, g)
+	}
+}
+
+func (b *ORMBuilder) handleChildAssociationsByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	field := ormable.Fields[fieldName]
+
+	if field == nil {
+		return
+	}
+
+	if field.GetHasMany() != nil || field.GetHasOne() != nil || field.GetManyToMany() != nil {
+		var assocHandler string
+		switch {
+		case field.GetHasMany() != nil:
+			switch {
+			case field.GetHasMany().GetClear():
+				assocHandler = "Clear"
+			case field.GetHasMany().GetAppend():
+				assocHandler = "Append"
+			case field.GetHasMany().GetReplace():
+				assocHandler = "Replace"
+			default:
+				assocHandler = "Remove"
+			}
+		case field.GetHasOne() != nil:
+			switch {
+			case field.GetHasOne().GetClear():
+				assocHandler = "Clear"
+			case field.GetHasOne().GetAppend():
+				assocHandler = "Append"
+			case field.GetHasOne().GetReplace():
+				assocHandler = "Replace"
+			default:
+				assocHandler = "Remove"
+			}
+		case field.GetManyToMany() != nil:
+			switch {
+			case field.GetManyToMany().GetClear():
+				assocHandler = "Clear"
+			case field.GetManyToMany().GetAppend():
+				assocHandler = "Append"
+			case field.GetManyToMany().GetReplace():
+				assocHandler = "Replace"
+			default:
+				assocHandler = "Replace"
+			}
+		}
+
+		if assocHandler == "Remove" {
+			b.removeChildAssociationsByName(message, fieldName, g)
+			return
+		}
+
+		action := fmt.Sprintf("%s(ormObj.%s)", assocHandler, fieldName)
+		if assocHandler == "Clear" {
+			action = fmt.Sprintf("%s()", assocHandler)
+		}
+
+		g.P(`if err = db.Model(&ormObj).Association("`, fieldName, `").`, action, `.Error; err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`ormObj.`, fieldName, ` = nil`)
+	}
+}
+
+func (b *ORMBuilder) removeChildAssociationsByName(message *protogen.Message, fieldName string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	field := ormable.Fields[fieldName]
+
+	if field == nil {
+		return
+	}
+
+	if field.GetHasMany() != nil || field.GetHasOne() != nil {
+		var assocKeyName, foreignKeyName string
+		switch {
+		case field.GetHasMany() != nil:
+			assocKeyName = field.GetHasMany().GetAssociationForeignkey()
+			foreignKeyName = field.GetHasMany().GetForeignkey()
+		case field.GetHasOne() != nil:
+			assocKeyName = field.GetHasOne().GetAssociationForeignkey()
+			foreignKeyName = field.GetHasOne().GetForeignkey()
+		}
+		assocKeyType := ormable.Fields[assocKeyName].Type
+		assocOrmable := b.getOrmable(field.Type)
+		foreignKeyType := assocOrmable.Fields[foreignKeyName].Type
+		g.P(`filter`, fieldName, ` := `, strings.Trim(field.Type, "[]*"), `{}`)
+		zeroValue := b.guessZeroValue(assocKeyType, g)
+		if strings.Contains(assocKeyType, "*") {
+			g.P(`if ormObj.`, assocKeyName, ` == nil || *ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+		} else {
+			g.P(`if ormObj.`, assocKeyName, ` == `, zeroValue, `{`)
+		}
+		g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+		filterDesc := "filter" + fieldName + "." + foreignKeyName
+		ormDesc := "ormObj." + assocKeyName
+		if strings.HasPrefix(foreignKeyType, "*") {
+			g.P(filterDesc, ` = new(`, strings.TrimPrefix(foreignKeyType, "*"), `)`)
+			filterDesc = "*" + filterDesc
+		}
+		if strings.HasPrefix(assocKeyType, "*") {
+			ormDesc = "*" + ormDesc
+		}
+		g.P(filterDesc, " = ", ormDesc)
+		g.P(`if err = db.Where(filter`, fieldName, `).Delete(`, strings.Trim(field.Type, "[]*"), `{}).Error; err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+	}
+}
+
+func (b *ORMBuilder) generatePatchHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	var isMultiAccount bool
+
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	if getMessageOptions(message).GetMultiAccount() {
+		isMultiAccount = true
+	}
+
+	if isMultiAccount && !b.hasIDField(message) {
+		g.P(fmt.Sprintf("// Cannot autogen DefaultPatch%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
+		return
+	}
+
+	g.P(`// DefaultPatch`, typeName, ` executes a basic gorm update call with patch behavior`)
+	g.P(`func DefaultPatch`, typeName, `(ctx context.Context, in *`,
+		typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`*`+typeName), ` {`)
+	b.generateMetricsObserve(message, "patch", g)
+	b.generateStatusErrors(g)
+
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`var pbObj `, typeName)
+	if !b.namedHandlerErr() {
+		g.P(`var err error`)
+	}
+	b.generateBeforePatchHookCall(ormable, "Read", g)
+
+	// TODO: not in original code, but it don't make a lot of sense to generate code with id if message doesn't have it
+	if b.hasIDField(message) {
+		if b.readHasFieldSelection(ormable) {
+			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, b.idKey(typeName, message), `, db, nil)`)
+		} else {
+			g.P(`pbReadRes, err := DefaultRead`, typeName, `(ctx, &`, typeName, b.idKey(typeName, message), `, db)`)
+		}
+
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`pbObj = *pbReadRes`)
+	}
+
+	b.generateBeforePatchHookCall(ormable, "ApplyFieldMask", g)
+	g.P(`if _, err := DefaultApplyFieldMask`, typeName, `(ctx, &pbObj, in, updateMask, "", db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+
+	b.generateBeforePatchHookCall(ormable, "Save", g)
+	g.P(`pbResponse, err := DefaultStrictUpdate`, typeName, `(ctx, &pbObj, db)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateAfterPatchHookCall(ormable, "Save", g)
+
+	g.P(`return pbResponse, nil`)
+	g.P(`}`)
+
+	b.generateBeforePatchHookDef(ormable, "Read", g)
+	b.generateBeforePatchHookDef(ormable, "ApplyFieldMask", g)
+	b.generateBeforePatchHookDef(ormable, "Save", g)
+	b.generateAfterPatchHookDef(ormable, "Save", g)
+
+}
+
+func (b *ORMBuilder) hasIDField(message *protogen.Message) bool {
+	for _, field := range message.Fields {
+		if strings.ToLower(field.GoName) == "id" { // TODO: not sure
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *ORMBuilder) generateBeforePatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(&pbObj).(`, orm.OriginName, `WithBeforePatch`, suffix, `); ok {`)
+	g.P(`if db, err = hook.BeforePatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterPatchHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`if hook, ok := interface{}(pbResponse).(`, orm.OriginName, `WithAfterPatch`, suffix, `); ok {`)
+	g.P(`if err = hook.AfterPatch`, suffix, `(ctx, in, updateMask, db); err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateBeforePatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`type `, orm.OriginName, `WithBeforePatch`, suffix, ` interface {`)
+	g.P(`BeforePatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
+		`) (*`, generateImport("DB", gormImport, g), `, error)`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generateAfterPatchHookDef(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+	g.P(`type `, orm.OriginName, `WithAfterPatch`, suffix, ` interface {`)
+	g.P(`AfterPatch`, suffix, `(context.Context, *`, orm.OriginName, `, *`, generateImport("FieldMask", fmImport, g), `, *`, generateImport("DB", gormImport, g),
+		`) error`)
+	g.P(`}`)
+}
+
+func (b *ORMBuilder) generatePatchSetHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	var isMultiAccount bool
+
+	typeName := string(message.Desc.Name())
+	if getMessageOptions(message).GetMultiAccount() {
+		isMultiAccount = true
+	}
+
+	if isMultiAccount && !b.hasIDField(message) {
+		g.P(fmt.Sprintf("// Cannot autogen DefaultPatchSet%s: this is a multi-account table without an \"id\" field in the message.\n", typeName))
+		return
+	}
+
+	_ = generateImport("", "fmt", g)
+	g.P(`// DefaultPatchSet`, typeName, ` executes a bulk gorm update call with patch behavior`)
+	g.P(`func DefaultPatchSet`, typeName, `(ctx context.Context, objects []*`,
+		typeName, `, updateMasks []*`, generateImport("FieldMask", fmImport, g), `, db *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`[]*`+typeName), ` {`)
+	b.generateMetricsObserve(message, "patch_set", g)
+	b.generateStatusErrors(g)
+	g.P(`if len(objects) != len(updateMasks) {`)
+	g.P(`return nil, fmt.Errorf(`, generateImport("BadRepeatedFieldMaskTpl", gerrorsImport, g), `, len(updateMasks), len(objects))`)
+	g.P(`}`)
+	g.P(``)
+	g.P(`results := make([]*`, typeName, `, 0, len(objects))`)
+	g.P(`for i, patcher := range objects {`)
+	g.P(`pbResponse, err := DefaultPatch`, typeName, `(ctx, patcher, updateMasks[i], db)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(``)
+	g.P(`results = append(results, pbResponse)`)
+	g.P(`}`)
+	g.P(``)
+	g.P(`return results, nil`)
+	g.P(`}`)
+
+}
+
+// generateUpdateIfHandler emits DefaultUpdate{Type}If{Field}, the compare and
+// swap update of the row of a key whose column of the field has the expected
+// value, in an UPDATE with the value in its WHERE clause
+func (b *ORMBuilder) generateUpdateIfHandler(message *protogen.Message, name string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	pkName, pk := b.findPrimaryKey(ormable)
+	opts := getMessageOptions(message)
+	var field *protogen.Field
+	for _, f := range message.Fields {
+		if camelCase(f.GoName) == name {
+			field = f
+		}
+	}
+	column := columnName(name, ormable.Fields[name])
+	pkColumn := columnName(pkName, pk)
+
+	gormDB := generateImport("DB", gormImport, g)
+	g.P(`// DefaultUpdate`, typeName, `If`, name, ` sets the `, column, ` column and those of the fields in updateMask to`)
+	g.P(`// their values in in for the row of its key, only if its `, column, ` is still expected, e.g. for`)
+	g.P(`// a transition of a state machine. A row of another `, column, ` fails with`)
+	g.P(`// errors.ConditionFailedError and a missing row with gorm.ErrRecordNotFound.`)
+	g.P(`func DefaultUpdate`, typeName, `If`, name, `(ctx context.Context, in *`, typeName, `, expected `, b.apiScalarType(field, g), `, updateMask *`, generateImport("FieldMask", fmImport, g),
+		`, db *`, gormDB, `) `, b.handlerResults(`*`+typeName), ` {`)
+	b.generateMetricsObserve(message, "update_if", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	b.generateValidateCall(g)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	if strings.Contains(pk.Type, "*") {
+		g.P(`if ormObj.`, pkName, ` == nil || *ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	} else {
+		g.P(`if ormObj.`, pkName, ` == `, b.guessZeroValue(pk.Type, g), ` {`)
+	}
+	g.P(`return nil, `, generateImport("EmptyIdError", gerrorsImport, g))
+	g.P(`}`)
+	// the expected value is stored as ToORM stores the field
+	g.P(`expectedObj, err := (&`, typeName, `{`, field.GoName, `: expected}).ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`columns := map[string]interface{}{"`, column, `": ormObj.`, name, `}`)
+	g.P(`for _, path := range updateMask.GetPaths() {`)
+	g.P(`switch path {`)
+	for _, masked := range b.maskedColumnFields(message) {
+		g.P(`case "`, masked, `":`)
+		g.P(`columns["`, columnName(masked, ormable.Fields[masked]), `"] = ormObj.`, masked)
+	}
+	g.P(`default:`)
+	g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("%w %q", `, generateImport("UnknownUpdateFieldError", gerrorsImport, g), `, path)`)
+	g.P(`}`)
+	g.P(`}`)
+	if byField := camelCase(opts.GetUpdatedByField()); byField != "" {
+		b.generateActor(`actor`, `nil, err`, g)
+		g.P(`columns["`, columnName(byField, ormable.Fields[byField]), `"] = actor`)
+	}
+	key := `"` + pkColumn + ` = ?", ormObj.` + pkName
+	if opts.GetMultiAccount() {
+		g.P(`acctId, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		key = `"` + columnName("AccountID", ormable.Fields["AccountID"]) + ` = ? AND ` + pkColumn + ` = ?", acctId, ormObj.` + pkName
+	}
+	b.generateSessionBegin(message, `nil, err`, g)
+	g.P(`updated := db.Model(&`, ormable.Name, `{}).Where(`, key, `).Where("`, column, ` = ?", expectedObj.`, name, `).Updates(columns)`)
+	g.P(`if err := updated.Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`if updated.RowsAffected == 0 {`)
+	g.P(`var count int`)
+	g.P(`if err := db.Model(&`, ormable.Name, `{}).Where(`, key, `).Count(&count).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`if count == 0 {`)
+	g.P(`return nil, `, generateImport("ErrRecordNotFound", gormImport, g))
+	g.P(`}`)
+	g.P(`return nil, `, generateImport("Errorf", stdFmtImport, g), `("%w: `, column, ` is not %v", `, generateImport("ConditionFailedError", gerrorsImport, g), `, expected)`)
+	g.P(`}`)
+	g.P(`stored := `, ormable.Name, `{}`)
+	g.P(`if err := db.Where(`, key, `).First(&stored).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`pbResponse, err := stored.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`return &pbResponse, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// maskedColumnFields returns the fields of the message an update of the
+// columns in a field mask sets, the fields of the API stored in columns but
+// the keys, the by-fields and those the DB computes or the handlers keep. The
+// DeletedAt of a soft delete and the shard_by field are left to the delete
+// handlers and to the shard the row is in.
+func (b *ORMBuilder) maskedColumnFields(message *protogen.Message) []string {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	pkName, _ := b.findPrimaryKey(ormable)
+	opts := getMessageOptions(message)
+	stored := make(map[string]bool)
+	for _, name := range b.columnFields(ormable) {
+		stored[name] = true
+	}
+	kept := map[string]bool{pkName: true, camelCase(opts.GetCreatedByField()): true, camelCase(opts.GetUpdatedByField()): true, "DeletedAt": true}
+	if opts.GetMultiAccount() {
+		kept["AccountID"] = true
+	}
+	var names []string
+	for _, field := range message.Fields {
+		fieldOpts := getFieldOptions(field.Desc.Options().(*descriptorpb.FieldOptions))
+		name := camelCase(field.GoName)
+		shardKey := opts.GetShardBy() != "" && string(field.Desc.Name()) == opts.GetShardBy()
+		if fieldOpts.GetDrop() || fieldOpts.GetRejectChange() || !stored[name] || kept[name] || shardKey || ormable.Fields[name].GetComputed() {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// generateUpdateByIdsHandler emits DefaultUpdate{Type}ByIds, setting the
+// fields of a field mask to the same values for a set of rows in UPDATEs of
+// idsBatchSize ids
+func (b *ORMBuilder) generateUpdateByIdsHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	if len(b.manyToManyKeys(ormable, "")) != 1 {
+		return
+	}
+	pkName, pk := b.findPrimaryKey(ormable)
+	opts := getMessageOptions(message)
+	multiAccount := opts.GetMultiAccount()
+	names := b.maskedColumnFields(message)
+	if len(names) == 0 {
+		return
+	}
+
+	keys := `key`
+	if multiAccount {
+		keys = `key, account`
+	}
+	if _, ok := ormable.Fields["DeletedAt"]; ok {
+		keys += `, DeletedAt`
+	}
+	if opts.GetShardBy() != "" {
+		keys += `, shard key`
+	}
+	gormDB := generateImport("DB", gormImport, g)
+	g.P(`// DefaultUpdate`, typeName, `ByIds sets the columns of the fields in updateMask to their values in in`)
+	g.P(`// for the rows of ids, in one transaction of UPDATEs of at most `, idsBatchSize, ` ids, and returns the`)
+	g.P(`// number of rows updated. The `, keys, ` and by-fields cannot be in updateMask.`)
+	g.P(`func DefaultUpdate`, typeName, `ByIds(ctx context.Context, ids []`, pk.Type, `, in *`, typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g),
+		`, db *`, gormDB, `) `, b.handlerResults(`int64`), ` {`)
+	b.generateMetricsObserve(message, "update_by_ids", g)
+	b.generateStatusErrors(g)
+	g.P(`if in == nil || updateMask == nil {`)
+	g.P(`return 0, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`if len(ids) == 0 || len(updateMask.Paths) == 0 {`)
+	g.P(`return 0, nil`)
+	g.P(`}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return 0, err`)
+	g.P(`}`)
+	g.P(`columns := make(map[string]interface{}, len(updateMask.Paths))`)
+	g.P(`for _, path := range updateMask.Paths {`)
+	g.P(`switch path {`)
+	for _, name := range names {
+		g.P(`case "`, name, `":`)
+		g.P(`columns["`, columnName(name, ormable.Fields[name]), `"] = ormObj.`, name)
+	}
+	g.P(`default:`)
+	g.P(`return 0, `, generateImport("Errorf", stdFmtImport, g), `("%w %q", `, generateImport("UnknownUpdateFieldError", gerrorsImport, g), `, path)`)
+	g.P(`}`)
+	g.P(`}`)
+	if byField := camelCase(opts.GetUpdatedByField()); byField != "" {
+		b.generateActor(`actor`, `0, err`, g)
+		g.P(`columns["`, columnName(byField, ormable.Fields[byField]), `"] = actor`)
+	}
+	where := `"` + columnName(pkName, pk) + ` IN (?)", batch`
+	if multiAccount {
+		g.P(`acctId, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return 0, err`)
+		g.P(`}`)
+		where = `"` + columnName("AccountID", ormable.Fields["AccountID"]) + ` = ? AND ` + columnName(pkName, pk) + ` IN (?)", acctId, batch`
+	}
+	b.generateSessionBegin(message, `0, err`, g)
+	g.P(`var updated int64`)
+	g.P(`if err := db.Transaction(func(tx *`, gormDB, `) error {`)
+	g.P(`for start := 0; start < len(ids); start += `, idsBatchSize, ` {`)
+	g.P(`batch := ids[start:]`)
+	g.P(`if len(batch) > `, idsBatchSize, ` {`)
+	g.P(`batch = batch[:`, idsBatchSize, `]`)
+	g.P(`}`)
+	g.P(`res := tx.Model(&`, ormable.Name, `{}).Where(`, where, `).Updates(columns)`)
+	g.P(`if res.Error != nil {`)
+	g.P(`return res.Error`)
+	g.P(`}`)
+	g.P(`updated += res.RowsAffected`)
+	g.P(`}`)
+	g.P(`return nil`)
+	g.P(`}); err != nil {`)
+	g.P(`return 0, err`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `0, err`, g)
+	g.P(`return updated, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+func (b *ORMBuilder) generateApplyFieldMask(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	g.P(`// DefaultApplyFieldMask`, typeName, ` patches an pbObject with patcher according to a field mask.`)
+	g.P(`func DefaultApplyFieldMask`, typeName, `(ctx context.Context, patchee *`,
+		typeName, `, patcher *`, typeName, `, updateMask *`, generateImport("FieldMask", fmImport, g),
+		`, prefix string, db *`, generateImport("DB", gormImport, g), `) (*`, typeName, `, error) {`)
+
+	g.P(`if patcher == nil {`)
+	g.P(`return nil, nil`)
+	g.P(`} else if patchee == nil {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`var err error`)
+
+	hasNested := false
+	for _, field := range message.Fields {
+		fieldType := getFieldType(field)
+
+		if field.Message != nil && !isSpecialType(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
+			g.P(`var updated`, camelCase(field.GoName), ` bool`)
+			hasNested = true
+		} else if strings.HasSuffix(fieldType, protoTypeJSON) {
+			g.P(`var updated`, camelCase(field.GoName), ` bool`)
+		}
+	}
+
+	// Patch pbObj with input according to a field mask.
+	if hasNested {
+		g.P(`for i, f := range updateMask.Paths {`)
+	} else {
+		g.P(`for _, f := range updateMask.Paths {`)
+	}
+	for _, field := range message.Fields {
+		ccName := camelCase(field.GoName)
+
+		fieldType := getFieldType(field)
+		//  for ormable message, do recursive patching
 		if field.Message != nil && b.isOrmable(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
 			if field.Message != nil {
 				// a hack work around imported types
 				fieldType = b.typeName(field.Message.GoIdent, g)
 			}
-			_ = generateImport("", stdStringsImport, g)
-			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
-			g.P(`updated`, ccName, ` = true`)
-			g.P(`if patcher.`, ccName, ` == nil {`)
-			g.P(`patchee.`, ccName, ` = nil`)
-			g.P(`continue`)
-			g.P(`}`)
-			g.P(`if patchee.`, ccName, ` == nil {`)
-			g.P(`patchee.`, ccName, ` = &`, strings.TrimPrefix(b.typeName(getFieldIdent(field), g), "*"), `{}`)
-			g.P(`}`)
-			if s := strings.Split(fieldType, "."); len(s) == 2 {
-				g.P(`if o, err := `, strings.TrimLeft(s[0], "*"), `.DefaultApplyFieldMask`, s[1], `(ctx, patchee.`, ccName,
-					`, patcher.`, ccName, `, &`, generateImport("FieldMask", fmImport, g),
-					`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
-			} else {
-				g.P(`if o, err := DefaultApplyFieldMask`, strings.TrimPrefix(fieldType, "*"), `(ctx, patchee.`, ccName,
-					`, patcher.`, ccName, `, &`, generateImport("FieldMask", fmImport, g),
-					`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
+			_ = generateImport("", stdStringsImport, g)
+			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
+			g.P(`updated`, ccName, ` = true`)
+			g.P(`if patcher.`, ccName, ` == nil {`)
+			g.P(`patchee.`, ccName, ` = nil`)
+			g.P(`continue`)
+			g.P(`}`)
+			g.P(`if patchee.`, ccName, ` == nil {`)
+			g.P(`patchee.`, ccName, ` = &`, strings.TrimPrefix(b.typeName(getFieldIdent(field), g), "*"), `{}`)
+			g.P(`}`)
+			if s := strings.Split(fieldType, "."); len(s) == 2 {
+				g.P(`if o, err := `, strings.TrimLeft(s[0], "*"), `.DefaultApplyFieldMask`, s[1], `(ctx, patchee.`, ccName,
+					`, patcher.`, ccName, `, &`, generateImport("FieldMask", fmImport, g),
+					`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
+			} else {
+				g.P(`if o, err := DefaultApplyFieldMask`, strings.TrimPrefix(fieldType, "*"), `(ctx, patchee.`, ccName,
+					`, patcher.`, ccName, `, &`, generateImport("FieldMask", fmImport, g),
+					`{Paths:updateMask.Paths[i:]}, prefix+"`, ccName, `.", db); err != nil {`)
+			}
+			g.P(`return nil, err`)
+			g.P(`} else {`)
+			g.P(`patchee.`, ccName, ` = o`)
+			g.P(`}`)
+			g.P(`continue`)
+			g.P(`}`)
+			g.P(`if f == prefix+"`, ccName, `" {`)
+			g.P(`updated`, ccName, ` = true`)
+			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
+			g.P(`continue`)
+			g.P(`}`)
+		} else if field.Message != nil && !isSpecialType(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
+			_ = generateImport("", stdStringsImport, g)
+			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
+			g.P(`if patcher.`, ccName, ` == nil {`)
+			g.P(`patchee.`, ccName, ` = nil`)
+			g.P(`continue`)
+			g.P(`}`)
+			g.P(`if patchee.`, ccName, ` == nil {`)
+			g.P(`patchee.`, ccName, ` = &`, strings.TrimPrefix(b.typeName(getFieldIdent(field), g), "*"), `{}`)
+			g.P(`}`)
+			g.P(`childMask := &`, generateImport("FieldMask", fmImport, g), `{}`)
+			g.P(`for j := i; j < len(updateMask.Paths); j++ {`)
+			g.P(`if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"`, ccName, `."); trimPath != updateMask.Paths[j] {`)
+			g.P(`childMask.Paths = append(childMask.Paths, trimPath)`)
+			g.P(`}`)
+			g.P(`}`)
+			g.P(`if err := `, generateImport("MergeWithMask", tkgormImport, g), `(patcher.`, ccName, `, patchee.`, ccName, `, childMask); err != nil {`)
+			g.P(`return nil, nil`)
+			g.P(`}`)
+			g.P(`}`)
+			g.P(`if f == prefix+"`, ccName, `" {`)
+			g.P(`updated`, ccName, ` = true`)
+			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
+			g.P(`continue`)
+			g.P(`}`)
+		} else if strings.HasSuffix(fieldType, protoTypeJSON) && field.Desc.Cardinality() != protoreflect.Repeated {
+			_ = generateImport("", stdStringsImport, g)
+			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `") {`)
+			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
+			g.P(`updated`, ccName, ` = true`)
+			g.P(`continue`)
+			g.P(`}`)
+		} else {
+			g.P(`if f == prefix+"`, ccName, `" {`)
+			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
+			g.P(`continue`)
+			g.P(`}`)
+		}
+	}
+	g.P(`}`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`return patchee, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+func isSpecialType(typeName string) bool {
+	switch typeName {
+	case protoTypeJSON, protoTypeUUID, protoTypeUUIDValue, protoTypeResource, protoTypeInet, protoTimeOnly:
+		return true
+	default:
+		return false
+	}
+}
+
+func (b *ORMBuilder) generateListHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	if b.listHasSorting(ormable) {
+		b.generateSortAllowList(ormable, g)
+	}
+
+	g.P(`// DefaultList`, typeName, ` executes a gorm list call, the scopes are applied`)
+	g.P(`// after the collection operators and the account scope`)
+	b.generateListFunc(message, "", nil, false, g)
+	b.generateBeforeListHookDef(ormable, "ApplyQuery", g)
+	b.generateBeforeListHookDef(ormable, "Find", g)
+	b.generateAfterListHookDef(ormable, g)
+
+	var suffixes []string
+	for suffix := range ormable.DistinctOn {
+		suffixes = append(suffixes, suffix)
+	}
+	sort.Strings(suffixes)
+	for _, suffix := range suffixes {
+		columns := strings.Join(ormable.DistinctOn[suffix], ", ")
+		g.P(`// DefaultList`, typeName, suffix, ` is DefaultList`, typeName, ` keeping the first row of each `, columns, `,`)
+		g.P(`// a SELECT DISTINCT ON ordered by `, columns, ` ahead of the sorting`)
+		b.generateListFunc(message, suffix, ormable.DistinctOn[suffix], false, g)
+	}
+	if ormable.ListsTrash {
+		g.P(`// DefaultListTrash`, typeName, ` is DefaultList`, typeName, ` of the soft deleted rows only,`)
+		g.P(`// it is not served by the default server`)
+		b.generateListFunc(message, "", nil, true, g)
+	}
+}
+
+// generateListFunc emits the DefaultList{Type}{suffix} handler, with a
+// DISTINCT ON the columns of distinctOn if any, or DefaultListTrash{Type}
+// listing the soft deleted rows instead of the others
+func (b *ORMBuilder) generateListFunc(message *protogen.Message, suffix string, distinctOn []string, trash bool, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	name, op := `DefaultList`, "list"
+	if trash {
+		name, op = `DefaultListTrash`, "list_trash"
+	}
+
+	listSign := fmt.Sprint(`func `, name, typeName, suffix, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), b.listParams(ormable, g),
+		`, scopes ...func(*`, generateImport("DB", gormImport, g), `) *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`[]*`+typeName), ` {`)
+	g.P(listSign)
+	b.generateMetricsObserve(message, op, g)
+	b.generateStatusErrors(g)
+	b.generateSessionBegin(message, `nil, err`, g)
+	if trash {
+		g.P(`db = db.Unscoped().Where("`, b.tableName(message), `.`, columnName("DeletedAt", ormable.Fields["DeletedAt"]), ` IS NOT NULL")`)
+	}
+	b.generateListQuery(message, `nil`, distinctOn, g)
+	g.P(`ormResponse := []`, ormable.Name, `{}`)
+	g.P(`if err := db.Find(&ormResponse).Error; err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	b.generateAfterListHookCall(ormable, g)
+	b.generateSessionCommit(message, `nil, err`, g)
+	g.P(`pbResponse := []*`, typeName, `{}`)
+	b.generateListEntries(ormable, g)
+	g.P(`temp, err := responseEntry.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, err`)
+	g.P(`}`)
+	g.P(`pbResponse = append(pbResponse, &temp)`)
+	g.P(`}`)
+	g.P(`return pbResponse, nil`)
+	g.P(`}`)
+}
+
+// generateListEntries opens the loop over the ormResponse rows, by pointer
+// for a type setting back-references, which point at the row in the slice
+func (b *ORMBuilder) generateListEntries(ormable *OrmableType, g *protogen.GeneratedFile) {
+	if len(backReferences(ormable)) > 0 {
+		g.P(`for i := range ormResponse {`)
+		g.P(`responseEntry := &ormResponse[i]`)
+	} else {
+		g.P(`for _, responseEntry := range ormResponse {`)
+	}
+}
+
+func (b *ORMBuilder) generateExplainListHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	g.P(`// DefaultExplainList`, typeName, ` returns the SELECT DefaultList`, typeName, ` would run for the`)
+	g.P(`// same arguments with the values inlined, nothing is executed. The preloads`)
+	g.P(`// are queries of their own and are not part of it.`)
+	g.P(`func DefaultExplainList`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), b.listParams(ormable, g),
+		`, scopes ...func(*`, generateImport("DB", gormImport, g), `) *`, generateImport("DB", gormImport, g), `) (string, error) {`)
+	b.generateListQuery(message, `""`, nil, g)
+	g.P(`return `, generateImport("SQL", explainImport, g), `(db, &`, ormable.Name, `{})`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateSelectHandler emits DefaultSelect{Type}, the query of DefaultList
+// selecting the allowed columns asked for and returning the rows unscanned
+func (b *ORMBuilder) generateSelectHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	table := b.tableName(message)
+
+	var columns []string
+	for _, name := range b.columnFields(ormable) {
+		columns = append(columns, b.fieldColumn(ormable, name))
+	}
+	sort.Strings(columns)
+	g.P(`// `, ormable.Name, `Selectable lists the columns accepted by DefaultSelect`, typeName)
+	g.P(`var `, ormable.Name, `Selectable = map[string]struct{}{`)
+	for _, column := range columns {
+		g.P(`"`, column, `": {},`)
+	}
+	g.P(`}`)
+	g.P()
+
+	g.P(`// DefaultSelect`, typeName, ` runs the query of DefaultList`, typeName, ` for the columns only, which`)
+	g.P(`// must be in `, ormable.Name, `Selectable, and returns the rows for the caller to scan and close.`)
+	if b.sessionScoped(message) {
+		g.P(`// The rows are read in the transaction of db, it fails with NoTransactionError outside of one.`)
+	}
+	g.P(`func DefaultSelect`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), `, columns []string`, b.listParams(ormable, g),
+		`, scopes ...func(*`, generateImport("DB", gormImport, g), `) *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`*`+generateImport("Rows", stdSQLImport, g)), ` {`)
+	b.generateMetricsObserve(message, "select", g)
+	b.generateStatusErrors(g)
+	g.P(`if len(columns) == 0 {`)
+	g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+	g.P(`}`)
+	g.P(`selected := make([]string, 0, len(columns))`)
+	g.P(`for _, column := range columns {`)
+	g.P(`if _, ok := `, ormable.Name, `Selectable[column]; !ok {`)
+	g.P(`return nil, `, generateImport("Errorf", "fmt", g), `("%w %q", `, generateImport("UnknownSelectColumnError", gerrorsImport, g), `, column)`)
+	g.P(`}`)
+	g.P(`selected = append(selected, "`, table, `."+column)`)
+	g.P(`}`)
+	if b.sessionScoped(message) {
+		// the rows outlive the handler, the session variable is set in the
+		// transaction of the caller which is still open when they are read
+		g.P(`if _, ok := db.CommonDB().(*`, generateImport("Tx", stdSQLImport, g), `); !ok {`)
+		g.P(`return nil, `, generateImport("NoTransactionError", gerrorsImport, g))
+		g.P(`}`)
+		b.generateSessionBegin(message, `nil, err`, g)
+	}
+	b.generateListQuery(message, `nil`, nil, g)
+	g.P(`return db.Model(&`, ormable.Name, `{}).Select(selected).Rows()`)
+	g.P(`}`)
+	g.P()
+}
+
+// listParams returns the collection operator parameters of DefaultList
+func (b *ORMBuilder) listParams(ormable *OrmableType, g *protogen.GeneratedFile) string {
+	var params string
+	if b.listHasFiltering(ormable) {
+		params += fmt.Sprint(`, f `, `*`, generateImport("Filtering", queryImport, g))
+	}
+	if b.listHasSorting(ormable) {
+		params += fmt.Sprint(`, s `, `*`, generateImport("Sorting", queryImport, g))
+	}
+	if b.listHasPagination(ormable) {
+		params += fmt.Sprint(`, p `, `*`, generateImport("Pagination", queryImport, g))
+	}
+	if b.listHasFieldSelection(ormable) {
+		params += fmt.Sprint(`, fs `, `*`, generateImport("FieldSelection", queryImport, g))
+	}
+	return params
+}
+
+// listArgs returns the collection operators passed to
+// ApplyCollectionOperators, nil for the ones DefaultList does not take
+func (b *ORMBuilder) listArgs(ormable *OrmableType) string {
+	args := []string{"nil", "nil", "nil", "nil"}
+	if b.listHasFiltering(ormable) {
+		args[0] = "f"
+	}
+	if b.listHasSorting(ormable) {
+		args[1] = "s"
+	}
+	if b.listHasPagination(ormable) {
+		args[2] = "p"
+	}
+	if b.listHasFieldSelection(ormable) {
+		args[3] = "fs"
+	}
+	return strings.Join(args, ",")
+}
+
+// generateListQuery builds the query of DefaultList on db, from the
+// collection operators to the ordering by primary key. The distinctOn columns
+// are selected DISTINCT ON and lead the ORDER BY, as postgres requires.
+func (b *ORMBuilder) generateListQuery(message *protogen.Message, zero string, distinctOn []string, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	errReturn := zero + `, err`
+	g.P(`in := `, typeName, `{}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return `, errReturn)
+	g.P(`}`)
+	if b.listHasSorting(ormable) {
+		// unknown sort tags would reach the ORDER BY clause verbatim
+		g.P(`for _, cr := range s.GetCriterias() {`)
+		g.P(`if _, ok := `, ormable.Name, `Sortable[cr.GetTag()]; !ok {`)
+		g.P(`return `, zero, `, `, generateImport("Errorf", stdFmtImport, g), `("%w %q", `, generateImport("UnknownSortColumnError", gerrorsImport, g), `, cr.GetTag())`)
+		g.P(`}`)
+		g.P(`}`)
+	}
+	b.generateBeforeListHookCall(ormable, "ApplyQuery", errReturn, g)
+	if len(distinctOn) > 0 {
+		table := b.tableName(message)
+		var columns []string
+		for _, column := range distinctOn {
+			columns = append(columns, table+"."+column)
+		}
+		g.P(`db = db.Select("DISTINCT ON (`, strings.Join(columns, ", "), `) `, table, `.*").Order("`, strings.Join(columns, ", "), `")`)
+	}
+	g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, `, b.listArgs(ormable), `)`)
+	g.P(`if err != nil {`)
+	g.P(`return `, errReturn)
+	g.P(`}`)
+	b.generateConditionalPreloadsCall(message, errReturn, g)
+	b.generateBeforeListHookCall(ormable, "Find", errReturn, g)
+	g.P(`db = db.Where(&ormObj).Scopes(scopes...)`)
+
+	// add default ordering by primary key
+	if b.hasPrimaryKey(ormable) {
+		pkName, pk := b.findPrimaryKey(ormable)
+		column := columnName(pkName, pk)
+		g.P(`db = db.Order("`, column, `")`)
+	}
+}
+
+// cursorSortable reports whether the keyset pagination can order the rows by
+// a column of the ORM type and encode its value in the cursor
+func cursorSortable(fieldType string) bool {
+	t := strings.TrimPrefix(fieldType, "*")
+	return t == "string" || strings.HasPrefix(t, "int") || strings.HasPrefix(t, "uint") ||
+		strings.HasSuffix(t, ".UUID") || strings.HasSuffix(t, ".Time")
+}
+
+// cursorSortField returns the field the cursor list of the type is ordered by,
+// the sort_field option or else the leading column of the first btree index
+// that can be sorted by, and the primary key if there is none
+func (b *ORMBuilder) cursorSortField(message *protogen.Message, pkName string) string {
+	ormable := b.getOrmable(message.GoIdent.GoName)
+	columnField := func(column string) string {
+		for name, field := range ormable.Fields {
+			if !isAssociation(field) && !field.GetTag().GetIgnore() && columnName(name, field) == column {
+				return name
+			}
+		}
+		return ""
+	}
+	var leading []string
+	for _, index := range b.indexDefinitions(message) {
+		if index.indexType == "" || index.indexType == "btree" {
+			leading = append(leading, index.columns[0])
+		}
+	}
+
+	if sortField := getMessageOptions(message).GetCursorList().GetSortField(); sortField != "" {
+		name := camelCase(sortField)
+		if field, ok := ormable.Fields[name]; !ok || isAssociation(field) || field.GetTag().GetIgnore() {
+			if name = columnField(sortField); name == "" {
+				panic(fmt.Sprintf("cursor_list sort_field %q of %s is not a column of it", sortField, ormable.Name))
 			}
-			g.P(`return nil, err`)
-			g.P(`} else {`)
-			g.P(`patchee.`, ccName, ` = o`)
-			g.P(`}`)
-			g.P(`continue`)
-			g.P(`}`)
-			g.P(`if f == prefix+"`, ccName, `" {`)
-			g.P(`updated`, ccName, ` = true`)
-			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
-			g.P(`continue`)
-			g.P(`}`)
-		} else if field.Message != nil && !isSpecialType(fieldType) && field.Desc.Cardinality() != protoreflect.Repeated {
-			_ = generateImport("", stdStringsImport, g)
-			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `.") {`)
-			g.P(`if patcher.`, ccName, ` == nil {`)
-			g.P(`patchee.`, ccName, ` = nil`)
-			g.P(`continue`)
-			g.P(`}`)
-			g.P(`if patchee.`, ccName, ` == nil {`)
-			g.P(`patchee.`, ccName, ` = &`, strings.TrimPrefix(b.typeName(getFieldIdent(field), g), "*"), `{}`)
-			g.P(`}`)
-			g.P(`childMask := &`, generateImport("FieldMask", fmImport, g), `{}`)
-			g.P(`for j := i; j < len(updateMask.Paths); j++ {`)
-			g.P(`if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"`, ccName, `."); trimPath != updateMask.Paths[j] {`)
-			g.P(`childMask.Paths = append(childMask.Paths, trimPath)`)
+		}
+		field := ormable.Fields[name]
+		if !cursorSortable(field.Type) {
+			panic(fmt.Sprintf("cursor_list sort_field %q of %s has type %s, only integers, strings, uuids and timestamps can be sorted by", sortField, ormable.Name, field.Type))
+		}
+		column := columnName(name, field)
+		indexed := name == pkName
+		for _, c := range leading {
+			indexed = indexed || c == column
+		}
+		if !indexed {
+			fmt.Fprintf(os.Stderr, "cursor_list sort_field %q of %s is not the leading column of an index, every page has to sort the table.\n", sortField, ormable.Name)
+		}
+		return name
+	}
+	for _, column := range leading {
+		if name := columnField(column); name != "" && cursorSortable(ormable.Fields[name].Type) {
+			return name
+		}
+	}
+	fmt.Fprintf(os.Stderr, "no index of %s has a leading column the cursor list can be sorted by, it is sorted by the primary key %s.\n", ormable.Name, pkName)
+	return pkName
+}
+
+// generateCursorListHandler emits DefaultList{Type}ByCursor for the types with
+// the cursor_list option, a keyset pagination after the sort value and the
+// primary key of the last row of the previous page
+func (b *ORMBuilder) generateCursorListHandler(message *protogen.Message, g *protogen.GeneratedFile) {
+	if getMessageOptions(message).GetCursorList() == nil {
+		return
+	}
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+	keys := b.manyToManyKeys(ormable, "")
+	if len(keys) != 1 {
+		panic(fmt.Sprintf("cursor_list of %s needs a single column primary key", ormable.Name))
+	}
+	pkName := keys[0]
+	pk := ormable.Fields[pkName]
+	if !cursorSortable(pk.Type) {
+		panic(fmt.Sprintf("cursor_list of %s needs a primary key of an integer, string or uuid type, not %s", ormable.Name, pk.Type))
+	}
+	sortName := b.cursorSortField(message, pkName)
+	sortField := ormable.Fields[sortName]
+	pkColumn, sortColumn := columnName(pkName, pk), columnName(sortName, sortField)
+	byKey := sortName == pkName
+	nullable := strings.HasPrefix(sortField.Type, "*")
+	deref := func(name string, field *Field) string {
+		if strings.HasPrefix(field.Type, "*") {
+			return `*last.` + name
+		}
+		return `last.` + name
+	}
+	invalid := func() {
+		g.P(`return nil, "", `, generateImport("Errorf", stdFmtImport, g), `("%w: %v", `, generateImport("InvalidCursorError", gerrorsImport, g), `, err)`)
+	}
+
+	if byKey {
+		g.P(`// DefaultList`, typeName, `ByCursor lists up to limit `, typeName, ` rows matching the filter after the cursor, ordered`)
+		g.P(`// by `, pkColumn, `. next is the cursor of the following page, empty after the last one, and a`)
+	} else {
+		g.P(`// DefaultList`, typeName, `ByCursor lists up to limit `, typeName, ` rows matching the filter after the cursor, ordered`)
+		g.P(`// by `, sortColumn, ` and `, pkColumn, `. next is the cursor of the following page, empty after the last one, and a`)
+	}
+	g.P(`// non-positive limit lists all the rows after the cursor`)
+	if nullable {
+		g.P(`// The rows without a `, sortColumn, ` are not listed.`)
+	}
+	g.P(`func DefaultList`, typeName, `ByCursor(ctx context.Context, db *`, generateImport("DB", gormImport, g), `, f *`, generateImport("Filtering", queryImport, g), `, cursor string, limit int) `, b.handlerResults(`[]*`+typeName, `string`), ` {`)
+	b.generateMetricsObserve(message, "list_by_cursor", g)
+	b.generateStatusErrors(g)
+	b.generateSessionBegin(message, `nil, "", err`, g)
+	g.P(`in := `, typeName, `{}`)
+	g.P(`ormObj, err := in.ToORM(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, "", err`)
+	g.P(`}`)
+	g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, f, nil, nil, nil)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, "", err`)
+	g.P(`}`)
+	g.P(`db = db.Where(&ormObj)`)
+	if nullable {
+		g.P(`db = db.Where("`, sortColumn, ` IS NOT NULL")`)
+	}
+	g.P(`if cursor != "" {`)
+	if byKey {
+		g.P(`var key `, strings.TrimPrefix(pk.Type, "*"))
+		g.P(`if err := `, generateImport("DecodeCursor", gtypesImport, g), `(cursor, &key); err != nil {`)
+		invalid()
+		g.P(`}`)
+		g.P(`db = db.Where("`, pkColumn, ` > ?", key)`)
+	} else {
+		g.P(`var value `, strings.TrimPrefix(sortField.Type, "*"))
+		g.P(`var key `, strings.TrimPrefix(pk.Type, "*"))
+		g.P(`if err := `, generateImport("DecodeCursor", gtypesImport, g), `(cursor, &value, &key); err != nil {`)
+		invalid()
+		g.P(`}`)
+		g.P(`db = db.Where("(`, sortColumn, `, `, pkColumn, `) > (?, ?)", value, key)`)
+	}
+	g.P(`}`)
+	if byKey {
+		g.P(`db = db.Order("`, pkColumn, `")`)
+	} else {
+		g.P(`db = db.Order("`, sortColumn, `").Order("`, pkColumn, `")`)
+	}
+	// one more row tells whether there is a next page
+	g.P(`if limit > 0 {`)
+	g.P(`db = db.Limit(limit + 1)`)
+	g.P(`}`)
+	g.P(`ormResponse := []`, ormable.Name, `{}`)
+	g.P(`if err := db.Find(&ormResponse).Error; err != nil {`)
+	g.P(`return nil, "", err`)
+	g.P(`}`)
+	g.P(`var next string`)
+	g.P(`if limit > 0 && len(ormResponse) > limit {`)
+	g.P(`ormResponse = ormResponse[:limit]`)
+	g.P(`last := ormResponse[limit-1]`)
+	if byKey {
+		g.P(`if next, err = `, generateImport("EncodeCursor", gtypesImport, g), `(`, deref(pkName, pk), `); err != nil {`)
+	} else {
+		g.P(`if next, err = `, generateImport("EncodeCursor", gtypesImport, g), `(`, deref(sortName, sortField), `, `, deref(pkName, pk), `); err != nil {`)
+	}
+	g.P(`return nil, "", err`)
+	g.P(`}`)
+	g.P(`}`)
+	b.generateSessionCommit(message, `nil, "", err`, g)
+	g.P(`pbResponse := []*`, typeName, `{}`)
+	b.generateListEntries(ormable, g)
+	g.P(`temp, err := responseEntry.ToPB(ctx)`)
+	g.P(`if err != nil {`)
+	g.P(`return nil, "", err`)
+	g.P(`}`)
+	g.P(`pbResponse = append(pbResponse, &temp)`)
+	g.P(`}`)
+	g.P(`return pbResponse, next, nil`)
+	g.P(`}`)
+	g.P()
+}
+
+// generateAggregateHandlers emits a Default{Method} handler for each aggregate
+// method of the type, along with the struct of the rows it returns
+func (b *ORMBuilder) generateAggregateHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	for _, method := range ormable.Aggregates {
+		aggregate := getMethodOptions(method.Method).GetAggregate()
+		rowName := method.ccName + "Row"
+		var selects, groups, fieldNames []string
+		rowFields := map[string]bool{}
+		rowField := func(name, fieldType, column string) {
+			if rowFields[name] {
+				panic(fmt.Sprintf("aggregate %s has two %s fields", method.ccName, name))
+			}
+			rowFields[name] = true
+			g.P(name, ` `, fieldType, " `gorm:\"column:", column, "\"`")
+		}
+
+		g.P(`// `, rowName, ` is a group of the `, typeName, ` rows returned by Default`, method.ccName)
+		g.P(`type `, rowName, ` struct {`)
+		for _, name := range aggregate.GetGroupBy() {
+			fieldName := camelCase(name)
+			column := columnName(fieldName, ormable.Fields[fieldName])
+			rowField(fieldName, ormable.Fields[fieldName].Type, column)
+			selects = append(selects, column)
+			groups = append(groups, column)
+			fieldNames = append(fieldNames, name)
+		}
+		if aggregate.GetCount() {
+			rowField("Count", "int64", "count")
+			selects = append(selects, "COUNT(*) AS count")
+		}
+		for _, name := range aggregate.GetSum() {
+			fieldName := camelCase(name)
+			column := columnName(fieldName, ormable.Fields[fieldName])
+			// the sum of an integer column may exceed its type, and is a
+			// numeric of postgres for the bigint ones
+			rowField("Sum"+fieldName, "float64", "sum_"+column)
+			selects = append(selects, fmt.Sprintf("COALESCE(SUM(%s), 0) AS sum_%s", column, column))
+		}
+		g.P(`}`)
+		g.P()
+
+		if len(fieldNames) > 0 {
+			g.P(`// Default`, method.ccName, ` groups the `, typeName, ` rows matching the filter by `, strings.Join(fieldNames, ", "), `,`)
+			g.P(`// the groups are ordered by their keys`)
+		} else {
+			g.P(`// Default`, method.ccName, ` aggregates the `, typeName, ` rows matching the filter into a single group`)
+		}
+		g.P(`// the scopes are applied after the filter and the account scope`)
+		g.P(`func Default`, method.ccName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), `, f *`, generateImport("Filtering", queryImport, g), `, scopes ...func(*`, generateImport("DB", gormImport, g), `) *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`[]*`+rowName), ` {`)
+		b.generateMetricsObserve(message, "aggregate", g)
+		b.generateStatusErrors(g)
+		b.generateSessionBegin(message, `nil, err`, g)
+		g.P(`in := `, typeName, `{}`)
+		g.P(`ormObj, err := in.ToORM(ctx)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, f, nil, nil, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`db = db.Model(&`, ormable.Name, `{}).Where(&ormObj).Scopes(scopes...).Select("`, strings.Join(selects, ", "), `")`)
+		if len(groups) > 0 {
+			g.P(`db = db.Group("`, strings.Join(groups, ", "), `").Order("`, strings.Join(groups, ", "), `")`)
+		}
+		g.P(`rows := []*`, rowName, `{}`)
+		g.P(`if err := db.Scan(&rows).Error; err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		b.generateSessionCommit(message, `nil, err`, g)
+		g.P(`return rows, nil`)
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// generateFacetHandlers emits a Default{Method} handler for each facet method
+// of the type, along with the struct of the counts it returns
+func (b *ORMBuilder) generateFacetHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	for _, method := range ormable.Facets {
+		facets := getMethodOptions(method.Method).GetFacet()
+		countsName := method.ccName + "Counts"
+
+		g.P(`// `, countsName, ` are the counts of the `, typeName, ` rows returned by Default`, method.ccName, ` by`)
+		g.P(`// each value of each facet`)
+		g.P(`type `, countsName, ` struct {`)
+		for _, name := range facets {
+			fieldName := camelCase(name)
+			g.P(fieldName, ` map[`, ormable.Fields[fieldName].Type, `]int64`)
+		}
+		g.P(`}`)
+		g.P()
+
+		g.P(`// Default`, method.ccName, ` counts the `, typeName, ` rows matching the filter by each value of `, strings.Join(facets, ", "), `,`)
+		g.P(`// in a grouped query per facet, a value of no rows is missing from the counts`)
+		g.P(`// the scopes are applied after the filter and the account scope`)
+		g.P(`func Default`, method.ccName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), `, f *`, generateImport("Filtering", queryImport, g), `, scopes ...func(*`, generateImport("DB", gormImport, g), `) *`, generateImport("DB", gormImport, g), `) `, b.handlerResults(`*`+countsName), ` {`)
+		b.generateMetricsObserve(message, "facets", g)
+		b.generateStatusErrors(g)
+		b.generateSessionBegin(message, `nil, err`, g)
+		g.P(`in := `, typeName, `{}`)
+		g.P(`ormObj, err := in.ToORM(ctx)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, f, nil, nil, nil)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, err`)
+		g.P(`}`)
+		g.P(`db = db.Model(&`, ormable.Name, `{}).Where(&ormObj).Scopes(scopes...)`)
+		g.P(`counts := &`, countsName, `{}`)
+		for _, name := range facets {
+			fieldName := camelCase(name)
+			field := ormable.Fields[fieldName]
+			column := columnName(fieldName, field)
+			rows := lowerFirst(fieldName) + "Rows"
+			g.P(`var `, rows, ` []struct {`)
+			g.P(`Value `, field.Type)
+			g.P(`Count int64`)
 			g.P(`}`)
+			g.P(`if err := db.Select("`, column, ` AS value, COUNT(*) AS count").Group("`, column, `").Scan(&`, rows, `).Error; err != nil {`)
+			g.P(`return nil, err`)
 			g.P(`}`)
-			g.P(`if err := `, generateImport("MergeWithMask", tkgormImport, g), `(patcher.`, ccName, `, patchee.`, ccName, `, childMask); err != nil {`)
-			g.P(`return nil, nil`)
+			g.P(`counts.`, fieldName, ` = make(map[`, field.Type, `]int64, len(`, rows, `))`)
+			g.P(`for _, row := range `, rows, ` {`)
+			g.P(`counts.`, fieldName, `[row.Value] = row.Count`)
 			g.P(`}`)
+		}
+		b.generateSessionCommit(message, `nil, err`, g)
+		g.P(`return counts, nil`)
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// generateReparentHandlers emits a DefaultReparent{Type}{Field} handler for each
+// has-many association of the type whose child type has a primary key and is
+// not immutable
+func (b *ORMBuilder) generateReparentHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	var fieldNames []string
+	for name, field := range ormable.Fields {
+		if field.GetHasMany() != nil && b.hasPrimaryKey(b.getOrmable(field.Type)) && !b.getOrmable(field.Type).Immutable {
+			fieldNames = append(fieldNames, name)
+		}
+	}
+	sort.Strings(fieldNames)
+	keyType := func(field *Field) string {
+		return b.keyType(field, g)
+	}
+
+	for _, fieldName := range fieldNames {
+		field := ormable.Fields[fieldName]
+		hasMany := field.GetHasMany()
+		child := b.getOrmable(field.Type)
+		childType := strings.TrimPrefix(field.Type, "[]*")
+		childKeyName, childKey := b.findPrimaryKey(child)
+		parentKeyName := hasMany.GetAssociationForeignkey()
+		parentKey := ormable.Fields[parentKeyName]
+		foreignKeyColumn := columnName(hasMany.GetForeignkey(), child.Fields[hasMany.GetForeignkey()])
+		parentWhere := columnName(parentKeyName, parentKey) + ` = ?`
+		childWhere := columnName(childKeyName, childKey) + ` = ?`
+		childRowWhere := childWhere
+		parentAccount := getMessageOptions(message).GetMultiAccount()
+		childAccount := b.isMultiAccount(child)
+		if parentAccount {
+			parentWhere += ` AND ` + columnName("AccountID", ormable.Fields["AccountID"]) + ` = ?`
+		}
+		if childAccount {
+			childWhere += ` AND ` + columnName("AccountID", child.Fields["AccountID"]) + ` = ?`
+		}
+
+		g.P(`// DefaultReparent`, typeName, fieldName, ` moves the `, child.OriginName, ` of childID to the `, fieldName, ` of the`)
+		if position := hasMany.GetPositionField(); position != "" {
+			g.P(`// `, typeName, ` of newParentID, which has to exist. The `, child.OriginName, ` is placed last among`)
+			g.P(`// its new siblings and the position it leaves is closed.`)
+		} else {
+			g.P(`// `, typeName, ` of newParentID, which has to exist`)
+		}
+		g.P(`func DefaultReparent`, typeName, fieldName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), `, childID `, keyType(childKey), `, newParentID `, keyType(parentKey), `) `, b.handlerResults(), ` {`)
+		b.generateMetricsObserve(message, "reparent", g)
+		b.generateStatusErrors(g)
+		g.P(`if childID == `, b.guessZeroValue(childKey.Type, g), ` || newParentID == `, b.guessZeroValue(parentKey.Type, g), ` {`)
+		g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+		parentArgs, childArgs := `newParentID`, `childID`
+		if parentAccount || childAccount {
+			g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+			g.P(`if err != nil {`)
+			g.P(`return err`)
 			g.P(`}`)
-			g.P(`if f == prefix+"`, ccName, `" {`)
-			g.P(`updated`, ccName, ` = true`)
-			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
-			g.P(`continue`)
+			if parentAccount {
+				parentArgs += `, accountID`
+			}
+			if childAccount {
+				childArgs += `, accountID`
+			}
+		}
+		b.generateSessionBegin(message, `err`, g)
+		g.P(`if err := db.Transaction(func(tx *`, generateImport("DB", gormImport, g), `) error {`)
+		g.P(`var parents int`)
+		g.P(`if err := tx.Model(&`, ormable.Name, `{}).Where("`, parentWhere, `", `, parentArgs, `).Count(&parents).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`if parents == 0 {`)
+		g.P(`return `, generateImport("ErrRecordNotFound", gormImport, g))
+		g.P(`}`)
+		g.P(`child := `, childType, `{}`)
+		g.P(`if err := tx.Where("`, childWhere, `", `, childArgs, `).First(&child).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		position := hasMany.GetPositionField()
+		if position == "" {
+			g.P(`return tx.Model(&`, childType, `{}).Where("`, childRowWhere, `", childID).UpdateColumn("`, foreignKeyColumn, `", newParentID).Error`)
+		} else {
+			positionColumn := columnName(position, child.Fields[position])
+			fk := `child.` + hasMany.GetForeignkey()
+			if strings.HasPrefix(child.Fields[hasMany.GetForeignkey()].Type, "*") {
+				g.P(`if `, fk, ` != nil && *`, fk, ` == newParentID {`)
+			} else {
+				g.P(`if `, fk, ` == newParentID {`)
+			}
+			g.P(`return nil`)
 			g.P(`}`)
-		} else if strings.HasSuffix(fieldType, protoTypeJSON) && field.Desc.Cardinality() != protoreflect.Repeated {
-			_ = generateImport("", stdStringsImport, g)
-			g.P(`if !updated`, ccName, ` && strings.HasPrefix(f, prefix+"`, ccName, `") {`)
-			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
-			g.P(`updated`, ccName, ` = true`)
-			g.P(`continue`)
+			g.P(`var siblings int`)
+			g.P(`if err := tx.Model(&`, childType, `{}).Where("`, foreignKeyColumn, ` = ?", newParentID).Count(&siblings).Error; err != nil {`)
+			g.P(`return err`)
 			g.P(`}`)
-		} else {
-			g.P(`if f == prefix+"`, ccName, `" {`)
-			g.P(`patchee.`, ccName, ` = patcher.`, ccName)
-			g.P(`continue`)
+			g.P(`if err := tx.Model(&`, childType, `{}).Where("`, childRowWhere, `", childID).UpdateColumns(map[string]interface{}{"`, foreignKeyColumn, `": newParentID, "`, positionColumn, `": siblings}).Error; err != nil {`)
+			g.P(`return err`)
 			g.P(`}`)
+			g.P(`return tx.Model(&`, childType, `{}).Where("`, foreignKeyColumn, ` = ? AND `, positionColumn, ` > ?", `, fk, `, child.`, position, `).`)
+			g.P(`UpdateColumn("`, positionColumn, `", `, generateImport("Expr", gormImport, g), `("`, positionColumn, ` - 1")).Error`)
 		}
+		g.P(`}); err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		b.generateSessionCommit(message, `err`, g)
+		g.P(`return nil`)
+		g.P(`}`)
+		g.P()
+	}
+}
+
+// keyType returns the type a handler takes a key of, uuid keys are taken by
+// value whatever import alias the ORM field has
+func (b *ORMBuilder) keyType(field *Field, g *protogen.GeneratedFile) string {
+	if strings.Contains(strings.ToLower(field.Type), "uuid") {
+		return generateImport("UUID", uuidImport, g)
+	}
+	return strings.TrimPrefix(field.Type, "*")
+}
+
+// generateReorderHandlers emits a DefaultReorder{Type}{Field} handler for each
+// has-many association with a position_field whose child type has a
+// comparable primary key and is not immutable
+func (b *ORMBuilder) generateReorderHandlers(message *protogen.Message, g *protogen.GeneratedFile) {
+	typeName := string(message.Desc.Name())
+	ormable := b.getOrmable(typeName)
+
+	var fieldNames []string
+	for name, field := range ormable.Fields {
+		if field.GetHasMany().GetPositionField() == "" || !b.hasPrimaryKey(b.getOrmable(field.Type)) || b.getOrmable(field.Type).Immutable {
+			continue
+		}
+		if _, childKey := b.findPrimaryKey(b.getOrmable(field.Type)); !strings.Contains(childKey.Type, "[]") {
+			fieldNames = append(fieldNames, name)
+		}
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		field := ormable.Fields[fieldName]
+		hasMany := field.GetHasMany()
+		child := b.getOrmable(field.Type)
+		childType := strings.TrimPrefix(field.Type, "[]*")
+		childKeyName, childKey := b.findPrimaryKey(child)
+		childKeyType := b.keyType(childKey, g)
+		parentKeyName := hasMany.GetAssociationForeignkey()
+		parentKey := ormable.Fields[parentKeyName]
+		positionColumn := columnName(hasMany.GetPositionField(), child.Fields[hasMany.GetPositionField()])
+		childKeyColumn := columnName(childKeyName, childKey)
+		parentWhere := columnName(parentKeyName, parentKey) + ` = ?`
+		childrenWhere := columnName(hasMany.GetForeignkey(), child.Fields[hasMany.GetForeignkey()]) + ` = ?`
+		parentAccount := getMessageOptions(message).GetMultiAccount()
+		childAccount := b.isMultiAccount(child)
+		if parentAccount {
+			parentWhere += ` AND ` + columnName("AccountID", ormable.Fields["AccountID"]) + ` = ?`
+		}
+		if childAccount {
+			childrenWhere += ` AND ` + columnName("AccountID", child.Fields["AccountID"]) + ` = ?`
+		}
+
+		g.P(`// DefaultReorder`, typeName, fieldName, ` sets the `, hasMany.GetPositionField(), ` of the `, fieldName, ` of the `, typeName, ` of`)
+		g.P(`// parentID to their index in childIDs, in one transaction. childIDs has to`)
+		g.P(`// list each of them once, otherwise it fails with errors.InvalidOrderError.`)
+		g.P(`func DefaultReorder`, typeName, fieldName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g), `, parentID `, b.keyType(parentKey, g), `, childIDs []`, childKeyType, `) `, b.handlerResults(), ` {`)
+		b.generateMetricsObserve(message, "reorder", g)
+		b.generateStatusErrors(g)
+		g.P(`if parentID == `, b.guessZeroValue(parentKey.Type, g), ` {`)
+		g.P(`return `, generateImport("EmptyIdError", gerrorsImport, g))
+		g.P(`}`)
+		g.P(`listed := make(map[`, childKeyType, `]bool, len(childIDs))`)
+		g.P(`for _, id := range childIDs {`)
+		g.P(`if listed[id] {`)
+		g.P(`return `, generateImport("Errorf", "fmt", g), `("%w: %v is listed twice", `, generateImport("InvalidOrderError", gerrorsImport, g), `, id)`)
+		g.P(`}`)
+		g.P(`listed[id] = true`)
+		g.P(`}`)
+		parentArgs, childArgs := `parentID`, `parentID`
+		if parentAccount || childAccount {
+			g.P(`accountID, err := `, generateImport("GetAccountID", authImport, g), `(ctx, nil)`)
+			g.P(`if err != nil {`)
+			g.P(`return err`)
+			g.P(`}`)
+			if parentAccount {
+				parentArgs += `, accountID`
+			}
+			if childAccount {
+				childArgs += `, accountID`
+			}
+		}
+		b.generateSessionBegin(message, `err`, g)
+		g.P(`if err := db.Transaction(func(tx *`, generateImport("DB", gormImport, g), `) error {`)
+		g.P(`var parents int`)
+		g.P(`if err := tx.Model(&`, ormable.Name, `{}).Where("`, parentWhere, `", `, parentArgs, `).Count(&parents).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`if parents == 0 {`)
+		g.P(`return `, generateImport("ErrRecordNotFound", gormImport, g))
+		g.P(`}`)
+		g.P(`var current []`, childKeyType)
+		g.P(`if err := tx.Model(&`, childType, `{}).Where("`, childrenWhere, `", `, childArgs, `).Pluck("`, childKeyColumn, `", &current).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`for _, id := range current {`)
+		g.P(`if !listed[id] {`)
+		g.P(`return fmt.Errorf("%w: %v is missing", `, generateImport("InvalidOrderError", gerrorsImport, g), `, id)`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`if len(current) != len(childIDs) {`)
+		g.P(`return fmt.Errorf("%w: %d ids for %d `, fieldName, `", `, generateImport("InvalidOrderError", gerrorsImport, g), `, len(childIDs), len(current))`)
+		g.P(`}`)
+		g.P(`for i, id := range childIDs {`)
+		g.P(`if err := tx.Model(&`, childType, `{}).Where("`, childKeyColumn, ` = ?", id).UpdateColumn("`, positionColumn, `", i).Error; err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		g.P(`}`)
+		g.P(`return nil`)
+		g.P(`}); err != nil {`)
+		g.P(`return err`)
+		g.P(`}`)
+		b.generateSessionCommit(message, `err`, g)
+		g.P(`return nil`)
+		g.P(`}`)
+		g.P()
 	}
-	g.P(`}`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`return patchee, nil`)
-	g.P(`}`)
-	g.P()
 }
 
-func isSpecialType(typeName string) bool {
-	switch typeName {
-	case protoTypeJSON, protoTypeUUID, protoTypeUUIDValue, protoTypeResource, protoTypeInet, protoTimeOnly:
-		return true
-	default:
-		return false
+// isMultiAccount tells whether the message of the ormable type has the
+// multi_account option
+func (b *ORMBuilder) isMultiAccount(ormable *OrmableType) bool {
+	if message := b.ormableMessage(ormable); message != nil {
+		return getMessageOptions(message).GetMultiAccount()
 	}
+	return false
 }
 
-func (b *ORMBuilder) generateListHandler(message *protogen.Message, g *protogen.GeneratedFile) {
-	typeName := string(message.Desc.Name())
-	ormable := b.getOrmable(typeName)
-
-	g.P(`// DefaultList`, typeName, ` executes a gorm list call`)
-	listSign := fmt.Sprint(`func DefaultList`, typeName, `(ctx context.Context, db *`, generateImport("DB", gormImport, g))
-	var f, s, pg, fs string
-	if b.listHasFiltering(ormable) {
-		listSign += fmt.Sprint(`, f `, `*`, generateImport("Filtering", queryImport, g))
-		f = "f"
-	} else {
-		f = "nil"
-	}
-	if b.listHasSorting(ormable) {
-		listSign += fmt.Sprint(`, s `, `*`, generateImport("Sorting", queryImport, g))
-		s = "s"
-	} else {
-		s = "nil"
-	}
-	if b.listHasPagination(ormable) {
-		listSign += fmt.Sprint(`, p `, `*`, generateImport("Pagination", queryImport, g))
-		pg = "p"
-	} else {
-		pg = "nil"
-	}
-	if b.listHasFieldSelection(ormable) {
-		listSign += fmt.Sprint(`, fs `, `*`, generateImport("FieldSelection", queryImport, g))
-		fs = "fs"
-	} else {
-		fs = "nil"
+// generateSortAllowList lists the sort tags of the columns of the ORM type, and
+// of its has-one and belongs-to associations which are sorted on by joining them
+func (b *ORMBuilder) generateSortAllowList(ormable *OrmableType, g *protogen.GeneratedFile) {
+	columns := func(ormable *OrmableType, prefix string) []string {
+		var tags []string
+		for name, field := range ormable.Fields {
+			if isAssociation(field) || field.GetTag().GetIgnore() {
+				continue
+			}
+			tags = append(tags, prefix+jgorm.ToDBName(name))
+		}
+		return tags
 	}
-	listSign += fmt.Sprint(`) ([]*`, typeName, `, error) {`)
-	g.P(listSign)
-	g.P(`in := `, typeName, `{}`)
-	g.P(`ormObj, err := in.ToORM(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	b.generateBeforeListHookCall(ormable, "ApplyQuery", g)
-	g.P(`db, err = `, generateImport("ApplyCollectionOperators", tkgormImport, g), `(ctx, db, &`, ormable.Name, `{}, &`, typeName, `{}, `, f, `,`, s, `,`, pg, `,`, fs, `)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	b.generateBeforeListHookCall(ormable, "Find", g)
-	g.P(`db = db.Where(&ormObj)`)
 
-	// add default ordering by primary key
-	if b.hasPrimaryKey(ormable) {
-		pkName, pk := b.findPrimaryKey(ormable)
-		column := pk.GetTag().GetColumn()
-		if len(column) == 0 {
-			column = jgorm.ToDBName(pkName)
+	tags := columns(ormable, "")
+	for name, field := range ormable.Fields {
+		if field.GetHasOne() == nil && field.GetBelongsTo() == nil {
+			continue
+		}
+		if assoc, err := GetOrmable(b.ormableTypes, field.Type); err == nil {
+			tags = append(tags, columns(assoc, jgorm.ToDBName(name)+".")...)
 		}
-		g.P(`db = db.Order("`, column, `")`)
 	}
+	sort.Strings(tags)
 
-	g.P(`ormResponse := []`, ormable.Name, `{}`)
-	g.P(`if err := db.Find(&ormResponse).Error; err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	b.generateAfterListHookCall(ormable, g)
-	g.P(`pbResponse := []*`, typeName, `{}`)
-	g.P(`for _, responseEntry := range ormResponse {`)
-	g.P(`temp, err := responseEntry.ToPB(ctx)`)
-	g.P(`if err != nil {`)
-	g.P(`return nil, err`)
-	g.P(`}`)
-	g.P(`pbResponse = append(pbResponse, &temp)`)
-	g.P(`}`)
-	g.P(`return pbResponse, nil`)
+	g.P(`// `, ormable.Name, `Sortable lists the sort tags accepted by DefaultList`, ormable.OriginName)
+	g.P(`var `, ormable.Name, `Sortable = map[string]struct{}{`)
+	for _, tag := range tags {
+		g.P(`"`, tag, `": {},`)
+	}
 	g.P(`}`)
-	b.generateBeforeListHookDef(ormable, "ApplyQuery", g)
-	b.generateBeforeListHookDef(ormable, "Find", g)
-	b.generateAfterListHookDef(ormable, g)
+	g.P()
 }
 
-func (b *ORMBuilder) generateBeforeListHookCall(orm *OrmableType, suffix string, g *protogen.GeneratedFile) {
+func (b *ORMBuilder) generateBeforeListHookCall(orm *OrmableType, suffix, errReturn string, g *protogen.GeneratedFile) {
 	g.P(`if hook, ok := interface{}(&ormObj).(`, orm.Name, `WithBeforeList`, suffix, `); ok {`)
 	hookCall := fmt.Sprint(`if db, err = hook.BeforeList`, suffix, `(ctx, db`)
 	if b.listHasFiltering(orm) {
@@ -2454,7 +9278,7 @@ func (b *ORMBuilder) generateBeforeListHookCall(orm *OrmableType, suffix string,
 	}
 	hookCall += `); err != nil {`
 	g.P(hookCall)
-	g.P(`return nil, err`)
+	g.P(`return `, errReturn)
 	g.P(`}`)
 	g.P(`}`)
 }
@@ -2592,7 +9416,12 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 			var verb, fmName, baseType string
 			var follows bool
 
-			if strings.HasPrefix(methodName, createService) {
+			// a Create method of a message named like CreateSettings is no
+			// CreateSet method
+			if strings.HasPrefix(methodName, createSetService) && hasField(input, "objects") {
+				verb = createSetService
+				follows, baseType = b.followsCreateSetConventions(input, output, createSetService)
+			} else if strings.HasPrefix(methodName, createService) {
 				verb = createService
 				follows, baseType = b.followsCreateConventions(input, output, createService)
 			} else if strings.HasPrefix(methodName, readService) {
@@ -2610,7 +9439,7 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 			} else if strings.HasPrefix(methodName, deleteService) {
 				verb = deleteService
 				follows, baseType = b.followsDeleteConventions(input, output, method)
-			} else if strings.HasPrefix(methodName, listService) {
+			} else if strings.HasPrefix(methodName, listService) && !getMethodOptions(method).GetListTrash() {
 				verb = listService
 				follows, baseType = b.followsListConventions(input, output, listService)
 			}
@@ -2629,7 +9458,53 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 			genSvc.methods = append(genSvc.methods, genMethod)
 
 			if genMethod.verb != "" && b.isOrmable(genMethod.baseType) {
+				updates := follows && (verb == updateService || verb == updateSetService) ||
+					verb == createService && getMethodOptions(method).GetCreateMode() == gorm.MethodOptions_SAVE
+				if updates && b.getOrmable(genMethod.baseType).Immutable {
+					panic(fmt.Sprintf("%s.%s updates %s, which is immutable", service.Desc.Name(), methodName, genMethod.baseType))
+				}
 				b.getOrmable(genMethod.baseType).Methods[genMethod.verb] = &genMethod
+				if verb == createService && follows && getMethodOptions(method).GetCreateMode() == gorm.MethodOptions_SAVE {
+					b.getOrmable(genMethod.baseType).SavedByCreate = true
+				}
+			}
+			if verb != createService && getMethodOptions(method).GetCreateMode() != gorm.MethodOptions_INSERT {
+				panic(fmt.Sprintf("create_mode of %s.%s is only valid on Create methods", service.Desc.Name(), methodName))
+			}
+			if batchSize := getMethodOptions(method).GetBatchSize(); batchSize != 0 {
+				if verb != createSetService {
+					panic(fmt.Sprintf("batch_size of %s.%s is only valid on CreateSet methods", service.Desc.Name(), methodName))
+				}
+				if batchSize < 0 {
+					panic(fmt.Sprintf("batch_size of %s.%s is negative", service.Desc.Name(), methodName))
+				}
+				if follows {
+					if max := b.maxBatchSize(b.getOrmable(baseType)); int(batchSize) > max {
+						fmt.Fprintf(os.Stderr, "batch_size %d of %s.%s exceeds the 65535 bind parameters of postgres for the %d columns of %s, %d rows are inserted at most.\n",
+							batchSize, service.Desc.Name(), methodName, len(b.insertFields(b.getOrmable(baseType))), baseType, max)
+					}
+				}
+			}
+			if getMethodOptions(method).GetAggregate() != nil {
+				b.parseAggregate(service, &genMethod)
+			}
+			if len(getMethodOptions(method).GetFacet()) > 0 {
+				b.parseFacets(service, &genMethod)
+			}
+			if getMethodOptions(method).GetPurge() {
+				b.parsePurge(service, &genMethod)
+			}
+			if getMethodOptions(method).GetListTrash() {
+				b.parseListTrash(service, &genMethod)
+			}
+			if len(getMethodOptions(method).GetDistinctOn()) > 0 {
+				b.parseDistinctOn(service, &genMethod)
+			}
+			if getMethodOptions(method).GetIdempotencyField() != "" {
+				b.parseIdempotencyField(service, &genMethod)
+			}
+			if getMethodOptions(method).GetCasField() != "" {
+				b.parseCasField(service, &genMethod)
 			}
 		}
 
@@ -2637,6 +9512,272 @@ func (b *ORMBuilder) parseServices(file *protogen.File) {
 	}
 }
 
+// parseAggregate checks the aggregate option of the method and adds it to the
+// aggregates of its object_type
+func (b *ORMBuilder) parseAggregate(service *protogen.Service, method *autogenMethod) {
+	opts := getMethodOptions(method.Method)
+	where := fmt.Sprintf("aggregate of %s.%s", service.Desc.Name(), method.ccName)
+	if !strings.HasPrefix(method.ccName, aggregateService) {
+		panic(fmt.Sprintf("%s needs a method name starting with %s", where, aggregateService))
+	}
+	typeName := camelCase(opts.GetObjectType())
+	if !b.isOrmable(typeName) {
+		panic(fmt.Sprintf("%s needs the (gorm.method).object_type option of an ormable type", where))
+	}
+	ormable := b.getOrmable(typeName)
+	aggregate := opts.GetAggregate()
+	if !aggregate.GetCount() && len(aggregate.GetSum()) == 0 {
+		panic(fmt.Sprintf("%s has neither count nor sum", where))
+	}
+	column := func(name string) *Field {
+		field, ok := ormable.Fields[camelCase(name)]
+		if !ok || field.GetAssociation() != nil || strings.HasPrefix(field.Type, "[]") || strings.HasSuffix(field.Type, "ORM") {
+			panic(fmt.Sprintf("%s refers to %s, which is not a column of %s", where, name, typeName))
+		}
+		return field
+	}
+	for _, name := range aggregate.GetGroupBy() {
+		column(name)
+	}
+	for _, name := range aggregate.GetSum() {
+		switch strings.TrimPrefix(column(name).Type, "*") {
+		case "int32", "int64", "uint32", "uint64", "float32", "float64":
+		default:
+			panic(fmt.Sprintf("%s sums %s, which is not a numeric field", where, name))
+		}
+	}
+	method.baseType = typeName
+	ormable.Aggregates = append(ormable.Aggregates, method)
+}
+
+// parseFacets checks the facet option of the method and adds it to the facet
+// methods of its object_type
+func (b *ORMBuilder) parseFacets(service *protogen.Service, method *autogenMethod) {
+	opts := getMethodOptions(method.Method)
+	where := fmt.Sprintf("facet of %s.%s", service.Desc.Name(), method.ccName)
+	if !strings.HasPrefix(method.ccName, facetsService) {
+		panic(fmt.Sprintf("%s needs a method name starting with %s", where, facetsService))
+	}
+	typeName := camelCase(opts.GetObjectType())
+	if !b.isOrmable(typeName) {
+		panic(fmt.Sprintf("%s needs the (gorm.method).object_type option of an ormable type", where))
+	}
+	ormable := b.getOrmable(typeName)
+	seen := map[string]bool{}
+	for _, name := range opts.GetFacet() {
+		fieldName := camelCase(name)
+		field, ok := ormable.Fields[fieldName]
+		if !ok || field.GetAssociation() != nil {
+			panic(fmt.Sprintf("%s refers to %s, which is not a column of %s", where, name, typeName))
+		}
+		// the values are the keys of a map, a pointer would be one of its own
+		switch field.Type {
+		case "string", "bool", "int16", "int32", "int64", "uint32", "uint64", "float32", "float64":
+		default:
+			panic(fmt.Sprintf("%s counts %s of type %s, a facet is a string, bool or numeric column that is not nullable", where, name, field.Type))
+		}
+		if seen[fieldName] {
+			panic(fmt.Sprintf("%s lists %s twice", where, name))
+		}
+		seen[fieldName] = true
+	}
+	method.baseType = typeName
+	ormable.Facets = append(ormable.Facets, method)
+}
+
+// parseCasField checks the cas_field option of the method, whose request
+// holds the expected value in the expected_{cas_field} field, and adds it to
+// the cas_field of its type
+func (b *ORMBuilder) parseCasField(service *protogen.Service, method *autogenMethod) {
+	opts := getMethodOptions(method.Method)
+	where := fmt.Sprintf("cas_field of %s.%s", service.Desc.Name(), method.ccName)
+	if method.verb != updateService || !method.followsConvention {
+		panic(fmt.Sprintf("%s is only valid on Update methods following the conventions", where))
+	}
+	ormable := b.getOrmable(method.baseType)
+	message := b.ormableMessage(ormable)
+	if getMessageOptions(message).GetHistory() {
+		panic(fmt.Sprintf("%s updates %s outside of its history", where, method.baseType))
+	}
+	name := opts.GetCasField()
+	var field *protogen.Field
+	for _, f := range message.Fields {
+		if string(f.Desc.Name()) == name {
+			field = f
+		}
+	}
+	if field == nil || !b.isMaskedColumn(message, camelCase(name)) {
+		panic(fmt.Sprintf("%s refers to %s, which is not a column of %s the update sets", where, name, method.baseType))
+	}
+	if b.apiScalarType(field, nil) == "" {
+		panic(fmt.Sprintf("%s refers to %s, the expected value is a singular string, bool, integer or enum field", where, name))
+	}
+	var expected *protogen.Field
+	for _, f := range method.inType.Fields {
+		if string(f.Desc.Name()) == "expected_"+name {
+			expected = f
+		}
+	}
+	if expected == nil || expected.Desc.Kind() != field.Desc.Kind() || expected.Desc.IsList() || expected.Desc.HasPresence() ||
+		field.Enum != nil && expected.Enum.Desc.FullName() != field.Enum.Desc.FullName() {
+		panic(fmt.Sprintf("%s needs an expected_%s field of the type of %s in %s", where, name, name, method.inType.Desc.Name()))
+	}
+	for _, existing := range ormable.CasFields {
+		if existing == camelCase(name) {
+			return
+		}
+	}
+	ormable.CasFields = append(ormable.CasFields, camelCase(name))
+	sort.Strings(ormable.CasFields)
+}
+
+// isMaskedColumn reports whether the field is one of the maskedColumnFields
+func (b *ORMBuilder) isMaskedColumn(message *protogen.Message, name string) bool {
+	for _, masked := range b.maskedColumnFields(message) {
+		if masked == name {
+			return true
+		}
+	}
+	return false
+}
+
+// apiScalarType returns the Go type of a singular string, bool, integer or
+// enum field of the API, "" for the other fields, g is only needed for the
+// enums
+func (b *ORMBuilder) apiScalarType(field *protogen.Field, g *protogen.GeneratedFile) string {
+	if field.Desc.IsList() || field.Desc.HasPresence() {
+		return ""
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.EnumKind:
+		if g == nil {
+			return field.Enum.GoIdent.GoName
+		}
+		return b.typeName(field.Enum.GoIdent, g)
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	}
+	return ""
+}
+
+// parsePurge checks the purge option of the method and enables the purge
+// handler of its object_type
+func (b *ORMBuilder) parsePurge(service *protogen.Service, method *autogenMethod) {
+	where := fmt.Sprintf("purge of %s.%s", service.Desc.Name(), method.ccName)
+	if !strings.HasPrefix(method.ccName, purgeService) {
+		panic(fmt.Sprintf("%s needs a method name starting with %s", where, purgeService))
+	}
+	typeName := camelCase(getMethodOptions(method.Method).GetObjectType())
+	if !b.isOrmable(typeName) {
+		panic(fmt.Sprintf("%s needs the (gorm.method).object_type option of an ormable type", where))
+	}
+	ormable := b.getOrmable(typeName)
+	if !b.hasPrimaryKey(ormable) {
+		panic(fmt.Sprintf("%s needs a primary key of %s", where, typeName))
+	}
+	if _, ok := ormable.Fields["DeletedAt"]; !ok {
+		panic(fmt.Sprintf("%s needs a deleted_at field of %s, its rows are deleted for good already", where, typeName))
+	}
+	method.baseType = typeName
+	ormable.Purged = true
+}
+
+// parseListTrash checks the list_trash option of the method and enables the
+// trash list handler of its object_type
+func (b *ORMBuilder) parseListTrash(service *protogen.Service, method *autogenMethod) {
+	where := fmt.Sprintf("list_trash of %s.%s", service.Desc.Name(), method.ccName)
+	if !strings.HasPrefix(method.ccName, listTrashService) {
+		panic(fmt.Sprintf("%s needs a method name starting with %s", where, listTrashService))
+	}
+	typeName := camelCase(getMethodOptions(method.Method).GetObjectType())
+	if !b.isOrmable(typeName) {
+		panic(fmt.Sprintf("%s needs the (gorm.method).object_type option of an ormable type", where))
+	}
+	ormable := b.getOrmable(typeName)
+	if _, ok := ormable.Fields["DeletedAt"]; !ok {
+		panic(fmt.Sprintf("%s needs a deleted_at field of %s, its rows are deleted for good", where, typeName))
+	}
+	method.baseType = typeName
+	ormable.ListsTrash = true
+}
+
+// parseIdempotencyField checks the idempotency_field option of the Create
+// method and adds the get or create handler of the field to its type
+func (b *ORMBuilder) parseIdempotencyField(service *protogen.Service, method *autogenMethod) {
+	opts := getMethodOptions(method.Method)
+	where := fmt.Sprintf("idempotency_field of %s.%s", service.Desc.Name(), method.ccName)
+	if method.verb != createService || !method.followsConvention {
+		panic(fmt.Sprintf("%s is only valid on Create methods following the conventions", where))
+	}
+	if opts.GetCreateMode() == gorm.MethodOptions_SAVE {
+		panic(fmt.Sprintf("%s cannot be used with the SAVE create mode, which updates the row of a provided primary key", where))
+	}
+	ormable := b.getOrmable(method.baseType)
+	name := camelCase(opts.GetIdempotencyField())
+	field, ok := ormable.Fields[name]
+	if !ok || isAssociation(field) || field.GetTag().GetIgnore() {
+		panic(fmt.Sprintf("%s refers to %s, which is not a column of %s", where, opts.GetIdempotencyField(), method.baseType))
+	}
+	switch strings.TrimPrefix(field.Type, "*") {
+	case "string", "int32", "int64", "uint32", "uint64":
+	default:
+		panic(fmt.Sprintf("%s refers to %s of type %s, the key is a string or an integer", where, opts.GetIdempotencyField(), field.Type))
+	}
+	// a concurrent retry is told apart by the unique violation of its insert
+	if !field.GetTag().GetUnique() && field.GetTag().GetUniqueIndex() == "" {
+		panic(fmt.Sprintf("%s refers to %s, which needs a unique or unique_index tag", where, opts.GetIdempotencyField()))
+	}
+	for _, existing := range ormable.IdempotencyFields {
+		if existing == name {
+			return
+		}
+	}
+	ormable.IdempotencyFields = append(ormable.IdempotencyFields, name)
+	sort.Strings(ormable.IdempotencyFields)
+}
+
+// parseDistinctOn checks the distinct_on option of the List method and adds
+// the handler of its columns to its type
+func (b *ORMBuilder) parseDistinctOn(service *protogen.Service, method *autogenMethod) {
+	where := fmt.Sprintf("distinct_on of %s.%s", service.Desc.Name(), method.ccName)
+	if method.verb != listService || !method.followsConvention {
+		panic(fmt.Sprintf("%s is only valid on List methods following the conventions", where))
+	}
+	if b.dbEngine != ENGINE_POSTGRES {
+		panic(fmt.Sprintf("%s needs engine=postgres, other engines have no DISTINCT ON", where))
+	}
+	ormable := b.getOrmable(method.baseType)
+	var columns []string
+	for _, name := range getMethodOptions(method.Method).GetDistinctOn() {
+		field, ok := ormable.Fields[camelCase(name)]
+		if !ok || isAssociation(field) || field.GetTag().GetIgnore() || strings.HasPrefix(field.Type, "[]") || strings.HasSuffix(field.Type, "ORM") {
+			panic(fmt.Sprintf("%s refers to %s, which is not a column of %s", where, name, method.baseType))
+		}
+		columns = append(columns, columnName(camelCase(name), field))
+	}
+	ormable.DistinctOn[distinctOnSuffix(getMethodOptions(method.Method).GetDistinctOn())] = columns
+}
+
+// distinctOnSuffix returns the suffix of the List handler of the distinct_on
+// fields, e.g. DistinctOnUserId
+func distinctOnSuffix(fields []string) string {
+	suffix := "DistinctOn"
+	for _, name := range fields {
+		suffix += camelCase(name)
+	}
+	return suffix
+}
+
 func (b *ORMBuilder) followsCreateConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
 	var inTypeName string
 	var typeOrmable bool
@@ -2703,6 +9844,57 @@ func (b *ORMBuilder) followsReadConventions(inType *protogen.Message, outType *p
 	return true, outTypeName
 }
 
+// hasField reports whether the message has a field of the proto name
+func hasField(message *protogen.Message, name string) bool {
+	for _, field := range message.Fields {
+		if string(field.Desc.Name()) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *ORMBuilder) followsCreateSetConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string) {
+	var inEntity, outEntity *protogen.Field
+	for _, field := range inType.Fields {
+		if string(field.Desc.Name()) == "objects" {
+			inEntity = field
+		}
+	}
+	for _, field := range outType.Fields {
+		if string(field.Desc.Name()) == "results" {
+			outEntity = field
+		}
+	}
+
+	if inEntity == nil || outEntity == nil || inEntity.Message == nil || outEntity.Message == nil {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since it needs a repeated field 'objects' in request and repeated field 'results' in response.\n", methodName)
+		return false, ""
+	}
+	if inEntity.Desc.Cardinality() != protoreflect.Repeated || outEntity.Desc.Cardinality() != protoreflect.Repeated {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since field 'objects' in request and field 'results' in response should be repeated.\n", methodName)
+		return false, ""
+	}
+	inTypeName, outTypeName := string(inEntity.Message.Desc.Name()), string(outEntity.Message.Desc.Name())
+	if !b.isOrmable(inTypeName) {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since type %s of 'objects' is not ormable.\n", methodName, inTypeName)
+		return false, ""
+	}
+	if inTypeName != outTypeName {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since field 'objects' in request has type %s but field 'results' in response has %s.\n", methodName, inTypeName, outTypeName)
+		return false, ""
+	}
+	if b.dbEngine != ENGINE_POSTGRES {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since DefaultCreate%sSet needs engine=postgres.\n", methodName, inTypeName)
+		return false, ""
+	}
+	if len(b.insertFields(b.getOrmable(inTypeName))) == 0 {
+		fmt.Fprintf(os.Stderr, "stub will be generated for %s since %s has no columns to insert.\n", methodName, inTypeName)
+		return false, ""
+	}
+	return true, inTypeName
+}
+
 func (b *ORMBuilder) followsUpdateSetConventions(inType *protogen.Message, outType *protogen.Message, methodName string) (bool, string, string) {
 	var (
 		inEntity    *protogen.Field
@@ -2776,7 +9968,7 @@ func (b *ORMBuilder) followsUpdateConventions(inType *protogen.Message, outType
 		}
 
 		// Check that type of field is a FieldMask
-		if string(field.Desc.Message().FullName()) == "google.protobuf.FieldMask" {
+		if field.Desc.Message() != nil && string(field.Desc.Message().FullName()) == "google.protobuf.FieldMask" {
 			// More than one mask in request is not allowed.
 			if updateMask != "" {
 				return false, "", ""
@@ -2960,6 +10152,8 @@ func (b *ORMBuilder) generateDefaultServer(file *protogen.File, g *protogen.Gene
 			switch method.verb {
 			case createService:
 				b.generateCreateServerMethod(service, method, g)
+			case createSetService:
+				b.generateCreateSetServerMethod(service, method, g)
 			case readService:
 				b.generateReadServerMethod(service, method, g)
 			case updateService:
@@ -3019,14 +10213,51 @@ func (b *ORMBuilder) generateSpanResultMethod(service autogenService, g *protoge
 func (b *ORMBuilder) generateCreateServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		g.P(`res, err := DefaultCreate`, method.baseType, `(ctx, in.GetPayload(), db)`)
+		save := getMethodOptions(method.Method).GetCreateMode() == gorm.MethodOptions_SAVE
+		idempotencyField := camelCase(getMethodOptions(method.Method).GetIdempotencyField())
+		if save {
+			g.P(`// create mode SAVE: a provided primary key updates the stored row`)
+			g.P(`res, err := DefaultSave`, method.baseType, `(ctx, in.GetPayload(), db)`)
+		} else if idempotencyField != "" {
+			ormable := b.getOrmable(method.baseType)
+			getOrCreate := "DefaultGetOrCreate" + method.baseType + "By" + idempotencyField
+			if keys := getMessageOptions(b.ormableMessage(ormable)).GetGetOrCreateKey(); len(keys) == 1 && camelCase(keys[0]) == idempotencyField {
+				getOrCreate = "DefaultGetOrCreate" + method.baseType
+			}
+			g.P(`// a retried create of the idempotency key returns the row of the key`)
+			var payload protogen.GoIdent
+			for _, field := range method.inType.Fields {
+				if field.Desc.Name() == "payload" {
+					payload = field.Message.GoIdent
+				}
+			}
+			g.P(`var res *`, b.typeName(payload, g))
+			g.P(`var err error`)
+			g.P(`created := true`)
+			g.P(`if in.GetPayload().Get`, idempotencyField, `() != `, b.guessZeroValue(ormable.Fields[idempotencyField].Type, g), ` {`)
+			g.P(`res, created, err = `, getOrCreate, `(ctx, in.GetPayload(), db)`)
+			g.P(`} else {`)
+			g.P(`res, err = DefaultCreate`, method.baseType, `(ctx, in.GetPayload(), db)`)
+			g.P(`}`)
+		} else {
+			g.P(`res, err := DefaultCreate`, method.baseType, `(ctx, in.GetPayload(), db)`)
+		}
 		g.P(`if err != nil {`)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
-		if b.gateway {
+		// a save may have updated the row and a retry returns the row of its
+		// key, so they are not reported as created
+		if b.gateway && idempotencyField != "" {
+			g.P(`if created {`)
+			g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
+			g.P(`if err != nil {`)
+			g.P(`return nil, `, b.wrapSpanError(service, "err"))
+			g.P(`}`)
+			g.P(`}`)
+		} else if b.gateway && !save {
 			g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
 			g.P(`if err != nil {`)
 			g.P(`return nil, `, b.wrapSpanError(service, "err"))
@@ -3034,6 +10265,38 @@ func (b *ORMBuilder) generateCreateServerMethod(service autogenService, method a
 		}
 
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
+		b.spanResultHandling(service, g)
+		g.P(`return out, nil`)
+		g.P(`}`)
+		b.generatePreserviceHook(service.ccName, method.baseType, method.ccName, g)
+		b.generatePostserviceHook(service.ccName, method.baseType, b.typeName(method.outType.GoIdent, g), method.ccName, g)
+	} else {
+		b.generateEmptyBody(service, method.outType, g)
+	}
+}
+
+func (b *ORMBuilder) generateCreateSetServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	b.generateMethodSignature(service, method, g)
+	if method.followsConvention {
+		g.P(`if in == nil {`)
+		g.P(`return nil, `, generateImport("NilArgumentError", gerrorsImport, g))
+		g.P(`}`)
+		b.generateDBSetup(service, method, g)
+		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
+		g.P(`res, err := DefaultCreate`, method.baseType, `Set(ctx, in.GetObjects(), db, `, getMethodOptions(method.Method).GetBatchSize(), `)`)
+		g.P(`if err != nil {`)
+		g.P(`return nil, `, b.wrapSpanError(service, "err"))
+		g.P(`}`)
+		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Results: res}`)
+		if b.gateway {
+			g.P(`err = `, generateImport("SetCreated", gatewayImport, g), `(ctx, "")`)
+			g.P(`if err != nil {`)
+			g.P(`return nil, `, b.wrapSpanError(service, "err"))
+			g.P(`}`)
+		}
+		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3086,7 +10349,7 @@ func (b *ORMBuilder) wrapSpanError(service autogenService, errVarName string) st
 	return errVarName
 }
 
-func (b *ORMBuilder) generateDBSetup(service autogenService, g *protogen.GeneratedFile) error {
+func (b *ORMBuilder) generateDBSetup(service autogenService, method autogenMethod, g *protogen.GeneratedFile) error {
 	if service.usesTxnMiddleware {
 		g.P(`txn, ok := `, generateImport("FromContext", tkgormImport, g), `(ctx)`)
 		g.P(`if !ok {`)
@@ -3099,9 +10362,67 @@ func (b *ORMBuilder) generateDBSetup(service autogenService, g *protogen.Generat
 	} else {
 		g.P(`db := m.DB`)
 	}
+	b.generateTimeoutBegin(service, method, g)
 	return nil
 }
 
+// methodTimeout returns the timeout option of the method, the default_timeout
+// parameter if it is not set, zero for none
+func (b *ORMBuilder) methodTimeout(method autogenMethod) time.Duration {
+	value := getMethodOptions(method.Method).GetTimeout()
+	if value == "" {
+		return b.defaultTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout < 0 {
+		panic(fmt.Sprintf("method %s has timeout %q, which is not a duration such as 30s", method.ccName, value))
+	}
+	return timeout
+}
+
+// durationLiteral returns the Go expression of d in the largest unit dividing
+// it
+func durationLiteral(d time.Duration, g *protogen.GeneratedFile) string {
+	for _, unit := range []struct {
+		name string
+		d    time.Duration
+	}{{"Hour", time.Hour}, {"Minute", time.Minute}, {"Second", time.Second}, {"Millisecond", time.Millisecond}, {"Microsecond", time.Microsecond}} {
+		if d%unit.d == 0 {
+			return fmt.Sprintf("%d*%s", d/unit.d, generateImport(unit.name, "time", g))
+		}
+	}
+	return fmt.Sprintf("%d*%s", d, generateImport("Nanosecond", "time", g))
+}
+
+// generateTimeoutBegin bounds the server method by its timeout, the handlers
+// run in a transaction of the deadline, so that it covers the whole of a
+// write, and on postgres every statement gets the statement_timeout
+func (b *ORMBuilder) generateTimeoutBegin(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	timeout := b.methodTimeout(method)
+	if timeout == 0 {
+		return
+	}
+	literal := durationLiteral(timeout, g)
+	g.P(`ctx, cancel := `, generateImport("WithTimeout", stdCtxImport, g), `(ctx, `, literal, `)`)
+	g.P(`defer cancel()`)
+	g.P(`db, timeoutTxn, errTimeout := `, generateImport("Begin", timeoutImport, g), `(ctx, db, `, literal, `)`)
+	g.P(`if errTimeout != nil {`)
+	g.P(`return nil, `, b.wrapSpanError(service, "errTimeout"))
+	g.P(`}`)
+	g.P(`defer timeoutTxn.Rollback()`)
+}
+
+// generateTimeoutCommit commits the transaction of generateTimeoutBegin once
+// the after hook of the server method has run
+func (b *ORMBuilder) generateTimeoutCommit(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
+	if b.methodTimeout(method) == 0 {
+		return
+	}
+	g.P(`if err := timeoutTxn.Commit(); err != nil {`)
+	g.P(`return nil, `, b.wrapSpanError(service, "err"))
+	g.P(`}`)
+}
+
 func (b *ORMBuilder) generatePreserviceCall(service autogenService, typeName, method string, g *protogen.GeneratedFile) {
 	g.P(`if custom, ok := interface{}(in).(`, service.ccName, typeName, `WithBefore`, method, `); ok {`)
 	g.P(`var err error`)
@@ -3137,19 +10458,20 @@ func (b *ORMBuilder) generatePostserviceHook(svc, typeName, outTypeName, method
 func (b *ORMBuilder) generateReadServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		typeName := method.baseType
 		if fields := b.getFieldSelection(method.inType); fields != "" {
-			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db, in.`, fields, `)`)
+			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, b.idKey(typeName, method.inType), `, db, in.`, fields, `)`)
 		} else {
-			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+			g.P(`res, err := DefaultRead`, typeName, `(ctx, &`, typeName, b.idKey(typeName, method.inType), `, db)`)
 		}
 		g.P(`if err != nil {`)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3202,9 +10524,15 @@ func (b *ORMBuilder) generateUpdateServerMethod(service autogenService, method a
 		g.P(`var err error`)
 		typeName := method.baseType
 		g.P(`var res *`, typeName)
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		if method.fieldMaskName != "" {
+		if name := getMethodOptions(method.Method).GetCasField(); name != "" {
+			mask := `nil`
+			if method.fieldMaskName != "" {
+				mask = `in.Get` + method.fieldMaskName + `()`
+			}
+			g.P(`res, err = DefaultUpdate`, typeName, `If`, camelCase(name), `(ctx, in.GetPayload(), in.GetExpected`, camelCase(name), `(), `, mask, `, db)`)
+		} else if method.fieldMaskName != "" {
 			g.P(`if in.Get`, method.fieldMaskName, `() == nil {`)
 			g.P(`res, err = DefaultStrictUpdate`, typeName, `(ctx, in.GetPayload(), db)`)
 			g.P(`} else {`)
@@ -3218,6 +10546,7 @@ func (b *ORMBuilder) generateUpdateServerMethod(service autogenService, method a
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Result: res}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3237,7 +10566,7 @@ func (b *ORMBuilder) generateUpdateSetServerMethod(service autogenService, metho
 		g.P(`return nil,`, generateImport("NilArgumentError", gerrorsImport, g))
 		g.P(`}`)
 		g.P(``)
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		g.P(``)
 		b.generatePreserviceCall(service, typeName, method.ccName, g)
 
@@ -3251,6 +10580,7 @@ func (b *ORMBuilder) generateUpdateSetServerMethod(service autogenService, metho
 
 		g.P(``)
 		b.generatePostserviceCall(service, typeName, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
 		g.P(``)
 		withSpan := getServiceOptions(service.Service).WithTracing
 		if withSpan {
@@ -3273,14 +10603,15 @@ func (b *ORMBuilder) generateDeleteServerMethod(service autogenService, method a
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
 		typeName := method.baseType
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
-		g.P(`err := DefaultDelete`, typeName, `(ctx, &`, typeName, `{Id: in.GetId()}, db)`)
+		g.P(`err := DefaultDelete`, typeName, `(ctx, &`, typeName, b.idKey(typeName, method.inType), `, db)`)
 		g.P(`if err != nil {`)
 		g.P(`return nil, `, b.wrapSpanError(service, "err"))
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3295,7 +10626,7 @@ func (b *ORMBuilder) generateDeleteSetServerMethod(service autogenService, metho
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
 		typeName := method.baseType
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		g.P(`objs := []*`, typeName, `{}`)
 		g.P(`for _, id := range in.Ids {`)
 		g.P(`objs = append(objs, &`, typeName, `{Id: id})`)
@@ -3307,6 +10638,7 @@ func (b *ORMBuilder) generateDeleteSetServerMethod(service autogenService, metho
 		g.P(`}`)
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{}`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3320,14 +10652,18 @@ func (b *ORMBuilder) generateDeleteSetServerMethod(service autogenService, metho
 func (b *ORMBuilder) generateListServerMethod(service autogenService, method autogenMethod, g *protogen.GeneratedFile) {
 	b.generateMethodSignature(service, method, g)
 	if method.followsConvention {
-		b.generateDBSetup(service, g)
+		b.generateDBSetup(service, method, g)
 		b.generatePreserviceCall(service, method.baseType, method.ccName, g)
 		pg := b.getPagination(method.inType)
 		pi := b.getPageInfo(method.outType)
 		if pg != "" && pi != "" {
 			b.generatePagedRequestSetup(pg, g)
 		}
-		handlerCall := fmt.Sprint(`res, err := DefaultList`, method.baseType, `(ctx, db`)
+		handler := `DefaultList` + method.baseType
+		if distinctOn := getMethodOptions(method.Method).GetDistinctOn(); len(distinctOn) > 0 {
+			handler += distinctOnSuffix(distinctOn)
+		}
+		handlerCall := fmt.Sprint(`res, err := `, handler, `(ctx, db`)
 		if f := b.getFiltering(method.inType); f != "" {
 			handlerCall += fmt.Sprint(",in.", f)
 		}
@@ -3352,6 +10688,7 @@ func (b *ORMBuilder) generateListServerMethod(service autogenService, method aut
 		}
 		g.P(`out := &`, b.typeName(method.outType.GoIdent, g), `{Results: res`, pageInfoIfExist, ` }`)
 		b.generatePostserviceCall(service, method.baseType, method.ccName, g)
+		b.generateTimeoutCommit(service, method, g)
 		b.spanResultHandling(service, g)
 		g.P(`return out, nil`)
 		g.P(`}`)
@@ -3424,7 +10761,7 @@ func (b *ORMBuilder) countHasAssociationDimension(message *protogen.Message, typ
 			fieldType = string(field.Desc.Message().Name())
 		}
 
-		if fieldOpts.GetManyToMany() == nil && fieldOpts.GetBelongsTo() == nil {
+		if fieldOpts.GetManyToMany() == nil && fieldOpts.GetBelongsTo() == nil && fieldOpts.GetThrough() == "" {
 			if strings.Trim(typeName, "[]*") == strings.Trim(fieldType, "[]*") {
 				dim++
 			}
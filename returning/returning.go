@@ -0,0 +1,221 @@
+package returning
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	setting  = "protoc-gen-gorm:returning"
+	returned = "protoc-gen-gorm:returned"
+	marker   = "protoc-gen-gorm:returning"
+)
+
+// Set returns db with its creates and updates of objects reading the stored
+// row back with RETURNING *, once Register replaced the callbacks
+func Set(db *gorm.DB) *gorm.DB {
+	return db.Set(setting, true)
+}
+
+// Returned reports whether the write resulting in db read the stored row
+// back, otherwise it has to be read after the write
+func Returned(db *gorm.DB) bool {
+	value, ok := db.Get(returned)
+	return ok && value == true
+}
+
+// Register replaces the gorm:create and gorm:update callbacks of db with ones
+// appending RETURNING * on postgres to the writes of a db of Set, the other
+// writes run the replaced callbacks. Registering it again has no effect.
+func Register(db *gorm.DB) {
+	if db.Callback().Create().Get(marker) != nil {
+		return
+	}
+	create := db.Callback().Create().Get("gorm:create")
+	update := db.Callback().Update().Get("gorm:update")
+	db.Callback().Create().Replace("gorm:create", func(scope *gorm.Scope) {
+		if !enabled(scope) {
+			create(scope)
+			return
+		}
+		createReturning(scope)
+	})
+	db.Callback().Update().Replace("gorm:update", func(scope *gorm.Scope) {
+		// an update of columns, e.g. Updates, changes no object
+		if _, ok := scope.InstanceGet("gorm:update_attrs"); ok || !enabled(scope) {
+			update(scope)
+			return
+		}
+		updateReturning(scope)
+	})
+	db.Callback().Create().After("gorm:create").Register(marker, func(*gorm.Scope) {})
+}
+
+// enabled reports whether the write of scope reads the row back
+func enabled(scope *gorm.Scope) bool {
+	_, ok := scope.Get(setting)
+	return ok && scope.Dialect().GetName() == "postgres"
+}
+
+// changeableField tells the fields written by scope apart, as GORM does
+func changeableField(scope *gorm.Scope, field *gorm.Field) bool {
+	if selectAttrs := scope.SelectAttrs(); len(selectAttrs) > 0 {
+		for _, attr := range selectAttrs {
+			if field.Name == attr || field.DBName == attr {
+				return true
+			}
+		}
+		return false
+	}
+	for _, attr := range scope.OmitAttrs() {
+		if field.Name == attr || field.DBName == attr {
+			return false
+		}
+	}
+	return true
+}
+
+// createReturning is the INSERT of gorm:create, the blank columns with a
+// default are left to the DB and read back along with the rest of the row
+func createReturning(scope *gorm.Scope) {
+	if scope.HasError() {
+		return
+	}
+	var columns, placeholders []string
+	for _, field := range scope.Fields() {
+		if !changeableField(scope, field) {
+			continue
+		}
+		if field.IsNormal && !field.IsIgnored {
+			if field.IsBlank && field.HasDefaultValue {
+				continue
+			}
+			if !field.IsPrimaryKey || !field.IsBlank {
+				columns = append(columns, scope.Quote(field.DBName))
+				placeholders = append(placeholders, scope.AddToVars(field.Field.Interface()))
+			}
+		} else if field.Relationship != nil && field.Relationship.Kind == "belongs_to" {
+			for _, foreignKey := range field.Relationship.ForeignDBNames {
+				if foreignField, ok := scope.FieldByName(foreignKey); ok && !changeableField(scope, foreignField) {
+					columns = append(columns, scope.Quote(foreignField.DBName))
+					placeholders = append(placeholders, scope.AddToVars(foreignField.Field.Interface()))
+				}
+			}
+		}
+	}
+	var extraOption, insertModifier string
+	if str, ok := scope.Get("gorm:insert_option"); ok {
+		extraOption = " " + fmt.Sprint(str)
+	}
+	if str, ok := scope.Get("gorm:insert_modifier"); ok {
+		if modifier := strings.ToUpper(fmt.Sprint(str)); modifier != "INTO" {
+			insertModifier = " " + modifier
+		}
+	}
+	if len(columns) == 0 {
+		scope.Raw(fmt.Sprintf("INSERT%s INTO %s DEFAULT VALUES%s RETURNING *", insertModifier, scope.QuotedTableName(), extraOption))
+	} else {
+		scope.Raw(fmt.Sprintf("INSERT%s INTO %s (%s) VALUES (%s)%s RETURNING *", insertModifier, scope.QuotedTableName(),
+			strings.Join(columns, ","), strings.Join(placeholders, ","), extraOption))
+	}
+	scanReturning(scope)
+}
+
+// updateReturning is the UPDATE of gorm:update for the Save of an object
+func updateReturning(scope *gorm.Scope) {
+	if scope.HasError() {
+		return
+	}
+	var sqls []string
+	for _, field := range scope.Fields() {
+		if !changeableField(scope, field) {
+			continue
+		}
+		if !field.IsPrimaryKey && field.IsNormal && (field.Name != "CreatedAt" || !field.IsBlank) {
+			if !field.IsForeignKey || !field.IsBlank || !field.HasDefaultValue {
+				sqls = append(sqls, fmt.Sprintf("%v = %v", scope.Quote(field.DBName), scope.AddToVars(field.Field.Interface())))
+			}
+		} else if relationship := field.Relationship; relationship != nil && relationship.Kind == "belongs_to" {
+			for _, foreignKey := range relationship.ForeignDBNames {
+				if foreignField, ok := scope.FieldByName(foreignKey); ok && !changeableField(scope, foreignField) {
+					sqls = append(sqls, fmt.Sprintf("%v = %v", scope.Quote(foreignField.DBName), scope.AddToVars(foreignField.Field.Interface())))
+				}
+			}
+		}
+	}
+	if len(sqls) == 0 {
+		return
+	}
+	var extraOption string
+	if str, ok := scope.Get("gorm:update_option"); ok {
+		extraOption = " " + fmt.Sprint(str)
+	}
+	var conditions string
+	if where := scope.CombinedConditionSql(); where != "" {
+		conditions = " " + where
+	}
+	scope.Raw(fmt.Sprintf("UPDATE %s SET %s%s%s RETURNING *", scope.QuotedTableName(), strings.Join(sqls, ", "), conditions, extraOption))
+	scanReturning(scope)
+}
+
+// scanReturning runs the statement of scope and scans the returned row into
+// its object, the rows affected are the rows returned
+func scanReturning(scope *gorm.Scope) {
+	rows, err := scope.SQLDB().Query(scope.SQL, scope.SQLVars...)
+	if scope.Err(err) != nil {
+		return
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if scope.Err(err) != nil {
+		return
+	}
+	fields := make(map[string]*gorm.Field)
+	for _, field := range scope.Fields() {
+		if field.IsNormal && !field.IsIgnored {
+			fields[field.DBName] = field
+		}
+	}
+	var affected int64
+	for rows.Next() {
+		affected++
+		values := make([]interface{}, len(columns))
+		for i, column := range columns {
+			field, ok := fields[column]
+			if !ok {
+				values[i] = new(interface{})
+				continue
+			}
+			// a NULL is scanned into the pointer to the field, leaving it nil
+			holder := reflect.New(reflect.PtrTo(field.Struct.Type))
+			holder.Elem().Set(field.Field.Addr())
+			values[i] = holder.Interface()
+		}
+		if scope.Err(rows.Scan(values...)) != nil {
+			return
+		}
+		for i, column := range columns {
+			if field, ok := fields[column]; ok {
+				if value := reflect.ValueOf(values[i]).Elem().Elem(); value.IsValid() {
+					field.Field.Set(value)
+				} else {
+					field.Field.Set(reflect.Zero(field.Field.Type()))
+				}
+				field.IsBlank = isBlank(field.Field)
+			}
+		}
+	}
+	if scope.Err(rows.Err()) != nil {
+		return
+	}
+	scope.DB().RowsAffected = affected
+	scope.Set(returned, true)
+}
+
+// isBlank reports whether the value is the zero value of its type
+func isBlank(value reflect.Value) bool {
+	return reflect.DeepEqual(value.Interface(), reflect.Zero(value.Type()).Interface())
+}
@@ -0,0 +1,135 @@
+package returning
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/infobloxopen/protoc-gen-gorm/internal/dbtest"
+)
+
+type item struct {
+	ID    uint64 `gorm:"primary_key"`
+	Name  string
+	State string `gorm:"default:'active'"`
+	Note  *string
+}
+
+func TestCreateReturning(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Register(db)
+	// registering again has no effect
+	Register(db)
+	recorder.Return(`INSERT INTO "items"`, []string{"id", "name", "state", "note", "computed"}, []driver.Value{int64(7), "a", "active", nil, "x"})
+
+	obj := item{Name: "a"}
+	written := Set(db).Create(&obj)
+	if err := written.Error; err != nil {
+		t.Fatalf("Create=%v; want success", err)
+	}
+	queries := recorder.Writes()
+	if len(queries) != 1 {
+		t.Fatalf("queries=%q; want only the insert", queries)
+	}
+	// the blank state is left to its default
+	if want := `INSERT INTO "items" ("name","note") VALUES ($1,$2) RETURNING *`; queries[0] != want {
+		t.Errorf("insert=%q; want %q", queries[0], want)
+	}
+	if obj.ID != 7 || obj.State != "active" || obj.Note != nil {
+		t.Errorf("object=%+v; want the returned row", obj)
+	}
+	if !Returned(written) {
+		t.Error("Returned=false; want true")
+	}
+	if written.RowsAffected != 1 {
+		t.Errorf("RowsAffected=%d; want 1", written.RowsAffected)
+	}
+}
+
+func TestUpdateReturning(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Register(db)
+	recorder.Return(`UPDATE "items"`, []string{"id", "name", "state", "note"}, []driver.Value{int64(7), "b", "archived", "set by a trigger"})
+
+	obj := item{ID: 7, Name: "b", State: "active"}
+	written := Set(db).Save(&obj)
+	if err := written.Error; err != nil {
+		t.Fatalf("Save=%v; want success", err)
+	}
+	queries := recorder.Writes()
+	if len(queries) == 0 || !strings.HasPrefix(queries[0], `UPDATE "items" SET `) || !strings.HasSuffix(queries[0], ` WHERE "items"."id" = $4 RETURNING *`) {
+		t.Fatalf("queries=%q; want the update returning the row", queries)
+	}
+	if obj.State != "archived" || obj.Note == nil || *obj.Note != "set by a trigger" {
+		t.Errorf("object=%+v; want the returned row", obj)
+	}
+	if !Returned(written) || written.RowsAffected != 1 {
+		t.Errorf("Returned=%v, RowsAffected=%d; want true, 1", Returned(written), written.RowsAffected)
+	}
+}
+
+func TestUpdateReturningNoRow(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Register(db)
+	obj := item{ID: 7, Name: "b"}
+	if err := Set(db).Save(&obj).Error; err != nil {
+		t.Fatalf("Save=%v; want success", err)
+	}
+	// an update returning no row leaves the Save to insert the object
+	queries := recorder.Writes()
+	if len(queries) < 2 || !strings.HasPrefix(queries[0], `UPDATE "items"`) || !strings.HasPrefix(queries[len(queries)-1], `INSERT INTO "items"`) {
+		t.Fatalf("queries=%q; want the update then the insert", queries)
+	}
+}
+
+func TestNotSet(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Register(db)
+	recorder.Return(`INSERT INTO "items"`, []string{"id"}, []driver.Value{int64(3)})
+	obj := item{Name: "a"}
+	written := db.Create(&obj)
+	if err := written.Error; err != nil {
+		t.Fatalf("Create=%v; want success", err)
+	}
+	// the replaced callback runs, reading back the primary key only
+	if queries := recorder.Writes(); len(queries) == 0 || !strings.HasSuffix(queries[0], `RETURNING "items"."id"`) {
+		t.Errorf("queries=%q; want the insert of gorm:create", queries)
+	}
+	if obj.ID != 3 {
+		t.Errorf("ID=%d; want 3", obj.ID)
+	}
+	if Returned(written) {
+		t.Error("Returned=true; want false")
+	}
+}
+
+func TestOtherEngine(t *testing.T) {
+	db, recorder, err := dbtest.Open("sqlite3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	Register(db)
+	obj := item{Name: "a"}
+	written := Set(db).Create(&obj)
+	if err := written.Error; err != nil {
+		t.Fatalf("Create=%v; want success", err)
+	}
+	if queries := recorder.Writes(); len(queries) == 0 || strings.Contains(queries[0], "RETURNING") {
+		t.Errorf("queries=%q; want the insert of gorm:create", queries)
+	}
+	if Returned(written) {
+		t.Error("Returned=true; want false")
+	}
+}
@@ -0,0 +1,26 @@
+package rls
+
+import (
+	"context"
+
+	"github.com/infobloxopen/protoc-gen-gorm/txn"
+	"github.com/jinzhu/gorm"
+)
+
+// Session is the transaction scope a row-level security session variable
+// was set in
+type Session = txn.Scope
+
+// Begin sets the session variable to the value extracted from ctx for the
+// rest of the transaction db is in. If db is not in a transaction yet, a new
+// one is opened and it must be finished by the returned Session.
+func Begin(ctx context.Context, db *gorm.DB, name string, extract func(context.Context) (string, error)) (*gorm.DB, *Session, error) {
+	value, err := extract(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return txn.Begin(ctx, db, func(tx *gorm.DB) error {
+		// set_config with is_local=true is the parametrized form of SET LOCAL
+		return tx.Exec("SELECT set_config(?, ?, true)", name, value).Error
+	})
+}
@@ -0,0 +1,51 @@
+package rls
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/infobloxopen/protoc-gen-gorm/internal/dbtest"
+)
+
+func TestBegin(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, session, err := Begin(context.Background(), db, "app.current_tenant", func(context.Context) (string, error) {
+		return "tenant-1", nil
+	})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if err := session.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	want := []dbtest.Statement{
+		{Query: "BEGIN"},
+		{Query: "SELECT set_config($1, $2, true)", Args: []interface{}{"app.current_tenant", "tenant-1"}},
+		{Query: "COMMIT"},
+	}
+	if got := recorder.Statements(); !reflect.DeepEqual(got, want) {
+		t.Errorf("statements=%v; want %v", got, want)
+	}
+}
+
+func TestBeginExtractFails(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := errors.New("no tenant")
+	if _, _, err := Begin(context.Background(), db, "app.current_tenant", func(context.Context) (string, error) {
+		return "", failed
+	}); err != failed {
+		t.Fatalf("Begin: %v; want %v", err, failed)
+	}
+	// nothing is opened without the value of the variable
+	if got := recorder.Queries(); len(got) != 0 {
+		t.Errorf("queries=%q; want none", got)
+	}
+}
@@ -0,0 +1,36 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"github.com/infobloxopen/protoc-gen-gorm/txn"
+	"github.com/jinzhu/gorm"
+)
+
+// EmptySchemaError is returned by Begin when the extractor gives no schema
+var EmptySchemaError = errors.New("tenant schema is empty")
+
+// Session is the transaction scope the search_path of a tenant was set in
+type Session = txn.Scope
+
+// Begin sets the search_path to the schema extracted from ctx followed by
+// public for the rest of the transaction db is in, so that the unqualified
+// tables are those of the tenant while the types and functions of extensions
+// installed in public, e.g. citext or PostGIS, still resolve. If db is not in a
+// transaction yet, a new one is opened and it must be finished by the returned
+// Session.
+func Begin(ctx context.Context, db *gorm.DB, extract func(context.Context) (string, error)) (*gorm.DB, *Session, error) {
+	schema, err := extract(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if schema == "" {
+		return nil, nil, EmptySchemaError
+	}
+	return txn.Begin(ctx, db, func(tx *gorm.DB) error {
+		// set_config with is_local=true is the parametrized form of SET LOCAL,
+		// quote_ident keeps the schema a single name whatever it contains
+		return tx.Exec("SELECT set_config('search_path', quote_ident(?) || ', public', true)", schema).Error
+	})
+}
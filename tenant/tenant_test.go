@@ -0,0 +1,51 @@
+package tenant
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/infobloxopen/protoc-gen-gorm/internal/dbtest"
+)
+
+func TestBegin(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	outer := db.Begin()
+	tx, session, err := Begin(context.Background(), outer, func(context.Context) (string, error) {
+		return "tenant_1", nil
+	})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if tx != outer {
+		t.Errorf("Begin returned %p; want the transaction of the caller %p", tx, outer)
+	}
+	if err := session.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	want := []dbtest.Statement{
+		{Query: "BEGIN"},
+		{Query: "SELECT set_config('search_path', quote_ident($1) || ', public', true)", Args: []interface{}{"tenant_1"}},
+	}
+	if got := recorder.Statements(); !reflect.DeepEqual(got, want) {
+		t.Errorf("statements=%v; want %v", got, want)
+	}
+}
+
+func TestBeginEmptySchema(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := Begin(context.Background(), db, func(context.Context) (string, error) {
+		return "", nil
+	}); err != EmptySchemaError {
+		t.Fatalf("Begin: %v; want %v", err, EmptySchemaError)
+	}
+	if got := recorder.Queries(); len(got) != 0 {
+		t.Errorf("queries=%q; want none", got)
+	}
+}
@@ -0,0 +1,29 @@
+package timeout
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/infobloxopen/protoc-gen-gorm/txn"
+	"github.com/jinzhu/gorm"
+)
+
+// Txn is the transaction scope the statements of a handler with a timeout
+// run in
+type Txn = txn.Scope
+
+// Begin opens a transaction bound to ctx, which carries the deadline of the
+// handler, unless db is in a transaction already, and on postgres sets the
+// statement_timeout for the rest of it. A transaction opened by Begin must be
+// finished by the returned Txn.
+func Begin(ctx context.Context, db *gorm.DB, timeout time.Duration) (*gorm.DB, *Txn, error) {
+	return txn.Begin(ctx, db, func(tx *gorm.DB) error {
+		if tx.Dialect().GetName() != "postgres" {
+			return nil
+		}
+		// a statement_timeout of 0 disables it
+		millis := (timeout + time.Millisecond - 1) / time.Millisecond
+		return tx.Exec("SELECT set_config('statement_timeout', ?, true)", strconv.FormatInt(int64(millis), 10)).Error
+	})
+}
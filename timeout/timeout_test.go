@@ -0,0 +1,48 @@
+package timeout
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/infobloxopen/protoc-gen-gorm/internal/dbtest"
+)
+
+func TestBeginPostgres(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, txn, err := Begin(context.Background(), db, 1500*time.Microsecond)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	txn.Rollback()
+	// the timeout is rounded up to whole milliseconds
+	want := []dbtest.Statement{
+		{Query: "BEGIN"},
+		{Query: "SELECT set_config('statement_timeout', $1, true)", Args: []interface{}{"2"}},
+		{Query: "ROLLBACK"},
+	}
+	if got := recorder.Statements(); !reflect.DeepEqual(got, want) {
+		t.Errorf("statements=%v; want %v", got, want)
+	}
+}
+
+func TestBeginOtherEngine(t *testing.T) {
+	db, recorder, err := dbtest.Open("sqlite3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, txn, err := Begin(context.Background(), db, 5*time.Second)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if err := txn.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	if got, want := recorder.Queries(), []string{"BEGIN", "COMMIT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("queries=%q; want %q", got, want)
+	}
+}
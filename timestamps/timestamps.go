@@ -0,0 +1,25 @@
+package timestamps
+
+import "github.com/jinzhu/gorm"
+
+// Skip replaces the gorm:update_time_stamp callbacks of db, which set the
+// CreatedAt and UpdatedAt of the objects created and updated, with ones
+// leaving them as they are for the objects for which skipped returns true,
+// the other writes run the replaced callbacks. The name marks the
+// registration, registering the name again has no effect.
+func Skip(db *gorm.DB, name string, skipped func(value interface{}) bool) {
+	if db.Callback().Create().Get(name) != nil {
+		return
+	}
+	// db.Callback() clones the callbacks of db each time, the processors of
+	// an earlier clone would not be registered with db
+	for _, callbacks := range []func(*gorm.Callback) *gorm.CallbackProcessor{(*gorm.Callback).Create, (*gorm.Callback).Update} {
+		stamp := callbacks(db.Callback()).Get("gorm:update_time_stamp")
+		callbacks(db.Callback()).Replace("gorm:update_time_stamp", func(scope *gorm.Scope) {
+			if !skipped(scope.Value) {
+				stamp(scope)
+			}
+		})
+	}
+	db.Callback().Create().Register(name, func(*gorm.Scope) {})
+}
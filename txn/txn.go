@@ -0,0 +1,58 @@
+// Package txn holds the transaction scope shared by the runtime helpers that
+// set up the transaction of a generated handler, and by the handlers that
+// need one of their own
+package txn
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/jinzhu/gorm"
+)
+
+// Scope is the transaction the statements of a handler run in, either that of
+// the caller or one opened by Begin
+type Scope struct {
+	txn   *gorm.DB
+	owned bool
+}
+
+// Begin returns db if it is in a transaction already, otherwise a new
+// transaction bound to ctx is opened and it must be finished by the returned
+// Scope. A non-nil setup then runs in the transaction, if it fails the
+// transaction opened by Begin is rolled back.
+func Begin(ctx context.Context, db *gorm.DB, setup func(*gorm.DB) error) (*gorm.DB, *Scope, error) {
+	scope := &Scope{txn: db}
+	if _, ok := db.CommonDB().(*sql.Tx); !ok {
+		if scope.txn = db.BeginTx(ctx, nil); scope.txn.Error != nil {
+			return nil, nil, scope.txn.Error
+		}
+		scope.owned = true
+	}
+	if setup != nil {
+		if err := setup(scope.txn); err != nil {
+			scope.Rollback()
+			return nil, nil, err
+		}
+	}
+	return scope.txn, scope, nil
+}
+
+// Commit commits the transaction opened by Begin, if any
+func (s *Scope) Commit() error {
+	if !s.owned {
+		return nil
+	}
+	s.owned = false
+	return s.txn.Commit().Error
+}
+
+// Rollback rolls back the transaction opened by Begin, unless it has already
+// been committed
+func (s *Scope) Rollback() {
+	if !s.owned {
+		return
+	}
+	s.owned = false
+	s.txn.Rollback()
+}
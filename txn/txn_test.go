@@ -0,0 +1,97 @@
+package txn
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/infobloxopen/protoc-gen-gorm/internal/dbtest"
+	"github.com/jinzhu/gorm"
+)
+
+func TestBeginOpensTransaction(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, scope, err := Begin(context.Background(), db, func(tx *gorm.DB) error {
+		return tx.Exec("SELECT 1").Error
+	})
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if err := tx.Exec("SELECT 2").Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := scope.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	// the scope is finished, neither commits nor rolls back again
+	if err := scope.Commit(); err != nil {
+		t.Fatalf("Commit again: %v", err)
+	}
+	scope.Rollback()
+	if got, want := recorder.Queries(), []string{"BEGIN", "SELECT 1", "SELECT 2", "COMMIT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("queries=%q; want %q", got, want)
+	}
+}
+
+func TestBeginRollback(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, scope, err := Begin(context.Background(), db, nil)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	scope.Rollback()
+	scope.Rollback()
+	if got, want := recorder.Queries(), []string{"BEGIN", "ROLLBACK"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("queries=%q; want %q", got, want)
+	}
+}
+
+func TestBeginReusesTransaction(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	outer := db.Begin()
+	tx, scope, err := Begin(context.Background(), outer, nil)
+	if err != nil {
+		t.Fatalf("Begin: %v", err)
+	}
+	if tx != outer {
+		t.Errorf("Begin returned %p; want the transaction of the caller %p", tx, outer)
+	}
+	// the transaction of the caller is left to the caller
+	if err := scope.Commit(); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+	scope.Rollback()
+	if got, want := recorder.Queries(), []string{"BEGIN"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("queries=%q; want %q", got, want)
+	}
+}
+
+func TestBeginSetupFails(t *testing.T) {
+	db, recorder, err := dbtest.Open("postgres")
+	if err != nil {
+		t.Fatal(err)
+	}
+	failed := errors.New("setup failed")
+	tx, scope, err := Begin(context.Background(), db, func(*gorm.DB) error {
+		return failed
+	})
+	if err != failed {
+		t.Fatalf("Begin: %v; want %v", err, failed)
+	}
+	if tx != nil || scope != nil {
+		t.Errorf("Begin returned %v, %v; want nil", tx, scope)
+	}
+	if got, want := recorder.Queries(), []string{"BEGIN", "ROLLBACK"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("queries=%q; want %q", got, want)
+	}
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// AnyJSON is a google.protobuf.Any stored as its protojson, e.g.
+// {"@type": "type.googleapis.com/example.IntPoint", "x": 1}, in a jsonb column.
+// A nil AnyJSON is NULL.
+type AnyJSON []byte
+
+// AnyJSONFromPB returns the protojson of a, an error if the message type of
+// the type URL is not in the registry or the value is malformed
+func AnyJSONFromPB(a *anypb.Any) (AnyJSON, error) {
+	if a == nil {
+		return nil, nil
+	}
+	data, err := protojson.MarshalOptions{Resolver: protoregistry.GlobalTypes}.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("Any of type %q: %w", a.GetTypeUrl(), err)
+	}
+	return data, nil
+}
+
+// ToPB returns the Any of the protojson, which keeps its type URL, an error
+// if the message type is not in the registry or the JSON is malformed
+func (j AnyJSON) ToPB() (*anypb.Any, error) {
+	if j == nil {
+		return nil, nil
+	}
+	a := &anypb.Any{}
+	if err := (protojson.UnmarshalOptions{Resolver: protoregistry.GlobalTypes}).Unmarshal(j, a); err != nil {
+		return nil, fmt.Errorf("Any %s: %w", j, err)
+	}
+	return a, nil
+}
+
+// Value implements the Value part of the sql scannable interface, the JSON
+// is written as a string so that it is not taken for a bytea
+func (j AnyJSON) Value() (driver.Value, error) {
+	if j == nil {
+		return nil, nil
+	}
+	return string(j), nil
+}
+
+// Scan implements the scan part of the sql scannable interface
+func (j *AnyJSON) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*j = nil
+	case []byte:
+		*j = append(AnyJSON{}, v...)
+	case string:
+		*j = AnyJSON(v)
+	default:
+		return errors.New("Could not cast value in AnyJSON.Scan as []byte or string")
+	}
+	return nil
+}
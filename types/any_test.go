@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestAnyJSONRoundTrip(t *testing.T) {
+	in, err := anypb.New(wrapperspb.String("value"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	j, err := AnyJSONFromPB(in)
+	if err != nil {
+		t.Fatalf("AnyJSONFromPB=%v; want success", err)
+	}
+	if !strings.Contains(string(j), `"@type":"type.googleapis.com/google.protobuf.StringValue"`) {
+		t.Errorf("AnyJSONFromPB=%s; want the @type of StringValue", j)
+	}
+	v, err := j.Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scanned AnyJSON
+	if err := scanned.Scan([]byte(v.(string))); err != nil {
+		t.Fatal(err)
+	}
+	out, err := scanned.ToPB()
+	if err != nil {
+		t.Fatalf("ToPB=%v; want success", err)
+	}
+	if !proto.Equal(in, out) {
+		t.Errorf("ToPB=%v; want %v", out, in)
+	}
+}
+
+func TestAnyJSONNull(t *testing.T) {
+	j, err := AnyJSONFromPB(nil)
+	if err != nil || j != nil {
+		t.Errorf("AnyJSONFromPB(nil)=%s, %v; want nil", j, err)
+	}
+	if v, err := j.Value(); err != nil || v != nil {
+		t.Errorf("Value()=%v, %v; want nil", v, err)
+	}
+	j = AnyJSON("{}")
+	if err := j.Scan(nil); err != nil || j != nil {
+		t.Errorf("Scan(nil)=%s, %v; want nil", j, err)
+	}
+	if a, err := j.ToPB(); err != nil || a != nil {
+		t.Errorf("ToPB()=%v, %v; want nil", a, err)
+	}
+}
+
+func TestAnyJSONUnknownType(t *testing.T) {
+	if _, err := AnyJSONFromPB(&anypb.Any{TypeUrl: "type.googleapis.com/example.Missing"}); err == nil {
+		t.Error("AnyJSONFromPB of an unregistered type should fail")
+	}
+	if _, err := AnyJSON(`{"@type": "type.googleapis.com/example.Missing"}`).ToPB(); err == nil {
+		t.Error("ToPB of an unregistered type should fail")
+	}
+	if _, err := AnyJSON(`{"@type": `).ToPB(); err == nil {
+		t.Error("ToPB of malformed JSON should fail")
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// YesNo is a bool stored as 'Y' or 'N' in a char(1) column
+type YesNo bool
+
+// Value implements the Value part of the sql scannable interface
+func (b YesNo) Value() (driver.Value, error) {
+	if b {
+		return "Y", nil
+	}
+	return "N", nil
+}
+
+// Scan implements the scan part of the sql scannable interface, NULL is
+// scanned as false
+func (b *YesNo) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case nil:
+		*b = false
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return fmt.Errorf("Could not cast value %v in YesNo.Scan as []byte or string", value)
+	}
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "Y":
+		*b = true
+	case "N":
+		*b = false
+	default:
+		return fmt.Errorf("Could not scan %q as YesNo, expected Y or N", s)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import "testing"
+
+func TestYesNoValue(t *testing.T) {
+	for in, expected := range map[YesNo]string{true: "Y", false: "N"} {
+		v, err := in.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != expected {
+			t.Errorf("YesNo(%v).Value()=%v; want %s", in, v, expected)
+		}
+	}
+}
+
+func TestYesNoScan(t *testing.T) {
+	for in, expected := range map[interface{}]YesNo{"Y": true, "n": false, "N ": false} {
+		b := YesNo(!expected)
+		if err := b.Scan(in); err != nil {
+			t.Fatal(err)
+		}
+		if b != expected {
+			t.Errorf("Scan(%q)=%v; want %v", in, b, expected)
+		}
+	}
+	b := YesNo(true)
+	if err := b.Scan([]byte("N")); err != nil || b {
+		t.Errorf("Scan([]byte N)=%v, %v; want false", b, err)
+	}
+	if err := b.Scan(nil); err != nil || b {
+		t.Errorf("Scan(nil)=%v, %v; want false", b, err)
+	}
+	if err := b.Scan("X"); err == nil {
+		t.Error("Scan(X) should fail")
+	}
+	if err := b.Scan(1); err == nil {
+		t.Error("Scan(1) should fail")
+	}
+}
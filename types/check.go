@@ -0,0 +1,16 @@
+package types
+
+import "fmt"
+
+// CheckDef describes a check constraint of a column, GORM v1 does not create
+// check constraints itself
+type CheckDef struct {
+	Name       string
+	Table      string
+	Expression string
+}
+
+// SQL returns the statement adding the check constraint to its table
+func (d CheckDef) SQL() string {
+	return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", d.Table, d.Name, d.Expression)
+}
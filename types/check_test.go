@@ -0,0 +1,10 @@
+package types
+
+import "testing"
+
+func TestCheckDefSQL(t *testing.T) {
+	def := CheckDef{Name: "chk_products_price", Table: "products", Expression: "price >= 0 OR state = 'draft'"}
+	if got, want := def.SQL(), "ALTER TABLE products ADD CONSTRAINT chk_products_price CHECK (price >= 0 OR state = 'draft')"; got != want {
+		t.Errorf("SQL()=%q; want %q", got, want)
+	}
+}
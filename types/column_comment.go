@@ -0,0 +1,19 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ColumnComment describes the comment of a column, postgres does not take
+// the COMMENT clause of the column definitions GORM v1 creates
+type ColumnComment struct {
+	Table   string
+	Column  string
+	Comment string
+}
+
+// SQL returns the statement setting the comment of the column
+func (c ColumnComment) SQL() string {
+	return fmt.Sprintf("COMMENT ON COLUMN %s.%s IS '%s'", c.Table, c.Column, strings.ReplaceAll(c.Comment, "'", "''"))
+}
@@ -0,0 +1,10 @@
+package types
+
+import "testing"
+
+func TestColumnCommentSQL(t *testing.T) {
+	comment := ColumnComment{Table: "users", Column: "email", Comment: "PII; the user's own"}
+	if got, want := comment.SQL(), "COMMENT ON COLUMN users.email IS 'PII; the user''s own'"; got != want {
+		t.Errorf("SQL()=%q; want %q", got, want)
+	}
+}
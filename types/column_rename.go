@@ -0,0 +1,16 @@
+package types
+
+import "fmt"
+
+// ColumnRename describes the rename of a column of a renamed field, GORM v1
+// AutoMigrate only ever adds columns
+type ColumnRename struct {
+	Table string
+	From  string
+	To    string
+}
+
+// SQL returns the statement renaming the column
+func (r ColumnRename) SQL() string {
+	return fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", r.Table, r.From, r.To)
+}
@@ -0,0 +1,10 @@
+package types
+
+import "testing"
+
+func TestColumnRenameSQL(t *testing.T) {
+	rename := ColumnRename{Table: "users", From: "mail", To: "email"}
+	if got, want := rename.SQL(), "ALTER TABLE users RENAME COLUMN mail TO email"; got != want {
+		t.Errorf("SQL()=%q; want %q", got, want)
+	}
+}
@@ -0,0 +1,203 @@
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// CompositeValue returns the row literal of a postgres composite value of the
+// attributes in order, e.g. ("1 Main St","Springfield",12345). The strings are
+// always quoted, an empty string is "" while a nil attribute is NULL.
+func CompositeValue(attrs ...interface{}) (driver.Value, error) {
+	var sb strings.Builder
+	sb.WriteByte('(')
+	for i, attr := range attrs {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		switch v := attr.(type) {
+		case nil:
+		case string:
+			sb.WriteByte('"')
+			for _, r := range v {
+				if r == '"' || r == '\\' {
+					sb.WriteByte('\\')
+				}
+				sb.WriteRune(r)
+			}
+			sb.WriteByte('"')
+		case bool:
+			if v {
+				sb.WriteByte('t')
+			} else {
+				sb.WriteByte('f')
+			}
+		case int32:
+			sb.WriteString(strconv.FormatInt(int64(v), 10))
+		case int64:
+			sb.WriteString(strconv.FormatInt(v, 10))
+		case uint32:
+			sb.WriteString(strconv.FormatUint(uint64(v), 10))
+		case uint64:
+			sb.WriteString(strconv.FormatUint(v, 10))
+		case float32:
+			sb.WriteString(formatCompositeFloat(float64(v), 32))
+		case float64:
+			sb.WriteString(formatCompositeFloat(v, 64))
+		default:
+			return nil, fmt.Errorf("composite attribute %d of type %T is not supported", i, attr)
+		}
+	}
+	sb.WriteByte(')')
+	return sb.String(), nil
+}
+
+// formatCompositeFloat writes the infinities the way postgres reads them
+func formatCompositeFloat(f float64, bitSize int) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	}
+	return strconv.FormatFloat(f, 'g', -1, bitSize)
+}
+
+// ScanComposite parses the row literal of a postgres composite value into the
+// pointers to the attributes in order, a *string, *bool, *int32, *int64,
+// *uint32, *uint64, *float32 or *float64. A NULL attribute leaves its
+// destination as is.
+func ScanComposite(value interface{}, dst ...interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return errors.New("Could not cast value in ScanComposite as []byte or string")
+	}
+	attrs, err := parseCompositeRow(s)
+	if err != nil {
+		return err
+	}
+	if len(attrs) != len(dst) {
+		return fmt.Errorf("composite %q has %d attributes, want %d", s, len(attrs), len(dst))
+	}
+	for i, attr := range attrs {
+		if attr == nil {
+			continue
+		}
+		if err := scanCompositeAttr(*attr, dst[i]); err != nil {
+			return fmt.Errorf("composite %q attribute %d: %v", s, i, err)
+		}
+	}
+	return nil
+}
+
+func scanCompositeAttr(s string, dst interface{}) error {
+	switch d := dst.(type) {
+	case *string:
+		*d = s
+	case *bool:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		*d = v
+	case *int32:
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		*d = int32(v)
+	case *int64:
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		*d = v
+	case *uint32:
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return err
+		}
+		*d = uint32(v)
+	case *uint64:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		*d = v
+	case *float32:
+		v, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return err
+		}
+		*d = float32(v)
+	case *float64:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		*d = v
+	default:
+		return fmt.Errorf("destination of type %T is not supported", dst)
+	}
+	return nil
+}
+
+// parseCompositeRow splits the row literal s into its attributes, nil for
+// NULL. An attribute may be quoted with double quotes, a doubled quote or a
+// backslash escapes the next character.
+func parseCompositeRow(s string) ([]*string, error) {
+	if len(s) < 2 || s[0] != '(' || s[len(s)-1] != ')' {
+		return nil, fmt.Errorf("composite %q is not a row literal", s)
+	}
+	body := s[1 : len(s)-1]
+	var attrs []*string
+	var sb strings.Builder
+	inQuotes, present := false, false
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case c == '\\':
+			if i+1 == len(body) {
+				return nil, fmt.Errorf("composite %q ends in an escape", s)
+			}
+			i++
+			sb.WriteByte(body[i])
+			present = true
+		case c == '"' && inQuotes && i+1 < len(body) && body[i+1] == '"':
+			i++
+			sb.WriteByte('"')
+		case c == '"':
+			inQuotes = !inQuotes
+			present = true
+		case c == ',' && !inQuotes:
+			attrs = append(attrs, compositeAttr(sb.String(), present))
+			sb.Reset()
+			present = false
+		default:
+			sb.WriteByte(c)
+			present = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("composite %q has an unterminated quote", s)
+	}
+	return append(attrs, compositeAttr(sb.String(), present)), nil
+}
+
+// compositeAttr returns the attribute, nil if nothing, not even "", was
+// written for it
+func compositeAttr(s string, present bool) *string {
+	if !present {
+		return nil
+	}
+	return &s
+}
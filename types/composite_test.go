@@ -0,0 +1,50 @@
+package types
+
+import "testing"
+
+func TestCompositeValue(t *testing.T) {
+	v, err := CompositeValue(`1 "Main" St\`, "", nil, int32(-7), uint64(18446744073709551615), true, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `("1 \"Main\" St\\","",,-7,18446744073709551615,t,0.5)`; v != want {
+		t.Errorf("CompositeValue()=%s; want %s", v, want)
+	}
+	if _, err := CompositeValue([]byte("x")); err == nil {
+		t.Error("CompositeValue of bytes should fail")
+	}
+}
+
+func TestScanComposite(t *testing.T) {
+	var (
+		street, city, empty string
+		zip                 int32
+		active              bool
+		ratio               float64
+	)
+	city = "kept"
+	// postgres quotes the attributes with spaces, commas or quotes and
+	// doubles the quotes
+	row := []byte(`("1 ""Main"", St\\",,"",12345,t,0.25)`)
+	if err := ScanComposite(row, &street, &city, &empty, &zip, &active, &ratio); err != nil {
+		t.Fatal(err)
+	}
+	if street != `1 "Main", St\` || city != "kept" || empty != "" || zip != 12345 || !active || ratio != 0.25 {
+		t.Errorf("ScanComposite()=%q %q %q %d %v %v", street, city, empty, zip, active, ratio)
+	}
+
+	v, _ := CompositeValue(street, "", int32(-1))
+	var back, blank string
+	var n int32
+	if err := ScanComposite(v, &back, &blank, &n); err != nil || back != street || blank != "" || n != -1 {
+		t.Errorf("ScanComposite(%s)=%q %q %d, %v", v, back, blank, n, err)
+	}
+
+	for _, bad := range []string{"1,2", `("a)`, "(a,b,c)", "(a,x)"} {
+		var a string
+		var b int32
+		if err := ScanComposite(bad, &a, &b); err == nil {
+			t.Errorf("ScanComposite(%s) should fail", bad)
+		}
+	}
+}
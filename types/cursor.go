@@ -0,0 +1,39 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// EncodeCursor returns the opaque cursor of a page of a keyset pagination,
+// the JSON of the sort values of its last row in base64url
+func EncodeCursor(values ...interface{}) (string, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor decodes the sort values of a cursor of EncodeCursor into the
+// pointers, the cursor must hold as many values
+func DecodeCursor(cursor string, values ...interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return err
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(values) {
+		return fmt.Errorf("cursor has %d values, want %d", len(raw), len(values))
+	}
+	for i, value := range raw {
+		if err := json.Unmarshal(value, values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	at := time.Date(2020, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	id := uuid.NewV4()
+	cursor, err := EncodeCursor(at, id, int64(-7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		gotAt time.Time
+		gotID uuid.UUID
+		gotN  int64
+	)
+	if err := DecodeCursor(cursor, &gotAt, &gotID, &gotN); err != nil {
+		t.Fatalf("DecodeCursor=%v; want success", err)
+	}
+	if !gotAt.Equal(at) || gotID != id || gotN != -7 {
+		t.Errorf("DecodeCursor=%v, %v, %d; want %v, %v, -7", gotAt, gotID, gotN, at, id)
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	two, err := EncodeCursor(1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n, m int64
+	var s string
+	for _, cursor := range []string{"not base64!", "bm90IGpzb24", two} {
+		if err := DecodeCursor(cursor, &n); err == nil {
+			t.Errorf("DecodeCursor(%q) should fail", cursor)
+		}
+	}
+	if err := DecodeCursor(two, &n, &s); err == nil {
+		t.Error("DecodeCursor of a number into a string should fail")
+	}
+	if err := DecodeCursor(two, &n, &m); err != nil || n != 1 || m != 2 {
+		t.Errorf("DecodeCursor=%d, %d, %v; want 1, 2", n, m, err)
+	}
+}
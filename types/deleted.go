@@ -0,0 +1,20 @@
+package types
+
+import "context"
+
+// includeDeletedKey is the context key of the conversions keeping the soft
+// deleted rows of associations
+type includeDeletedKey struct{}
+
+// WithDeleted returns a context making ToPB keep the soft deleted rows of the
+// associations, e.g. those of an Unscoped read, which are otherwise left out
+func WithDeleted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, includeDeletedKey{}, true)
+}
+
+// IncludeDeleted reports whether the conversions of ctx keep the soft deleted
+// rows of associations
+func IncludeDeleted(ctx context.Context) bool {
+	include, _ := ctx.Value(includeDeletedKey{}).(bool)
+	return include
+}
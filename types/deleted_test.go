@@ -0,0 +1,15 @@
+package types
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIncludeDeleted(t *testing.T) {
+	if IncludeDeleted(context.Background()) {
+		t.Error("IncludeDeleted without WithDeleted=true; want false")
+	}
+	if !IncludeDeleted(WithDeleted(context.Background())) {
+		t.Error("IncludeDeleted(WithDeleted)=false; want true")
+	}
+}
@@ -0,0 +1,145 @@
+package types
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Interval is a duration stored as a postgres interval, which keeps
+// microseconds
+type Interval time.Duration
+
+// DurationNanos returns the nanoseconds of d, an error if d is invalid or out
+// of the time.Duration range
+func DurationNanos(d *durationpb.Duration) (int64, error) {
+	if err := d.CheckValid(); err != nil {
+		return 0, err
+	}
+	nanos := d.AsDuration()
+	if back := durationpb.New(nanos); back.Seconds != d.Seconds || back.Nanos != d.Nanos {
+		return 0, fmt.Errorf("duration %v is out of range", d.AsDuration())
+	}
+	return int64(nanos), nil
+}
+
+// IntervalFromPB returns the Interval of d, an error if d is out of range or
+// has a precision the interval would not keep
+func IntervalFromPB(d *durationpb.Duration) (Interval, error) {
+	nanos, err := DurationNanos(d)
+	if err != nil {
+		return 0, err
+	}
+	if nanos%int64(time.Microsecond) != 0 {
+		return 0, fmt.Errorf("duration %v is more precise than an interval", time.Duration(nanos))
+	}
+	return Interval(nanos), nil
+}
+
+// Value implements the Value part of the sql scannable interface, the
+// interval is written as [-]hours:minutes:seconds.microseconds
+func (i Interval) Value() (driver.Value, error) {
+	d := time.Duration(i)
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	h, d := d/time.Hour, d%time.Hour
+	m, d := d/time.Minute, d%time.Minute
+	s, d := d/time.Second, d%time.Second
+	return fmt.Sprintf("%s%d:%02d:%02d.%06d", sign, h, m, s, d/time.Microsecond), nil
+}
+
+// Scan implements the scan part of the sql scannable interface for the
+// default postgres interval style, e.g. "1 year 2 mons 3 days 04:05:06.789",
+// years and months count 365.25 and 30 days like extract(epoch)
+func (i *Interval) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return errors.New("Could not cast value in Interval.Scan as []byte or string")
+	}
+	const day = 24 * time.Hour
+	var d time.Duration
+	fields := strings.Fields(s)
+	for n := 0; n < len(fields); n++ {
+		if strings.Contains(fields[n], ":") {
+			t, err := parseIntervalTime(fields[n])
+			if err != nil {
+				return fmt.Errorf("unexpected interval %q: %v", s, err)
+			}
+			d += t
+			continue
+		}
+		if n+1 == len(fields) {
+			return fmt.Errorf("unexpected interval %q", s)
+		}
+		count, err := strconv.ParseInt(fields[n], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unexpected interval %q: %v", s, err)
+		}
+		n++
+		switch strings.TrimSuffix(fields[n], "s") {
+		case "year":
+			d += time.Duration(count) * (365*day + 6*time.Hour)
+		case "mon":
+			d += time.Duration(count) * 30 * day
+		case "day":
+			d += time.Duration(count) * day
+		default:
+			return fmt.Errorf("unexpected interval %q", s)
+		}
+	}
+	*i = Interval(d)
+	return nil
+}
+
+// parseIntervalTime parses the [-]hours:minutes:seconds[.fraction] part of an
+// interval
+func parseIntervalTime(s string) (time.Duration, error) {
+	negative := strings.HasPrefix(s, "-")
+	parts := strings.Split(strings.TrimLeft(s, "+-"), ":")
+	if len(parts) != 3 {
+		return 0, errors.New("time is not hours:minutes:seconds")
+	}
+	h, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	seconds, fraction := parts[2], ""
+	if dot := strings.Index(seconds, "."); dot >= 0 {
+		seconds, fraction = seconds[:dot], seconds[dot+1:]
+	}
+	sec, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	d := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(sec)*time.Second
+	if fraction != "" {
+		if len(fraction) > 9 {
+			fraction = fraction[:9]
+		}
+		nanos, err := strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		d += time.Duration(nanos)
+	}
+	if negative {
+		d = -d
+	}
+	return d, nil
+}
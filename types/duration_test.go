@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestIntervalValue(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                                      "0:00:00.000000",
+		90*time.Minute + 1500*time.Microsecond: "1:30:00.001500",
+		-(26*time.Hour + 3*time.Second):        "-26:00:03.000000",
+	} {
+		v, err := Interval(d).Value()
+		if err != nil || v != want {
+			t.Errorf("Interval(%v).Value()=%v, %v; want %q", d, v, err, want)
+		}
+	}
+}
+
+func TestIntervalScan(t *testing.T) {
+	for s, want := range map[string]time.Duration{
+		"26:00:03":        26*time.Hour + 3*time.Second,
+		"-00:00:01.5":     -1500 * time.Millisecond,
+		"1 day -02:00:00": 22 * time.Hour,
+		"3 days":          72 * time.Hour,
+		"1 year":          8766 * time.Hour,
+	} {
+		var i Interval
+		if err := i.Scan([]byte(s)); err != nil || time.Duration(i) != want {
+			t.Errorf("Scan(%q)=%v, %v; want %v", s, time.Duration(i), err, want)
+		}
+	}
+	for _, s := range []string{"04:05", "P1D", "3 weeks", "day"} {
+		var i Interval
+		if err := i.Scan(s); err == nil {
+			t.Errorf("Scan(%q)=%v; want an error", s, time.Duration(i))
+		}
+	}
+}
+
+func TestIntervalFromPB(t *testing.T) {
+	if i, err := IntervalFromPB(&durationpb.Duration{Seconds: 5, Nanos: 1000}); err != nil || time.Duration(i) != 5*time.Second+time.Microsecond {
+		t.Errorf("IntervalFromPB=%v, %v; want 5.000001s", time.Duration(i), err)
+	}
+	if _, err := IntervalFromPB(&durationpb.Duration{Nanos: 1}); err == nil {
+		t.Error("IntervalFromPB of 1ns succeeded; want an error")
+	}
+	if _, err := DurationNanos(&durationpb.Duration{Seconds: 300 * 365 * 24 * 3600}); err == nil {
+		t.Error("DurationNanos out of range succeeded; want an error")
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"database/sql/driver"
+
+	"github.com/lib/pq"
+)
+
+// EnumNames are the names of the values of a repeated enum in a postgres
+// text[] column. No names are written as the empty array {} rather than NULL,
+// while a nil EnumNames is left out of the conditions of a query struct.
+type EnumNames []string
+
+// Value implements the Value part of the sql scannable interface
+func (a EnumNames) Value() (driver.Value, error) {
+	if a == nil {
+		return pq.StringArray{}.Value()
+	}
+	return pq.StringArray(a).Value()
+}
+
+// Scan implements the scan part of the sql scannable interface, NULL and {}
+// are scanned as no names
+func (a *EnumNames) Scan(value interface{}) error {
+	var names pq.StringArray
+	if err := names.Scan(value); err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		*a = nil
+		return nil
+	}
+	*a = EnumNames(names)
+	return nil
+}
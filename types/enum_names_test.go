@@ -0,0 +1,41 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEnumNamesValue(t *testing.T) {
+	for _, tc := range []struct {
+		in       EnumNames
+		expected string
+	}{
+		{nil, "{}"},
+		{EnumNames{}, "{}"},
+		{EnumNames{"GOOD", "BAD"}, `{"GOOD","BAD"}`},
+	} {
+		v, err := tc.in.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != tc.expected {
+			t.Errorf("EnumNames(%v).Value()=%v; want %s", tc.in, v, tc.expected)
+		}
+	}
+}
+
+func TestEnumNamesScan(t *testing.T) {
+	a := EnumNames{"GOOD"}
+	if err := a.Scan([]byte(`{GOOD,BAD}`)); err != nil || !reflect.DeepEqual(a, EnumNames{"GOOD", "BAD"}) {
+		t.Errorf("Scan({GOOD,BAD})=%v, %v; want [GOOD BAD]", a, err)
+	}
+	for _, in := range []interface{}{nil, []byte("{}"), "{}"} {
+		a = EnumNames{"GOOD"}
+		if err := a.Scan(in); err != nil || a != nil {
+			t.Errorf("Scan(%v)=%v, %v; want nil", in, a, err)
+		}
+	}
+	if err := a.Scan(1); err == nil {
+		t.Error("Scan(1) should fail")
+	}
+}
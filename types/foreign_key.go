@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ForeignKeyDef describes the foreign key of an association between ORM
+// types, GORM v1 does not create foreign keys itself
+type ForeignKeyDef struct {
+	Name              string
+	Table             string
+	Columns           []string
+	References        string
+	ReferencedColumns []string
+	// OnDelete and OnUpdate are the referential actions, e.g. CASCADE, empty
+	// for the default of the engine
+	OnDelete string
+	OnUpdate string
+	// Deferred makes the constraint DEFERRABLE INITIALLY DEFERRED, so it is
+	// only checked when the transaction commits
+	Deferred bool
+}
+
+// SQL returns the statement adding the foreign key to its table
+func (d ForeignKeyDef) SQL() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "ALTER TABLE %s ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)",
+		d.Table, d.Name, strings.Join(d.Columns, ", "), d.References, strings.Join(d.ReferencedColumns, ", "))
+	if d.OnDelete != "" {
+		fmt.Fprintf(&b, " ON DELETE %s", d.OnDelete)
+	}
+	if d.OnUpdate != "" {
+		fmt.Fprintf(&b, " ON UPDATE %s", d.OnUpdate)
+	}
+	if d.Deferred {
+		b.WriteString(" DEFERRABLE INITIALLY DEFERRED")
+	}
+	return b.String()
+}
@@ -0,0 +1,21 @@
+package types
+
+import "testing"
+
+func TestForeignKeyDefSQL(t *testing.T) {
+	tests := []struct {
+		def  ForeignKeyDef
+		want string
+	}{
+		{ForeignKeyDef{Name: "fk_emails_user_id", Table: "emails", Columns: []string{"user_id"}, References: "users", ReferencedColumns: []string{"id"}},
+			"ALTER TABLE emails ADD CONSTRAINT fk_emails_user_id FOREIGN KEY (user_id) REFERENCES users (id)"},
+		{ForeignKeyDef{Name: "fk_cards_user_id", Table: "cards", Columns: []string{"user_id"}, References: "users", ReferencedColumns: []string{"id"},
+			OnDelete: "SET NULL", OnUpdate: "CASCADE", Deferred: true},
+			"ALTER TABLE cards ADD CONSTRAINT fk_cards_user_id FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE SET NULL ON UPDATE CASCADE DEFERRABLE INITIALLY DEFERRED"},
+	}
+	for _, test := range tests {
+		if got := test.def.SQL(); got != test.want {
+			t.Errorf("SQL()=%q; want %q", got, test.want)
+		}
+	}
+}
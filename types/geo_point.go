@@ -0,0 +1,118 @@
+package types
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Point is a WGS 84 coordinate stored in a PostGIS geography(Point,4326) or
+// geometry(Point,4326) column
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// pointSRID is the spatial reference of the coordinates, WGS 84
+const pointSRID = 4326
+
+// NewPoint returns the Point of the coordinates, an error if they are out of
+// range
+func NewPoint(lat, lng float64) (*Point, error) {
+	if math.IsNaN(lat) || lat < -90 || lat > 90 {
+		return nil, fmt.Errorf("latitude %v is not within [-90, 90]", lat)
+	}
+	if math.IsNaN(lng) || lng < -180 || lng > 180 {
+		return nil, fmt.Errorf("longitude %v is not within [-180, 180]", lng)
+	}
+	return &Point{Lat: lat, Lng: lng}, nil
+}
+
+// Value implements the Value part of the sql scannable interface, the point
+// is written as EWKT, longitude first
+func (p Point) Value() (driver.Value, error) {
+	return fmt.Sprintf("SRID=%d;POINT(%s %s)", pointSRID,
+		strconv.FormatFloat(p.Lng, 'g', -1, 64), strconv.FormatFloat(p.Lat, 'g', -1, 64)), nil
+}
+
+// Scan implements the scan part of the sql scannable interface for the hex
+// EWKB PostGIS returns and for WKT or EWKT
+func (p *Point) Scan(value interface{}) error {
+	var s string
+	switch v := value.(type) {
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return errors.New("Could not cast value in Point.Scan as []byte or string")
+	}
+	if strings.Contains(strings.ToUpper(s), "POINT") {
+		return p.scanWKT(s)
+	}
+	return p.scanEWKB(s)
+}
+
+// scanWKT parses [SRID=4326;]POINT(lng lat)
+func (p *Point) scanWKT(s string) error {
+	wkt := strings.TrimSpace(s)
+	if i := strings.Index(wkt, ";"); i >= 0 {
+		if srid := strings.TrimSpace(wkt[:i]); srid != fmt.Sprintf("SRID=%d", pointSRID) {
+			return fmt.Errorf("point %q is not of SRID %d", s, pointSRID)
+		}
+		wkt = strings.TrimSpace(wkt[i+1:])
+	}
+	upper := strings.ToUpper(wkt)
+	if !strings.HasPrefix(upper, "POINT") || !strings.HasSuffix(wkt, ")") || !strings.Contains(wkt, "(") {
+		return fmt.Errorf("unexpected point %q", s)
+	}
+	coords := strings.Fields(wkt[strings.Index(wkt, "(")+1 : len(wkt)-1])
+	if len(coords) != 2 {
+		return fmt.Errorf("unexpected point %q", s)
+	}
+	lng, err := strconv.ParseFloat(coords[0], 64)
+	if err != nil {
+		return fmt.Errorf("unexpected point %q: %v", s, err)
+	}
+	lat, err := strconv.ParseFloat(coords[1], 64)
+	if err != nil {
+		return fmt.Errorf("unexpected point %q: %v", s, err)
+	}
+	p.Lat, p.Lng = lat, lng
+	return nil
+}
+
+// scanEWKB parses the hex EWKB of a 2D point
+func (p *Point) scanEWKB(s string) error {
+	data, err := hex.DecodeString(s)
+	if err != nil || len(data) < 5 {
+		return fmt.Errorf("unexpected point %q", s)
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if data[0] == 0 {
+		order = binary.BigEndian
+	}
+	const hasSRID = 0x20000000
+	kind := order.Uint32(data[1:5])
+	rest := data[5:]
+	if kind&hasSRID != 0 {
+		if len(rest) < 4 {
+			return fmt.Errorf("unexpected point %q", s)
+		}
+		if srid := order.Uint32(rest[:4]); srid != pointSRID {
+			return fmt.Errorf("point %q is of SRID %d, not %d", s, srid, pointSRID)
+		}
+		rest = rest[4:]
+	}
+	if kind&^hasSRID != 1 || len(rest) != 16 {
+		return fmt.Errorf("point %q is not a 2D point", s)
+	}
+	p.Lng = math.Float64frombits(order.Uint64(rest[:8]))
+	p.Lat = math.Float64frombits(order.Uint64(rest[8:]))
+	return nil
+}
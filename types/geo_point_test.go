@@ -0,0 +1,50 @@
+package types
+
+import "testing"
+
+func TestPointValue(t *testing.T) {
+	v, err := Point{Lat: 42.5, Lng: -71}.Value()
+	if err != nil || v != "SRID=4326;POINT(-71 42.5)" {
+		t.Errorf("Value()=%v, %v; want SRID=4326;POINT(-71 42.5)", v, err)
+	}
+}
+
+func TestPointScan(t *testing.T) {
+	for _, in := range []interface{}{
+		// SRID=4326;POINT(1 2) as returned by PostGIS
+		[]byte("0101000020E6100000000000000000F03F0000000000000040"),
+		"0020000001000010E63FF00000000000004000000000000000",
+		"0101000000000000000000F03F0000000000000040",
+		"SRID=4326;POINT(1 2)",
+		"POINT(1 2)",
+	} {
+		var p Point
+		if err := p.Scan(in); err != nil || p.Lng != 1 || p.Lat != 2 {
+			t.Errorf("Scan(%s)=%v, %+v; want lng 1, lat 2", in, err, p)
+		}
+	}
+	for _, in := range []interface{}{
+		"0101000020110F0000000000000000F03F0000000000000040",
+		"0102000000",
+		"SRID=3857;POINT(1 2)",
+		"POINT(1)",
+		"zz",
+		1,
+	} {
+		var p Point
+		if err := p.Scan(in); err == nil {
+			t.Errorf("Scan(%v)=%+v; want an error", in, p)
+		}
+	}
+}
+
+func TestNewPoint(t *testing.T) {
+	if p, err := NewPoint(-90, 180); err != nil || p.Lat != -90 || p.Lng != 180 {
+		t.Errorf("NewPoint(-90, 180)=%v, %v; want the point", p, err)
+	}
+	for _, coords := range [][2]float64{{91, 0}, {0, -181}} {
+		if _, err := NewPoint(coords[0], coords[1]); err == nil {
+			t.Errorf("NewPoint(%v)=nil; want an error", coords)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IndexDef describes a DB index declared by the gorm tags of an ORM type
+type IndexDef struct {
+	Name    string
+	Columns []string
+	Unique  bool
+	// Where holds the predicate of a partial index, empty for a full index
+	Where string
+	// Type is the postgres index method, e.g. gin, empty for the default
+	Type string
+	// NullsNotDistinct makes a unique index treat NULLs as equal, which
+	// needs postgres 15
+	NullsNotDistinct bool
+	// Include holds the columns stored in a covering index for index-only
+	// scans, which needs postgres 11
+	Include []string
+}
+
+// SQL returns the statement creating the index on the table, GORM does not
+// create the indexes with a Type, NullsNotDistinct, Include or Where itself,
+// nor those with the Columns in another order than the struct fields
+func (d IndexDef) SQL(table string) string {
+	var b strings.Builder
+	b.WriteString("CREATE ")
+	if d.Unique {
+		b.WriteString("UNIQUE ")
+	}
+	fmt.Fprintf(&b, "INDEX IF NOT EXISTS %s ON %s", d.Name, table)
+	if d.Type != "" {
+		fmt.Fprintf(&b, " USING %s", d.Type)
+	}
+	fmt.Fprintf(&b, " (%s)", strings.Join(d.Columns, ", "))
+	if len(d.Include) > 0 {
+		fmt.Fprintf(&b, " INCLUDE (%s)", strings.Join(d.Include, ", "))
+	}
+	if d.NullsNotDistinct {
+		b.WriteString(" NULLS NOT DISTINCT")
+	}
+	if d.Where != "" {
+		fmt.Fprintf(&b, " WHERE %s", d.Where)
+	}
+	return b.String()
+}
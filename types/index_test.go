@@ -0,0 +1,24 @@
+package types
+
+import "testing"
+
+func TestIndexDefSQL(t *testing.T) {
+	tests := []struct {
+		def  IndexDef
+		want string
+	}{
+		{IndexDef{Name: "idx_meta", Columns: []string{"meta"}, Type: "gin"},
+			"CREATE INDEX IF NOT EXISTS idx_meta ON things USING gin (meta)"},
+		{IndexDef{Name: "idx_name", Columns: []string{"a", "b"}, Unique: true, Where: "deleted_at IS NULL"},
+			"CREATE UNIQUE INDEX IF NOT EXISTS idx_name ON things (a, b) WHERE deleted_at IS NULL"},
+		{IndexDef{Name: "uix_code", Columns: []string{"code"}, Unique: true, NullsNotDistinct: true},
+			"CREATE UNIQUE INDEX IF NOT EXISTS uix_code ON things (code) NULLS NOT DISTINCT"},
+		{IndexDef{Name: "uix_code_name", Columns: []string{"code"}, Unique: true, NullsNotDistinct: true, Include: []string{"email", "name"}},
+			"CREATE UNIQUE INDEX IF NOT EXISTS uix_code_name ON things (code) INCLUDE (email, name) NULLS NOT DISTINCT"},
+	}
+	for _, test := range tests {
+		if got := test.def.SQL("things"); got != test.want {
+			t.Errorf("SQL()=%q; want %q", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+import "strings"
+
+// Default stands for the default of its column in the values of InsertTuple
+var Default = columnDefault{}
+
+type columnDefault struct{}
+
+// InsertTuple returns the parenthesized placeholders of a row of a multi-row
+// INSERT and the values bound to them, a Default value is written as DEFAULT
+// so that the column takes its default as it would in a GORM create
+func InsertTuple(values []interface{}) (string, []interface{}) {
+	placeholders := make([]string, len(values))
+	args := make([]interface{}, 0, len(values))
+	for i, value := range values {
+		if _, ok := value.(columnDefault); ok {
+			placeholders[i] = "DEFAULT"
+			continue
+		}
+		placeholders[i] = "?"
+		args = append(args, value)
+	}
+	return "(" + strings.Join(placeholders, ", ") + ")", args
+}
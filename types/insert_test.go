@@ -0,0 +1,24 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInsertTuple(t *testing.T) {
+	tuple, args := InsertTuple([]interface{}{"a", Default, 2, Default})
+	if want := "(?, DEFAULT, ?, DEFAULT)"; tuple != want {
+		t.Errorf("tuple=%q; want %q", tuple, want)
+	}
+	if want := []interface{}{"a", 2}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args=%v; want %v", args, want)
+	}
+
+	tuple, args = InsertTuple([]interface{}{nil, ""})
+	if want := "(?, ?)"; tuple != want {
+		t.Errorf("tuple=%q; want %q", tuple, want)
+	}
+	if want := []interface{}{nil, ""}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args=%v; want %v", args, want)
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// JSONPath decodes the value at the dotted path in the JSON object data into
+// dst. It returns false, leaving dst as is, if a key is missing, the value is
+// null or it is not of the type of dst.
+func JSONPath(data []byte, path string, dst interface{}) bool {
+	raw := json.RawMessage(data)
+	for _, key := range strings.Split(path, ".") {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil || object == nil {
+			return false
+		}
+		var ok bool
+		if raw, ok = object[key]; !ok {
+			return false
+		}
+	}
+	if string(raw) == "null" {
+		return false
+	}
+	return json.Unmarshal(raw, dst) == nil
+}
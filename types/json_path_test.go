@@ -0,0 +1,32 @@
+package types
+
+import "testing"
+
+func TestJSONPath(t *testing.T) {
+	data := []byte(`{"region": "eu", "limits": {"max": 10, "ratio": 0.5, "on": true}, "gone": null}`)
+	var s string
+	if !JSONPath(data, "region", &s) || s != "eu" {
+		t.Errorf("JSONPath(region)=%q; want eu", s)
+	}
+	var n int64
+	if !JSONPath(data, "limits.max", &n) || n != 10 {
+		t.Errorf("JSONPath(limits.max)=%d; want 10", n)
+	}
+	var f float64
+	if !JSONPath(data, "limits.ratio", &f) || f != 0.5 {
+		t.Errorf("JSONPath(limits.ratio)=%v; want 0.5", f)
+	}
+	var b bool
+	if !JSONPath(data, "limits.on", &b) || !b {
+		t.Errorf("JSONPath(limits.on)=%v; want true", b)
+	}
+	for _, path := range []string{"missing", "limits.missing", "region.sub", "gone", "limits.ratio"} {
+		n = 0
+		if JSONPath(data, path, &n) || n != 0 {
+			t.Errorf("JSONPath(%s)=%d; want missing", path, n)
+		}
+	}
+	if JSONPath(nil, "region", &s) {
+		t.Error("JSONPath of no JSON should be missing")
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+// LockWait selects what a locking read does about the rows locked by other
+// transactions
+type LockWait int
+
+const (
+	// LockWaitBlock waits until the other transactions release the rows
+	LockWaitBlock LockWait = iota
+	// LockWaitNoWait fails at once with a lock_not_available error
+	LockWaitNoWait
+	// LockWaitSkipLocked leaves the locked rows out, so that workers can
+	// claim different rows
+	LockWaitSkipLocked
+)
+
+// ForUpdate returns the locking clause of a SELECT ... FOR UPDATE waiting
+// for the locked rows as w
+func (w LockWait) ForUpdate() string {
+	switch w {
+	case LockWaitNoWait:
+		return "FOR UPDATE NOWAIT"
+	case LockWaitSkipLocked:
+		return "FOR UPDATE SKIP LOCKED"
+	default:
+		return "FOR UPDATE"
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+import "testing"
+
+func TestLockWaitForUpdate(t *testing.T) {
+	for w, expected := range map[LockWait]string{
+		LockWaitBlock:      "FOR UPDATE",
+		LockWaitNoWait:     "FOR UPDATE NOWAIT",
+		LockWaitSkipLocked: "FOR UPDATE SKIP LOCKED",
+	} {
+		if got := w.ForUpdate(); got != expected {
+			t.Errorf("LockWait(%d).ForUpdate()=%q; want %q", w, got, expected)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseMinorUnits returns the integer count of the minor units of the decimal
+// amount s with scale decimal places, e.g. 1205 for "12.05" with a scale of 2.
+// It fails if s has more decimal places than the scale or does not fit.
+func ParseMinorUnits(s string, scale int) (int64, error) {
+	digits := strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	whole, fraction := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		whole, fraction = digits[:i], digits[i+1:]
+	}
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("amount %q is not a decimal number", s)
+	}
+	if len(fraction) > scale {
+		return 0, fmt.Errorf("amount %q has more than %d decimal places", s, scale)
+	}
+	v, err := strconv.ParseUint(whole+fraction+strings.Repeat("0", scale-len(fraction)), 10, 64)
+	negative := strings.HasPrefix(s, "-")
+	if err != nil || !negative && v > math.MaxInt64 || negative && v > -math.MinInt64 {
+		return 0, fmt.Errorf("amount %q does not fit in 64 bits of minor units", s)
+	}
+	if negative {
+		return -int64(v), nil
+	}
+	return int64(v), nil
+}
+
+// FormatMinorUnits returns the decimal amount of the count v of minor units
+// with scale decimal places, e.g. "-12.05" for -1205 with a scale of 2
+func FormatMinorUnits(v int64, scale int) string {
+	sign := ""
+	u := uint64(v)
+	if v < 0 {
+		sign, u = "-", -u
+	}
+	digits := strconv.FormatUint(u, 10)
+	if scale <= 0 {
+		return sign + digits
+	}
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+}
+
+// isDigits reports whether s is a run of decimal digits, which may be empty
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseMinorUnits(t *testing.T) {
+	for _, tc := range []struct {
+		in    string
+		scale int
+		want  int64
+	}{
+		{"12.05", 2, 1205},
+		{"12.5", 2, 1250},
+		{"12", 2, 1200},
+		{"-0.07", 2, -7},
+		{"+3.", 2, 300},
+		{".5", 3, 500},
+		{"42", 0, 42},
+		{"-9223372036854775808", 0, math.MinInt64},
+		{"92233720368547758.07", 2, math.MaxInt64},
+	} {
+		if v, err := ParseMinorUnits(tc.in, tc.scale); err != nil || v != tc.want {
+			t.Errorf("ParseMinorUnits(%q, %d)=%d, %v; want %d", tc.in, tc.scale, v, err, tc.want)
+		}
+	}
+	for _, in := range []string{"12.055", "1.5e2", "", "-", ".", "1,5", "1.2.3", "--1", "92233720368547758.08"} {
+		if v, err := ParseMinorUnits(in, 2); err == nil {
+			t.Errorf("ParseMinorUnits(%q, 2)=%d; want an error", in, v)
+		}
+	}
+}
+
+func TestFormatMinorUnits(t *testing.T) {
+	for _, tc := range []struct {
+		in    int64
+		scale int
+		want  string
+	}{
+		{1205, 2, "12.05"},
+		{-1205, 2, "-12.05"},
+		{7, 2, "0.07"},
+		{-7, 3, "-0.007"},
+		{0, 2, "0.00"},
+		{42, 0, "42"},
+		{math.MinInt64, 2, "-92233720368547758.08"},
+	} {
+		if s := FormatMinorUnits(tc.in, tc.scale); s != tc.want {
+			t.Errorf("FormatMinorUnits(%d, %d)=%q; want %q", tc.in, tc.scale, s, tc.want)
+		}
+		if v, err := ParseMinorUnits(tc.want, tc.scale); err != nil || v != tc.in {
+			t.Errorf("ParseMinorUnits(%q, %d)=%d, %v; want %d", tc.want, tc.scale, v, err, tc.in)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import "context"
+
+// ConditionalPreload is an association the Read and List handlers of a type
+// preload when Cond holds for their context
+type ConditionalPreload struct {
+	Name string
+	Cond func(context.Context) bool
+}
+
+// conditionalPreloadsKey is the context key of the conditional preloads of a
+// type, named by its message
+type conditionalPreloadsKey struct {
+	typeName string
+}
+
+// WithConditionalPreload returns a context making the Read and List handlers
+// of the type, named by its message, e.g. "User", preload the association
+// name when cond holds for their context. The handlers fail with
+// errors.UnknownPreloadError if name is not an association of the type.
+func WithConditionalPreload(ctx context.Context, typeName, name string, cond func(context.Context) bool) context.Context {
+	key := conditionalPreloadsKey{typeName}
+	preloads := ConditionalPreloads(ctx, typeName)
+	// the preloads of the parent context stay as they are
+	preloads = append(preloads[:len(preloads):len(preloads)], ConditionalPreload{Name: name, Cond: cond})
+	return context.WithValue(ctx, key, preloads)
+}
+
+// ConditionalPreloads returns the conditional preloads of the type in ctx
+func ConditionalPreloads(ctx context.Context, typeName string) []ConditionalPreload {
+	preloads, _ := ctx.Value(conditionalPreloadsKey{typeName}).([]ConditionalPreload)
+	return preloads
+}
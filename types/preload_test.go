@@ -0,0 +1,25 @@
+package types
+
+import (
+	"context"
+	"testing"
+)
+
+func TestConditionalPreloads(t *testing.T) {
+	always := func(context.Context) bool { return true }
+	ctx := WithConditionalPreload(context.Background(), "User", "Emails", always)
+	if preloads := ConditionalPreloads(ctx, "User"); len(preloads) != 1 || preloads[0].Name != "Emails" || !preloads[0].Cond(ctx) {
+		t.Errorf("ConditionalPreloads(User)=%v; want Emails", preloads)
+	}
+	child := WithConditionalPreload(ctx, "User", "Tasks", always)
+	other := WithConditionalPreload(ctx, "User", "Friends", always)
+	if preloads := ConditionalPreloads(child, "User"); len(preloads) != 2 || preloads[1].Name != "Tasks" {
+		t.Errorf("ConditionalPreloads of the child=%v; want Emails, Tasks", preloads)
+	}
+	if preloads := ConditionalPreloads(other, "User"); len(preloads) != 2 || preloads[1].Name != "Friends" {
+		t.Errorf("ConditionalPreloads of the other child=%v; want Emails, Friends", preloads)
+	}
+	if preloads := ConditionalPreloads(ctx, "Task"); len(preloads) != 0 {
+		t.Errorf("ConditionalPreloads(Task)=%v; want none", preloads)
+	}
+}
@@ -0,0 +1,10 @@
+package types
+
+// WriteResult reports the outcome of a generated update or delete handler
+type WriteResult struct {
+	// RowsAffected is the count reported by the database for the write
+	RowsAffected int64
+	// Found reports whether the row existed before the write, an update of
+	// a missing row falls back to creating it
+	Found bool
+}
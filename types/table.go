@@ -0,0 +1,31 @@
+package types
+
+// TableInfo describes the table of an ORM type, as generated with the
+// describe parameter
+type TableInfo struct {
+	Name         string
+	Columns      []ColumnInfo
+	Associations []AssociationInfo
+}
+
+// ColumnInfo describes a column of an ORM type
+type ColumnInfo struct {
+	Name string
+	// Type is the type tag of the column, empty when the dialect picks it
+	Type       string
+	GoType     string
+	Nullable   bool
+	PrimaryKey bool
+}
+
+// AssociationInfo describes an association of an ORM type
+type AssociationInfo struct {
+	Field string
+	// Kind is one of has_one, has_many, belongs_to and many_to_many
+	Kind string
+	// Type is the ORM type of the associated objects
+	Type       string
+	ForeignKey string
+	// JoinTable is only set for many_to_many
+	JoinTable string
+}
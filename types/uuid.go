@@ -0,0 +1,10 @@
+package types
+
+import "github.com/google/uuid"
+
+// NewUUID returns the values of the default_uuid fields left empty on create,
+// a random google/uuid by default. A program may set another generator, e.g.
+// of time ordered UUIDs, ahead of its first create.
+var NewUUID = func() string {
+	return uuid.New().String()
+}
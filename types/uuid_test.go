@@ -0,0 +1,19 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewUUID(t *testing.T) {
+	first, second := NewUUID(), NewUUID()
+	if first == second {
+		t.Errorf("NewUUID returned %s twice", first)
+	}
+	for _, s := range []string{first, second} {
+		if id, err := uuid.Parse(s); err != nil || id.Version() != 4 {
+			t.Errorf("NewUUID=%s is not a random UUID: %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package types
+
+import "context"
+
+// visitedKey is the context key of an object being converted
+type visitedKey struct {
+	object interface{}
+}
+
+// WithVisited returns a context recording that the object, a pointer, is
+// being converted, the conversion of a back-reference to it is skipped so
+// that a cyclic graph of ORM objects converts to a tree of messages
+func WithVisited(ctx context.Context, object interface{}) context.Context {
+	return context.WithValue(ctx, visitedKey{object}, true)
+}
+
+// Visited reports whether the object is being converted by a caller of
+// the conversion of ctx
+func Visited(ctx context.Context, object interface{}) bool {
+	visited, _ := ctx.Value(visitedKey{object}).(bool)
+	return visited
+}
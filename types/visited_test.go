@@ -0,0 +1,21 @@
+package types
+
+import (
+	"context"
+	"testing"
+)
+
+func TestVisited(t *testing.T) {
+	type node struct{ id int }
+	a, b := &node{1}, &node{1}
+	ctx := WithVisited(context.Background(), a)
+	if !Visited(ctx, a) {
+		t.Error("Visited(a)=false; want true")
+	}
+	if Visited(ctx, b) {
+		t.Error("Visited of an equal object=true; want false, objects are told apart by pointer")
+	}
+	if Visited(context.Background(), a) {
+		t.Error("Visited without WithVisited=true; want false")
+	}
+}